@@ -53,6 +53,7 @@ const (
 	SMASessionStart          = "SMA_SESSION_START"
 	SMASessionTerminate      = "SMA_SESSION_TERMINATE"
 	ARICGRResourceAllocation = "CGRResourceAllocation"
+	ARITimeoutAbsolute       = "TIMEOUT(absolute)" // dialplan function limiting the remaining duration of the channel
 )
 
 // NewAsteriskAgent constructs a new Asterisk Agent
@@ -133,13 +134,19 @@ func (sma *AsteriskAgent) ListenAndServe(stopChan <-chan struct{}) (err error) {
 	}
 }
 
-// setChannelVar will set the value of a variable
-func (sma *AsteriskAgent) setChannelVar(chanID string, vrblName, vrblVal string) (success bool) {
-	if _, err := sma.astConn.Call(aringo.HTTP_POST,
+// setChannelVariable will set the value of a variable on the channel
+func (sma *AsteriskAgent) setChannelVariable(chanID string, vrblName, vrblVal string) (err error) {
+	_, err = sma.astConn.Call(aringo.HTTP_POST,
 		fmt.Sprintf("http://%s/ari/channels/%s/variable?variable=%s&value=%s", // Asterisk having issue with variable terminating empty so harcoding param in url
 			sma.cgrCfg.AsteriskAgentCfg().AsteriskConns[sma.astConnIdx].Address,
 			chanID, vrblName, vrblVal),
-		nil); err != nil {
+		nil)
+	return
+}
+
+// setChannelVar will set the value of a variable, disconnecting the channel on error
+func (sma *AsteriskAgent) setChannelVar(chanID string, vrblName, vrblVal string) (success bool) {
+	if err := sma.setChannelVariable(chanID, vrblName, vrblVal); err != nil {
 		// Since we got error, disconnect channel
 		sma.hangupChannel(chanID,
 			fmt.Sprintf("<%s> error: <%s> setting <%s> for channelID: <%s>",
@@ -405,9 +412,36 @@ func (sma *AsteriskAgent) V1GetActiveSessionIDs(ctx *context.Context, ignParam s
 
 }
 
-// V1AlterSession is used to implement the sessions.BiRPClient interface
-func (*AsteriskAgent) V1AlterSession(*context.Context, utils.CGREvent, *string) error {
-	return utils.ErrNotImplemented
+// V1AlterSession sets the variables on the running channel together with its new absolute timeout
+func (sma *AsteriskAgent) V1AlterSession(ctx *context.Context, cgrEv utils.CGREvent, reply *string) error {
+	sa, err := newSessionAlteration(&cgrEv)
+	if err != nil {
+		return err
+	}
+	channelID := engine.NewMapEvent(cgrEv.Event).GetStringIgnoreErrors(utils.OriginID)
+	vrbls := make([][2]string, 0, len(sa.varNames)+2)
+	for _, name := range sa.varNames {
+		vrbls = append(vrbls, [2]string{name, url.QueryEscape(sa.vars[name])})
+	}
+	if sa.maxUsage != nil {
+		vrbls = append(vrbls, [2]string{CGRMaxSessionTime, strconv.Itoa(int(sa.maxUsage.Milliseconds()))})
+		if *sa.maxUsage > 0 { // a zero timeout would clear the limit instead
+			vrbls = append(vrbls, [2]string{ARITimeoutAbsolute, strconv.Itoa(usageSeconds(*sa.maxUsage))})
+		}
+	}
+	for _, vrbl := range vrbls {
+		if err = sma.setChannelVariable(channelID, vrbl[0], vrbl[1]); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> error: <%s> setting <%s> for channelID: <%s>",
+					utils.AsteriskAgent, err.Error(), vrbl[0], channelID))
+			return err
+		}
+	}
+	if sa.maxUsage != nil && *sa.maxUsage <= 0 { // no more usage allowed
+		sma.hangupChannel(channelID, "")
+	}
+	*reply = utils.OK
+	return nil
 }
 
 // V1DisconnectPeer is used to implement the sessions.BiRPClient interface
@@ -114,8 +114,8 @@ func TestAsteriskAgentV1AlterSession(t *testing.T) {
 	tAsteriskAgent := &AsteriskAgent{}
 	tCGREvent := utils.CGREvent{}
 	tString := ""
-	err := tAsteriskAgent.V1AlterSession(nil, tCGREvent, &tString)
-	if err != utils.ErrNotImplemented {
-		t.Errorf("Expected error: %v, got: %v", utils.ErrNotImplemented, err)
+	expErr := utils.NewErrMandatoryIeMissing(utils.OptsAlterVariables, utils.OptsAlterMaxUsage).Error()
+	if err := tAsteriskAgent.V1AlterSession(nil, tCGREvent, &tString); err == nil || err.Error() != expErr {
+		t.Errorf("Expected error: %s, got: %v", expErr, err)
 	}
 }
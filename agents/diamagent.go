@@ -19,9 +19,13 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package agents
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
+	"os"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -71,6 +75,14 @@ func NewDiameterAgent(cgrCfg *config.CGRConfig, filterS *engine.FilterS,
 			return nil, err
 		}
 	}
+	for _, peerCfg := range cgrCfg.DiameterAgentCfg().Peers {
+		peer, err := newDiamPeer(peerCfg, da.smSettings(),
+			cgrCfg.DiameterAgentCfg().WatchdogInterval, da.handleMessage)
+		if err != nil {
+			return nil, err
+		}
+		da.relayPeers = append(da.relayPeers, peer)
+	}
 	msgTemplates := da.cgrCfg.TemplatesCfg()
 	// Inflate *template field types
 	for _, procsr := range da.cgrCfg.DiameterAgentCfg().RequestProcessors {
@@ -102,46 +114,129 @@ type DiameterAgent struct {
 	dpaLck   sync.RWMutex
 	dpa      map[string]chan *diam.Message
 
+	relayPeers []*diamPeer // outbound peers, used by *relay processors
+
 	ctx *context.Context
 }
 
 // ListenAndServe is called when DiameterAgent is started, usually from within cmd/cgr-engine
 func (da *DiameterAgent) ListenAndServe(stopChan <-chan struct{}) (err error) {
-	utils.Logger.Info(fmt.Sprintf("<%s> Start listening on <%s>", utils.DiameterAgent, da.cgrCfg.DiameterAgentCfg().Listen))
-	srv := &diam.Server{
-		Network: da.cgrCfg.DiameterAgentCfg().ListenNet,
-		Addr:    da.cgrCfg.DiameterAgentCfg().Listen,
-		Handler: da.handlers(),
-		Dict:    nil,
-	}
-	// used to control the server state
-	var lsn net.Listener
-	if lsn, err = diam.MultistreamListen(utils.FirstNonEmpty(srv.Network, utils.TCP),
-		utils.FirstNonEmpty(srv.Addr, ":3868")); err != nil {
+	// used to control the servers state
+	lsns := make([]net.Listener, 0, len(da.cgrCfg.DiameterAgentCfg().Listeners))
+	closeListeners := func() (err error) {
+		for _, lsn := range lsns {
+			if errClose := lsn.Close(); errClose != nil && err == nil {
+				err = errClose
+			}
+		}
 		return
 	}
-	errChan := make(chan error)
-	go func() {
-		errChan <- srv.Serve(lsn)
-	}()
+	for _, lstnCfg := range da.cgrCfg.DiameterAgentCfg().Listeners {
+		var lsn net.Listener
+		if lsn, err = diamListen(lstnCfg, da.cgrCfg.TLSCfg()); err != nil {
+			closeListeners()
+			return
+		}
+		utils.Logger.Info(fmt.Sprintf("<%s> Start listening on <%s:%s>",
+			utils.DiameterAgent, lstnCfg.Network, lstnCfg.Address))
+		lsns = append(lsns, lsn)
+	}
+	for _, peer := range da.relayPeers {
+		go peer.connect(stopChan, da.cgrCfg.DiameterAgentCfg().ReconnectInterval)
+	}
+	handler := da.handlers()
+	errChan := make(chan error, len(lsns))
+	for _, lsn := range lsns {
+		go func(lsn net.Listener) {
+			errChan <- (&diam.Server{Handler: handler}).Serve(lsn)
+		}(lsn)
+	}
 	select {
 	case err = <-errChan:
+		closeListeners()
 		return
 	case <-stopChan:
-		return lsn.Close()
+		return closeListeners()
+	}
+}
+
+// diamListen opens the listener out of config, wrapping it into TLS for the tls network
+func diamListen(lstnCfg config.DiameterListener, tlsCfg *config.TLSCfg) (lsn net.Listener, err error) {
+	network := utils.FirstNonEmpty(lstnCfg.Network, utils.TCP)
+	isTLS := network == utils.TLSNoCaps
+	if isTLS {
+		network = utils.TCP
+	}
+	if lsn, err = diam.MultistreamListen(network,
+		utils.FirstNonEmpty(lstnCfg.Address, ":3868")); err != nil || !isTLS {
+		return
+	}
+	var tlsConfig *tls.Config
+	if tlsConfig, err = diamTLSConfig(tlsCfg); err != nil {
+		lsn.Close()
+		return nil, err
+	}
+	return tls.NewListener(lsn, tlsConfig), nil
+}
+
+// diamTLSConfig builds the server side TLS configuration out of tls section
+func diamTLSConfig(tlsCfg *config.TLSCfg) (tlsConfig *tls.Config, err error) {
+	cert, err := tls.LoadX509KeyPair(tlsCfg.ServerCerificate, tlsCfg.ServerKey)
+	if err != nil {
+		return nil, fmt.Errorf("load certificate error <%v>", err)
+	}
+	tlsConfig = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.ClientAuthType(tlsCfg.ServerPolicy),
+		ServerName:   tlsCfg.ServerName,
 	}
+	if tlsCfg.CaCertificate != utils.EmptyString {
+		var ca []byte
+		if ca, err = os.ReadFile(tlsCfg.CaCertificate); err != nil {
+			return nil, fmt.Errorf("read CA error <%v>", err)
+		}
+		tlsConfig.ClientCAs = x509.NewCertPool()
+		if !tlsConfig.ClientCAs.AppendCertsFromPEM(ca) {
+			return nil, errors.New("cannot append certificate authority")
+		}
+	}
+	return
 }
 
 // Creates the message handlers
 func (da *DiameterAgent) handlers() diam.Handler {
-	settings := &sm.Settings{
+	dSM := sm.New(da.smSettings())
+	if da.cgrCfg.DiameterAgentCfg().SyncedConnReqs {
+		dSM.HandleFunc(all, da.handleMessage)
+		dSM.HandleFunc(raa, da.handleRAA)
+		dSM.HandleFunc(dpa, da.handleDPA)
+	} else {
+		dSM.HandleFunc(all, func(c diam.Conn, m *diam.Message) { go da.handleMessage(c, m) })
+		dSM.HandleFunc(raa, func(c diam.Conn, m *diam.Message) { go da.handleRAA(c, m) })
+		dSM.HandleFunc(dpa, func(c diam.Conn, m *diam.Message) { go da.handleDPA(c, m) })
+	}
+	go da.handleConns(dSM.HandshakeNotify())
+	go func() {
+		for err := range dSM.ErrorReports() {
+			utils.Logger.Err(fmt.Sprintf("<%s> sm error: %v", utils.DiameterAgent, err))
+		}
+	}()
+	return dSM
+}
+
+// smSettings returns the settings used in CER/CEA exchange
+func (da *DiameterAgent) smSettings() (settings *sm.Settings) {
+	settings = &sm.Settings{
 		OriginHost:       datatype.DiameterIdentity(da.cgrCfg.DiameterAgentCfg().OriginHost),
 		OriginRealm:      datatype.DiameterIdentity(da.cgrCfg.DiameterAgentCfg().OriginRealm),
 		VendorID:         datatype.Unsigned32(da.cgrCfg.DiameterAgentCfg().VendorID),
 		ProductName:      datatype.UTF8String(da.cgrCfg.DiameterAgentCfg().ProductName),
 		FirmwareRevision: datatype.Unsigned32(utils.DiameterFirmwareRevision),
 	}
-	hosts := disectDiamListen(da.cgrCfg.DiameterAgentCfg().Listen)
+	var hosts []net.IP
+	for _, lstnCfg := range da.cgrCfg.DiameterAgentCfg().Listeners {
+		hosts = append(hosts, disectDiamListen(lstnCfg.Address)...)
+	}
 	if len(hosts) == 0 {
 		interfaces, err := net.Interfaces()
 		if err != nil {
@@ -163,24 +258,7 @@ func (da *DiameterAgent) handlers() diam.Handler {
 	for i, host := range hosts {
 		settings.HostIPAddresses[i] = datatype.Address(host)
 	}
-
-	dSM := sm.New(settings)
-	if da.cgrCfg.DiameterAgentCfg().SyncedConnReqs {
-		dSM.HandleFunc(all, da.handleMessage)
-		dSM.HandleFunc(raa, da.handleRAA)
-		dSM.HandleFunc(dpa, da.handleDPA)
-	} else {
-		dSM.HandleFunc(all, func(c diam.Conn, m *diam.Message) { go da.handleMessage(c, m) })
-		dSM.HandleFunc(raa, func(c diam.Conn, m *diam.Message) { go da.handleRAA(c, m) })
-		dSM.HandleFunc(dpa, func(c diam.Conn, m *diam.Message) { go da.handleDPA(c, m) })
-	}
-	go da.handleConns(dSM.HandshakeNotify())
-	go func() {
-		for err := range dSM.ErrorReports() {
-			utils.Logger.Err(fmt.Sprintf("<%s> sm error: %v", utils.DiameterAgent, err))
-		}
-	}()
-	return dSM
+	return
 }
 
 // handleALL is the handler of all messages coming in via Diameter
@@ -248,8 +326,7 @@ func (da *DiameterAgent) handleMessage(c diam.Conn, m *diam.Message) {
 	var processed bool
 	for _, reqProcessor := range da.cgrCfg.DiameterAgentCfg().RequestProcessors {
 		var lclProcessed bool
-		lclProcessed, err = processRequest(
-			da.ctx,
+		lclProcessed, err = da.processRequest(m,
 			reqProcessor,
 			NewAgentRequest(
 				diamDP, reqVars, cgrRplyNM, rply,
@@ -259,10 +336,7 @@ func (da *DiameterAgent) handleMessage(c diam.Conn, m *diam.Message) {
 					reqProcessor.Timezone,
 					da.cgrCfg.GeneralCfg().DefaultTimezone,
 				),
-				da.filterS, nil),
-			utils.DiameterAgent, da.connMgr,
-			da.cgrCfg.DiameterAgentCfg().SessionSConns,
-			da.filterS)
+				da.filterS, nil))
 		if lclProcessed {
 			processed = lclProcessed
 		}
@@ -297,6 +371,90 @@ func (da *DiameterAgent) handleMessage(c diam.Conn, m *diam.Message) {
 	writeOnConn(c, a)
 }
 
+// processRequest processes the request with one processor,
+// relaying it towards the diameter peers in case of *relay flag
+func (da *DiameterAgent) processRequest(m *diam.Message, reqProcessor *config.RequestProcessor,
+	agReq *AgentRequest) (_ bool, err error) {
+	if !reqProcessor.Flags.Has(utils.MetaRelay) {
+		return processRequest(da.ctx, reqProcessor, agReq,
+			utils.DiameterAgent, da.connMgr,
+			da.cgrCfg.DiameterAgentCfg().SessionSConns,
+			da.filterS)
+	}
+	if pass, err := da.filterS.Pass(agReq.Tenant,
+		reqProcessor.Filters, agReq); err != nil || !pass {
+		return pass, err
+	}
+	if err = agReq.SetFields(reqProcessor.RequestFields); err != nil {
+		return
+	}
+	if reqProcessor.Flags.Has(utils.MetaLog) {
+		utils.Logger.Info(
+			fmt.Sprintf("<%s> LOG, processorID: %s, diameter message: %s",
+				utils.DiameterAgent, reqProcessor.ID, agReq.Request.String()))
+	}
+	if a, err := da.relay(m, agReq, reqProcessor.Flags); err != nil {
+		agReq.CGRReply.Map[utils.Error] = utils.NewLeafNode(err.Error())
+		agReq.ExtraDP[utils.MetaDiamrep] = utils.MapStorage{} // no answer fields
+	} else {
+		agReq.ExtraDP[utils.MetaDiamrep] = newDADataProvider(nil, a)
+	}
+	if err = agReq.SetFields(reqProcessor.ReplyFields); err != nil {
+		return
+	}
+	if reqProcessor.Flags.Has(utils.MetaLog) {
+		utils.Logger.Info(
+			fmt.Sprintf("<%s> LOG, Diameter reply: %s",
+				utils.DiameterAgent, agReq.Reply))
+	}
+	return true, nil
+}
+
+// relay sends the request to the first connected peer serving its Destination-Realm and application,
+// failing over to the next ones on errors
+func (da *DiameterAgent) relay(m *diam.Message, agReq *AgentRequest,
+	flags utils.FlagsWithParams) (a *diam.Message, err error) {
+	var out *diam.Message
+	if out, err = relayedMessage(m, agReq.diamreq, flags,
+		da.cgrCfg.DiameterAgentCfg().OriginHost, agReq.Timezone); err != nil {
+		return
+	}
+	outDP := newDADataProvider(nil, out)
+	dstHost, _ := outDP.FieldAsString([]string{"Destination-Host"})
+	dstRealm, _ := outDP.FieldAsString([]string{"Destination-Realm"})
+	peerIDs := flags.ParamsSlice(utils.MetaRelay, utils.MetaPeers)
+	var peers []*diamPeer
+	var hostPeers int // peers matching the Destination-Host are tried first
+	for _, peer := range da.relayPeers {
+		if len(peerIDs) != 0 && !slices.Contains(peerIDs, peer.cfg.ID) {
+			continue
+		}
+		if matched, hostMatched := peer.matches(dstHost, dstRealm, out.Header.ApplicationID); !matched {
+			continue
+		} else if hostMatched {
+			peers = slices.Insert(peers, hostPeers, peer)
+			hostPeers++
+			continue
+		}
+		peers = append(peers, peer)
+	}
+	if len(peers) == 0 {
+		return nil, errNoDiamPeer
+	}
+	for i, peer := range peers {
+		if i != 0 { // failover
+			out.Header.CommandFlags |= diam.RetransmittedFlag
+		}
+		if a, err = peer.sendRequest(out, da.cgrCfg.DiameterAgentCfg().RelayTimeout); err == nil {
+			return
+		}
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> failed relaying message to peer <%s>, err: %s",
+				utils.DiameterAgent, peer.cfg.ID, err.Error()))
+	}
+	return
+}
+
 // V1DisconnectSession is part of the sessions.BiRPClient
 func (da *DiameterAgent) V1DisconnectSession(ctx *context.Context, cgrEv utils.CGREvent, reply *string) (err error) {
 	ssID, has := cgrEv.Event[utils.OriginID]
@@ -18,6 +18,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package agents
 
 import (
+	"crypto/tls"
+	"net"
 	"reflect"
 	"strings"
 	"testing"
@@ -31,6 +33,10 @@ import (
 	"github.com/cgrates/cgrates/utils"
 	"github.com/cgrates/rpcclient"
 	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
 )
 
 func TestDAsSessionSClientIface(t *testing.T) {
@@ -580,3 +586,74 @@ func TestV1DisconnectPeer(t *testing.T) {
 		t.Errorf("Expected ErrNotFound, got: %v", err)
 	}
 }
+
+func TestDiamListen(t *testing.T) {
+	tlsCfg := &config.TLSCfg{
+		ServerCerificate: "/tmp/missing.crt",
+		ServerKey:        "/tmp/missing.key",
+	}
+	if _, err := diamListen(config.DiameterListener{Address: "127.0.0.1:0", Network: utils.TLSNoCaps},
+		tlsCfg); err == nil || !strings.HasPrefix(err.Error(), "load certificate error") {
+		t.Errorf("Expected load certificate error, received: %v", err)
+	}
+	tlsCfg.ServerCerificate = "../data/tls/server.crt"
+	tlsCfg.ServerKey = "../data/tls/server.key"
+	tlsCfg.CaCertificate = "../data/tls/ca.crt"
+	lsn, err := diamListen(config.DiameterListener{Address: "127.0.0.1:0", Network: utils.TLSNoCaps}, tlsCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lsn.Close()
+
+	cfg := config.NewDefaultCGRConfig()
+	da := &DiameterAgent{
+		cgrCfg: cfg,
+		dpa:    make(map[string]chan *diam.Message),
+		peers:  make(map[string]diam.Conn),
+	}
+	go (&diam.Server{Handler: da.handlers()}).Serve(lsn)
+
+	cli := &sm.Client{
+		Handler: sm.New(&sm.Settings{
+			OriginHost:  "client",
+			OriginRealm: "client.org",
+			ProductName: "TestClient",
+		}),
+		MaxRetransmits:     1,
+		RetransmitInterval: time.Second,
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+		},
+	}
+	conn, err := cli.DialTLS(lsn.Addr().String(),
+		"../data/tls/client.crt", "../data/tls/client.key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, isTLS := conn.Connection().(*tls.Conn); !isTLS {
+		t.Errorf("Expected TLS connection, received: %T", conn.Connection())
+	}
+	if meta, has := smpeer.FromContext(conn.Context()); !has {
+		t.Error("Expected capabilities exchange to succeed")
+	} else if meta.OriginHost != "CGR-DA" {
+		t.Errorf("Expected CGR-DA, received: %q", meta.OriginHost)
+	}
+}
+
+func TestDiamAgentSMSettingsListeners(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.DiameterAgentCfg().Listeners = []config.DiameterListener{
+		{Address: "127.0.0.1/192.168.56.10:3868", Network: utils.SCTP},
+		{Address: "10.0.0.1:3869", Network: utils.TLSNoCaps},
+	}
+	da := &DiameterAgent{cgrCfg: cfg}
+	exp := []datatype.Address{
+		datatype.Address(net.ParseIP("127.0.0.1")),
+		datatype.Address(net.ParseIP("192.168.56.10")),
+		datatype.Address(net.ParseIP("10.0.0.1")),
+	}
+	if rcv := da.smSettings().HostIPAddresses; !reflect.DeepEqual(rcv, exp) {
+		t.Errorf("Expected %v, received: %v", exp, rcv)
+	}
+}
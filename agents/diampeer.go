@@ -0,0 +1,273 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
+)
+
+const relayAppID = 0xffffffff // Relay application, advertised by peers accepting any application
+
+var errNoDiamPeer = errors.New("NO_DIAMETER_PEER")
+
+// newDiamPeer creates the outbound connection towards one Diameter peer
+// reqHandler will be called for the requests initiated by the peer (ie: RAR)
+func newDiamPeer(peerCfg *config.DiameterPeer, settings *sm.Settings,
+	watchdogIntvl time.Duration, reqHandler diam.HandlerFunc) (p *diamPeer, err error) {
+	p = &diamPeer{
+		cfg:     peerCfg,
+		answers: make(map[uint32]chan *diam.Message),
+	}
+	dSM := sm.New(settings)
+	dSM.HandleFunc(all, func(c diam.Conn, m *diam.Message) {
+		if m.Header.CommandFlags&diam.RequestFlag != 0 {
+			go reqHandler(c, m)
+			return
+		}
+		p.handleAnswer(m)
+	})
+	go func() {
+		for err := range dSM.ErrorReports() {
+			utils.Logger.Err(fmt.Sprintf("<%s> peer <%s> sm error: %v",
+				utils.DiameterAgent, peerCfg.ID, err))
+		}
+	}()
+	p.cli = &sm.Client{
+		Handler:            dSM,
+		MaxRetransmits:     3,
+		RetransmitInterval: time.Second,
+		EnableWatchdog:     true,
+		WatchdogInterval:   watchdogIntvl,
+	}
+	if p.cli.AuthApplicationID, p.cli.AcctApplicationID,
+		p.cli.VendorSpecificApplicationID, err = diamPeerApps(peerCfg.ApplicationIDs); err != nil {
+		return nil, fmt.Errorf("peer <%s>: %w", peerCfg.ID, err)
+	}
+	return
+}
+
+// diamPeerApps returns the AVPs advertising the applications in CER
+// with no application IDs all the applications out of dictionaries are advertised
+func diamPeerApps(appIDs []uint32) (auth, acct, vndr []*diam.AVP, err error) {
+	supported := sm.PrepareSupportedApps(dict.Default)
+	slices.SortFunc(supported, func(a, b *sm.SupportedApp) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	if len(appIDs) != 0 {
+		apps := make([]*sm.SupportedApp, len(appIDs))
+		for i, appID := range appIDs {
+			idx := slices.IndexFunc(supported, func(app *sm.SupportedApp) bool { return app.ID == appID })
+			if idx == -1 {
+				return nil, nil, nil, fmt.Errorf("unsupported application id: <%d>", appID)
+			}
+			apps[i] = supported[idx]
+		}
+		supported = apps
+	}
+	for _, app := range supported {
+		avpCode := uint32(avp.AuthApplicationID)
+		if app.AppType == "acct" {
+			avpCode = avp.AcctApplicationID
+		}
+		appAVP := diam.NewAVP(avpCode, avp.Mbit, 0, datatype.Unsigned32(app.ID))
+		switch {
+		case app.Vendor != 0:
+			vndr = append(vndr, diam.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0,
+				&diam.GroupedAVP{AVP: []*diam.AVP{
+					diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(app.Vendor)),
+					appAVP,
+				}}))
+		case avpCode == avp.AcctApplicationID:
+			acct = append(acct, appAVP)
+		default:
+			auth = append(auth, appAVP)
+		}
+	}
+	return
+}
+
+// diamPeer is an outbound connection towards a Diameter peer, supervised via DWR/DWA
+type diamPeer struct {
+	cfg *config.DiameterPeer
+	cli *sm.Client
+
+	connLck sync.RWMutex
+	conn    diam.Conn
+	meta    *smpeer.Metadata // peer capabilities received in CEA
+
+	answersLck sync.Mutex
+	answers    map[uint32]chan *diam.Message // pending requests indexed on Hop-by-Hop-Identifier
+}
+
+// connect keeps the connection towards the peer open, reconnecting on failures until stopChan is closed
+func (p *diamPeer) connect(stopChan <-chan struct{}, reconnectIntvl time.Duration) {
+	for {
+		conn, err := p.cli.DialNetwork(p.cfg.Network, p.cfg.Address)
+		if err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> failed connecting to peer <%s> at <%s>, err: %s",
+				utils.DiameterAgent, p.cfg.ID, p.cfg.Address, err.Error()))
+		} else {
+			meta, _ := smpeer.FromContext(conn.Context())
+			p.setConn(conn, meta)
+			utils.Logger.Info(fmt.Sprintf("<%s> connected to peer <%s> at <%s>",
+				utils.DiameterAgent, p.cfg.ID, p.cfg.Address))
+			select {
+			case <-conn.(diam.CloseNotifier).CloseNotify():
+				utils.Logger.Warning(fmt.Sprintf("<%s> lost connection to peer <%s> at <%s>",
+					utils.DiameterAgent, p.cfg.ID, p.cfg.Address))
+				p.setConn(nil, nil)
+			case <-stopChan:
+				p.setConn(nil, nil)
+				conn.Close()
+				return
+			}
+		}
+		select {
+		case <-stopChan:
+			return
+		case <-time.After(reconnectIntvl):
+		}
+	}
+}
+
+func (p *diamPeer) setConn(conn diam.Conn, meta *smpeer.Metadata) {
+	p.connLck.Lock()
+	p.conn, p.meta = conn, meta
+	p.connLck.Unlock()
+}
+
+// matches checks if the peer is connected and able to serve the realm and application
+// the host match is returned separately so the peers can be prioritized
+func (p *diamPeer) matches(dstHost, dstRealm string, appID uint32) (matched, hostMatched bool) {
+	p.connLck.RLock()
+	defer p.connLck.RUnlock()
+	if p.conn == nil || p.meta == nil {
+		return
+	}
+	if realm := utils.FirstNonEmpty(p.cfg.Realm, string(p.meta.OriginRealm)); dstRealm != utils.EmptyString &&
+		realm != dstRealm {
+		return
+	}
+	appIDs := p.cfg.ApplicationIDs
+	if len(appIDs) == 0 {
+		appIDs = p.meta.Applications
+	}
+	if !slices.Contains(appIDs, appID) &&
+		!slices.Contains(appIDs, relayAppID) {
+		return
+	}
+	return true, dstHost == string(p.meta.OriginHost)
+}
+
+// sendRequest writes the request to the peer and waits for its answer
+func (p *diamPeer) sendRequest(m *diam.Message, timeout time.Duration) (a *diam.Message, err error) {
+	p.connLck.RLock()
+	conn := p.conn
+	p.connLck.RUnlock()
+	if conn == nil {
+		return nil, utils.ErrDisconnected
+	}
+	hopByHopID := m.Header.HopByHopID
+	ansCh := make(chan *diam.Message, 1)
+	p.answersLck.Lock()
+	p.answers[hopByHopID] = ansCh
+	p.answersLck.Unlock()
+	defer func() {
+		p.answersLck.Lock()
+		delete(p.answers, hopByHopID)
+		p.answersLck.Unlock()
+	}()
+	if err = writeOnConn(conn, m); err != nil {
+		return
+	}
+	select {
+	case a = <-ansCh:
+	case <-time.After(timeout):
+		err = utils.ErrTimedOut
+	}
+	return
+}
+
+// handleAnswer passes the answer to the request waiting for it
+func (p *diamPeer) handleAnswer(m *diam.Message) {
+	p.answersLck.Lock()
+	ansCh, has := p.answers[m.Header.HopByHopID]
+	p.answersLck.Unlock()
+	if !has {
+		utils.Logger.Warning(fmt.Sprintf("<%s> peer <%s> sent unexpected answer: %s",
+			utils.DiameterAgent, p.cfg.ID, m))
+		return
+	}
+	select {
+	case ansCh <- m:
+	default: // already answered
+	}
+}
+
+// relayedMessage returns the message sent towards the peers:
+// built out of *diamreq fields when these are populated, otherwise a copy of the received one
+func relayedMessage(m *diam.Message, diamreq *utils.OrderedNavigableMap,
+	flags utils.FlagsWithParams, originHost, tmz string) (out *diam.Message, err error) {
+	if diamreq.Empty() {
+		var b []byte
+		if b, err = m.Serialize(); err != nil {
+			return
+		}
+		if out, err = diam.ReadMessage(bytes.NewReader(b), m.Dictionary()); err != nil {
+			return
+		}
+		out.Header.HopByHopID = rand.Uint32() // unique on the outbound connection
+		out.NewAVP(avp.RouteRecord, avp.Mbit, 0, datatype.DiameterIdentity(originHost))
+		return
+	}
+	appID, cmdCode := m.Header.ApplicationID, m.Header.CommandCode
+	if appIDs := flags.ParamsSlice(utils.MetaRelay, utils.MetaAppID); len(appIDs) != 0 {
+		var id int64
+		if id, err = utils.IfaceAsTInt64(appIDs[0]); err != nil {
+			return
+		}
+		appID = uint32(id)
+	}
+	if cmdCodes := flags.ParamsSlice(utils.MetaRelay, utils.MetaCmd); len(cmdCodes) != 0 {
+		var code int64
+		if code, err = utils.IfaceAsTInt64(cmdCodes[0]); err != nil {
+			return
+		}
+		cmdCode = uint32(code)
+	}
+	out = diam.NewRequest(cmdCode, appID, m.Dictionary())
+	err = updateDiamMsgFromNavMap(out, diamreq, tmz)
+	return
+}
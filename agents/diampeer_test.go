@@ -0,0 +1,222 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+)
+
+func newTestCCR(sessID, dstRealm string) (m *diam.Message) {
+	m = diam.NewRequest(diam.CreditControl, 4, nil)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessID))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("client"))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("client.org"))
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity(dstRealm))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4))
+	m.NewAVP(avp.CCRequestType, avp.Mbit, 0, datatype.Enumerated(1))
+	m.NewAVP(avp.CCRequestNumber, avp.Mbit, 0, datatype.Unsigned32(0))
+	return
+}
+
+func TestDiamPeerApps(t *testing.T) {
+	auth, acct, vndr, err := diamPeerApps([]uint32{4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(auth) != 1 || len(acct) != 0 || len(vndr) != 0 {
+		t.Fatalf("unexpected apps: %v, %v, %v", auth, acct, vndr)
+	}
+	if appID := auth[0].Data.(datatype.Unsigned32); appID != 4 {
+		t.Errorf("Expected application 4, received: %v", appID)
+	}
+	if auth, acct, vndr, err = diamPeerApps(nil); err != nil {
+		t.Error(err)
+	} else if len(auth)+len(acct)+len(vndr) != len(sm.PrepareSupportedApps(dict.Default)) {
+		t.Errorf("Expected all applications advertised, received: %v, %v, %v", auth, acct, vndr)
+	}
+	expErr := "unsupported application id: <99999>"
+	if _, _, _, err = diamPeerApps([]uint32{4, 99999}); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %s, received: %v", expErr, err)
+	}
+}
+
+func TestRelayedMessage(t *testing.T) {
+	m := newTestCCR("sess1", "pcrf.org")
+	out, err := relayedMessage(m, utils.NewOrderedNavigableMap(),
+		utils.FlagsWithParamsFromSlice([]string{utils.MetaRelay}), "CGR-DA", utils.EmptyString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Header.HopByHopID == m.Header.HopByHopID ||
+		out.Header.EndToEndID != m.Header.EndToEndID {
+		t.Errorf("unexpected header: %s", out.Header)
+	}
+	outDP := newDADataProvider(nil, out)
+	if sessID, err := outDP.FieldAsString([]string{"Session-Id"}); err != nil {
+		t.Error(err)
+	} else if sessID != "sess1" {
+		t.Errorf("Expected sess1, received: %q", sessID)
+	}
+	if rr, err := outDP.FieldAsString([]string{"Route-Record"}); err != nil {
+		t.Error(err)
+	} else if rr != "CGR-DA" {
+		t.Errorf("Expected CGR-DA, received: %q", rr)
+	}
+	if _, err := m.FindAVP(avp.RouteRecord, 0); err == nil {
+		t.Error("Expected the received message to not be altered")
+	}
+
+	diamreq := utils.NewOrderedNavigableMap()
+	diamreq.SetAsSlice(&utils.FullPath{PathSlice: []string{"Session-Id"}, Path: "Session-Id"},
+		[]*utils.DataNode{{Type: utils.NMDataType, Value: &utils.DataLeaf{Data: "sess2"}}})
+	if out, err = relayedMessage(m, diamreq,
+		utils.FlagsWithParamsFromSlice([]string{utils.MetaRelay + ":*appid:16777238"}),
+		"CGR-DA", utils.EmptyString); err != nil {
+		t.Fatal(err)
+	}
+	if out.Header.ApplicationID != 16777238 ||
+		out.Header.CommandCode != diam.CreditControl {
+		t.Errorf("unexpected header: %s", out.Header)
+	}
+	if sessID, err := newDADataProvider(nil, out).FieldAsString([]string{"Session-Id"}); err != nil {
+		t.Error(err)
+	} else if sessID != "sess2" {
+		t.Errorf("Expected sess2, received: %q", sessID)
+	}
+	if _, err = relayedMessage(m, diamreq,
+		utils.FlagsWithParamsFromSlice([]string{utils.MetaRelay + ":*appid:gx"}),
+		"CGR-DA", utils.EmptyString); err == nil {
+		t.Error("Expected error for invalid application id")
+	}
+}
+
+func TestDiameterAgentRelay(t *testing.T) {
+	// remote peer answering the CCRs
+	srvSM := sm.New(&sm.Settings{
+		OriginHost:  "pcrf",
+		OriginRealm: "pcrf.org",
+		VendorID:    0,
+		ProductName: "PCRF",
+	})
+	rcvd := make(chan *diam.Message, 1)
+	srvSM.HandleFunc("CCR", func(c diam.Conn, m *diam.Message) {
+		rcvd <- m
+		a := m.Answer(diam.Success)
+		a.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("pcrf"))
+		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("pcrf.org"))
+		a.WriteTo(c)
+	})
+	lsn, err := diam.MultistreamListen(utils.TCP, "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lsn.Close()
+	go (&diam.Server{Handler: srvSM}).Serve(lsn)
+
+	cfg := config.NewDefaultCGRConfig()
+	cfg.DiameterAgentCfg().RelayTimeout = time.Second
+	da := &DiameterAgent{
+		cgrCfg:  cfg,
+		filterS: engine.NewFilterS(cfg, nil, nil),
+	}
+	peer, err := newDiamPeer(&config.DiameterPeer{
+		ID:      "pcrf1",
+		Address: lsn.Addr().String(),
+		Network: utils.TCP,
+	}, da.smSettings(), time.Second, da.handleMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	da.relayPeers = []*diamPeer{peer}
+	stopChan := make(chan struct{})
+	defer close(stopChan)
+	go peer.connect(stopChan, 10*time.Millisecond)
+	for i := 0; i < 100; i++ {
+		if matched, _ := peer.matches(utils.EmptyString, "pcrf.org", 4); matched {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	reqProcessor := &config.RequestProcessor{
+		ID:    "relay",
+		Flags: utils.FlagsWithParamsFromSlice([]string{utils.MetaRelay}),
+		ReplyFields: []*config.FCTemplate{
+			{Tag: "ResultCode", Type: utils.MetaVariable, Path: utils.MetaRep + utils.NestingSep + "Result-Code",
+				Value: config.NewRSRParsersMustCompile("~*diamrep.Result-Code", utils.InfieldSep)},
+			{Tag: "Error", Type: utils.MetaVariable, Path: utils.MetaRep + utils.NestingSep + "Error-Message",
+				Value: config.NewRSRParsersMustCompile("~*cgrep.Error", utils.InfieldSep)},
+		},
+	}
+	for _, v := range reqProcessor.ReplyFields {
+		v.ComputePath()
+	}
+	m := newTestCCR("sess1", "pcrf.org")
+	agReq := NewAgentRequest(newDADataProvider(nil, m), nil, nil, nil, nil, nil,
+		"cgrates.org", utils.EmptyString, da.filterS, nil)
+	if processed, err := da.processRequest(m, reqProcessor, agReq); err != nil {
+		t.Fatal(err)
+	} else if !processed {
+		t.Fatal("Expected the request to be processed")
+	}
+	a, err := diamAnswer(m, 0, false, agReq.Reply, utils.EmptyString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rc, err := newDADataProvider(nil, a).FieldAsString([]string{"Result-Code"}); err != nil {
+		t.Error(err)
+	} else if rc != "2001" {
+		t.Errorf("Expected 2001, received: %q", rc)
+	}
+	select {
+	case relayed := <-rcvd:
+		if rr, err := newDADataProvider(nil, relayed).FieldAsString([]string{"Route-Record"}); err != nil {
+			t.Error(err)
+		} else if rr != "CGR-DA" {
+			t.Errorf("Expected CGR-DA, received: %q", rr)
+		}
+	default:
+		t.Error("Expected the request to reach the peer")
+	}
+
+	m = newTestCCR("sess2", "other.org")
+	agReq = NewAgentRequest(newDADataProvider(nil, m), nil, nil, nil, nil, nil,
+		"cgrates.org", utils.EmptyString, da.filterS, nil)
+	if _, err := da.processRequest(m, reqProcessor, agReq); err != nil {
+		t.Fatal(err)
+	}
+	if a, err = diamAnswer(m, 0, false, agReq.Reply, utils.EmptyString); err != nil {
+		t.Fatal(err)
+	}
+	if errMsg, err := newDADataProvider(nil, a).FieldAsString([]string{"Error-Message"}); err != nil {
+		t.Error(err)
+	} else if errMsg != errNoDiamPeer.Error() {
+		t.Errorf("Expected %s, received: %q", errNoDiamPeer, errMsg)
+	}
+}
@@ -494,9 +494,62 @@ func (fsa *FSsessions) V1WarnDisconnect(ctx *context.Context, args map[string]an
 	return
 }
 
-// V1AlterSession is used to implement the sessions.BiRPClient interface
-func (*FSsessions) V1AlterSession(*context.Context, utils.CGREvent, *string) error {
-	return utils.ErrNotImplemented
+// V1AlterSession pushes the variables into the running channel and reschedules its hangup on new max usage
+func (fsa *FSsessions) V1AlterSession(ctx *context.Context, cgrEv utils.CGREvent, reply *string) (err error) {
+	sa, err := newSessionAlteration(&cgrEv)
+	if err != nil {
+		return
+	}
+	ev := engine.NewMapEvent(cgrEv.Event)
+	channelID := ev.GetStringIgnoreErrors(utils.OriginID)
+	connIdx, err := ev.GetTInt64(FsConnID)
+	if err != nil {
+		utils.Logger.Err(
+			fmt.Sprintf("<%s> error: <%s:%s> when attempting to alter channelID: <%s>",
+				utils.FreeSWITCHAgent, err.Error(), FsConnID, channelID))
+		return
+	}
+	if int(connIdx) >= len(fsa.conns) { // protection against index out of range panic
+		err = fmt.Errorf("Index out of range[0,%v): %v ", len(fsa.conns), connIdx)
+		utils.Logger.Err(fmt.Sprintf("<%s> %s", utils.FreeSWITCHAgent, err.Error()))
+		return
+	}
+	for _, name := range sa.varNames {
+		if _, err = fsa.conns[connIdx].SendApiCmd(
+			fmt.Sprintf("uuid_setvar %s %s %s\n\n", channelID, name, sa.vars[name])); err != nil {
+			utils.Logger.Err(
+				fmt.Sprintf("<%s> Could not set %s variable to freeswitch channel, error: <%s>, connIdx: %v",
+					utils.FreeSWITCHAgent, name, err.Error(), connIdx))
+			return
+		}
+	}
+	if sa.maxUsage != nil {
+		maxUsage := usageSeconds(*sa.maxUsage)
+		if _, err = fsa.conns[connIdx].SendApiCmd(
+			fmt.Sprintf("uuid_setvar %s %s %d\n\n", channelID, VarCGRMaxUsage, maxUsage)); err != nil {
+			utils.Logger.Err(
+				fmt.Sprintf("<%s> Could not set %s variable to freeswitch channel, error: <%s>, connIdx: %v",
+					utils.FreeSWITCHAgent, VarCGRMaxUsage, err.Error(), connIdx))
+			return
+		}
+		// remove the hangup scheduled out of the previous max usage
+		if _, err = fsa.conns[connIdx].SendApiCmd(
+			fmt.Sprintf("sched_del %s\n\n", channelID)); err != nil {
+			utils.Logger.Err(
+				fmt.Sprintf("<%s> Could not send sched_del command to freeswitch, error: <%s>, connIdx: %v",
+					utils.FreeSWITCHAgent, err.Error(), connIdx))
+			return
+		}
+		if _, err = fsa.conns[connIdx].SendApiCmd(
+			fmt.Sprintf("sched_hangup +%d %s alloted_timeout\n\n", maxUsage, channelID)); err != nil {
+			utils.Logger.Err(
+				fmt.Sprintf("<%s> Could not send sched_hangup command to freeswitch, error: <%s>, connIdx: %v",
+					utils.FreeSWITCHAgent, err.Error(), connIdx))
+			return
+		}
+	}
+	*reply = utils.OK
+	return
 }
 
 // V1DisconnectPeer is used to implement the sessions.BiRPClient interface
@@ -47,9 +47,9 @@ func TestFsAgentV1AlterSession(t *testing.T) {
 	ctx := context.Background()
 	cgrEv := utils.CGREvent{}
 	fss := &FSsessions{}
-	err := fss.V1AlterSession(ctx, cgrEv, nil)
-	if err != utils.ErrNotImplemented {
-		t.Errorf("Expected error: %v, got: %v", utils.ErrNotImplemented, err)
+	expErr := utils.NewErrMandatoryIeMissing(utils.OptsAlterVariables, utils.OptsAlterMaxUsage).Error()
+	if err := fss.V1AlterSession(ctx, cgrEv, nil); err == nil || err.Error() != expErr {
+		t.Errorf("Expected error: %s, got: %v", expErr, err)
 	}
 }
 
@@ -270,11 +270,24 @@ func TestFSsessionsV1AlterSession(t *testing.T) {
 		connMgr: &engine.ConnManager{},
 	}
 	ctx := context.Background()
-	event := utils.CGREvent{}
+	event := utils.CGREvent{
+		Event: map[string]any{
+			utils.OriginID: "e4e2f5a2-8ae7-4a5e-9a1d-0c5c1e0e7b3a",
+			"Announce":     "low_balance",
+		},
+		APIOpts: map[string]any{
+			utils.OptsAlterVariables: "Announce",
+		},
+	}
 	reply := ""
-	err := fsSessions.V1AlterSession(ctx, event, &reply)
-	if err != utils.ErrNotImplemented {
-		t.Errorf("Expected error %v, got %v", utils.ErrNotImplemented, err)
+	expErr := "NOT_FOUND"
+	if err := fsSessions.V1AlterSession(ctx, event, &reply); err == nil || err.Error() != expErr {
+		t.Errorf("Expected error %s, got %v", expErr, err)
+	}
+	event.Event[FsConnID] = 1
+	expErr = "Index out of range[0,0): 1 "
+	if err := fsSessions.V1AlterSession(ctx, event, &reply); err == nil || err.Error() != expErr {
+		t.Errorf("Expected error %s, got %v", expErr, err)
 	}
 }
 
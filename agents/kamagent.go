@@ -458,9 +458,42 @@ func (ka *KamailioAgent) Reload() {
 	ka.conns = make([]*kamevapi.KamEvapi, len(ka.cfg.EvapiConns))
 }
 
-// V1AlterSession is used to implement the sessions.BiRPClient interface
-func (*KamailioAgent) V1AlterSession(*context.Context, utils.CGREvent, *string) error {
-	return utils.ErrNotImplemented
+// V1AlterSession sends the variables and the new max usage of the dialog to Kamailio
+func (ka *KamailioAgent) V1AlterSession(ctx *context.Context, cgrEv utils.CGREvent, reply *string) (err error) {
+	sa, err := newSessionAlteration(&cgrEv)
+	if err != nil {
+		return
+	}
+	hEntry := utils.IfaceAsString(cgrEv.Event[KamHashEntry])
+	hID := utils.IfaceAsString(cgrEv.Event[KamHashID])
+	connIdxIface, has := cgrEv.Event[EvapiConnID]
+	if !has {
+		utils.Logger.Err(
+			fmt.Sprintf("<%s> error: <%s:%s> when attempting to alter <%s:%s> and <%s:%s>",
+				utils.KamailioAgent, utils.ErrNotFound.Error(), EvapiConnID,
+				KamHashEntry, hEntry, KamHashID, hID))
+		return utils.NewErrMandatoryIeMissing(EvapiConnID)
+	}
+	connIdx, err := utils.IfaceAsTInt64(connIdxIface)
+	if err != nil {
+		return err
+	}
+	if int(connIdx) >= len(ka.conns) { // protection against index out of range panic
+		err = fmt.Errorf("Index out of range[0,%v): %v ", len(ka.conns), connIdx)
+		utils.Logger.Err(fmt.Sprintf("<%s> %s", utils.KamailioAgent, err.Error()))
+		return
+	}
+	altEv := NewKamSessionAlter(hEntry, hID,
+		utils.IfaceAsString(cgrEv.Event[utils.OriginID]),
+		utils.IfaceAsString(cgrEv.Event[KamFromTag]),
+		utils.IfaceAsString(cgrEv.Event[KamToTag]), sa)
+	if err = ka.conns[connIdx].Send(altEv.String()); err != nil {
+		utils.Logger.Err(fmt.Sprintf("<%s> failed sending alter request: %s,  connection id: %v, error %s",
+			utils.KamailioAgent, utils.ToJSON(altEv), connIdx, err.Error()))
+		return
+	}
+	*reply = utils.OK
+	return
 }
 
 // V1DisconnectPeer is used to implement the sessions.BiRPClient interface
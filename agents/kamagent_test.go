@@ -58,9 +58,27 @@ func TestKamailioAgentV1AlterSession(t *testing.T) {
 	ctx := context.Background()
 	cgrEvent := utils.CGREvent{}
 	var reply string
-	err := agent.V1AlterSession(ctx, cgrEvent, &reply)
-	if err != utils.ErrNotImplemented {
-		t.Errorf("Expected ErrNotImplemented, got %v", err)
+	expErr := utils.NewErrMandatoryIeMissing(utils.OptsAlterVariables, utils.OptsAlterMaxUsage).Error()
+	if err := agent.V1AlterSession(ctx, cgrEvent, &reply); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %s, got %v", expErr, err)
+	}
+	cgrEvent = utils.CGREvent{
+		Event: map[string]any{
+			KamHashEntry: "1",
+			KamHashID:    "2",
+		},
+		APIOpts: map[string]any{
+			utils.OptsAlterMaxUsage: "1m",
+		},
+	}
+	expErr = utils.NewErrMandatoryIeMissing(EvapiConnID).Error()
+	if err := agent.V1AlterSession(ctx, cgrEvent, &reply); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %s, got %v", expErr, err)
+	}
+	cgrEvent.Event[EvapiConnID] = 0
+	expErr = "Index out of range[0,0): 0 "
+	if err := agent.V1AlterSession(ctx, cgrEvent, &reply); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %s, got %v", expErr, err)
 	}
 }
 
@@ -35,6 +35,7 @@ const (
 	CGR_AUTH_REQUEST       = "CGR_AUTH_REQUEST"
 	CGR_AUTH_REPLY         = "CGR_AUTH_REPLY"
 	CGR_SESSION_DISCONNECT = "CGR_SESSION_DISCONNECT"
+	CGR_SESSION_ALTER      = "CGR_SESSION_ALTER"
 	CGR_CALL_START         = "CGR_CALL_START"
 	CGR_CALL_END           = "CGR_CALL_END"
 	CGR_PROCESS_MESSAGE    = "CGR_PROCESS_MESSAGE"
@@ -43,6 +44,8 @@ const (
 	KamTRLabel             = "tr_label"
 	KamHashEntry           = "h_entry"
 	KamHashID              = "h_id"
+	KamFromTag             = "from_tag"
+	KamToTag               = "to_tag"
 	KamReplyRoute          = "reply_route"
 	EvapiConnID            = "EvapiConnID" // used to share connID info in event for remote disconnects
 	CGR_DLG_LIST           = "CGR_DLG_LIST"
@@ -72,6 +75,39 @@ func (ksd *KamSessionDisconnect) String() string {
 	return utils.ToJSON(ksd)
 }
 
+func NewKamSessionAlter(hEntry, hID, callID, fromTag, toTag string, sa *sessionAlteration) *KamSessionAlter {
+	ksa := &KamSessionAlter{
+		Event:     CGR_SESSION_ALTER,
+		HashEntry: hEntry,
+		HashId:    hID,
+		CallId:    callID,
+		FromTag:   fromTag,
+		ToTag:     toTag,
+		Variables: sa.digest(),
+		MaxUsage:  -1,
+	}
+	if sa.maxUsage != nil {
+		ksa.MaxUsage = int(utils.Round(sa.maxUsage.Seconds(), 0, utils.MetaRoundingMiddle))
+	}
+	return ksa
+}
+
+// KamSessionAlter is sent to Kamailio to update a running dialog
+type KamSessionAlter struct {
+	Event     string
+	HashEntry string
+	HashId    string
+	CallId    string // CallId and the tags identify the dialog when setting its variables
+	FromTag   string
+	ToTag     string
+	Variables string // variables to be set on the dialog, name:value pairs comma separated
+	MaxUsage  int    // new maximum session time from now on, -1 for unchanged
+}
+
+func (ksa *KamSessionAlter) String() string {
+	return utils.ToJSON(ksa)
+}
+
 // NewKamEvent parses bytes received over the wire from Kamailio into KamEvent
 func NewKamEvent(kamEvData []byte, alias, adress string) (KamEvent, error) {
 	kev := make(map[string]string)
@@ -1097,3 +1097,34 @@ func TestKamReplyString(t *testing.T) {
 		})
 	}
 }
+
+func TestNewKamSessionAlter(t *testing.T) {
+	maxUsage := 90*time.Second + 600*time.Millisecond
+	sa := &sessionAlteration{
+		varNames: []string{"Announce"},
+		vars:     map[string]string{"Announce": "low_balance"},
+		maxUsage: &maxUsage,
+	}
+	exp := &KamSessionAlter{
+		Event:     CGR_SESSION_ALTER,
+		HashEntry: "1",
+		HashId:    "2",
+		CallId:    "callid1",
+		FromTag:   "ftag1",
+		ToTag:     "ttag1",
+		Variables: "Announce:low_balance",
+		MaxUsage:  91,
+	}
+	rcv := NewKamSessionAlter("1", "2", "callid1", "ftag1", "ttag1", sa)
+	if !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected %s, received %s", exp, rcv)
+	}
+	expStr := `{"Event":"CGR_SESSION_ALTER","HashEntry":"1","HashId":"2","CallId":"callid1","FromTag":"ftag1","ToTag":"ttag1","Variables":"Announce:low_balance","MaxUsage":91}`
+	if rcv.String() != expStr {
+		t.Errorf("Expected %s, received %s", expStr, rcv.String())
+	}
+	sa.maxUsage = nil
+	if rcv = NewKamSessionAlter("1", "2", "callid1", "ftag1", "ttag1", sa); rcv.MaxUsage != -1 {
+		t.Errorf("Expected unchanged max usage, received %d", rcv.MaxUsage)
+	}
+}
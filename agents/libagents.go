@@ -21,6 +21,7 @@ package agents
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/config"
@@ -203,3 +204,64 @@ func processRequest(ctx *context.Context, reqProcessor *config.RequestProcessor,
 	}
 	return true, nil
 }
+
+// sessionAlteration holds the changes requested via SessionSv1.AlterSessions for one running call
+type sessionAlteration struct {
+	varNames []string          // names of the variables, in the requested order
+	vars     map[string]string // variables to be set on the call
+	maxUsage *time.Duration    // new maximum usage counted from now on, nil if not changed
+}
+
+// newSessionAlteration extracts the alteration out of the event received from SessionS,
+// *alterVariables option listing the event fields to be pushed as variables
+func newSessionAlteration(cgrEv *utils.CGREvent) (sa *sessionAlteration, err error) {
+	sa = new(sessionAlteration)
+	if iface, has := cgrEv.APIOpts[utils.OptsAlterVariables]; has {
+		var names []string
+		if strVal, isStr := iface.(string); isStr { // coming from *alter_sessions action
+			names = strings.Split(strVal, utils.FieldsSep)
+		} else if names, err = utils.IfaceAsSliceString(iface); err != nil {
+			return nil, err
+		}
+		sa.vars = make(map[string]string, len(names))
+		for _, name := range names {
+			if name == utils.EmptyString {
+				continue
+			}
+			var val string
+			if val, err = cgrEv.FieldAsString(name); err != nil {
+				return nil, utils.NewErrMandatoryIeMissing(name)
+			}
+			if _, has := sa.vars[name]; !has {
+				sa.varNames = append(sa.varNames, name)
+			}
+			sa.vars[name] = val
+		}
+	}
+	if maxUsage, err := cgrEv.OptAsDuration(utils.OptsAlterMaxUsage); err == nil {
+		sa.maxUsage = &maxUsage
+	} else if err != utils.ErrNotFound {
+		return nil, err
+	}
+	if len(sa.varNames) == 0 && sa.maxUsage == nil {
+		return nil, utils.NewErrMandatoryIeMissing(utils.OptsAlterVariables, utils.OptsAlterMaxUsage)
+	}
+	return
+}
+
+// digest returns the variables as name:value pairs separated by comma
+func (sa *sessionAlteration) digest() (dgst string) {
+	for i, name := range sa.varNames {
+		if i != 0 {
+			dgst += utils.FieldsSep
+		}
+		dgst += name + utils.InInFieldSep + sa.vars[name]
+	}
+	return
+}
+
+// usageSeconds converts the usage into the whole seconds expected by the switches
+// rounding up so the usage under one second does not end the call right away
+func usageSeconds(usage time.Duration) int {
+	return int((usage + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,113 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+package agents
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestNewSessionAlteration(t *testing.T) {
+	cgrEv := &utils.CGREvent{
+		Event: map[string]any{
+			"Announce":  "low_balance",
+			"Rate":      0.02,
+			"Character": "*voice",
+		},
+		APIOpts: map[string]any{
+			utils.OptsAlterVariables: "Announce,Rate,Announce",
+		},
+	}
+	exp := &sessionAlteration{
+		varNames: []string{"Announce", "Rate"},
+		vars: map[string]string{
+			"Announce": "low_balance",
+			"Rate":     "0.02",
+		},
+	}
+	sa, err := newSessionAlteration(cgrEv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(exp, sa) {
+		t.Errorf("Expected %+v, received %+v", exp, sa)
+	}
+	if dgst := sa.digest(); dgst != "Announce:low_balance,Rate:0.02" {
+		t.Errorf("Unexpected digest: <%s>", dgst)
+	}
+
+	cgrEv.APIOpts = map[string]any{
+		utils.OptsAlterVariables: []any{"Character"},
+		utils.OptsAlterMaxUsage:  "90s",
+	}
+	maxUsage := 90 * time.Second
+	exp = &sessionAlteration{
+		varNames: []string{"Character"},
+		vars:     map[string]string{"Character": "*voice"},
+		maxUsage: &maxUsage,
+	}
+	if sa, err = newSessionAlteration(cgrEv); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(exp, sa) {
+		t.Errorf("Expected %+v, received %+v", exp, sa)
+	}
+}
+
+func TestNewSessionAlterationErrors(t *testing.T) {
+	cgrEv := &utils.CGREvent{
+		Event: map[string]any{},
+		APIOpts: map[string]any{
+			utils.OptsAlterVariables: "Announce",
+		},
+	}
+	expErr := utils.NewErrMandatoryIeMissing("Announce").Error()
+	if _, err := newSessionAlteration(cgrEv); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %s, received %v", expErr, err)
+	}
+	cgrEv.APIOpts = map[string]any{
+		utils.OptsAlterMaxUsage: "invalid",
+	}
+	if _, err := newSessionAlteration(cgrEv); err == nil {
+		t.Error("Expected error for invalid max usage")
+	}
+	cgrEv.APIOpts = map[string]any{
+		utils.OptsAlterVariables: utils.EmptyString,
+	}
+	expErr = utils.NewErrMandatoryIeMissing(utils.OptsAlterVariables, utils.OptsAlterMaxUsage).Error()
+	if _, err := newSessionAlteration(cgrEv); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %s, received %v", expErr, err)
+	}
+}
+
+func TestUsageSeconds(t *testing.T) {
+	for usage, exp := range map[time.Duration]int{
+		0:                              0,
+		time.Millisecond:               1,
+		500 * time.Millisecond:         1,
+		time.Second:                    1,
+		time.Second + time.Millisecond: 2,
+		90 * time.Second:               90,
+	} {
+		if rcv := usageSeconds(usage); rcv != exp {
+			t.Errorf("Expected %d for usage %v, received: %d", exp, usage, rcv)
+		}
+	}
+}
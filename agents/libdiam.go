@@ -328,6 +328,15 @@ func (dP *diameterDP) FieldAsInterface(fldPath []string) (data any, err error) {
 	} else {
 		return // data was found in cache
 	}
+	for i, grpPath := range fldPath[:len(fldPath)-1] { // selector on a grouped AVP, continue the search inside the selected group
+		if strings.HasSuffix(grpPath, utils.IdxEnd) {
+			if data, err = dP.fieldFromGroup(fldPath, i); err != nil {
+				return nil, err
+			}
+			dP.cache.Set(fldPath, data)
+			return
+		}
+	}
 	// lastPath can contain selector inside
 	lastPath := fldPath[len(fldPath)-1]
 	var slctrStr string
@@ -355,20 +364,7 @@ func (dP *diameterDP) FieldAsInterface(fldPath []string) (data any, err error) {
 			selIndxs := make(map[int]int) // use it to find intersection of all matched filters
 			slctrStrs := strings.Split(slctrStr, utils.PipeSep)
 			for _, slctrStr := range slctrStrs {
-				var fltrs utils.RSRFilters
-				if strings.HasSuffix(slctrStr, utils.FilterValEnd) { // Has filter, populate the var
-					fltrStart := strings.Index(slctrStr, utils.FilterValStart)
-					if fltrStart < 1 {
-						return nil, fmt.Errorf("invalid RSRFilter start rule in string: <%s>", slctrStr)
-					}
-					fltrVal := slctrStr[fltrStart+1 : len(slctrStr)-1]
-					if fltrs, err = utils.ParseRSRFilters(fltrVal, utils.ANDSep); err != nil {
-						return nil, fmt.Errorf("Invalid FilterValue in string: %s, err: %s", fltrVal, err.Error())
-					}
-					slctrStr = slctrStr[:fltrStart] // Take the filter part out before compiling further
-				}
-
-				slctr, err := config.NewRSRParser(slctrStr)
+				slctr, fltrs, err := newDiamSelector(slctrStr)
 				if err != nil {
 					return nil, err
 				}
@@ -422,6 +418,89 @@ func (dP *diameterDP) FieldAsInterface(fldPath []string) (data any, err error) {
 	return
 }
 
+// newDiamSelector compiles one AVP selector in the form ~AVP-Name(filter)
+func newDiamSelector(slctrStr string) (slctr *config.RSRParser, fltrs utils.RSRFilters, err error) {
+	if strings.HasSuffix(slctrStr, utils.FilterValEnd) { // Has filter, populate the var
+		fltrStart := strings.Index(slctrStr, utils.FilterValStart)
+		if fltrStart < 1 {
+			return nil, nil, fmt.Errorf("invalid RSRFilter start rule in string: <%s>", slctrStr)
+		}
+		fltrVal := slctrStr[fltrStart+1 : len(slctrStr)-1]
+		if fltrs, err = utils.ParseRSRFilters(fltrVal, utils.ANDSep); err != nil {
+			return nil, nil, fmt.Errorf("Invalid FilterValue in string: %s, err: %s", fltrVal, err.Error())
+		}
+		slctrStr = slctrStr[:fltrStart] // Take the filter part out before compiling further
+	}
+	slctr, err = config.NewRSRParser(slctrStr)
+	return
+}
+
+// fieldFromGroup selects one of the grouped AVPs found at fldPath[:grpIdx+1]
+// and returns the value of the remaining path searched inside of it
+// eg: Multiple-Services-Credit-Control[~Rating-Group(1)].Used-Service-Unit.CC-Total-Octets
+func (dP *diameterDP) fieldFromGroup(fldPath []string, grpIdx int) (data any, err error) {
+	grpPath := fldPath[grpIdx]
+	idxStart := strings.Index(grpPath, utils.IdxStart)
+	if idxStart < 1 {
+		return nil, fmt.Errorf("invalid group selector in path: <%s>", grpPath)
+	}
+	slctrStr := grpPath[idxStart+1 : len(grpPath)-1]
+	pathIface := utils.SliceStringToIface(fldPath[:grpIdx+1])
+	pathIface[grpIdx] = grpPath[:idxStart]
+	var grpAVPs []*diam.AVP
+	if grpAVPs, err = dP.m.FindAVPsWithPath(pathIface, dict.UndefinedVendorID); err != nil {
+		return nil, err
+	}
+	grpDPs := make([]*diameterDP, 0, len(grpAVPs))
+	for _, grpAVP := range grpAVPs {
+		grpData, isGrp := grpAVP.Data.(*diam.GroupedAVP)
+		if !isGrp {
+			return nil, fmt.Errorf("AVP <%s> is not grouped", grpPath[:idxStart])
+		}
+		m := diam.NewMessage(dP.m.Header.CommandCode, dP.m.Header.CommandFlags,
+			dP.m.Header.ApplicationID, dP.m.Header.HopByHopID, dP.m.Header.EndToEndID, dP.m.Dictionary())
+		m.AVP = grpData.AVP
+		grpDPs = append(grpDPs, &diameterDP{c: dP.c, m: m, cache: utils.MapStorage{}})
+	}
+	if slctdIdx, errIdx := strconv.Atoi(slctrStr); errIdx == nil {
+		if slctdIdx >= len(grpDPs) {
+			return nil, utils.ErrNotFound
+		}
+		return grpDPs[slctdIdx].FieldAsInterface(fldPath[grpIdx+1:])
+	}
+	slctrStrs := strings.Split(slctrStr, utils.PipeSep)
+	for _, grpDP := range grpDPs {
+		pass := true
+		for _, slctrStr := range slctrStrs {
+			var slctr *config.RSRParser
+			var fltrs utils.RSRFilters
+			if slctr, fltrs, err = newDiamSelector(slctrStr); err != nil {
+				return nil, err
+			}
+			var fld string
+			if fld, err = slctr.ParseDataProvider(grpDP); err != nil {
+				if err != utils.ErrNotFound {
+					return nil, err
+				}
+				err = nil
+				if fltrs.FilterRules() == "^$" { // filter on missing AVP
+					continue
+				}
+				pass = false
+				break
+			}
+			if !fltrs.Pass(fld, true) {
+				pass = false
+				break
+			}
+		}
+		if pass {
+			return grpDP.FieldAsInterface(fldPath[grpIdx+1:])
+		}
+	}
+	return nil, utils.ErrNotFound // no group matching the selector
+}
+
 // updateDiamMsgFromNavMap will update the diameter message with items from navigable map
 func updateDiamMsgFromNavMap(m *diam.Message, navMp *utils.OrderedNavigableMap, tmz string) (err error) {
 	// write reply into message
@@ -1136,6 +1136,60 @@ func TestLibDiamAvpGroupIface(t *testing.T) {
 	}
 }
 
+func TestLibDiamAvpGroupSelector(t *testing.T) {
+	m := diam.NewRequest(diam.CreditControl, 4, nil)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("session1"))
+	m.NewAVP("Multiple-Services-Credit-Control", avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(432, avp.Mbit, 0, datatype.Unsigned32(1)),
+			diam.NewAVP(446, avp.Mbit, 0, &diam.GroupedAVP{
+				AVP: []*diam.AVP{
+					diam.NewAVP(421, avp.Mbit, 0, datatype.Unsigned64(100)),
+				}}),
+		}})
+	m.NewAVP("Multiple-Services-Credit-Control", avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(432, avp.Mbit, 0, datatype.Unsigned32(2)),
+		}})
+	m.NewAVP("Multiple-Services-Credit-Control", avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(432, avp.Mbit, 0, datatype.Unsigned32(3)),
+			diam.NewAVP(446, avp.Mbit, 0, &diam.GroupedAVP{
+				AVP: []*diam.AVP{
+					diam.NewAVP(421, avp.Mbit, 0, datatype.Unsigned64(300)),
+				}}),
+		}})
+	dP := newDADataProvider(nil, m)
+	if out, err := dP.FieldAsInterface([]string{"Multiple-Services-Credit-Control[~Rating-Group(3)]",
+		"Used-Service-Unit", "CC-Total-Octets"}); err != nil {
+		t.Error(err)
+	} else if out != any(uint64(300)) {
+		t.Errorf("Expecting: 300, received: %v", out)
+	}
+	if out, err := dP.FieldAsInterface([]string{"Multiple-Services-Credit-Control[1]", "Rating-Group"}); err != nil {
+		t.Error(err)
+	} else if out != any(uint32(2)) {
+		t.Errorf("Expecting: 2, received: %v", out)
+	}
+	if out, err := dP.FieldAsInterface([]string{"Multiple-Services-Credit-Control[0]",
+		"Used-Service-Unit", "CC-Total-Octets"}); err != nil {
+		t.Error(err)
+	} else if out != any(uint64(100)) {
+		t.Errorf("Expecting: 100, received: %v", out)
+	}
+	if _, err := dP.FieldAsInterface([]string{"Multiple-Services-Credit-Control[~Rating-Group(2)]",
+		"Used-Service-Unit", "CC-Total-Octets"}); err != utils.ErrNotFound {
+		t.Errorf("Expecting: %v, received: %v", utils.ErrNotFound, err)
+	}
+	if _, err := dP.FieldAsInterface([]string{"Multiple-Services-Credit-Control[5]", "Rating-Group"}); err != utils.ErrNotFound {
+		t.Errorf("Expecting: %v, received: %v", utils.ErrNotFound, err)
+	}
+	expErr := "AVP <Session-Id> is not grouped"
+	if _, err := dP.FieldAsInterface([]string{"Session-Id[0]", "Rating-Group"}); err == nil || err.Error() != expErr {
+		t.Errorf("Expecting: %v, received: %v", expErr, err)
+	}
+}
+
 func TestLibDiamFilterWithDiameterDP(t *testing.T) {
 	avps := diam.NewRequest(diam.CreditControl, 4, nil)
 	avps.NewAVP("Multiple-Services-Credit-Control", avp.Mbit, 0, &diam.GroupedAVP{
@@ -0,0 +1,190 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"fmt"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// NewPrometheusAgent constructs a PrometheusAgent
+func NewPrometheusAgent(cfg *config.CGRConfig, connMgr *engine.ConnManager) *PrometheusAgent {
+	return &PrometheusAgent{
+		cfg:     cfg,
+		connMgr: connMgr,
+		statMetric: prometheus.NewDesc("cgrates_stats_metric",
+			"Current value of a StatQueue metric",
+			[]string{"tenant", "queue", "metric"}, nil),
+		resourceUsage: prometheus.NewDesc("cgrates_resource_usage",
+			"Total units allocated on a Resource",
+			[]string{"tenant", "resource"}, nil),
+		resourceLimit: prometheus.NewDesc("cgrates_resource_limit",
+			"Configured limit of a Resource",
+			[]string{"tenant", "resource"}, nil),
+		trendMetric: prometheus.NewDesc("cgrates_trend_metric",
+			"Last computed value of a Trend metric",
+			[]string{"tenant", "trend", "metric"}, nil),
+		trendGrowth: prometheus.NewDesc("cgrates_trend_growth",
+			"Growth of a Trend metric compared with the previous run",
+			[]string{"tenant", "trend", "metric"}, nil),
+		retryQueueDepth: prometheus.NewDesc("cgrates_ees_retry_queue_depth",
+			"Number of exports waiting in the retry queue of an exporter",
+			[]string{"exporter"}, nil),
+		retryQueueAge: prometheus.NewDesc("cgrates_ees_retry_queue_oldest_age_seconds",
+			"Age of the oldest export waiting in the retry queue of an exporter",
+			[]string{"exporter"}, nil),
+	}
+}
+
+// PrometheusAgent is a prometheus.Collector exposing the state of
+// StatS, ResourceS and TrendS subsystems and the retry queues of EEs
+type PrometheusAgent struct {
+	cfg     *config.CGRConfig
+	connMgr *engine.ConnManager
+
+	statMetric    *prometheus.Desc
+	resourceUsage *prometheus.Desc
+	resourceLimit *prometheus.Desc
+	trendMetric   *prometheus.Desc
+	trendGrowth   *prometheus.Desc
+
+	retryQueueDepth *prometheus.Desc
+	retryQueueAge   *prometheus.Desc
+}
+
+// Describe implements prometheus.Collector
+func (pa *PrometheusAgent) Describe(ch chan<- *prometheus.Desc) {
+	ch <- pa.statMetric
+	ch <- pa.resourceUsage
+	ch <- pa.resourceLimit
+	ch <- pa.trendMetric
+	ch <- pa.trendGrowth
+	ch <- pa.retryQueueDepth
+	ch <- pa.retryQueueAge
+}
+
+// Collect implements prometheus.Collector, querying the subsystems on each scrape
+func (pa *PrometheusAgent) Collect(ch chan<- prometheus.Metric) {
+	pCfg := pa.cfg.PrometheusAgentCfg()
+	if len(pCfg.StatSConns) != 0 {
+		for _, tntID := range pCfg.StatQueueIDs {
+			pa.collectStatQueue(ch, pCfg.StatSConns, pa.tenantID(tntID))
+		}
+	}
+	if len(pCfg.ResourceSConns) != 0 {
+		for _, tntID := range pCfg.ResourceIDs {
+			pa.collectResource(ch, pCfg.ResourceSConns, pa.tenantID(tntID))
+		}
+	}
+	if len(pCfg.TrendSConns) != 0 {
+		for _, tntID := range pCfg.TrendIDs {
+			pa.collectTrend(ch, pCfg.TrendSConns, pa.tenantID(tntID))
+		}
+	}
+	if len(pCfg.EEsConns) != 0 {
+		pa.collectRetryQueues(ch, pCfg.EEsConns)
+	}
+}
+
+// tenantID parses the <[tenant:]ID> format, defaulting to the configured tenant
+func (pa *PrometheusAgent) tenantID(tntID string) *utils.TenantID {
+	tID := utils.NewTenantID(tntID)
+	if tID.Tenant == utils.EmptyString {
+		tID.Tenant = pa.cfg.GeneralCfg().DefaultTenant
+	}
+	return tID
+}
+
+func (pa *PrometheusAgent) collectStatQueue(ch chan<- prometheus.Metric, conns []string, tID *utils.TenantID) {
+	var metrics map[string]float64
+	if err := pa.connMgr.Call(context.Background(), conns, utils.StatSv1GetQueueFloatMetrics,
+		&utils.TenantIDWithAPIOpts{TenantID: tID}, &metrics); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed retrieving metrics for StatQueue <%s>: %v",
+			utils.PrometheusAgent, tID.TenantID(), err))
+		return
+	}
+	for metricID, val := range metrics {
+		if val == utils.StatsNA {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(pa.statMetric, prometheus.GaugeValue,
+			val, tID.Tenant, tID.ID, metricID)
+	}
+}
+
+func (pa *PrometheusAgent) collectResource(ch chan<- prometheus.Metric, conns []string, tID *utils.TenantID) {
+	var rsWithCfg engine.ResourceWithConfig
+	if err := pa.connMgr.Call(context.Background(), conns, utils.ResourceSv1GetResourceWithConfig,
+		&utils.TenantIDWithAPIOpts{TenantID: tID}, &rsWithCfg); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed retrieving Resource <%s>: %v",
+			utils.PrometheusAgent, tID.TenantID(), err))
+		return
+	}
+	if rsWithCfg.Resource != nil {
+		ch <- prometheus.MustNewConstMetric(pa.resourceUsage, prometheus.GaugeValue,
+			rsWithCfg.Resource.TotalUsage(), tID.Tenant, tID.ID)
+	}
+	if rsWithCfg.Config != nil {
+		ch <- prometheus.MustNewConstMetric(pa.resourceLimit, prometheus.GaugeValue,
+			rsWithCfg.Config.Limit, tID.Tenant, tID.ID)
+	}
+}
+
+func (pa *PrometheusAgent) collectTrend(ch chan<- prometheus.Metric, conns []string, tID *utils.TenantID) {
+	var trSum engine.TrendSummary
+	if err := pa.connMgr.Call(context.Background(), conns, utils.TrendSv1GetTrendSummary,
+		utils.TenantIDWithAPIOpts{TenantID: tID}, &trSum); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed retrieving Trend <%s>: %v",
+			utils.PrometheusAgent, tID.TenantID(), err))
+		return
+	}
+	for metricID, mWt := range trSum.Metrics {
+		if mWt.Value != utils.StatsNA {
+			ch <- prometheus.MustNewConstMetric(pa.trendMetric, prometheus.GaugeValue,
+				mWt.Value, tID.Tenant, tID.ID, metricID)
+		}
+		if mWt.TrendLabel != utils.NotAvailable {
+			ch <- prometheus.MustNewConstMetric(pa.trendGrowth, prometheus.GaugeValue,
+				mWt.TrendGrowth, tID.Tenant, tID.ID, metricID)
+		}
+	}
+}
+
+func (pa *PrometheusAgent) collectRetryQueues(ch chan<- prometheus.Metric, conns []string) {
+	var stats map[string]*engine.RetryQueueStats
+	if err := pa.connMgr.Call(context.Background(), conns, utils.EeSv1GetRetryQueues,
+		&engine.ArgsGetRetryQueues{}, &stats); err != nil {
+		if err.Error() != utils.ErrNotFound.Error() {
+			utils.Logger.Warning(fmt.Sprintf("<%s> failed retrieving the EEs retry queues: %v",
+				utils.PrometheusAgent, err))
+		}
+		return
+	}
+	for expID, st := range stats {
+		ch <- prometheus.MustNewConstMetric(pa.retryQueueDepth, prometheus.GaugeValue,
+			float64(st.Depth), expID)
+		ch <- prometheus.MustNewConstMetric(pa.retryQueueAge, prometheus.GaugeValue,
+			st.OldestAge.Seconds(), expID)
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestPrometheusAgentCollect(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	pCfg := cfg.PrometheusAgentCfg()
+	pCfg.StatSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats)}
+	pCfg.StatQueueIDs = []string{"SQ_1"}
+	pCfg.ResourceSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources)}
+	pCfg.ResourceIDs = []string{"cgrates.net:RES_1"}
+	pCfg.TrendSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaTrends)}
+	pCfg.TrendIDs = []string{"TR_1"}
+	pCfg.EEsConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)}
+
+	conn := &testMockSessionConn{calls: map[string]func(arg any, rply any) error{
+		utils.StatSv1GetQueueFloatMetrics: func(arg any, rply any) error {
+			if tID := arg.(*utils.TenantIDWithAPIOpts).TenantID; tID.Tenant != "cgrates.org" || tID.ID != "SQ_1" {
+				t.Errorf("unexpected StatQueue: %s", utils.ToJSON(tID))
+			}
+			*rply.(*map[string]float64) = map[string]float64{
+				utils.MetaASR: 50,
+				utils.MetaACD: utils.StatsNA,
+			}
+			return nil
+		},
+		utils.ResourceSv1GetResourceWithConfig: func(arg any, rply any) error {
+			if tID := arg.(*utils.TenantIDWithAPIOpts).TenantID; tID.Tenant != "cgrates.net" || tID.ID != "RES_1" {
+				t.Errorf("unexpected Resource: %s", utils.ToJSON(tID))
+			}
+			*rply.(*engine.ResourceWithConfig) = engine.ResourceWithConfig{
+				Resource: &engine.Resource{
+					Tenant: "cgrates.net",
+					ID:     "RES_1",
+					Usages: map[string]*engine.ResourceUsage{
+						"RU_1": {ID: "RU_1", Units: 2},
+						"RU_2": {ID: "RU_2", Units: 3},
+					},
+				},
+				Config: &engine.ResourceProfile{Limit: 10},
+			}
+			return nil
+		},
+		utils.TrendSv1GetTrendSummary: func(arg any, rply any) error {
+			*rply.(*engine.TrendSummary) = engine.TrendSummary{
+				Tenant: "cgrates.org",
+				ID:     "TR_1",
+				Metrics: map[string]*engine.MetricWithTrend{
+					utils.MetaTCD: {ID: utils.MetaTCD, Value: 120, TrendGrowth: 20, TrendLabel: utils.MetaPositive},
+				},
+			}
+			return nil
+		},
+		utils.EeSv1GetRetryQueues: func(arg any, rply any) error {
+			*rply.(*map[string]*engine.RetryQueueStats) = map[string]*engine.RetryQueueStats{
+				"EXP_KAFKA": {Depth: 3, OldestAge: 90 * time.Second, Backoff: 4 * time.Second},
+			}
+			return nil
+		},
+	}}
+	stsChan := make(chan birpc.ClientConnector, 1)
+	stsChan <- conn
+	rsChan := make(chan birpc.ClientConnector, 1)
+	rsChan <- conn
+	trChan := make(chan birpc.ClientConnector, 1)
+	trChan <- conn
+	eesChan := make(chan birpc.ClientConnector, 1)
+	eesChan <- conn
+	connMgr := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats):     stsChan,
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources): rsChan,
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaTrends):    trChan,
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs):       eesChan,
+	})
+
+	exp := `# HELP cgrates_ees_retry_queue_depth Number of exports waiting in the retry queue of an exporter
+# TYPE cgrates_ees_retry_queue_depth gauge
+cgrates_ees_retry_queue_depth{exporter="EXP_KAFKA"} 3
+# HELP cgrates_ees_retry_queue_oldest_age_seconds Age of the oldest export waiting in the retry queue of an exporter
+# TYPE cgrates_ees_retry_queue_oldest_age_seconds gauge
+cgrates_ees_retry_queue_oldest_age_seconds{exporter="EXP_KAFKA"} 90
+# HELP cgrates_resource_limit Configured limit of a Resource
+# TYPE cgrates_resource_limit gauge
+cgrates_resource_limit{resource="RES_1",tenant="cgrates.net"} 10
+# HELP cgrates_resource_usage Total units allocated on a Resource
+# TYPE cgrates_resource_usage gauge
+cgrates_resource_usage{resource="RES_1",tenant="cgrates.net"} 5
+# HELP cgrates_stats_metric Current value of a StatQueue metric
+# TYPE cgrates_stats_metric gauge
+cgrates_stats_metric{metric="*asr",queue="SQ_1",tenant="cgrates.org"} 50
+# HELP cgrates_trend_growth Growth of a Trend metric compared with the previous run
+# TYPE cgrates_trend_growth gauge
+cgrates_trend_growth{metric="*tcd",tenant="cgrates.org",trend="TR_1"} 20
+# HELP cgrates_trend_metric Last computed value of a Trend metric
+# TYPE cgrates_trend_metric gauge
+cgrates_trend_metric{metric="*tcd",tenant="cgrates.org",trend="TR_1"} 120
+`
+	if err := testutil.CollectAndCompare(NewPrometheusAgent(cfg, connMgr),
+		strings.NewReader(exp)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPrometheusAgentCollectNoConns(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.PrometheusAgentCfg().StatQueueIDs = []string{"SQ_1"}
+	pa := NewPrometheusAgent(cfg, engine.NewConnManager(cfg, nil))
+	if cnt := testutil.CollectAndCount(pa); cnt != 0 {
+		t.Errorf("expected no metrics, received %d", cnt)
+	}
+}
@@ -19,6 +19,7 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package agents
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -39,6 +40,7 @@ const (
 	MetaRadReqType     = "*radReqType"
 	MetaRadAuth        = "*radAuth"
 	MetaRadReplyCode   = "*radReplyCode"
+	MetaRadClientID    = "*radClientID"
 	UserPasswordAVP    = "User-Password"
 	CHAPPasswordAVP    = "CHAP-Password"
 	MSCHAPChallengeAVP = "MS-CHAP-Challenge"
@@ -80,9 +82,24 @@ func NewRadiusAgent(cgrCfg *config.CGRConfig, filterS *engine.FilterS, connMgr *
 	radAgent.dacCfg = newRadiusDAClientCfg(dicts, secrets, radAgentCfg)
 	radAgent.rsAuth = make(map[string]*radigo.Server, len(radAgentCfg.Listeners))
 	radAgent.rsAcct = make(map[string]*radigo.Server, len(radAgentCfg.Listeners))
+	radAgent.rsTLS = make(map[string]*radsecServer)
+	var radsecTLSCfg *tls.Config
 	for i := range radAgentCfg.Listeners {
 		net := radAgentCfg.Listeners[i].Network
 		authAddr := radAgentCfg.Listeners[i].AuthAddr
+		if net == utils.TLSNoCaps {
+			if radsecTLSCfg == nil {
+				if radsecTLSCfg, err = radsecServerTLSConfig(cgrCfg.TLSCfg()); err != nil {
+					return nil, err
+				}
+			}
+			// auth and acct can share the same address, as recommended by RFC 6614
+			radAgent.addRadsecHandler(authAddr, radsecTLSCfg, dicts,
+				radigo.AccessRequest, radAgent.handleAuthRequest)
+			radAgent.addRadsecHandler(radAgentCfg.Listeners[i].AcctAddr, radsecTLSCfg, dicts,
+				radigo.AccountingRequest, radAgent.handleAcctRequest)
+			continue
+		}
 		radAgent.rsAuth[net+"://"+authAddr] = radigo.NewServer(net, authAddr, secrets, dicts,
 			map[radigo.PacketCode]func(*radigo.Packet) (*radigo.Packet, error){
 				radigo.AccessRequest: radAgent.handleAuth,
@@ -96,6 +113,18 @@ func NewRadiusAgent(cgrCfg *config.CGRConfig, filterS *engine.FilterS, connMgr *
 	return radAgent, nil
 }
 
+// addRadsecHandler registers the handler on the RadSec server listening on addr, creating the server if needed
+func (ra *RadiusAgent) addRadsecHandler(addr string, tlsCfg *tls.Config, dicts *radigo.Dictionaries,
+	code radigo.PacketCode, hndlr radsecHandler) {
+	uri := utils.TLSNoCaps + "://" + addr
+	rs, has := ra.rsTLS[uri]
+	if !has {
+		rs = newRadsecServer(addr, tlsCfg, dicts, make(map[radigo.PacketCode]radsecHandler))
+		ra.rsTLS[uri] = rs
+	}
+	rs.handlers[code] = hndlr
+}
+
 type RadiusAgent struct {
 	sync.RWMutex
 	cgrCfg  *config.CGRConfig // reference for future config reloads
@@ -104,6 +133,7 @@ type RadiusAgent struct {
 	filterS *engine.FilterS
 	rsAuth  map[string]*radigo.Server
 	rsAcct  map[string]*radigo.Server
+	rsTLS   map[string]*radsecServer
 	dacCfg  radiusDAClientCfg
 	ctx     *context.Context
 	sync.WaitGroup
@@ -137,8 +167,26 @@ func newRadiusDAClientCfg(dicts *radigo.Dictionaries, secrets *radigo.Secrets,
 	return rdac
 }
 
+// radDAClient sends Dynamic Authorization requests towards the RADIUS clients
+type radDAClient interface {
+	NewRequest(code radigo.PacketCode, id uint8) *radigo.Packet
+	SendRequest(req *radigo.Packet) (*radigo.Packet, error)
+}
+
+// radCachedPacket keeps the RADIUS packet together with the address it was received from
+type radCachedPacket struct {
+	pkt        *radigo.Packet
+	remoteAddr string
+}
+
 // handleAuth handles RADIUS Authorization request
 func (ra *RadiusAgent) handleAuth(reqPacket *radigo.Packet) (*radigo.Packet, error) {
+	return ra.handleAuthRequest(reqPacket, reqPacket.RemoteAddr().String(), utils.EmptyString)
+}
+
+// handleAuthRequest handles RADIUS Authorization request received from remoteAddr,
+// clientID being the identity out of the client certificate for RadSec
+func (ra *RadiusAgent) handleAuthRequest(reqPacket *radigo.Packet, remoteAddr, clientID string) (*radigo.Packet, error) {
 	if ra.caps.IsLimited() {
 		if err := ra.caps.Allocate(); err != nil {
 			return reqPacket, err
@@ -152,12 +200,7 @@ func (ra *RadiusAgent) handleAuth(reqPacket *radigo.Packet) (*radigo.Packet, err
 	replyNM := utils.NewOrderedNavigableMap()
 	opts := utils.MapStorage{}
 
-	varsDataNode := &utils.DataNode{
-		Type: utils.NMMapType,
-		Map: map[string]*utils.DataNode{
-			utils.RemoteHost: utils.NewLeafNode(reqPacket.RemoteAddr().String()),
-		},
-	}
+	varsDataNode := radVarsDataNode(remoteAddr, clientID)
 	radDP := newRADataProvider(reqPacket)
 	var processed bool
 	var processReqErr error
@@ -196,6 +239,12 @@ func (ra *RadiusAgent) handleAuth(reqPacket *radigo.Packet) (*radigo.Packet, err
 // handleAcct processes RADIUS Accounting requests and generates a reply.
 // It supports Acct-Status-Type values: Start, Interim-Update, Stop.
 func (ra *RadiusAgent) handleAcct(reqPacket *radigo.Packet) (*radigo.Packet, error) {
+	return ra.handleAcctRequest(reqPacket, reqPacket.RemoteAddr().String(), utils.EmptyString)
+}
+
+// handleAcctRequest processes RADIUS Accounting requests received from remoteAddr,
+// clientID being the identity out of the client certificate for RadSec
+func (ra *RadiusAgent) handleAcctRequest(reqPacket *radigo.Packet, remoteAddr, clientID string) (*radigo.Packet, error) {
 	if ra.caps.IsLimited() {
 		if err := ra.caps.Allocate(); err != nil {
 			return nil, err
@@ -212,13 +261,7 @@ func (ra *RadiusAgent) handleAcct(reqPacket *radigo.Packet) (*radigo.Packet, err
 	rplyNM := utils.NewOrderedNavigableMap()
 	opts := utils.MapStorage{}
 
-	remoteAddr := reqPacket.RemoteAddr().String()
-	varsDataNode := &utils.DataNode{
-		Type: utils.NMMapType,
-		Map: map[string]*utils.DataNode{
-			utils.RemoteHost: utils.NewLeafNode(remoteAddr),
-		},
-	}
+	varsDataNode := radVarsDataNode(remoteAddr, clientID)
 
 	radDP := newRADataProvider(reqPacket)
 	radAgentCfg := ra.cgrCfg.RadiusAgentCfg()
@@ -275,6 +318,20 @@ func (ra *RadiusAgent) handleAcct(reqPacket *radigo.Packet) (*radigo.Packet, err
 	return replyPacket, nil
 }
 
+// radVarsDataNode builds the *vars of the request, populating the client identity only for RadSec
+func radVarsDataNode(remoteAddr, clientID string) *utils.DataNode {
+	varsDataNode := &utils.DataNode{
+		Type: utils.NMMapType,
+		Map: map[string]*utils.DataNode{
+			utils.RemoteHost: utils.NewLeafNode(remoteAddr),
+		},
+	}
+	if clientID != utils.EmptyString {
+		varsDataNode.Map[MetaRadClientID] = utils.NewLeafNode(clientID)
+	}
+	return varsDataNode
+}
+
 // cacheRadiusPacket caches a RADIUS packet if there are client options found for its source address.
 func cacheRadiusPacket(packet *radigo.Packet, address string, cfg *config.RadiusAgentCfg,
 	dp utils.DataProvider) error {
@@ -286,8 +343,8 @@ func cacheRadiusPacket(packet *radigo.Packet, address string, cfg *config.Radius
 	if err != nil {
 		return fmt.Errorf("failed to parse the RADIUS packet cache key: %w", err)
 	}
-	if err = engine.Cache.Set(utils.CacheRadiusPackets, cacheKey, packet,
-		nil, true, utils.NonTransactional); err != nil {
+	if err = engine.Cache.Set(utils.CacheRadiusPackets, cacheKey,
+		&radCachedPacket{pkt: packet, remoteAddr: address}, nil, true, utils.NonTransactional); err != nil {
 		return fmt.Errorf("failed to cache RADIUS packet: %w", err)
 	}
 	return nil
@@ -506,6 +563,21 @@ func (ra *RadiusAgent) ListenAndServe(stopChan <-chan struct{}) (err error) {
 			}
 		}(server, uri)
 	}
+	for uri, server := range ra.rsTLS {
+		ra.Add(1)
+		go func(srv *radsecServer, uri string) {
+			defer ra.Done()
+			utils.Logger.Info(fmt.Sprintf("<%s> Start listening for RadSec requests on <%s>", utils.RadiusAgent, uri))
+			if err := srv.ListenAndServe(stopChan); err != nil {
+				utils.Logger.Warning(fmt.Sprintf("<%s> error <%v>, on ListenAndServe <%s>",
+					utils.RadiusAgent, err, uri))
+				if strings.Contains(err.Error(), "address already in use") {
+					return
+				}
+				errListen <- err
+			}
+		}(server, uri)
+	}
 
 	err = <-errListen
 	return
@@ -593,7 +665,7 @@ func (ra *RadiusAgent) sendRadDaReq(requestType radigo.PacketCode, requestTempla
 	if !has {
 		return 0, fmt.Errorf("failed to retrieve packet from cache: %w", utils.ErrNotFound)
 	}
-	packet := cachedPacket.(*radigo.Packet)
+	packet := cachedPacket.(*radCachedPacket).pkt
 
 	agReq := NewAgentRequest(
 		requestEv, requestVars, nil, nil, nil, nil,
@@ -607,17 +679,31 @@ func (ra *RadiusAgent) sendRadDaReq(requestType radigo.PacketCode, requestTempla
 		return 0, fmt.Errorf("could not set attributes: %w", err)
 	}
 
-	remoteAddr, remoteHost, err := daRequestAddress(packet.RemoteAddr().String(),
+	remoteAddr, remoteHost, err := daRequestAddress(cachedPacket.(*radCachedPacket).remoteAddr,
 		ra.cgrCfg.RadiusAgentCfg().ClientDaAddresses)
 	if err != nil {
 		return 0, fmt.Errorf("retrieving remote address failed: %w", err)
 	}
 	clientOpts := ra.cgrCfg.RadiusAgentCfg().ClientDaAddresses[remoteHost]
-	dynAuthClient, err := radigo.NewClient(clientOpts.Transport, remoteAddr,
+	var dynAuthClient radDAClient
+	if clientOpts.Transport == utils.TLSNoCaps {
+		var tlsCfg *tls.Config
+		if tlsCfg, err = radsecClientTLSConfig(ra.cgrCfg.TLSCfg()); err != nil {
+			return 0, fmt.Errorf("dynamic authorization client init failed: %w", err)
+		}
+		var rsClient *radsecClient
+		if rsClient, err = newRadsecClient(remoteAddr, tlsCfg,
+			ra.dacCfg.dicts.GetInstance(remoteHost),
+			ra.cgrCfg.GeneralCfg().ConnectAttempts,
+			ra.cgrCfg.GeneralCfg().ReplyTimeout); err != nil {
+			return 0, fmt.Errorf("dynamic authorization client init failed: %w", err)
+		}
+		defer rsClient.Close()
+		dynAuthClient = rsClient
+	} else if dynAuthClient, err = radigo.NewClient(clientOpts.Transport, remoteAddr,
 		ra.dacCfg.secrets.GetSecret(remoteHost),
 		ra.dacCfg.dicts.GetInstance(remoteHost),
-		ra.cgrCfg.GeneralCfg().ConnectAttempts, nil, utils.Logger)
-	if err != nil {
+		ra.cgrCfg.GeneralCfg().ConnectAttempts, nil, utils.Logger); err != nil {
 		return 0, fmt.Errorf("dynamic authorization client init failed: %w", err)
 	}
 	dynAuthReq := dynAuthClient.NewRequest(requestType, 1)
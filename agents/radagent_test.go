@@ -20,6 +20,7 @@ package agents
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/cgrates/birpc/context"
@@ -61,6 +62,42 @@ func TestNewRadiusAgentOK(t *testing.T) {
 	}
 }
 
+func TestNewRadiusAgentRadsec(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.RadiusAgentCfg().ClientDictionaries = map[string][]string{}
+	cfg.RadiusAgentCfg().Listeners = []config.RadiusListener{{
+		Network:  utils.TLSNoCaps,
+		AuthAddr: "127.0.0.1:2083",
+		AcctAddr: "127.0.0.1:2083",
+	}}
+	cfg.TLSCfg().ServerCerificate = "/tmp/missing.crt"
+	cfg.TLSCfg().ServerKey = "/tmp/missing.key"
+	if _, err := NewRadiusAgent(cfg, nil, nil, nil); err == nil ||
+		!strings.HasPrefix(err.Error(), "load certificate error") {
+		t.Errorf("Expected load certificate error, received: %v", err)
+	}
+	cfg.TLSCfg().ServerCerificate = "../data/tls/server.crt"
+	cfg.TLSCfg().ServerKey = "../data/tls/server.key"
+	cfg.TLSCfg().CaCertificate = "../data/tls/ca.crt"
+	ra, err := NewRadiusAgent(cfg, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ra.rsAuth) != 0 || len(ra.rsAcct) != 0 {
+		t.Errorf("Unexpected radigo servers: %v, %v", ra.rsAuth, ra.rsAcct)
+	}
+	rs, has := ra.rsTLS["tls://127.0.0.1:2083"]
+	if !has || len(ra.rsTLS) != 1 {
+		t.Fatalf("Unexpected RadSec servers: %v", ra.rsTLS)
+	}
+	if _, has := rs.handlers[radigo.AccessRequest]; !has {
+		t.Error("Missing handler for AccessRequest")
+	}
+	if _, has := rs.handlers[radigo.AccountingRequest]; !has {
+		t.Error("Missing handler for AccountingRequest")
+	}
+}
+
 func TestNewRadiusDAClientCfgOK(t *testing.T) {
 
 	radAgCfg := &config.RadiusAgentCfg{
@@ -0,0 +1,302 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/radigo"
+)
+
+const (
+	// radsecSecret is the fixed shared secret used over TLS as defined in RFC 6614
+	radsecSecret = "radsec"
+	// radHeaderLen is the length of the RADIUS header carrying code, identifier, length and authenticator
+	radHeaderLen = 20
+)
+
+// radsecHandler processes one RADIUS request received over TLS
+type radsecHandler func(req *radigo.Packet, remoteAddr, clientID string) (*radigo.Packet, error)
+
+// newRadsecServer constructs a RadSec (RADIUS over TLS) server
+func newRadsecServer(addr string, tlsCfg *tls.Config, dicts *radigo.Dictionaries,
+	handlers map[radigo.PacketCode]radsecHandler) *radsecServer {
+	return &radsecServer{
+		addr:     addr,
+		tlsCfg:   tlsCfg,
+		dicts:    dicts,
+		coder:    radigo.NewCoder(),
+		handlers: handlers,
+	}
+}
+
+// radsecServer is a RADIUS server listening over TLS with mutual authentication,
+// identifying the clients out of their certificates
+type radsecServer struct {
+	addr     string
+	tlsCfg   *tls.Config
+	dicts    *radigo.Dictionaries // client bounded dictionaries, indexed on certificate identity
+	coder    radigo.Coder
+	handlers map[radigo.PacketCode]radsecHandler
+}
+
+// ListenAndServe binds to the address and serves the TLS connections until stopChan is closed
+func (rs *radsecServer) ListenAndServe(stopChan <-chan struct{}) (err error) {
+	var ln net.Listener
+	if ln, err = tls.Listen(utils.TCP, rs.addr, rs.tlsCfg); err != nil {
+		return
+	}
+	go func() {
+		<-stopChan
+		ln.Close()
+	}()
+	return rs.serve(ln)
+}
+
+// serve accepts the TLS connections out of the listener until this is closed
+func (rs *radsecServer) serve(ln net.Listener) (err error) {
+	for {
+		var conn net.Conn
+		if conn, err = ln.Accept(); err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			utils.Logger.Warning(fmt.Sprintf("<%s> error <%v> when accepting RadSec connection",
+				utils.RadiusAgent, err))
+			continue
+		}
+		go rs.handleConn(conn.(*tls.Conn))
+	}
+}
+
+// handleConn reads the requests out of one TLS connection and dispatches them to the handlers
+func (rs *radsecServer) handleConn(conn *tls.Conn) {
+	defer conn.Close()
+	if err := conn.Handshake(); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> TLS handshake with <%s> failed: %v",
+			utils.RadiusAgent, conn.RemoteAddr(), err))
+		return
+	}
+	clientID := radsecClientIdentity(conn.ConnectionState())
+	remoteAddr := conn.RemoteAddr().String()
+	var wrMux sync.Mutex // replies are written asynchronously
+	for {
+		b, err := readRadPacket(conn)
+		if err != nil {
+			if err != io.EOF {
+				utils.Logger.Debug(fmt.Sprintf("<%s> error <%v> when reading RadSec packets from <%s>, disconnecting...",
+					utils.RadiusAgent, err, remoteAddr))
+			}
+			return
+		}
+		req := radigo.NewPacket(0, 0, rs.dicts.GetInstance(clientID), rs.coder, radsecSecret)
+		if err = req.Decode(b); err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> error <%v> when decoding RadSec packet from <%s>",
+				utils.RadiusAgent, err, remoteAddr))
+			continue
+		}
+		go func(req *radigo.Packet) { // execute the handler asynchronously
+			var rply *radigo.Packet
+			var err error
+			if hndlr, has := rs.handlers[req.Code]; !has {
+				rply = req.NegativeReply("no handler")
+			} else if rply, err = hndlr(req, remoteAddr, clientID); err != nil {
+				rply = req.NegativeReply(err.Error())
+			}
+			if rply == nil {
+				return
+			}
+			wrMux.Lock()
+			defer wrMux.Unlock()
+			if err = writeRadPacket(conn, rply); err != nil {
+				utils.Logger.Warning(fmt.Sprintf("<%s> error <%v> when sending RadSec reply to <%s>",
+					utils.RadiusAgent, err, remoteAddr))
+			}
+		}(req)
+	}
+}
+
+// radsecClientIdentity returns the identity of the client out of its certificate,
+// the CommonName with fallback on the first DNS name
+func radsecClientIdentity(state tls.ConnectionState) string {
+	if len(state.PeerCertificates) == 0 {
+		return utils.EmptyString
+	}
+	cert := state.PeerCertificates[0]
+	if cert.Subject.CommonName != utils.EmptyString ||
+		len(cert.DNSNames) == 0 {
+		return cert.Subject.CommonName
+	}
+	return cert.DNSNames[0]
+}
+
+// readRadPacket reads one RADIUS packet out of the stream based on the length from its header
+func readRadPacket(r io.Reader) (b []byte, err error) {
+	var hdr [4]byte
+	if _, err = io.ReadFull(r, hdr[:]); err != nil {
+		return
+	}
+	pktLen := int(binary.BigEndian.Uint16(hdr[2:4]))
+	if pktLen < radHeaderLen || pktLen > radigo.MaxPacketLen {
+		return nil, fmt.Errorf("unexpected packet length: <%d>", pktLen)
+	}
+	b = make([]byte, pktLen)
+	copy(b, hdr[:])
+	_, err = io.ReadFull(r, b[4:])
+	return
+}
+
+// writeRadPacket encodes the packet and writes it on the stream
+func writeRadPacket(w io.Writer, pkt *radigo.Packet) (err error) {
+	var buf [radigo.MaxPacketLen]byte
+	var n int
+	if n, err = pkt.Encode(buf[:]); err != nil {
+		return
+	}
+	_, err = w.Write(buf[:n])
+	return
+}
+
+// radsecServerTLSConfig builds the server side TLS configuration out of tls section,
+// always requesting and verifying the client certificate
+func radsecServerTLSConfig(tlsCfg *config.TLSCfg) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(tlsCfg.ServerCerificate, tlsCfg.ServerKey)
+	if err != nil {
+		return nil, fmt.Errorf("load certificate error <%v>", err)
+	}
+	caPool, err := radsecCAPool(tlsCfg.CaCertificate)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    caPool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// radsecClientTLSConfig builds the client side TLS configuration out of tls section
+func radsecClientTLSConfig(tlsCfg *config.TLSCfg) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(tlsCfg.ClientCerificate, tlsCfg.ClientKey)
+	if err != nil {
+		return nil, fmt.Errorf("load certificate error <%v>", err)
+	}
+	caPool, err := radsecCAPool(tlsCfg.CaCertificate)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+		ServerName:   tlsCfg.ServerName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// radsecCAPool returns the certificate pool containing the CA, nil for the system one
+func radsecCAPool(caCert string) (*x509.CertPool, error) {
+	if caCert == utils.EmptyString {
+		return nil, nil
+	}
+	ca, err := os.ReadFile(caCert)
+	if err != nil {
+		return nil, fmt.Errorf("read CA error <%v>", err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("cannot append certificate authority")
+	}
+	return caPool, nil
+}
+
+// newRadsecClient connects over TLS to the RADIUS peer, used for Dynamic Authorization requests
+func newRadsecClient(address string, tlsCfg *tls.Config, dict *radigo.Dictionary,
+	connAttempts int, replyTimeout time.Duration) (rc *radsecClient, err error) {
+	rc = &radsecClient{
+		dict:         dict,
+		coder:        radigo.NewCoder(),
+		replyTimeout: replyTimeout,
+	}
+	if connAttempts <= 0 {
+		connAttempts = 1
+	}
+	for i := 0; i < connAttempts; i++ {
+		if rc.conn, err = tls.Dial(utils.TCP, address, tlsCfg); err == nil {
+			return
+		}
+	}
+	return nil, err
+}
+
+// radsecClient sends RADIUS requests over a TLS connection, one at a time
+type radsecClient struct {
+	sync.Mutex
+	conn         *tls.Conn
+	dict         *radigo.Dictionary
+	coder        radigo.Coder
+	replyTimeout time.Duration
+}
+
+// NewRequest produces a new request bound to the client dictionary
+func (rc *radsecClient) NewRequest(code radigo.PacketCode, id uint8) *radigo.Packet {
+	return radigo.NewPacket(code, id, rc.dict, rc.coder, radsecSecret)
+}
+
+// SendRequest writes the request and waits for its reply
+func (rc *radsecClient) SendRequest(req *radigo.Packet) (rply *radigo.Packet, err error) {
+	rc.Lock()
+	defer rc.Unlock()
+	if err = writeRadPacket(rc.conn, req); err != nil {
+		return
+	}
+	if rc.replyTimeout > 0 {
+		if err = rc.conn.SetReadDeadline(time.Now().Add(rc.replyTimeout)); err != nil {
+			return
+		}
+	}
+	var b []byte
+	if b, err = readRadPacket(rc.conn); err != nil {
+		return
+	}
+	rply = radigo.NewPacket(0, 0, rc.dict, rc.coder, radsecSecret)
+	if err = rply.Decode(b); err != nil {
+		return nil, err
+	}
+	if rply.Identifier != req.Identifier {
+		return nil, fmt.Errorf("unexpected reply identifier: <%d>", rply.Identifier)
+	}
+	return
+}
+
+// Close closes the underlying TLS connection
+func (rc *radsecClient) Close() error {
+	return rc.conn.Close()
+}
@@ -0,0 +1,198 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+package agents
+
+import (
+	"bytes"
+	"crypto/tls"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/radigo"
+)
+
+func TestRadsecServerClient(t *testing.T) {
+	tlsCfg := &config.TLSCfg{
+		ServerCerificate: "../data/tls/server.crt",
+		ServerKey:        "../data/tls/server.key",
+		ClientCerificate: "../data/tls/client.crt",
+		ClientKey:        "../data/tls/client.key",
+		CaCertificate:    "../data/tls/ca.crt",
+	}
+	srvTLS, err := radsecServerTLSConfig(tlsCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := tls.Listen(utils.TCP, "127.0.0.1:0", srvTLS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	dict := radigo.RFC2865Dictionary()
+	var rcvClientID string
+	rs := newRadsecServer(ln.Addr().String(), srvTLS,
+		radigo.NewDictionaries(map[string]*radigo.Dictionary{utils.MetaDefault: dict}),
+		map[radigo.PacketCode]radsecHandler{
+			radigo.AccessRequest: func(req *radigo.Packet, remoteAddr, clientID string) (*radigo.Packet, error) {
+				rcvClientID = clientID
+				req.SetAVPValues()
+				rply := req.Reply()
+				rply.Code = radigo.AccessAccept
+				if err := rply.AddAVPWithName("Reply-Message",
+					req.AttributesWithName("User-Name", utils.EmptyString)[0].StringValue,
+					utils.EmptyString); err != nil {
+					return nil, err
+				}
+				return rply, nil
+			},
+		})
+	go rs.serve(ln)
+
+	clntTLS, err := radsecClientTLSConfig(tlsCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clnt, err := newRadsecClient(ln.Addr().String(), clntTLS, dict, 1, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clnt.Close()
+
+	req := clnt.NewRequest(radigo.AccessRequest, 1)
+	if err = req.AddAVPWithName("User-Name", "1001", utils.EmptyString); err != nil {
+		t.Fatal(err)
+	}
+	rply, err := clnt.SendRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rply.Code != radigo.AccessAccept {
+		t.Errorf("Expected <%v>, received <%v>", radigo.AccessAccept, rply.Code)
+	}
+	rply.SetAVPValues()
+	if rplyMsg := rply.AttributesWithName("Reply-Message", utils.EmptyString); len(rplyMsg) != 1 ||
+		rplyMsg[0].StringValue != "1001" {
+		t.Errorf("Unexpected reply: %s", utils.ToJSON(rply))
+	}
+	if rcvClientID != "localhost" {
+		t.Errorf("Expected client identity <localhost>, received <%s>", rcvClientID)
+	}
+
+	// no handler for accounting
+	req = clnt.NewRequest(radigo.AccountingRequest, 2)
+	if rply, err = clnt.SendRequest(req); err != nil {
+		t.Fatal(err)
+	}
+	if rply.Code != radigo.AccountingResponse {
+		t.Errorf("Expected <%v>, received <%v>", radigo.AccountingResponse, rply.Code)
+	}
+}
+
+func TestRadsecServerRejectsMissingClientCert(t *testing.T) {
+	srvTLS, err := radsecServerTLSConfig(&config.TLSCfg{
+		ServerCerificate: "../data/tls/server.crt",
+		ServerKey:        "../data/tls/server.key",
+		CaCertificate:    "../data/tls/ca.crt",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := tls.Listen(utils.TCP, "127.0.0.1:0", srvTLS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	rs := newRadsecServer(ln.Addr().String(), srvTLS, radigo.NewDictionaries(nil), nil)
+	go rs.serve(ln)
+
+	caPool, err := radsecCAPool("../data/tls/ca.crt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	clnt, err := newRadsecClient(ln.Addr().String(), &tls.Config{RootCAs: caPool},
+		radigo.RFC2865Dictionary(), 1, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clnt.Close()
+	if _, err = clnt.SendRequest(clnt.NewRequest(radigo.AccessRequest, 1)); err == nil {
+		t.Error("Expected error for missing client certificate")
+	}
+}
+
+func TestRadsecTLSConfigErrors(t *testing.T) {
+	if _, err := radsecServerTLSConfig(&config.TLSCfg{
+		ServerCerificate: "/tmp/missing.crt",
+		ServerKey:        "/tmp/missing.key",
+	}); err == nil || !strings.HasPrefix(err.Error(), "load certificate error") {
+		t.Errorf("Expected load certificate error, received: %v", err)
+	}
+	if _, err := radsecClientTLSConfig(&config.TLSCfg{
+		ClientCerificate: "../data/tls/client.crt",
+		ClientKey:        "../data/tls/client.key",
+		CaCertificate:    "/tmp/missing_ca.crt",
+	}); err == nil || !strings.HasPrefix(err.Error(), "read CA error") {
+		t.Errorf("Expected read CA error, received: %v", err)
+	}
+	if _, err := radsecCAPool("../data/tls/server.key"); err == nil ||
+		err.Error() != "cannot append certificate authority" {
+		t.Errorf("Expected cannot append certificate authority, received: %v", err)
+	}
+}
+
+func TestReadRadPacket(t *testing.T) {
+	if _, err := readRadPacket(bytes.NewReader([]byte{1, 1, 0, 4})); err == nil ||
+		err.Error() != "unexpected packet length: <4>" {
+		t.Errorf("Expected unexpected packet length, received: %v", err)
+	}
+	var buf bytes.Buffer
+	pkt := radigo.NewPacket(radigo.AccessRequest, 7, radigo.RFC2865Dictionary(),
+		radigo.NewCoder(), radsecSecret)
+	if err := writeRadPacket(&buf, pkt); err != nil {
+		t.Fatal(err)
+	}
+	buf.Write([]byte{1, 2}) // start of the next packet
+	b, err := readRadPacket(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != radHeaderLen || b[1] != 7 {
+		t.Errorf("Unexpected packet: %v", b)
+	}
+}
+
+func TestRadVarsDataNode(t *testing.T) {
+	exp := &utils.DataNode{
+		Type: utils.NMMapType,
+		Map: map[string]*utils.DataNode{
+			utils.RemoteHost: utils.NewLeafNode("127.0.0.1:2083"),
+			MetaRadClientID:  utils.NewLeafNode("nas1.example.org"),
+		},
+	}
+	if rcv := radVarsDataNode("127.0.0.1:2083", "nas1.example.org"); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	delete(exp.Map, MetaRadClientID)
+	if rcv := radVarsDataNode("127.0.0.1:2083", utils.EmptyString); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
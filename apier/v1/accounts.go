@@ -480,13 +480,14 @@ func (apierSv1 *APIerSv1) modifyBalance(aType string, attr *AttrAddBalance, repl
 				return
 			}
 			balance.Timings = append(balance.Timings, &engine.RITiming{
-				ID:        tmg.ID,
-				Years:     tmg.Years,
-				Months:    tmg.Months,
-				MonthDays: tmg.MonthDays,
-				WeekDays:  tmg.WeekDays,
-				StartTime: tmg.StartTime,
-				EndTime:   tmg.EndTime,
+				ID:                 tmg.ID,
+				Years:              tmg.Years,
+				Months:             tmg.Months,
+				MonthDays:          tmg.MonthDays,
+				WeekDays:           tmg.WeekDays,
+				StartTime:          tmg.StartTime,
+				EndTime:            tmg.EndTime,
+				HolidayCalendarIDs: tmg.HolidayCalendarIDs,
 			})
 		}
 	}
@@ -558,13 +559,14 @@ func (apierSv1 *APIerSv1) SetBalance(ctx *context.Context, attr *utils.AttrSetBa
 				return
 			}
 			balance.Timings = append(balance.Timings, &engine.RITiming{
-				ID:        tmg.ID,
-				Years:     tmg.Years,
-				Months:    tmg.Months,
-				MonthDays: tmg.MonthDays,
-				WeekDays:  tmg.WeekDays,
-				StartTime: tmg.StartTime,
-				EndTime:   tmg.EndTime,
+				ID:                 tmg.ID,
+				Years:              tmg.Years,
+				Months:             tmg.Months,
+				MonthDays:          tmg.MonthDays,
+				WeekDays:           tmg.WeekDays,
+				StartTime:          tmg.StartTime,
+				EndTime:            tmg.EndTime,
+				HolidayCalendarIDs: tmg.HolidayCalendarIDs,
 			})
 		}
 	}
@@ -640,13 +642,14 @@ func (apierSv1 *APIerSv1) SetBalances(ctx *context.Context, attr *utils.AttrSetB
 					return
 				}
 				balFltr.Timings = append(balFltr.Timings, &engine.RITiming{
-					ID:        tmg.ID,
-					Years:     tmg.Years,
-					Months:    tmg.Months,
-					MonthDays: tmg.MonthDays,
-					WeekDays:  tmg.WeekDays,
-					StartTime: tmg.StartTime,
-					EndTime:   tmg.EndTime,
+					ID:                 tmg.ID,
+					Years:              tmg.Years,
+					Months:             tmg.Months,
+					MonthDays:          tmg.MonthDays,
+					WeekDays:           tmg.WeekDays,
+					StartTime:          tmg.StartTime,
+					EndTime:            tmg.EndTime,
+					HolidayCalendarIDs: tmg.HolidayCalendarIDs,
 				})
 			}
 		}
@@ -666,13 +666,14 @@ func (apierSv1 *APIerSv1) SetActions(ctx *context.Context, attrs *V1AttrSetActio
 						err.Error(), timingID)
 				}
 				a.Balance.Timings = append(a.Balance.Timings, &engine.RITiming{
-					ID:        timingID,
-					Years:     timing.Years,
-					Months:    timing.Months,
-					MonthDays: timing.MonthDays,
-					WeekDays:  timing.WeekDays,
-					StartTime: timing.StartTime,
-					EndTime:   timing.EndTime,
+					ID:                 timingID,
+					Years:              timing.Years,
+					Months:             timing.Months,
+					MonthDays:          timing.MonthDays,
+					WeekDays:           timing.WeekDays,
+					StartTime:          timing.StartTime,
+					EndTime:            timing.EndTime,
+					HolidayCalendarIDs: timing.HolidayCalendarIDs,
 				})
 			}
 		}
@@ -772,12 +773,16 @@ func (attr *AttrActionPlan) getRITiming(dm *engine.DataManager) (timing *engine.
 			timing.WeekDays = dbTiming.WeekDays
 			timing.StartTime = dbTiming.StartTime
 			timing.EndTime = dbTiming.EndTime
+			timing.HolidayCalendarIDs = dbTiming.HolidayCalendarIDs
 		}
 	}
 	timing.ID = attr.TimingID
 	timing.Years.Parse(attr.Years, ";")
 	timing.Months.Parse(attr.Months, ";")
 	timing.MonthDays.Parse(attr.MonthDays, ";")
+	if hcIDs := utils.ParseHolidayCalendarIDs(attr.MonthDays, ";"); len(hcIDs) != 0 {
+		timing.HolidayCalendarIDs = hcIDs
+	}
 	timing.WeekDays.Parse(attr.WeekDays, ";")
 	if !verifyFormat(attr.Time) {
 		err = fmt.Errorf("%s:%s", utils.ErrUnsupportedFormat.Error(), attr.Time)
@@ -1533,6 +1538,32 @@ func (apierSv1 *APIerSv1) ComputeActionPlanIndexes(ctx *context.Context, _ strin
 	return nil
 }
 
+// SnapshotDataDB compacts the dump files of the *internal DataDB into a new snapshot
+func (apierSv1 *APIerSv1) SnapshotDataDB(ctx *context.Context, _ string, reply *string) (err error) {
+	iDB, canCast := apierSv1.DataManager.DataDB().(*engine.InternalDB)
+	if !canCast {
+		return utils.ErrNotImplemented
+	}
+	if err = iDB.Snapshot(); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SnapshotStorDB compacts the dump files of the *internal StorDB into a new snapshot
+func (apierSv1 *APIerSv1) SnapshotStorDB(ctx *context.Context, _ string, reply *string) (err error) {
+	iDB, canCast := apierSv1.StorDb.(*engine.InternalDB)
+	if !canCast {
+		return utils.ErrNotImplemented
+	}
+	if err = iDB.Snapshot(); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
 // GetActionPlanIDs returns list of ActionPlan IDs registered for a tenant
 func (apierSv1 *APIerSv1) GetActionPlanIDs(ctx *context.Context, args *utils.PaginatorWithTenant, attrPrfIDs *[]string) error {
 	prfx := utils.ActionPlanPrefix
@@ -2069,10 +2100,19 @@ func (apierSv1 *APIerSv1) TimingIsActiveAt(ctx *context.Context, params TimePara
 	if err != nil {
 		return err
 	}
-	if tm, err := utils.ParseTimeDetectLayout(params.Time, apierSv1.Config.GeneralCfg().DefaultTimezone); err != nil {
+	tm, err := utils.ParseTimeDetectLayout(params.Time, apierSv1.Config.GeneralCfg().DefaultTimezone)
+	if err != nil {
 		return err
-	} else {
-		*reply = timing.IsActiveAt(tm)
 	}
+	*reply = (&engine.RITiming{
+		ID:                 timing.ID,
+		Years:              timing.Years,
+		Months:             timing.Months,
+		MonthDays:          timing.MonthDays,
+		WeekDays:           timing.WeekDays,
+		StartTime:          timing.StartTime,
+		EndTime:            timing.EndTime,
+		HolidayCalendarIDs: timing.HolidayCalendarIDs,
+	}).IsActiveAt(tm)
 	return
 }
@@ -855,6 +855,12 @@ func (dSv1 DispatcherSv1) GetProfilesForEvent(ctx *context.Context, ev *utils.CG
 	return dSv1.dS.DispatcherSv1GetProfilesForEvent(ctx, ev, dPrfl)
 }
 
+// GetHostsHealth returns the health state of the DispatcherHosts within the tenant
+func (dSv1 DispatcherSv1) GetHostsHealth(ctx *context.Context, args *utils.TenantWithAPIOpts,
+	reply *map[string]*dispatchers.HostHealth) error {
+	return dSv1.dS.DispatcherSv1GetHostsHealth(ctx, args, reply)
+}
+
 func (dS *DispatcherSv1) RemoteStatus(ctx *context.Context, args *cores.V1StatusParams, reply *map[string]any) (err error) {
 	return dS.dS.DispatcherSv1RemoteStatus(ctx, args, reply)
 }
@@ -1047,6 +1053,10 @@ func (dS *DispatcherEeSv1) ProcessEvent(ctx *context.Context, args *engine.CGREv
 	return dS.dS.EeSv1ProcessEvent(ctx, args, reply)
 }
 
+func (dS *DispatcherEeSv1) GetRetryQueues(ctx *context.Context, args *engine.ArgsGetRetryQueues, reply *map[string]*engine.RetryQueueStats) error {
+	return dS.dS.EeSv1GetRetryQueues(ctx, args, reply)
+}
+
 // DispatcherErSv1 exports RPC from ErSv1.
 type DispatcherErSv1 struct {
 	dS *dispatchers.DispatcherService
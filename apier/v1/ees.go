@@ -37,3 +37,9 @@ func (eeSv1 *EeSv1) ProcessEvent(ctx *context.Context, args *engine.CGREventWith
 	reply *map[string]map[string]any) error {
 	return eeSv1.eeS.V1ProcessEvent(ctx, args, reply)
 }
+
+// GetRetryQueues returns the depth and the age of the oldest export for the retry queues
+func (eeSv1 *EeSv1) GetRetryQueues(ctx *context.Context, args *engine.ArgsGetRetryQueues,
+	reply *map[string]*engine.RetryQueueStats) error {
+	return eeSv1.eeS.V1GetRetryQueues(ctx, args, reply)
+}
@@ -0,0 +1,131 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// GetHolidayCalendar returns a HolidayCalendar
+func (apierSv1 *APIerSv1) GetHolidayCalendar(ctx *context.Context, arg *utils.TenantIDWithAPIOpts, reply *engine.HolidayCalendar) (err error) {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	hc, err := apierSv1.DataManager.GetHolidayCalendar(tnt, arg.ID, true, true, utils.NonTransactional)
+	if err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = *hc
+	return
+}
+
+// GetHolidayCalendarIDs returns list of HolidayCalendar IDs registered for a tenant
+func (apierSv1 *APIerSv1) GetHolidayCalendarIDs(ctx *context.Context, args *utils.PaginatorWithTenant, hcIDs *[]string) (err error) {
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	prfx := utils.HolidayCalendarPrefix + tnt + utils.ConcatenatedKeySep
+	keys, err := apierSv1.DataManager.DataDB().GetKeysForPrefix(prfx)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return utils.ErrNotFound
+	}
+	retIDs := make([]string, len(keys))
+	for i, key := range keys {
+		retIDs[i] = key[len(prfx):]
+	}
+	*hcIDs = args.PaginateStringSlice(retIDs)
+	return
+}
+
+// SetHolidayCalendar alters/creates a HolidayCalendar
+func (apierSv1 *APIerSv1) SetHolidayCalendar(ctx *context.Context, arg *engine.HolidayCalendarWithAPIOpts, reply *string) error {
+	if missing := utils.MissingStructFields(arg.HolidayCalendar, []string{utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if arg.Tenant == utils.EmptyString {
+		arg.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err := arg.Compile(); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	if err := apierSv1.DataManager.SetHolidayCalendar(arg.HolidayCalendar); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheHolidayCalendars and store it in database
+	loadID := time.Now().UnixNano()
+	if err := apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheHolidayCalendars: loadID}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	// delay if needed before cache call
+	if apierSv1.Config.GeneralCfg().CachingDelay != 0 {
+		utils.Logger.Info(fmt.Sprintf("<SetHolidayCalendar> Delaying cache call for %v", apierSv1.Config.GeneralCfg().CachingDelay))
+		time.Sleep(apierSv1.Config.GeneralCfg().CachingDelay)
+	}
+	//handle caching for HolidayCalendar
+	if err := apierSv1.CallCache(utils.IfaceAsString(arg.APIOpts[utils.CacheOpt]), arg.Tenant, utils.CacheHolidayCalendars,
+		arg.TenantID(), utils.EmptyString, nil, nil, arg.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return nil
+}
+
+// RemoveHolidayCalendar removes a specific HolidayCalendar
+func (apierSv1 *APIerSv1) RemoveHolidayCalendar(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error {
+	if missing := utils.MissingStructFields(args, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err := apierSv1.DataManager.RemoveHolidayCalendar(tnt, args.ID); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	// delay if needed before cache call
+	if apierSv1.Config.GeneralCfg().CachingDelay != 0 {
+		utils.Logger.Info(fmt.Sprintf("<RemoveHolidayCalendar> Delaying cache call for %v", apierSv1.Config.GeneralCfg().CachingDelay))
+		time.Sleep(apierSv1.Config.GeneralCfg().CachingDelay)
+	}
+	//handle caching for HolidayCalendar
+	if err := apierSv1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]), tnt, utils.CacheHolidayCalendars,
+		utils.ConcatenatedKey(tnt, args.ID), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheHolidayCalendars and store it in database
+	loadID := time.Now().UnixNano()
+	if err := apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheHolidayCalendars: loadID}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return nil
+}
@@ -142,6 +142,17 @@ func (rplSv1 *ReplicatorSv1) GetRankingProfile(ctx *context.Context, tntID *util
 	return nil
 }
 
+// GetHolidayCalendar is the remote method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) GetHolidayCalendar(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.HolidayCalendar) error {
+	engine.UpdateReplicationFilters(utils.HolidayCalendarPrefix, tntID.TenantID.TenantID(), utils.IfaceAsString(tntID.APIOpts[utils.RemoteHostOpt]))
+	rcv, err := rplSv1.dm.DataDB().GetHolidayCalendarDrv(tntID.Tenant, tntID.ID)
+	if err != nil {
+		return err
+	}
+	*reply = *rcv
+	return nil
+}
+
 // GetRanking is the remote method coresponding to the dataDb driver method
 func (rplSv1 *ReplicatorSv1) GetRanking(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.Ranking) error {
 	engine.UpdateReplicationFilters(utils.RankingPrefix, tntID.TenantID.TenantID(), utils.IfaceAsString(tntID.APIOpts[utils.RemoteHostOpt]))
@@ -496,6 +507,19 @@ func (rplSv1 *ReplicatorSv1) SetRankingProfile(ctx *context.Context, sg *engine.
 	return
 }
 
+// SetHolidayCalendar is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetHolidayCalendar(ctx *context.Context, hc *engine.HolidayCalendarWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetHolidayCalendarDrv(hc.HolidayCalendar); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(hc.APIOpts[utils.CacheOpt]),
+		hc.Tenant, utils.CacheHolidayCalendars, hc.TenantID(), utils.EmptyString, nil, nil, hc.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
 // SetTrendProfile is the replication method coresponding to the dataDb driver method
 func (rplSv1 *ReplicatorSv1) SetTrendProfile(ctx *context.Context, sg *engine.TrendProfileWithAPIOpts, reply *string) (err error) {
 	if err = rplSv1.dm.DataDB().SetTrendProfileDrv(sg.TrendProfile); err != nil {
@@ -919,6 +943,19 @@ func (rplSv1 *ReplicatorSv1) RemoveStatQueueProfile(ctx *context.Context, args *
 	return
 }
 
+// RemoveHolidayCalendar is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) RemoveHolidayCalendar(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemoveHolidayCalendarDrv(args.Tenant, args.ID); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		args.Tenant, utils.CacheHolidayCalendars, args.TenantID.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
 // RemoveRankingProfile is the replication method coresponding to the dataDb driver method
 func (rplSv1 *ReplicatorSv1) RemoveRankingProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
 	if err = rplSv1.dm.DataDB().RemRankingProfileDrv(args.Tenant, args.ID); err != nil {
@@ -36,6 +36,7 @@ import (
 	"github.com/cgrates/birpc"
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/cores"
+	"github.com/cgrates/cgrates/grpcapi"
 	"github.com/cgrates/cgrates/loaders"
 	"github.com/cgrates/cgrates/registrarc"
 
@@ -46,6 +47,7 @@ import (
 	"github.com/cgrates/cgrates/servmanager"
 	"github.com/cgrates/cgrates/utils"
 	"github.com/cgrates/rpcclient"
+	"google.golang.org/grpc"
 )
 
 var (
@@ -61,7 +63,7 @@ var (
 	memProfTimestamp  = cgrEngineFlags.Bool(utils.MemProfTimestampCgr, false, "Add timestamp to memory profile files")
 	scheduledShutdown = cgrEngineFlags.Duration(utils.ScheduledShutdownCgr, 0, "Shutdown the engine after the specified duration")
 	singleCPU         = cgrEngineFlags.Bool(utils.SingleCpuCgr, false, "Run on a single CPU core")
-	syslogger         = cgrEngineFlags.String(utils.LoggerCfg, utils.EmptyString, "Logger type <*syslog|*stdout>")
+	syslogger         = cgrEngineFlags.String(utils.LoggerCfg, utils.EmptyString, "Logger type <*syslog|*stdout|*json|*ees>")
 	nodeID            = cgrEngineFlags.String(utils.NodeIDCfg, utils.EmptyString, "Node ID of the engine")
 	logLevel          = cgrEngineFlags.Int(utils.LogLevelCfg, -1, "Log level (0=emergency to 7=debug)")
 	preload           = cgrEngineFlags.String(utils.PreloadCgr, utils.EmptyString, "Loader IDs used to load data before engine starts")
@@ -147,7 +149,7 @@ func startRPC(server *cores.Server, internalRaterChan,
 	internalSMGChan, internalAnalyzerSChan, internalDispatcherSChan,
 	internalLoaderSChan, internalRALsv1Chan, internalCacheSChan,
 	internalEEsChan, internalERsChan chan birpc.ClientConnector,
-	shdChan *utils.SyncedChan) {
+	gSrv *grpc.Server, shdChan *utils.SyncedChan) {
 	if !cfg.DispatcherSCfg().Enabled {
 		select { // Any of the rpc methods will unlock listening to rpc requests
 		case resp := <-internalRaterChan:
@@ -206,6 +208,9 @@ func startRPC(server *cores.Server, internalRaterChan,
 		cfg.HTTPCfg().HTTPAuthUsers,
 		shdChan,
 	)
+	if gSrv != nil {
+		go server.ServeGRPC(gSrv, cfg.ListenCfg().GRPCListen, shdChan)
+	}
 	if (len(cfg.ListenCfg().RPCGOBTLSListen) != 0 ||
 		len(cfg.ListenCfg().RPCJSONTLSListen) != 0 ||
 		len(cfg.ListenCfg().HTTPTLSListen) != 0) &&
@@ -396,6 +401,12 @@ func main() {
 	if *nodeID != utils.EmptyString {
 		cfg.GeneralCfg().NodeID = *nodeID
 	}
+	if *syslogger != utils.EmptyString { // the logger from command line needs the same checks
+		cfg.GeneralCfg().Logger = *syslogger
+		if err = cfg.CheckConfigSanity(); err != nil {
+			log.Fatalf("Could not parse config: <%s>", err.Error())
+		}
+	}
 
 	config.SetCgrConfig(cfg) // Share the config object
 
@@ -409,6 +420,7 @@ func main() {
 		lgLevel = *logLevel
 	}
 	utils.Logger.SetLogLevel(lgLevel)
+	utils.Logger.SetLogLevels(cfg.GeneralCfg().LogLevels)
 
 	if *printConfig {
 		cfgJSON := utils.ToIJSON(cfg.AsMapInterface(cfg.GeneralCfg().RSRSep))
@@ -417,6 +429,7 @@ func main() {
 
 	// init the concurrentRequests
 	caps := engine.NewCaps(cfg.CoreSCfg().Caps, cfg.CoreSCfg().CapsStrategy)
+	caps.SetRateLimiter(engine.NewRateLimiter(cfg.CoreSCfg().RateLimits))
 	utils.Logger.Info(fmt.Sprintf("<CoreS> starting version <%s><%s>", vers, goVers))
 
 	// init the channel here because we need to pass them to connManager
@@ -476,6 +489,11 @@ func main() {
 
 		utils.ConcatenatedKey(rpcclient.BiRPCInternal, utils.MetaSessionS): internalSessionSChan,
 	})
+	// the *ees logger needs the connManager to reach EEs
+	if utils.FirstNonEmpty(*syslogger, cfg.GeneralCfg().Logger) == utils.MetaEEs {
+		utils.Logger = engine.NewExportLogger(cfg, connManager)
+		utils.Logger.SetLogLevel(lgLevel)
+	}
 	srvDep := map[string]*sync.WaitGroup{
 		utils.AnalyzerS:       new(sync.WaitGroup),
 		utils.APIerSv1:        new(sync.WaitGroup),
@@ -631,6 +649,7 @@ func main() {
 			shdChan, connManager, server, internalERsChan, anz, srvDep),
 		services.NewSIPAgent(cfg, filterSChan, shdChan, connManager, srvDep),
 		services.NewJanusAgent(cfg, filterSChan, server, connManager, srvDep),
+		services.NewPrometheusAgent(cfg, connManager, srvDep),
 	)
 	srvManager.StartServices()
 	// Start FilterS
@@ -681,12 +700,20 @@ func main() {
 	}
 
 	// Serve rpc connections
+	var gSrv *grpc.Server
+	if cfg.ListenCfg().GRPCListen != utils.EmptyString {
+		gSrv = cores.NewGRPCServer(
+			cfg.HTTPCfg().HTTPUseBasicAuth,
+			cfg.HTTPCfg().HTTPAuthUsers,
+			func(srv grpc.ServiceRegistrar) { grpcapi.Register(srv, server) },
+		)
+	}
 	go startRPC(server, internalResponderChan, internalCDRServerChan,
 		internalResourceSChan, internalStatSChan,
 		internalAttributeSChan, internalChargerSChan, internalThresholdSChan,
 		internalTrendSChan, internalRouteSChan, internalSessionSChan, internalAnalyzerSChan,
 		internalDispatcherSChan, internalLoaderSChan, internalRALsChan,
-		internalCacheSChan, internalEEsChan, internalERsChan, shdChan)
+		internalCacheSChan, internalEEsChan, internalERsChan, gSrv, shdChan)
 
 	if *memProfDir != utils.EmptyString {
 		if err := cS.StartMemoryProfiling(cores.MemoryProfilingParams{
@@ -704,6 +731,9 @@ func main() {
 	<-shdChan.Done()
 	shtdDone := make(chan struct{})
 	go func() {
+		if gSrv != nil {
+			gSrv.GracefulStop() // wait for the in-flight gRPC requests
+		}
 		shdWg.Wait()
 		close(shtdDone)
 	}()
@@ -720,4 +750,6 @@ func main() {
 		}
 	}
 	utils.Logger.Info("<CoreS> stopped all components. CGRateS shutdown!")
+	// flush the records still queued by the *ees logger, falling back on stdout
+	utils.Logger.Close()
 }
@@ -26,6 +26,7 @@ import (
 	"strings"
 
 	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/migrator"
 	"github.com/cgrates/cgrates/utils"
 )
@@ -137,6 +138,11 @@ var (
 	outStorDBPass = cgrMigratorFlags.String(utils.OutStorDBPasswordCfg, utils.MetaStorDB,
 		"output StorDB password")
 
+	inDataDBDump = cgrMigratorFlags.String(utils.DataDBDumpCgr, utils.EmptyString,
+		"The directory with the *internal DataDB dump files loaded as input DataDB")
+	inStorDBDump = cgrMigratorFlags.String(utils.StorDBDumpCgr, utils.EmptyString,
+		"The directory with the *internal StorDB dump files loaded as input StorDB")
+
 	dryRun = cgrMigratorFlags.Bool(utils.DryRunCfg, false,
 		"parse loaded data for consistency and errors, without storing it")
 	verbose = cgrMigratorFlags.Bool(utils.VerboseCgr, false, "enable detailed verbose logging output")
@@ -162,6 +168,8 @@ func main() {
 		}
 		config.SetCgrConfig(mgrCfg)
 	}
+	// the accounts version to migrate to depends on the decimal balances
+	engine.SetDecimalBalances(mgrCfg.RalsCfg().DecimalBalances)
 
 	// inDataDB
 	if *inDataDBType != dfltCfg.DataDbCfg().Type {
@@ -309,6 +317,11 @@ func main() {
 		mgrCfg.CacheCfg(), mgrCfg.DataDbCfg().Opts, mgrCfg.DataDbCfg().Items); err != nil {
 		log.Fatal(err)
 	}
+	if *inDataDBDump != utils.EmptyString {
+		if err = loadInternalDump(dmIN.DataManager().DataDB(), *inDataDBDump); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	if sameDataDB {
 		dmOUT = dmIN
@@ -397,6 +410,11 @@ func main() {
 		mgrCfg.StorDbCfg().Opts, mgrCfg.StorDbCfg().Items); err != nil {
 		log.Fatal(err)
 	}
+	if *inStorDBDump != utils.EmptyString {
+		if err = loadInternalDump(storDBIn.StorDB(), *inStorDBDump); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	if sameStorDB {
 		storDBOut = storDBIn
@@ -436,3 +454,12 @@ func main() {
 	}
 
 }
+
+// loadInternalDump loads the dump files into the *internal input DB so they can be exported in the output DB
+func loadInternalDump(db engine.Storage, path string) error {
+	iDB, isInternal := db.(*engine.InternalDB)
+	if !isInternal {
+		return fmt.Errorf("the dump path requires an input DB of type %s", utils.MetaInternal)
+	}
+	return iDB.LoadDump(path)
+}
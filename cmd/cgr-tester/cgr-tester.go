@@ -101,6 +101,9 @@ var (
 	usage       = cgrTesterFlags.String("usage", "1m", "The duration to use in call simulation.")
 	fPath       = cgrTesterFlags.String("file_path", "", "read requests from file with path")
 	reqSep      = cgrTesterFlags.String("req_separator", "\n\n", "separator for requests in file")
+	scnPath     = cgrTesterFlags.String("scenario_path", "", "run the scenario from the YAML or JSON file with path")
+	rptPath     = cgrTesterFlags.String("report_path", "", "write the scenario latency report to the CSV or JSON file with path")
+	rptInterval = cgrTesterFlags.Duration("report_interval", 10*time.Second, "Interval used to report the scenario throughput over time")
 	verbose     = cgrTesterFlags.Bool(utils.VerboseCgr, false, "Enable detailed verbose logging output")
 	err         error
 )
@@ -334,6 +337,12 @@ func main() {
 		}
 		return
 	}
+	if *scnPath != "" {
+		if err := runScenario(*scnPath, *rptPath, *rptInterval, *timeoutDur); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 
 	switch *exec {
 	default: // unsupported task
@@ -0,0 +1,651 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package main
+
+import (
+	"encoding/csv"
+	encjson "encoding/json"
+	"fmt"
+	"log"
+	"maps"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/sessions"
+	"github.com/cgrates/cgrates/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// steps of a scenario flow
+const (
+	metaProcessCDR     = "*process_cdr"
+	metaProcessMessage = "*process_message"
+)
+
+// usage distributions
+const (
+	metaFixed       = "*fixed"
+	metaUniform     = "*uniform"
+	metaNormal      = "*normal"
+	metaExponential = "*exponential"
+)
+
+// metaTotal marks the report lines for the whole run
+const metaTotal = "*total"
+
+// Scenario describes the load generated by cgr-tester in scenario mode
+type Scenario struct {
+	Tenant       string         `json:"tenant" yaml:"tenant"`
+	RequestType  string         `json:"request_type" yaml:"request_type"`
+	Duration     string         `json:"duration" yaml:"duration"` // how long new flows are started
+	CPS          ScenarioCPS    `json:"cps" yaml:"cps"`
+	Accounts     ScenarioPool   `json:"accounts" yaml:"accounts"`
+	Destinations ScenarioPool   `json:"destinations" yaml:"destinations"`
+	Flows        []ScenarioFlow `json:"flows" yaml:"flows"`
+
+	duration time.Duration
+	rampUp   time.Duration
+	weights  int
+}
+
+// ScenarioCPS is the rate of new flows started each second
+// increased linearly from Start to Target during RampUp
+type ScenarioCPS struct {
+	Start  int    `json:"start" yaml:"start"`
+	Target int    `json:"target" yaml:"target"`
+	RampUp string `json:"ramp_up" yaml:"ramp_up"`
+}
+
+// ScenarioPool is the pool the accounts or destinations are drawn from
+// either the explicit IDs or Count numbers starting with Start and prefixed with Prefix
+type ScenarioPool struct {
+	IDs    []string `json:"ids" yaml:"ids"`
+	Prefix string   `json:"prefix" yaml:"prefix"`
+	Start  int      `json:"start" yaml:"start"`
+	Count  int      `json:"count" yaml:"count"`
+}
+
+// ScenarioFlow is one type of flow from the call mix
+type ScenarioFlow struct {
+	ID             string            `json:"id" yaml:"id"`
+	Weight         int               `json:"weight" yaml:"weight"` // share of the flow within the mix
+	ToR            string            `json:"tor" yaml:"tor"`
+	Category       string            `json:"category" yaml:"category"`
+	Steps          []string          `json:"steps" yaml:"steps"` // <*authorize|*initiate|*update|*terminate|*process_cdr|*process_message>
+	Usage          UsageDistribution `json:"usage" yaml:"usage"`
+	UpdateInterval string            `json:"update_interval" yaml:"update_interval"` // wait between the updates of the session
+	UpdateUsage    string            `json:"update_usage" yaml:"update_usage"`       // usage reported on each update, defaults to update_interval
+
+	updateInterval time.Duration
+	updateUsage    time.Duration
+}
+
+// UsageDistribution describes how the usage of the flows is distributed
+type UsageDistribution struct {
+	Type   string `json:"type" yaml:"type"` // <*fixed|*uniform|*normal|*exponential>
+	Value  string `json:"value" yaml:"value"`
+	Min    string `json:"min" yaml:"min"`
+	Max    string `json:"max" yaml:"max"`
+	Mean   string `json:"mean" yaml:"mean"`
+	StdDev string `json:"std_dev" yaml:"std_dev"`
+
+	value, min, max, mean, stdDev time.Duration
+}
+
+// NewScenarioFromFile reads the scenario from a YAML or JSON file
+func NewScenarioFromFile(fPath string) (sc *Scenario, err error) {
+	var content []byte
+	if content, err = os.ReadFile(fPath); err != nil {
+		return
+	}
+	sc = new(Scenario)
+	switch strings.ToLower(filepath.Ext(fPath)) {
+	case utils.JSNSuffix:
+		err = encjson.Unmarshal(content, sc)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, sc)
+	default:
+		err = fmt.Errorf("unsupported scenario file: <%s>", fPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = sc.compile(); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// parseScenarioDuration parses the duration ignoring the empty values
+func parseScenarioDuration(fldName, dur string) (d time.Duration, err error) {
+	if dur == utils.EmptyString {
+		return
+	}
+	if d, err = utils.ParseDurationWithNanosecs(dur); err != nil {
+		err = fmt.Errorf("invalid %s <%s>: %s", fldName, dur, err.Error())
+	}
+	return
+}
+
+// compile validates the scenario and parses its durations
+func (sc *Scenario) compile() (err error) {
+	if sc.Tenant == utils.EmptyString {
+		sc.Tenant = *tenant
+	}
+	if sc.RequestType == utils.EmptyString {
+		sc.RequestType = *requestType
+	}
+	if sc.duration, err = parseScenarioDuration("duration", sc.Duration); err != nil {
+		return
+	}
+	if sc.duration <= 0 {
+		return fmt.Errorf("the scenario duration should be bigger than 0")
+	}
+	if sc.rampUp, err = parseScenarioDuration("ramp_up", sc.CPS.RampUp); err != nil {
+		return
+	}
+	if sc.CPS.Target <= 0 {
+		return fmt.Errorf("the target cps should be bigger than 0")
+	}
+	if len(sc.Accounts.IDs) == 0 && sc.Accounts.Count <= 0 {
+		return fmt.Errorf("empty accounts pool")
+	}
+	if len(sc.Destinations.IDs) == 0 && sc.Destinations.Count <= 0 {
+		return fmt.Errorf("empty destinations pool")
+	}
+	if len(sc.Flows) == 0 {
+		return fmt.Errorf("no flows defined")
+	}
+	sc.weights = 0
+	for i := range sc.Flows {
+		flw := &sc.Flows[i]
+		if flw.ID == utils.EmptyString {
+			flw.ID = strconv.Itoa(i)
+		}
+		if flw.Weight < 0 {
+			return fmt.Errorf("negative weight for flow <%s>", flw.ID)
+		}
+		sc.weights += flw.Weight
+		if flw.ToR == utils.EmptyString {
+			flw.ToR = utils.MetaVoice
+		}
+		if flw.Category == utils.EmptyString {
+			flw.Category = *category
+		}
+		if len(flw.Steps) == 0 {
+			return fmt.Errorf("no steps defined for flow <%s>", flw.ID)
+		}
+		for _, step := range flw.Steps {
+			switch step {
+			case utils.MetaAuthorize, utils.MetaInitiate, utils.MetaUpdate,
+				utils.MetaTerminate, metaProcessCDR, metaProcessMessage:
+			default:
+				return fmt.Errorf("unsupported step <%s> for flow <%s>", step, flw.ID)
+			}
+		}
+		if flw.updateInterval, err = parseScenarioDuration("update_interval", flw.UpdateInterval); err != nil {
+			return
+		}
+		if flw.updateUsage, err = parseScenarioDuration("update_usage", flw.UpdateUsage); err != nil {
+			return
+		}
+		if flw.updateUsage == 0 {
+			flw.updateUsage = flw.updateInterval
+		}
+		if slices.Contains(flw.Steps, utils.MetaUpdate) && flw.updateUsage <= 0 {
+			return fmt.Errorf("missing update_interval for flow <%s>", flw.ID)
+		}
+		if err = flw.Usage.compile(); err != nil {
+			return fmt.Errorf("%s for flow <%s>", err.Error(), flw.ID)
+		}
+	}
+	if sc.weights == 0 {
+		return fmt.Errorf("the weights of the flows should not sum to 0")
+	}
+	return
+}
+
+// cps returns the rate of new flows after elapsed time
+func (sc *Scenario) cps(elapsed time.Duration) float64 {
+	if sc.rampUp <= 0 || elapsed >= sc.rampUp {
+		return float64(sc.CPS.Target)
+	}
+	return float64(sc.CPS.Start) +
+		float64(sc.CPS.Target-sc.CPS.Start)*elapsed.Seconds()/sc.rampUp.Seconds()
+}
+
+// pickFlow returns a random flow based on the weights
+func (sc *Scenario) pickFlow() *ScenarioFlow {
+	w := rand.Intn(sc.weights)
+	for i := range sc.Flows {
+		if w < sc.Flows[i].Weight {
+			return &sc.Flows[i]
+		}
+		w -= sc.Flows[i].Weight
+	}
+	return &sc.Flows[len(sc.Flows)-1]
+}
+
+// pick returns a random ID from the pool
+func (sp *ScenarioPool) pick() string {
+	if len(sp.IDs) != 0 {
+		return sp.IDs[rand.Intn(len(sp.IDs))]
+	}
+	return sp.Prefix + strconv.Itoa(sp.Start+rand.Intn(sp.Count))
+}
+
+// compile parses the durations of the distribution
+func (ud *UsageDistribution) compile() (err error) {
+	if ud.Type == utils.EmptyString {
+		ud.Type = metaFixed
+	}
+	for _, fld := range []struct {
+		name string
+		val  string
+		dur  *time.Duration
+	}{
+		{"value", ud.Value, &ud.value},
+		{"min", ud.Min, &ud.min},
+		{"max", ud.Max, &ud.max},
+		{"mean", ud.Mean, &ud.mean},
+		{"std_dev", ud.StdDev, &ud.stdDev},
+	} {
+		if *fld.dur, err = parseScenarioDuration(fld.name, fld.val); err != nil {
+			return
+		}
+	}
+	switch ud.Type {
+	case metaFixed:
+	case metaUniform:
+		if ud.max < ud.min {
+			return fmt.Errorf("usage min should be equal or smaller than max")
+		}
+	case metaNormal, metaExponential:
+		if ud.mean <= 0 {
+			return fmt.Errorf("usage mean should be bigger than 0")
+		}
+	default:
+		return fmt.Errorf("unsupported usage distribution <%s>", ud.Type)
+	}
+	return
+}
+
+// random returns a random usage following the distribution
+// limited to the min and max if defined
+func (ud *UsageDistribution) random() (usage time.Duration) {
+	switch ud.Type {
+	case metaFixed:
+		return ud.value
+	case metaUniform:
+		if ud.min == ud.max {
+			return ud.min
+		}
+		return time.Duration(utils.RandomInteger(int64(ud.min), int64(ud.max)))
+	case metaNormal:
+		usage = time.Duration(rand.NormFloat64()*float64(ud.stdDev) + float64(ud.mean))
+	case metaExponential:
+		usage = time.Duration(rand.ExpFloat64() * float64(ud.mean))
+	}
+	if usage < ud.min {
+		usage = ud.min
+	}
+	if ud.max > 0 && usage > ud.max {
+		usage = ud.max
+	}
+	return
+}
+
+// NewScenarioRunner returns the runner for the scenario over the conn
+func NewScenarioRunner(sc *Scenario, conn birpc.ClientConnector,
+	reportInterval time.Duration) *ScenarioRunner {
+	return &ScenarioRunner{
+		sc:    sc,
+		conn:  conn,
+		stats: newLatencyStats(reportInterval),
+		tick:  time.Second,
+	}
+}
+
+// ScenarioRunner generates the load described by the scenario
+// and collects the latencies of the API calls
+type ScenarioRunner struct {
+	sc    *Scenario
+	conn  birpc.ClientConnector
+	stats *latencyStats
+	tick  time.Duration // how often new flows are started
+	wg    sync.WaitGroup
+}
+
+// Run starts the flows during the scenario duration and waits for them
+// to finish for at most timeout
+func (sr *ScenarioRunner) Run(ctx *context.Context, timeout time.Duration) (err error) {
+	sr.stats.start = time.Now()
+	ticker := time.NewTicker(sr.tick)
+	var pending float64 // the fractions of flows left from the previous ticks
+	for elapsed := time.Duration(0); elapsed < sr.sc.duration; elapsed = time.Since(sr.stats.start) {
+		pending += sr.sc.cps(elapsed) * sr.tick.Seconds()
+		for ; pending >= 1; pending-- {
+			sr.wg.Add(1)
+			go sr.runFlow(ctx, sr.sc.pickFlow())
+		}
+		select {
+		case <-ctx.Done():
+			ticker.Stop()
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	ticker.Stop()
+	done := make(chan struct{})
+	go func() {
+		sr.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		return fmt.Errorf("timed out waiting for the flows to finish")
+	}
+	return
+}
+
+// call executes the API call recording its latency
+func (sr *ScenarioRunner) call(ctx *context.Context, api string, args, reply any) (err error) {
+	startTime := time.Now()
+	err = sr.conn.Call(ctx, api, args, reply)
+	sr.stats.record(api, startTime, time.Since(startTime), err)
+	if err != nil && *verbose {
+		log.Printf("%s error: %s", api, err.Error())
+	}
+	return
+}
+
+// runFlow executes the steps of the flow stopping on the first error
+func (sr *ScenarioRunner) runFlow(ctx *context.Context, flw *ScenarioFlow) {
+	defer sr.wg.Done()
+	totalUsage := flw.Usage.random()
+	ev := &utils.CGREvent{
+		Tenant: sr.sc.Tenant,
+		ID:     utils.GenUUID(),
+		Time:   utils.TimePointer(time.Now()),
+		Event: map[string]any{
+			utils.AccountField: sr.sc.Accounts.pick(),
+			utils.Destination:  sr.sc.Destinations.pick(),
+			utils.ToR:          flw.ToR,
+			utils.Category:     flw.Category,
+			utils.OriginHost:   utils.Local,
+			utils.RequestType:  sr.sc.RequestType,
+			utils.Source:       utils.CGRTester,
+			utils.OriginID:     utils.GenUUID(),
+			utils.SetupTime:    time.Now(),
+		},
+		APIOpts: make(map[string]any),
+	}
+	for _, step := range flw.Steps {
+		var err error
+		switch step {
+		case utils.MetaAuthorize:
+			ev.Event[utils.Usage] = totalUsage
+			var rply sessions.V1AuthorizeReply
+			err = sr.call(ctx, utils.SessionSv1AuthorizeEvent,
+				&sessions.V1AuthorizeArgs{GetMaxUsage: true, CGREvent: ev}, &rply)
+		case utils.MetaInitiate:
+			ev.Event[utils.AnswerTime] = time.Now()
+			delete(ev.Event, utils.Usage)
+			var rply sessions.V1InitSessionReply
+			err = sr.call(ctx, utils.SessionSv1InitiateSession,
+				&sessions.V1InitSessionArgs{InitSession: true, CGREvent: ev}, &rply)
+		case utils.MetaUpdate:
+			for used := flw.updateUsage; used < totalUsage && err == nil; used += flw.updateUsage {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(flw.updateInterval):
+				}
+				ev.Event[utils.Usage] = flw.updateUsage
+				ev.Event[utils.LastUsed] = flw.updateUsage
+				var rply sessions.V1UpdateSessionReply
+				err = sr.call(ctx, utils.SessionSv1UpdateSession,
+					&sessions.V1UpdateSessionArgs{UpdateSession: true, CGREvent: ev}, &rply)
+			}
+			delete(ev.Event, utils.LastUsed)
+		case utils.MetaTerminate:
+			ev.Event[utils.Usage] = totalUsage
+			var rply string
+			err = sr.call(ctx, utils.SessionSv1TerminateSession,
+				&sessions.V1TerminateSessionArgs{TerminateSession: true, CGREvent: ev}, &rply)
+		case metaProcessCDR:
+			ev.Event[utils.Usage] = totalUsage
+			var rply string
+			err = sr.call(ctx, utils.SessionSv1ProcessCDR, ev, &rply)
+		case metaProcessMessage:
+			ev.Event[utils.AnswerTime] = time.Now()
+			ev.Event[utils.Usage] = totalUsage
+			var rply sessions.V1ProcessMessageReply
+			err = sr.call(ctx, utils.SessionSv1ProcessMessage,
+				&sessions.V1ProcessMessageArgs{Debit: true, CGREvent: ev}, &rply)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Report returns the latency report of the run
+func (sr *ScenarioRunner) Report() []*latencyReport {
+	return sr.stats.report()
+}
+
+// apiLatencies are the latencies and the errors of one API
+type apiLatencies struct {
+	latencies []time.Duration
+	errors    uint64
+}
+
+func newLatencyStats(interval time.Duration) *latencyStats {
+	return &latencyStats{
+		interval:  interval,
+		totals:    make(map[string]*apiLatencies),
+		intervals: make(map[int]map[string]*apiLatencies),
+	}
+}
+
+// latencyStats collects the latencies per API both overall and per interval
+type latencyStats struct {
+	mux       sync.Mutex
+	start     time.Time
+	interval  time.Duration
+	totals    map[string]*apiLatencies
+	intervals map[int]map[string]*apiLatencies
+}
+
+// record adds the latency of a call started at startTime
+func (ls *latencyStats) record(api string, startTime time.Time, latency time.Duration, err error) {
+	ls.mux.Lock()
+	defer ls.mux.Unlock()
+	idx := 0
+	if ls.interval > 0 {
+		idx = int(startTime.Sub(ls.start) / ls.interval)
+	}
+	if _, has := ls.intervals[idx]; !has {
+		ls.intervals[idx] = make(map[string]*apiLatencies)
+	}
+	for _, apis := range []map[string]*apiLatencies{ls.totals, ls.intervals[idx]} {
+		lats, has := apis[api]
+		if !has {
+			lats = new(apiLatencies)
+			apis[api] = lats
+		}
+		lats.latencies = append(lats.latencies, latency)
+		if err != nil {
+			lats.errors++
+		}
+	}
+}
+
+// latencyReport is one line of the report
+type latencyReport struct {
+	Interval   string        // start of the interval since the beginning of the run or *total
+	API        string        // the API called
+	Requests   int           // number of requests sent
+	Errors     uint64        // number of requests which failed
+	Throughput float64       // requests per second
+	Min        time.Duration // the latencies of the requests
+	Avg        time.Duration
+	P50        time.Duration
+	P90        time.Duration
+	P95        time.Duration
+	P99        time.Duration
+	Max        time.Duration
+}
+
+// percentile returns the nearest-rank percentile out of the sorted latencies
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+// newLatencyReport computes the report out of the latencies collected during dur
+func newLatencyReport(interval, api string, lats *apiLatencies, dur time.Duration) (lr *latencyReport) {
+	sorted := make([]time.Duration, len(lats.latencies))
+	copy(sorted, lats.latencies)
+	slices.Sort(sorted)
+	lr = &latencyReport{
+		Interval: interval,
+		API:      api,
+		Requests: len(sorted),
+		Errors:   lats.errors,
+		Min:      sorted[0],
+		Avg:      calculateAverageDuration(sorted),
+		P50:      percentile(sorted, 50),
+		P90:      percentile(sorted, 90),
+		P95:      percentile(sorted, 95),
+		P99:      percentile(sorted, 99),
+		Max:      sorted[len(sorted)-1],
+	}
+	if dur > 0 {
+		lr.Throughput = float64(lr.Requests) / dur.Seconds()
+	}
+	return
+}
+
+// report returns the lines per interval followed by the totals
+func (ls *latencyStats) report() (rpt []*latencyReport) {
+	ls.mux.Lock()
+	defer ls.mux.Unlock()
+	idxs := make([]int, 0, len(ls.intervals))
+	for idx := range ls.intervals {
+		idxs = append(idxs, idx)
+	}
+	slices.Sort(idxs)
+	for _, idx := range idxs {
+		for _, api := range slices.Sorted(maps.Keys(ls.intervals[idx])) {
+			rpt = append(rpt, newLatencyReport((time.Duration(idx)*ls.interval).String(),
+				api, ls.intervals[idx][api], ls.interval))
+		}
+	}
+	var totalDur time.Duration
+	if !ls.start.IsZero() {
+		totalDur = time.Since(ls.start)
+	}
+	for _, api := range slices.Sorted(maps.Keys(ls.totals)) {
+		rpt = append(rpt, newLatencyReport(metaTotal, api, ls.totals[api], totalDur))
+	}
+	return
+}
+
+// writeLatencyReport writes the report in CSV or JSON format based on the extension of the file
+func writeLatencyReport(rpt []*latencyReport, fPath string) (err error) {
+	var f *os.File
+	if f, err = os.Create(fPath); err != nil {
+		return
+	}
+	defer f.Close()
+	if strings.ToLower(filepath.Ext(fPath)) == utils.JSNSuffix {
+		enc := encjson.NewEncoder(f)
+		enc.SetIndent(utils.EmptyString, "  ")
+		return enc.Encode(rpt)
+	}
+	w := csv.NewWriter(f)
+	w.Write([]string{"Interval", "API", "Requests", "Errors", "Throughput",
+		"Min", "Avg", "P50", "P90", "P95", "P99", "Max"})
+	for _, lr := range rpt {
+		w.Write([]string{lr.Interval, lr.API, strconv.Itoa(lr.Requests),
+			strconv.FormatUint(lr.Errors, 10), strconv.FormatFloat(lr.Throughput, 'f', 2, 64),
+			lr.Min.String(), lr.Avg.String(), lr.P50.String(), lr.P90.String(),
+			lr.P95.String(), lr.P99.String(), lr.Max.String()})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// printLatencySummary prints the totals of the report
+func printLatencySummary(rpt []*latencyReport) {
+	fmt.Printf("| %-32s | %-10s | %-8s | %-10s | %-12s | %-12s | %-12s | %-12s |\n",
+		"API", "Requests", "Errors", "Req/s", "P50", "P90", "P99", "Max")
+	for _, lr := range rpt {
+		if lr.Interval != metaTotal {
+			continue
+		}
+		fmt.Printf("| %-32s | %-10d | %-8d | %-10.2f | %-12s | %-12s | %-12s | %-12s |\n",
+			lr.API, lr.Requests, lr.Errors, lr.Throughput, lr.P50, lr.P90, lr.P99, lr.Max)
+	}
+}
+
+// runScenario runs the scenario from the file against SessionS
+func runScenario(fPath, reportPath string, reportInterval, timeout time.Duration) (err error) {
+	var sc *Scenario
+	if sc, err = NewScenarioFromFile(fPath); err != nil {
+		return
+	}
+	srv, err := birpc.NewService(new(smock), utils.AgentV1, true)
+	if err != nil {
+		return
+	}
+	var conn *birpc.BirpcClient
+	if conn, err = utils.NewBiJSONrpcClient(tstCfg.SessionSCfg().ListenBijson, srv); err != nil {
+		return
+	}
+	defer conn.Close()
+	sr := NewScenarioRunner(sc, conn, reportInterval)
+	runErr := sr.Run(context.Background(), timeout)
+	rpt := sr.Report()
+	printLatencySummary(rpt)
+	if reportPath != utils.EmptyString {
+		if err = writeLatencyReport(rpt, reportPath); err != nil {
+			return
+		}
+	}
+	return runErr
+}
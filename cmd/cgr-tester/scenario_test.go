@@ -0,0 +1,283 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package main
+
+import (
+	encjson "encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestNewScenarioFromFile(t *testing.T) {
+	sc, err := NewScenarioFromFile(filepath.Join("..", "..", "data", "tester", "scenario.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sc.duration != 5*time.Minute || sc.rampUp != time.Minute ||
+		sc.weights != 100 || len(sc.Flows) != 3 {
+		t.Errorf("Unexpected scenario: %s", utils.ToJSON(sc))
+	}
+	if flw := sc.Flows[2]; flw.updateInterval != 10*time.Second ||
+		flw.updateUsage != 10485760 ||
+		flw.Usage.min != 1048576 {
+		t.Errorf("Unexpected flow: %+v", flw)
+	}
+
+	fPath := filepath.Join(t.TempDir(), "scenario.json")
+	if err := os.WriteFile(fPath, []byte(`{
+	"duration": "1s",
+	"cps": {"target": 10},
+	"accounts": {"ids": ["1001"]},
+	"destinations": {"ids": ["1002"]},
+	"flows": [{"weight": 1, "steps": ["*update"], "usage": {"value": "1s"}}]
+}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	expErr := "missing update_interval for flow <0>"
+	if _, err := NewScenarioFromFile(fPath); err == nil || err.Error() != expErr {
+		t.Errorf("Expected error %q, received %v", expErr, err)
+	}
+}
+
+func TestScenarioCompileErrors(t *testing.T) {
+	newScenario := func() *Scenario {
+		return &Scenario{
+			Duration:     "1s",
+			CPS:          ScenarioCPS{Target: 10},
+			Accounts:     ScenarioPool{Prefix: "10", Start: 1, Count: 10},
+			Destinations: ScenarioPool{IDs: []string{"1002"}},
+			Flows: []ScenarioFlow{{
+				Weight: 1,
+				Steps:  []string{utils.MetaAuthorize},
+				Usage:  UsageDistribution{Value: "1s"},
+			}},
+		}
+	}
+	if err := newScenario().compile(); err != nil {
+		t.Fatal(err)
+	}
+	for expErr, modify := range map[string]func(*Scenario){
+		"the scenario duration should be bigger than 0": func(sc *Scenario) { sc.Duration = "" },
+		"the target cps should be bigger than 0":        func(sc *Scenario) { sc.CPS.Target = 0 },
+		"empty accounts pool":                           func(sc *Scenario) { sc.Accounts.Count = 0 },
+		"no flows defined":                              func(sc *Scenario) { sc.Flows = nil },
+		"unsupported step <*debit> for flow <0>":        func(sc *Scenario) { sc.Flows[0].Steps = []string{"*debit"} },
+		"unsupported usage distribution <*poisson> for flow <0>": func(sc *Scenario) {
+			sc.Flows[0].Usage.Type = "*poisson"
+		},
+		"the weights of the flows should not sum to 0": func(sc *Scenario) { sc.Flows[0].Weight = 0 },
+	} {
+		sc := newScenario()
+		modify(sc)
+		if err := sc.compile(); err == nil || err.Error() != expErr {
+			t.Errorf("Expected error %q, received %v", expErr, err)
+		}
+	}
+}
+
+func TestScenarioCPS(t *testing.T) {
+	sc := &Scenario{
+		CPS:    ScenarioCPS{Start: 10, Target: 110},
+		rampUp: 10 * time.Second,
+	}
+	for elapsed, exp := range map[time.Duration]float64{
+		0:                10,
+		5 * time.Second:  60,
+		10 * time.Second: 110,
+		time.Minute:      110,
+	} {
+		if rcv := sc.cps(elapsed); rcv != exp {
+			t.Errorf("For %s expected %v, received %v", elapsed, exp, rcv)
+		}
+	}
+}
+
+func TestUsageDistributionRandom(t *testing.T) {
+	for _, ud := range []*UsageDistribution{
+		{Type: metaUniform, Min: "10s", Max: "20s"},
+		{Type: metaNormal, Mean: "15s", StdDev: "10s", Min: "10s", Max: "20s"},
+		{Type: metaExponential, Mean: "15s", Min: "10s", Max: "20s"},
+	} {
+		if err := ud.compile(); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 100; i++ {
+			if usage := ud.random(); usage < 10*time.Second || usage > 20*time.Second {
+				t.Fatalf("Usage %s out of bounds for %s", usage, ud.Type)
+			}
+		}
+	}
+	ud := &UsageDistribution{Value: "1m"}
+	if err := ud.compile(); err != nil {
+		t.Fatal(err)
+	} else if usage := ud.random(); usage != time.Minute {
+		t.Errorf("Expected %s, received %s", time.Minute, usage)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i+1) * time.Millisecond
+	}
+	for p, exp := range map[float64]time.Duration{
+		50: 50 * time.Millisecond,
+		90: 90 * time.Millisecond,
+		99: 99 * time.Millisecond,
+		0:  time.Millisecond,
+	} {
+		if rcv := percentile(sorted, p); rcv != exp {
+			t.Errorf("For p%v expected %s, received %s", p, exp, rcv)
+		}
+	}
+	if rcv := percentile(nil, 50); rcv != 0 {
+		t.Errorf("Expected 0, received %s", rcv)
+	}
+}
+
+type scenarioConnMock struct {
+	mux   sync.Mutex
+	calls map[string]int
+}
+
+func (cm *scenarioConnMock) Call(ctx *context.Context, method string, args, reply any) error {
+	cm.mux.Lock()
+	cm.calls[method]++
+	cm.mux.Unlock()
+	if method == utils.SessionSv1ProcessMessage {
+		return errors.New("INSUFFICIENT_CREDIT")
+	}
+	return nil
+}
+
+func TestScenarioRunnerRun(t *testing.T) {
+	sc := &Scenario{
+		Duration:     "100ms",
+		CPS:          ScenarioCPS{Target: 400},
+		Accounts:     ScenarioPool{Prefix: "10", Start: 1, Count: 10},
+		Destinations: ScenarioPool{IDs: []string{"1002"}},
+		Flows: []ScenarioFlow{
+			{
+				ID:             "voice",
+				Weight:         1,
+				Steps:          []string{utils.MetaAuthorize, utils.MetaInitiate, utils.MetaUpdate, utils.MetaTerminate, metaProcessCDR},
+				Usage:          UsageDistribution{Value: "30s"},
+				UpdateInterval: "1ms",
+				UpdateUsage:    "10s",
+			},
+			{
+				ID:     "sms",
+				Weight: 1,
+				ToR:    utils.MetaSMS,
+				Steps:  []string{metaProcessMessage, metaProcessCDR},
+				Usage:  UsageDistribution{Value: "1"},
+			},
+		},
+	}
+	if err := sc.compile(); err != nil {
+		t.Fatal(err)
+	}
+	conn := &scenarioConnMock{calls: make(map[string]int)}
+	sr := NewScenarioRunner(sc, conn, 50*time.Millisecond)
+	sr.tick = 10 * time.Millisecond
+	if err := sr.Run(context.Background(), time.Second); err != nil {
+		t.Fatal(err)
+	}
+	flows := conn.calls[utils.SessionSv1AuthorizeEvent] + conn.calls[utils.SessionSv1ProcessMessage]
+	if flows < 36 || flows > 44 { // 4 flows each 10ms tick during 100ms
+		t.Errorf("Expected around 40 flows, received: %+v", conn.calls)
+	}
+	if conn.calls[utils.SessionSv1UpdateSession] != 2*conn.calls[utils.SessionSv1InitiateSession] {
+		t.Errorf("Expected 2 updates per session, received: %+v", conn.calls)
+	}
+	if conn.calls[utils.SessionSv1ProcessCDR] != conn.calls[utils.SessionSv1TerminateSession] {
+		t.Errorf("Expected no CDRs after failed messages, received: %+v", conn.calls)
+	}
+	rpt := sr.Report()
+	totals := make(map[string]*latencyReport)
+	for _, lr := range rpt {
+		if lr.Interval == metaTotal {
+			totals[lr.API] = lr
+		}
+	}
+	if len(totals) != 6 {
+		t.Fatalf("Unexpected report: %s", utils.ToJSON(rpt))
+	}
+	if lr := totals[utils.SessionSv1ProcessMessage]; lr.Errors != uint64(lr.Requests) ||
+		lr.Requests != conn.calls[utils.SessionSv1ProcessMessage] {
+		t.Errorf("Unexpected report: %s", utils.ToJSON(lr))
+	}
+	if lr := totals[utils.SessionSv1AuthorizeEvent]; lr.Errors != 0 ||
+		lr.Min > lr.P50 || lr.P50 > lr.P99 || lr.P99 > lr.Max || lr.Throughput <= 0 {
+		t.Errorf("Unexpected report: %s", utils.ToJSON(lr))
+	}
+	if rpt[0].Interval != "0s" {
+		t.Errorf("Expected the report to start with the first interval, received: %s", utils.ToJSON(rpt[0]))
+	}
+}
+
+func TestWriteLatencyReport(t *testing.T) {
+	rpt := []*latencyReport{{
+		Interval:   metaTotal,
+		API:        utils.SessionSv1AuthorizeEvent,
+		Requests:   2,
+		Errors:     1,
+		Throughput: 0.5,
+		Min:        time.Millisecond,
+		Avg:        2 * time.Millisecond,
+		P50:        time.Millisecond,
+		P90:        3 * time.Millisecond,
+		P95:        3 * time.Millisecond,
+		P99:        3 * time.Millisecond,
+		Max:        3 * time.Millisecond,
+	}}
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "report.csv")
+	if err := writeLatencyReport(rpt, csvPath); err != nil {
+		t.Fatal(err)
+	}
+	exp := `Interval,API,Requests,Errors,Throughput,Min,Avg,P50,P90,P95,P99,Max
+*total,SessionSv1.AuthorizeEvent,2,1,0.50,1ms,2ms,1ms,3ms,3ms,3ms,3ms
+`
+	if rcv, err := os.ReadFile(csvPath); err != nil {
+		t.Fatal(err)
+	} else if string(rcv) != exp {
+		t.Errorf("Expected %q, received %q", exp, string(rcv))
+	}
+	jsnPath := filepath.Join(dir, "report.json")
+	if err := writeLatencyReport(rpt, jsnPath); err != nil {
+		t.Fatal(err)
+	}
+	var rcv []*latencyReport
+	if b, err := os.ReadFile(jsnPath); err != nil {
+		t.Fatal(err)
+	} else if err := encjson.Unmarshal(b, &rcv); err != nil {
+		t.Fatal(err)
+	} else if len(rcv) != 1 || *rcv[0] != *rpt[0] {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(rpt), strings.TrimSpace(string(b)))
+	}
+}
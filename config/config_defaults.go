@@ -29,8 +29,11 @@ const CGRATES_CFG_JSON = `
 
 "general": {
 	"node_id": "",						// identifier of this instance in the cluster, if empty it will be autogenerated
-	"logger":"*syslog",					// controls the destination of logs <*syslog|*stdout>
+	"logger":"*syslog",					// controls the destination of logs <*syslog|*stdout|*json|*ees>
 	"log_level": 6,						// control the level of messages logged (0-emerg to 7-debug)
+	"log_levels": {},					// overwrite the log_level per subsystem, ie: {"SessionS": 7}
+	"logger_ees_conns": [],					// connections to EEs used by the *ees logger <""|*internal|$rpc_conns_id>
+	"logger_exporter_ids": [],				// list of EventExporter profiles used by the *ees logger
 	"rounding_decimals": 5,					// system level precision for floats
 	"dbdata_encoding": "*msgpack",				// encoding used to store object data in strings: <*msgpack|*json>
 	"tpexport_dir": "/var/spool/cgrates/tpe",		// path towards export folder for offline TariffPlans
@@ -62,7 +65,19 @@ const CGRATES_CFG_JSON = `
 	"caps": 0,			// maximum concurrent request allowed ( 0 to disabled )
 	"caps_strategy": "*busy",	// strategy in case of concurrent requests reached	
 	"caps_stats_interval": "0",	// the interval duration we sample for caps stats ( 0 to disabled )
-	"shutdown_timeout": "1s"	// the duration to wait until all services are stopped
+	"shutdown_timeout": "1s",	// the duration to wait until all services are stopped
+	"rate_limits": [		// token bucket limits applied to the API requests received by the RPC server
+		// {
+		//	"id": "",			// identifier of the limit
+		//	"tenants": [],			// tenants the limit applies to, empty for all
+		//	"apis": [],			// API methods the limit applies to, ie: SessionSv1.AuthorizeEvent, empty for all
+		//	"per_tenant": false,		// keep a separate bucket for each tenant
+		//	"per_api": false,		// keep a separate bucket for each API method
+		//	"per_connection": false,	// keep a separate bucket for each client connection
+		//	"rate": 0,			// number of requests allowed per second
+		//	"burst": 0			// maximum number of requests allowed at once
+		// }
+	]
 },
 
 
@@ -99,6 +114,8 @@ const CGRATES_CFG_JSON = `
 	"replication_conns":[],			// the conns the items are replicated
 	"replication_filtered": false, 		// if this is enabled the replication will be made only to the conns that received a get
 	"replication_cache": "", 		// the caching action that is executed on the replication_conns when the items are replicated 
+	"ees_conns": [],				// connections to EEs where the changes of the items are exported <""|*internal|$rpc_conns_id>
+	"ees_exporter_ids": [],			// list of EventExporter profiles to use for the changes of the items
 	"items":{
 		"*accounts": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*reverse_destinations": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
@@ -111,6 +128,7 @@ const CGRATES_CFG_JSON = `
 		"*action_triggers": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*shared_groups": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*timings": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*holiday_calendars": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*resource_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*resources": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*ranking_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
@@ -156,7 +174,10 @@ const CGRATES_CFG_JSON = `
 		"redisClientKey":"",			// path to client key
 		"redisCACertificate":"",		// path to CA certificate (populate for self-signed certificate otherwise let it empty)
 		"mongoQueryTimeout":"10s",		// timeout for query when mongo is used
-		"mongoConnScheme": "mongodb"		// scheme for MongoDB connection <mongodb|mongodb+srv>
+		"mongoConnScheme": "mongodb",		// scheme for MongoDB connection <mongodb|mongodb+srv>
+		"internalDBDumpPath": "",		// the directory where the *internal DataDB is persisted, empty to keep it only in memory
+		"internalDBFsyncInterval": "1s",	// interval to sync the log of changes to disk (0 to sync after each change)
+		"internalDBSnapshotInterval": "1h"	// interval to compact the log into a new snapshot (0 to take the snapshots only on demand)
 	}
 },
 
@@ -184,12 +205,16 @@ const CGRATES_CFG_JSON = `
 		//"pgSSLPassword": "",		// specifies the password for the secret key specified in pgSSLKey
 		//"pgSSLCertMode": "allow",	// determines whether a client certificate may be sent to the server, and whether the server is required to request one
 		//"pgSSLRootCert": "",		// name of a file containing SSL certificate authority (CA) certificate(s)
-		"pgSchema": ""			// postgres schema to use
+		"pgSchema": "",			// postgres schema to use
+		"internalDBDumpPath": "",		// the directory where the *internal StorDB is persisted, empty to keep it only in memory
+		"internalDBFsyncInterval": "1s",	// interval to sync the log of changes to disk (0 to sync after each change)
+		"internalDBSnapshotInterval": "1h"	// interval to compact the log into a new snapshot (0 to take the snapshots only on demand)
 	},
 	"items":{
 		"*session_costs": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*cdrs": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_timings": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*tp_holiday_calendars": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_destinations": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_rates": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_destination_rates": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
@@ -222,7 +247,8 @@ const CGRATES_CFG_JSON = `
 	"http": "127.0.0.1:2080",		// HTTP listening address
 	"rpc_json_tls" : "127.0.0.1:2022",	// RPC JSON TLS listening address
 	"rpc_gob_tls": "127.0.0.1:2023",	// RPC GOB TLS listening address
-	"http_tls": "127.0.0.1:2280"		// HTTP TLS listening address
+	"http_tls": "127.0.0.1:2280",		// HTTP TLS listening address
+	"grpc": ""				// gRPC listening address, empty to disable <""|127.0.0.1:2014>
 },
 
 
@@ -276,7 +302,10 @@ const CGRATES_CFG_JSON = `
 	"thresholds_conns": [],		// connections to ThresholdS for *reset_threshold action <""|*internal|$rpc_conns_id>
 	"stats_conns": [],		// connections to StatS for *reset_stat_queue action: <""|*internal|$rpc_conns_id>
 	"filters": [],			// only execute actions matching these filters
-	"dynaprepaid_actionplans": []	// actionPlans to be executed in case of *dynaprepaid request type
+	"dynaprepaid_actionplans": [],	// actionPlans to be executed in case of *dynaprepaid request type
+	"leader_election": false,	// only one of the nodes sharing the data_db executes the actions, another one taking over on failure
+	"lease_ttl": "10s",		// leadership is lost if not renewed within this interval, renewed each third of it
+	"catchup_policy": "*skip"	// executions missed while no leader was running: <*skip|*run_once|*run_all>
 },
 
 
@@ -292,6 +321,7 @@ const CGRATES_CFG_JSON = `
 		"*action_triggers": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// action triggers caching
 		"*shared_groups": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// shared groups caching
 		"*timings": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// timings caching
+		"*holiday_calendars": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// holiday calendars caching
 		"*resource_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control resource profiles caching
 		"*resources": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// control resources caching
 		"*event_resources": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false},				// matching resources to events
@@ -368,7 +398,8 @@ const CGRATES_CFG_JSON = `
 	"balance_rating_subject":{		// default rating subject in case that balance rating subject is empty
 		"*any": "*zero1ns",
 		"*voice": "*zero1s"
-	}
+	},
+	"decimal_balances": false		// store and debit *monetary balances, costs and value factors using arbitrary-precision decimals: <true|false>
 
 },
 
@@ -446,6 +477,9 @@ const CGRATES_CFG_JSON = `
 				// "kafkaTLS": false, 				// if true it will try to authenticate the client
 				// "kafkaCAPath": "",				// path to certificate authority pem
 				// "kafkaSkipTLSVerify": false,			// if true it will skip certificate verification
+				// "kafkaSASLMechanism": "",			// SASL mechanism used to authenticate <""|plain|scram-sha-256|scram-sha-512>
+				// "kafkaSASLUsername": "",			// username for SASL authentication
+				// "kafkaSASLPassword": "",			// password for SASL authentication
 
 				// SQL
 				// "sqlDBName": "cgrates", 			// the name of the database from were the events are read
@@ -477,6 +511,18 @@ const CGRATES_CFG_JSON = `
 				// "natsClientCertificate": "",			// the path to a client certificate( used by tls)
 				// "natsClientKey": "",				// the path to a client key( used by tls)
 				// "natsJetStreamMaxWait": "5s",		// the maximum amount of time to wait for a response
+
+				// mqtt
+				// "mqttTopic": "cgrates_cdrs",			// the topic filter the reader subscribes to, wildcards (+, #) allowed
+				// "mqttQoS": 0,				// the QoS level of the subscription <0|1|2>
+				// "mqttClientID": "",				// the client identifier, defaults to cgrates<node_id><reader_id>
+				// "mqttUsername": "",				// username used to authenticate
+				// "mqttPassword": "",				// password used to authenticate
+				// "mqttSharedGroup": "",			// subscribe as part of a shared subscription group, load balancing the messages between its members
+				// "mqttCertificateAuthority": "",		// the path to a custom certificate authority file( used by tls)
+				// "mqttClientCertificate": "",			// the path to a client certificate( used by tls)
+				// "mqttClientKey": "",				// the path to a client key( used by tls)
+				// "mqttSkipTLSVerify": false,			// if true it will skip certificate verification
 			},
 			"fields":[						// import fields template, tag will match internally CDR field, in case of .csv value will be represented by index of the field value
 				{"tag": "ToR", "path": "*cgreq.ToR", "type": "*variable", "value": "~*req.2", "mandatory": true},
@@ -502,6 +548,8 @@ const CGRATES_CFG_JSON = `
 	"attributes_conns":[],				// RPC Connections IDs
 	"cache": {
 		"*file_csv": {"limit": -1, "ttl": "5s", "static_ttl": false},
+		"*file_parquet": {"limit": -1, "ttl": "5s", "static_ttl": false},
+		"*file_avro": {"limit": -1, "ttl": "5s", "static_ttl": false},
 	},
 	"exporters": [
 		{
@@ -509,6 +557,9 @@ const CGRATES_CFG_JSON = `
 			"type": "*none",					// exporter type 
 			"export_path": "/var/spool/cgrates/ees",		// path where the exported events will be placed
 			"failed_posts_dir": "/var/spool/cgrates/failed_posts",	// directory path where we store failed requests
+			"retry_queue_dir": "*none",				// directory of the durable queue retrying the failed exports in the background, in order, instead of failed_posts_dir <*none|$dir>
+			"retry_backoff": "1s",					// delay before the first retry, doubled after each failed retry
+			"retry_max_backoff": "5m",				// maximum delay between two retries
 			"concurrent_requests": 0,				// maximum simultaneous requests to process, 0 for unlimited
 			"timezone": "",						// timezone for timestamps where not specified <""|UTC|Local|$IANA_TZ_DB>
 			"filters": [],						// limit parsing based on the filters
@@ -522,6 +573,9 @@ const CGRATES_CFG_JSON = `
 				// CSV
 				// "csvFieldSeparator": ",",			// separator used when reading the fields
 
+				// Parquet and Avro
+				// "rowGroupSize": 10000,			// number of records buffered before a parquet row group or an avro block is written
+
 				
  				// Elasticsearch options
 				// "elsCloud": true,			// if true, use cloud ID deployment
@@ -568,6 +622,11 @@ const CGRATES_CFG_JSON = `
 				// "kafkaTLS": false,			// if true, it will try to authenticate the server
 				// "kafkaCAPath": "", 			// path to certificate authority pem
 				// "kafkaSkipTLSVerify: false, 		// if true it will skip certificate verification
+				// "kafkaSASLMechanism": "",		// SASL mechanism used to authenticate <""|plain|scram-sha-256|scram-sha-512>
+				// "kafkaSASLUsername": "",		// username for SASL authentication
+				// "kafkaSASLPassword": "",		// password for SASL authentication
+				// "kafkaKeyField": "",			// exported field used as message key, the CGRID and RunID are used if empty
+				// "kafkaHeaders": [],			// *opts fields attached to the message as headers
 
 
 				// AMQP
@@ -602,6 +661,18 @@ const CGRATES_CFG_JSON = `
 				// "natsClientKey": "",			// the path to a client key( used by tls)
 				// "natsJetStreamMaxWait": "5s",	// the maximum amount of time to wait for a response
 
+				// mqtt
+				// "mqttTopic": "cgrates_cdrs",		// the topic were the events are exported
+				// "mqttQoS": 0,			// the QoS level used when publishing <0|1|2>
+				// "mqttClientID": "",			// the client identifier, defaults to cgrates<node_id><exporter_id>
+				// "mqttUsername": "",			// username used to authenticate
+				// "mqttPassword": "",			// password used to authenticate
+				// "mqttRetained": false,		// if true the broker will retain the last message on the topic
+				// "mqttCertificateAuthority": "",	// the path to a custom certificate authority file( used by tls)
+				// "mqttClientCertificate": "",		// the path to a client certificate( used by tls)
+				// "mqttClientKey": "",			// the path to a client key( used by tls)
+				// "mqttSkipTLSVerify": false,		// if true it will skip certificate verification
+
 				//RPC
 				// "rpcCodec": "",  		// for compression, encoding and decoding <internalRPC | BIRPC | JSON/HTTP/GOB>
 				// "serviceMethod": "", 	// the method that should be called trough RPC
@@ -723,8 +794,12 @@ const CGRATES_CFG_JSON = `
 
 "diameter_agent": {
 	"enabled": false,						// enables the diameter agent: <true|false>
-	"listen": "127.0.0.1:3868",					// address where to listen for diameter requests <x.y.z.y/x1.y1.z1.y1:1234>
-	"listen_net": "tcp",						// transport type for diameter <tcp|sctp>
+	"listeners": [
+		{
+			"address": "127.0.0.1:3868",			// address where to listen for diameter requests <x.y.z.y/x1.y1.z1.y1:1234>
+			"network": "tcp"				// transport type for diameter <tcp|sctp|tls>, tls using the certificates from tls section
+		}
+	],
 	"dictionaries_path": "/usr/share/cgrates/diameter/dict/",	// path towards directory holding additional dictionaries to load
 	"sessions_conns": ["*birpc_internal"],
 	"origin_host": "CGR-DA",					// diameter Origin-Host AVP used in replies
@@ -735,6 +810,18 @@ const CGRATES_CFG_JSON = `
 	"asr_template": "",						// enable AbortSession message being sent to client on DisconnectSession
 	"rar_template": "",						// template used to build the Re-Auth-Request
 	"forced_disconnect": "*none",					// the request to send to diameter on DisconnectSession <*none|*asr|*rar>
+	"peers": [							// outbound connections towards diameter peers, used by *relay request processors
+		// {
+		//	"id": "",					// peer identifier, referenced in *relay:*peers flag
+		//	"address": "",					// address of the peer <x.y.z.y:1234>
+		//	"network": "tcp",				// transport type towards the peer <tcp|sctp>
+		//	"realm": "",					// Destination-Realm served by the peer, empty to use the Origin-Realm from CEA
+		//	"application_ids": []				// application ids routed to the peer, empty to use the ones advertised in CEA
+		// }
+	],
+	"watchdog_interval": "5s",					// interval between DWRs sent to peers
+	"reconnect_interval": "5s",					// interval between reconnect attempts towards disconnected peers
+	"relay_timeout": "3s",						// time to wait for an answer from a peer before failing over to the next one
 	"request_processors": []					// list of processors to be applied to diameter messages
 },
 
@@ -743,7 +830,7 @@ const CGRATES_CFG_JSON = `
 	"enabled": false,					// enables the radius agent: <true|false>
 	"listeners":[
 		{
-			"network": "udp",			// network to listen on <udp|tcp>
+			"network": "udp",			// network to listen on <udp|tcp|tls>, tls (RadSec) using the certificates from tls section
 			"auth_address": "127.0.0.1:1812",	// address where to listen for radius authentication requests <x.y.z.y:1234>
 			"acct_address": "127.0.0.1:1813"	// address where to listen for radius accounting requests <x.y.z.y:1234>
 		}
@@ -758,7 +845,7 @@ const CGRATES_CFG_JSON = `
 	},
 	"client_da_addresses": { 				// configuration for clients capable of handling Dynamic Authorization (CoA/DM) requests.
 		// "nasIdentifier": { 				// identifier for the NAS, typically the host from the initial RADIUS packet.
-		// 	"transport": "udp", 			// transport protocol for Dynamic Authorization requests, defaults to UDP.
+		// 	"transport": "udp", 			// transport protocol for Dynamic Authorization requests <udp|tcp|tls>, defaults to UDP.
 		// 	"host": "", 				// optionally specify an alternative host for DA requests. Defaults to the NAS identifier if empty.
 		// 	"port": 3799, 				// port for Dynamic Authorization requests, default is 3799.
 		// 	"flags": [] 				// additional options, currently supports *log for logging DA requests before sending.
@@ -931,6 +1018,21 @@ const CGRATES_CFG_JSON = `
 		"field_separator": ",",				// separator used in case of csv files
 		"tp_in_dir": "/var/spool/cgrates/loader/in",	// absolute path towards the directory where the TPs are stored
 		"tp_out_dir": "/var/spool/cgrates/loader/out",	// absolute path towards the directory where processed TPs will be moved
+		"source_type": "*local",			// source of the TPs: <*local|*s3|*http|*sql>, tp_in_dir being the S3 endpoint, the base URL or the DB URL for the remote ones
+		"opts": {
+			// S3
+			// "awsRegion": "",
+			// "awsKey": "",
+			// "awsSecret": "",
+			// "awsToken": "",
+			// "s3BucketID": "cgrates_tps",		// the bucket from where the TPs are read
+			// "s3FolderPath": "",			// the folder within the bucket from where the TPs are read, tp_out_dir being the folder where they are moved
+
+			// SQL
+			// "sqlDBName": "cgrates",		// the name of the database from where the TPs are read, one table per file name
+			// "sqlColumns": {},			// the columns read out of each table, in the order expected by the data fields, eg: {"Rates": ["tag", "connect_fee", "rate"]}; all of them if not defined
+			// "pgSSLMode": "disable",		// the ssl mode for postgres db
+		},
 		"data":[					// data profiles to load
 			{
 				"type": "*attributes",		// data source type
@@ -1191,6 +1293,10 @@ const CGRATES_CFG_JSON = `
 	"attributes_conns": [],		// connections to AttributeS for API authorization, empty to disable auth functionality: <""|*internal|$rpc_conns_id>
 	"any_subsystem": true,		// if we match the *any subsystem
 	"prevent_loops": false,
+	"health_check_interval": "0s",		// interval to probe the DispatcherHosts, 0 to disable health checking
+	"health_check_method": "CoreSv1.Ping",	// API used to probe the hosts: <CoreSv1.Ping|CoreSv1.Status>
+	"unhealthy_threshold": 1,		// consecutive failed probes after which a host is skipped by the strategies
+	"healthy_threshold": 3,			// consecutive successful probes after which an unhealthy host is used again
 },
 
 
@@ -1253,6 +1359,18 @@ const CGRATES_CFG_JSON = `
 },
 
 
+"prometheus_agent": {
+	"enabled": false,				// exports StatS, ResourceS and TrendS state on the http prometheus_url: <true|false>
+	"stats_conns": [],				// connections to StatS for queue metrics: <""|*internal|$rpc_conns_id>
+	"stat_queue_ids": [],				// StatQueues to export: <[tenant:]ID>
+	"resources_conns": [],				// connections to ResourceS for usages and limits: <""|*internal|$rpc_conns_id>
+	"resource_ids": [],				// Resources to export: <[tenant:]ID>
+	"trends_conns": [],				// connections to TrendS for trend metrics: <""|*internal|$rpc_conns_id>
+	"trend_ids": [],				// Trends to export: <[tenant:]ID>
+	"ees_conns": []					// connections to EEs for the retry queues of the exporters: <""|*internal|$rpc_conns_id>
+},
+
+
 "templates": {
 	"*err": [
 		{"tag": "SessionId", "path": "*rep.Session-Id", "type": "*variable",
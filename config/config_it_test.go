@@ -627,6 +627,7 @@ func testCGRConfigReloadERs(t *testing.T) {
 					Kafka:              &KafkaROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -660,6 +661,7 @@ func testCGRConfigReloadERs(t *testing.T) {
 					SQL:                &SQLROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -54,6 +54,9 @@ func TestDfGeneralJsonCfg(t *testing.T) {
 		Digest_equal:           utils.StringPointer(":"),
 		Rsr_separator:          utils.StringPointer(";"),
 		Max_parallel_conns:     utils.IntPointer(100),
+		Log_levels:             map[string]int{},
+		Logger_ees_conns:       &[]string{},
+		Logger_exporter_ids:    &[]string{},
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -72,6 +75,7 @@ func TestDfCoreSJsonCfg(t *testing.T) {
 		Caps_strategy:       utils.StringPointer(utils.MetaBusy),
 		Caps_stats_interval: utils.StringPointer("0"),
 		Shutdown_timeout:    utils.StringPointer("1s"),
+		Rate_limits:         &[]*RateLimitJsonCfg{},
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -117,6 +121,9 @@ func TestCacheJsonCfg(t *testing.T) {
 			utils.CacheTimings: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheHolidayCalendars: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
 			utils.CacheResourceProfiles: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
@@ -269,6 +276,7 @@ func TestDfListenJsonCfg(t *testing.T) {
 		Rpc_json_tls: utils.StringPointer("127.0.0.1:2022"),
 		Rpc_gob_tls:  utils.StringPointer("127.0.0.1:2023"),
 		Http_tls:     utils.StringPointer("127.0.0.1:2280"),
+		Grpc:         utils.StringPointer(""),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -294,6 +302,8 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 		Replication_filtered: utils.BoolPointer(false),
 		Remote_conn_id:       utils.StringPointer(""),
 		Replication_cache:    utils.StringPointer(""),
+		Ees_conns:            &[]string{},
+		Ees_exporter_ids:     &[]string{},
 		Opts: &DBOptsJson{
 			RedisMaxConns:           utils.IntPointer(10),
 			RedisConnectAttempts:    utils.IntPointer(20),
@@ -312,6 +322,10 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 			RedisClientCertificate:  utils.StringPointer(utils.EmptyString),
 			RedisClientKey:          utils.StringPointer(utils.EmptyString),
 			RedisCACertificate:      utils.StringPointer(utils.EmptyString),
+
+			InternalDBDumpPath:         utils.StringPointer(utils.EmptyString),
+			InternalDBFsyncInterval:    utils.StringPointer("1s"),
+			InternalDBSnapshotInterval: utils.StringPointer("1h"),
 		},
 		Items: &map[string]*ItemOptJson{
 			utils.MetaAccounts: {
@@ -391,6 +405,13 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.MetaHolidayCalendars: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 			utils.MetaResourceProfile: {
 				Replicate:  utils.BoolPointer(false),
 				Remote:     utils.BoolPointer(false),
@@ -614,6 +635,10 @@ func TestDfStorDBJsonCfg(t *testing.T) {
 			PgSSLMode:          utils.StringPointer(utils.PgSSLModeDisable),
 			MySQLLocation:      utils.StringPointer("Local"),
 			PgSchema:           utils.StringPointer(""),
+
+			InternalDBDumpPath:         utils.StringPointer(utils.EmptyString),
+			InternalDBFsyncInterval:    utils.StringPointer("1s"),
+			InternalDBSnapshotInterval: utils.StringPointer("1h"),
 		},
 		Items: &map[string]*ItemOptJson{
 			utils.CacheTBLTPTimings: {
@@ -623,6 +648,13 @@ func TestDfStorDBJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.CacheTBLTPHolidayCalendars: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 			utils.CacheTBLTPDestinations: {
 				Replicate:  utils.BoolPointer(false),
 				Remote:     utils.BoolPointer(false),
@@ -825,6 +857,7 @@ func TestDfRalsJsonCfg(t *testing.T) {
 			utils.MetaAny:   "*zero1ns",
 			utils.MetaVoice: "*zero1s",
 		},
+		Decimal_balances: utils.BoolPointer(false),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -845,6 +878,9 @@ func TestDfSchedulerJsonCfg(t *testing.T) {
 		Stats_conns:             &[]string{},
 		Filters:                 &[]string{},
 		Dynaprepaid_actionplans: &[]string{},
+		Leader_election:         utils.BoolPointer(false),
+		Lease_ttl:               utils.StringPointer("10s"),
+		Catchup_policy:          utils.StringPointer(utils.MetaSkip),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -1021,9 +1057,13 @@ func TestAsteriskAgentJsonCfg(t *testing.T) {
 
 func TestDiameterAgentJsonCfg(t *testing.T) {
 	eCfg := &DiameterAgentJsonCfg{
-		Enabled:              utils.BoolPointer(false),
-		Listen:               utils.StringPointer("127.0.0.1:3868"),
-		Listen_net:           utils.StringPointer(utils.TCP),
+		Enabled: utils.BoolPointer(false),
+		Listeners: &[]*DiamListenerJsnCfg{
+			{
+				Address: utils.StringPointer("127.0.0.1:3868"),
+				Network: utils.StringPointer(utils.TCP),
+			},
+		},
 		Dictionaries_path:    utils.StringPointer("/usr/share/cgrates/diameter/dict/"),
 		Sessions_conns:       &[]string{rpcclient.BiRPCInternal},
 		Origin_host:          utils.StringPointer("CGR-DA"),
@@ -1034,6 +1074,10 @@ func TestDiameterAgentJsonCfg(t *testing.T) {
 		Asr_template:         utils.StringPointer(""),
 		Rar_template:         utils.StringPointer(""),
 		Forced_disconnect:    utils.StringPointer(utils.MetaNone),
+		Peers:                &[]*DiameterPeerJsnCfg{},
+		Watchdog_interval:    utils.StringPointer("5s"),
+		Reconnect_interval:   utils.StringPointer("5s"),
+		Relay_timeout:        utils.StringPointer("3s"),
 		Request_processors:   &[]*ReqProcessorJsnCfg{},
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
@@ -1312,6 +1356,8 @@ func TestDfLoaderJsonCfg(t *testing.T) {
 			Field_separator: utils.StringPointer(","),
 			Tp_in_dir:       utils.StringPointer("/var/spool/cgrates/loader/in"),
 			Tp_out_dir:      utils.StringPointer("/var/spool/cgrates/loader/out"),
+			Source_type:     utils.StringPointer(utils.MetaLocal),
+			Opts:            &LoaderOptsJson{},
 			Data: &[]*LoaderJsonDataType{
 				{
 					Type:      utils.StringPointer(utils.MetaAttributes),
@@ -1921,6 +1967,10 @@ func TestDfDispatcherSJsonCfg(t *testing.T) {
 		Attributes_conns:      &[]string{},
 		Nested_fields:         utils.BoolPointer(false),
 		Any_subsystem:         utils.BoolPointer(true),
+		Health_check_interval: utils.StringPointer("0s"),
+		Health_check_method:   utils.StringPointer(utils.CoreSv1Ping),
+		Unhealthy_threshold:   utils.IntPointer(1),
+		Healthy_threshold:     utils.IntPointer(3),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -2149,6 +2199,16 @@ func TestDfEventExporterCfg(t *testing.T) {
 				Ttl:        utils.StringPointer("5s"),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.MetaFileParquet: {
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer("5s"),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaFileAvro: {
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer("5s"),
+				Static_ttl: utils.BoolPointer(false),
+			},
 		},
 		Exporters: &[]*EventExporterJsonCfg{
 			{
@@ -2166,6 +2226,9 @@ func TestDfEventExporterCfg(t *testing.T) {
 				Opts:                &EventExporterOptsJson{},
 				Concurrent_requests: utils.IntPointer(0),
 				Failed_posts_dir:    utils.StringPointer("/var/spool/cgrates/failed_posts"),
+				Retry_queue_dir:     utils.StringPointer(utils.MetaNone),
+				Retry_backoff:       utils.StringPointer("1s"),
+				Retry_max_backoff:   utils.StringPointer("5m"),
 			},
 		},
 	}
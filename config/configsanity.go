@@ -123,13 +123,22 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 		if !ldrSCfg.Enabled {
 			continue
 		}
-		if _, err := os.Stat(ldrSCfg.TpInDir); err != nil && os.IsNotExist(err) { // if loader is enabled tpInDir must exist
-			return fmt.Errorf("<%s> nonexistent folder: %s", utils.LoaderS, ldrSCfg.TpInDir)
-		}
-		if ldrSCfg.TpOutDir != utils.EmptyString { // tpOutDir support empty string for no moving files after process
-			if _, err := os.Stat(ldrSCfg.TpOutDir); err != nil && os.IsNotExist(err) {
-				return fmt.Errorf("<%s> nonexistent folder: %s", utils.LoaderS, ldrSCfg.TpOutDir)
+		switch ldrSCfg.SourceType {
+		case utils.EmptyString, utils.MetaLocal:
+			if _, err := os.Stat(ldrSCfg.TpInDir); err != nil && os.IsNotExist(err) { // if loader is enabled tpInDir must exist
+				return fmt.Errorf("<%s> nonexistent folder: %s", utils.LoaderS, ldrSCfg.TpInDir)
+			}
+			if ldrSCfg.TpOutDir != utils.EmptyString { // tpOutDir support empty string for no moving files after process
+				if _, err := os.Stat(ldrSCfg.TpOutDir); err != nil && os.IsNotExist(err) {
+					return fmt.Errorf("<%s> nonexistent folder: %s", utils.LoaderS, ldrSCfg.TpOutDir)
+				}
 			}
+		case utils.MetaS3, utils.MetaHTTP, utils.MetaSQL:
+			if ldrSCfg.RunDelay == -1 {
+				return fmt.Errorf("<%s> run_delay -1 is not supported for source_type %s", utils.LoaderS, ldrSCfg.SourceType)
+			}
+		default:
+			return fmt.Errorf("<%s> unsupported source_type %s", utils.LoaderS, ldrSCfg.SourceType)
 		}
 		if ldrSCfg.LockFilePath != utils.EmptyString { // tpOutDir support empty string for no moving files after process
 			pathL := ldrSCfg.GetLockFilePath()
@@ -162,6 +171,9 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				}
 			}
 		}
+		if ldrSCfg.FieldSeparator == utils.EmptyString {
+			return fmt.Errorf("<%s> empty %s for loader with ID: %s", utils.LoaderS, utils.FieldSepCfg, ldrSCfg.ID)
+		}
 	}
 	// SessionS checks
 	if cfg.sessionSCfg.Enabled {
@@ -311,6 +323,23 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.DiameterAgent, connID)
 			}
 		}
+		if len(cfg.diameterAgentCfg.Listeners) == 0 {
+			return fmt.Errorf("<%s> no listeners defined", utils.DiameterAgent)
+		}
+		for _, lstn := range cfg.diameterAgentCfg.Listeners {
+			switch lstn.Network {
+			case utils.TCP, utils.SCTP:
+			case utils.TLSNoCaps:
+				if cfg.tlsCfg.ServerCerificate == utils.EmptyString ||
+					cfg.tlsCfg.ServerKey == utils.EmptyString {
+					return fmt.Errorf("<%s> missing server certificate or key in tls section for listener <%s>",
+						utils.DiameterAgent, lstn.Address)
+				}
+			default:
+				return fmt.Errorf("<%s> unsupported network <%s> for listener <%s>",
+					utils.DiameterAgent, lstn.Network, lstn.Address)
+			}
+		}
 		for prf, tmp := range cfg.templates {
 			for _, field := range tmp {
 				if field.Type != utils.MetaNone && field.Path == utils.EmptyString {
@@ -369,6 +398,33 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 			if err := utils.CheckInLineFilter(req.Filters); err != nil {
 				return fmt.Errorf("<%s> %s for %s at %s", utils.DiameterAgent, err, req.Filters, utils.RequestProcessorsCfg)
 			}
+			if req.Flags.Has(utils.MetaRelay) && len(cfg.diameterAgentCfg.Peers) == 0 {
+				return fmt.Errorf("<%s> no %s defined for %s processor %s", utils.DiameterAgent, utils.PeersCfg, utils.MetaRelay, req.ID)
+			}
+		}
+		peerIDs := make(utils.StringSet)
+		for _, peer := range cfg.diameterAgentCfg.Peers {
+			if peer.ID == utils.EmptyString || peer.Address == utils.EmptyString {
+				return fmt.Errorf("<%s> peer without %s or %s", utils.DiameterAgent, utils.IDCfg, utils.AddressCfg)
+			}
+			if peerIDs.Has(peer.ID) {
+				return fmt.Errorf("<%s> duplicated peer with %s: <%s>", utils.DiameterAgent, utils.IDCfg, peer.ID)
+			}
+			peerIDs.Add(peer.ID)
+			if !slices.Contains([]string{utils.TCP, utils.SCTP}, peer.Network) {
+				return fmt.Errorf("<%s> unsupported %s <%s> for peer <%s>", utils.DiameterAgent, utils.NetworkCfg, peer.Network, peer.ID)
+			}
+		}
+		if len(cfg.diameterAgentCfg.Peers) != 0 {
+			if cfg.diameterAgentCfg.WatchdogInterval <= 0 {
+				return fmt.Errorf("<%s> %s should be greater than 0", utils.DiameterAgent, utils.WatchdogIntervalCfg)
+			}
+			if cfg.diameterAgentCfg.ReconnectInterval <= 0 {
+				return fmt.Errorf("<%s> %s should be greater than 0", utils.DiameterAgent, utils.ReconnectIntervalCfg)
+			}
+			if cfg.diameterAgentCfg.RelayTimeout <= 0 {
+				return fmt.Errorf("<%s> %s should be greater than 0", utils.DiameterAgent, utils.RelayTimeoutCfg)
+			}
 		}
 	}
 	//Radius Agent
@@ -391,6 +447,32 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.RadiusAgent, connID)
 			}
 		}
+		for _, lstn := range cfg.radiusAgentCfg.Listeners {
+			switch lstn.Network {
+			case utils.UDP, utils.TCP:
+			case utils.TLSNoCaps:
+				if cfg.tlsCfg.ServerCerificate == utils.EmptyString ||
+					cfg.tlsCfg.ServerKey == utils.EmptyString {
+					return fmt.Errorf("<%s> missing server certificate or key in tls section for listener <%s>",
+						utils.RadiusAgent, lstn.AuthAddr)
+				}
+				if cfg.tlsCfg.CaCertificate == utils.EmptyString {
+					return fmt.Errorf("<%s> missing ca certificate in tls section for listener <%s>",
+						utils.RadiusAgent, lstn.AuthAddr)
+				}
+			default:
+				return fmt.Errorf("<%s> unsupported network <%s> for listener <%s>",
+					utils.RadiusAgent, lstn.Network, lstn.AuthAddr)
+			}
+		}
+		for client, daOpts := range cfg.radiusAgentCfg.ClientDaAddresses {
+			if daOpts.Transport == utils.TLSNoCaps &&
+				(cfg.tlsCfg.ClientCerificate == utils.EmptyString ||
+					cfg.tlsCfg.ClientKey == utils.EmptyString) {
+				return fmt.Errorf("<%s> missing client certificate or key in tls section for client_da_addresses <%s>",
+					utils.RadiusAgent, client)
+			}
+		}
 		for _, req := range cfg.radiusAgentCfg.RequestProcessors {
 			for _, field := range req.RequestFields {
 				if field.Type != utils.MetaNone && field.Path == utils.EmptyString {
@@ -591,6 +673,46 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 		}
 	}
 
+	// PrometheusAgent checks
+	if cfg.prometheusAgentCfg.Enabled {
+		if cfg.httpCfg.PrometheusURL == utils.EmptyString {
+			return fmt.Errorf("<%s> requires %s to be defined in the %s section",
+				utils.PrometheusAgent, utils.PrometheusURLCfg, HTTP_JSN)
+		}
+		for _, connID := range cfg.prometheusAgentCfg.StatSConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.statsCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.StatS, utils.PrometheusAgent)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.PrometheusAgent, connID)
+			}
+		}
+		for _, connID := range cfg.prometheusAgentCfg.ResourceSConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.resourceSCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.ResourceS, utils.PrometheusAgent)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.PrometheusAgent, connID)
+			}
+		}
+		for _, connID := range cfg.prometheusAgentCfg.TrendSConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.trendsCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.TrendS, utils.PrometheusAgent)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.PrometheusAgent, connID)
+			}
+		}
+		for _, connID := range cfg.prometheusAgentCfg.EEsConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.eesCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.EEs, utils.PrometheusAgent)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.PrometheusAgent, connID)
+			}
+		}
+	}
+
 	if cfg.attributeSCfg.Enabled {
 		if cfg.attributeSCfg.Opts.ProcessRuns < 1 {
 			return fmt.Errorf("<%s> process_runs needs to be bigger than 0", utils.AttributeS)
@@ -703,7 +825,20 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 		if err := utils.CheckInLineFilter(cfg.schedulerCfg.Filters); err != nil {
 			return fmt.Errorf("<%s> got %s in %s", utils.SchedulerS, err, utils.Filters)
 		}
-
+		switch cfg.schedulerCfg.CatchUpPolicy {
+		case utils.MetaSkip:
+		case utils.MetaRunOnce, utils.MetaRunAll:
+			if !cfg.schedulerCfg.LeaderElection {
+				return fmt.Errorf("<%s> %s <%s> requires %s", utils.SchedulerS,
+					utils.CatchUpPolicyCfg, cfg.schedulerCfg.CatchUpPolicy, utils.LeaderElectionCfg)
+			}
+		default:
+			return fmt.Errorf("<%s> unsupported %s <%s>", utils.SchedulerS,
+				utils.CatchUpPolicyCfg, cfg.schedulerCfg.CatchUpPolicy)
+		}
+		if cfg.schedulerCfg.LeaderElection && cfg.schedulerCfg.LeaseTTL <= 0 {
+			return fmt.Errorf("<%s> %s should be greater than 0", utils.SchedulerS, utils.LeaseTTLCfg)
+		}
 	}
 	// EventReader sanity checks
 	if cfg.ersCfg.Enabled {
@@ -793,7 +928,18 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				if rdr.RunDelay > 0 {
 					return fmt.Errorf("<%s> the RunDelay field can not be bigger than zero for reader with ID: %s", utils.ERs, rdr.ID)
 				}
-			case utils.MetaFileXML, utils.MetaFileFWV, utils.MetaFileJSON:
+				if rdr.Opts.Kafka != nil && !isValidKafkaSASLMechanism(rdr.Opts.Kafka.SASLMechanism) {
+					return fmt.Errorf("<%s> unsupported %s: %s for reader with ID: %s",
+						utils.ERs, utils.KafkaSASLMechanism, *rdr.Opts.Kafka.SASLMechanism, rdr.ID)
+				}
+			case utils.MetaMQTTjsonMap:
+				if rdr.Opts.MQTT != nil && rdr.Opts.MQTT.QoS != nil &&
+					(*rdr.Opts.MQTT.QoS < 0 || *rdr.Opts.MQTT.QoS > 2) {
+					return fmt.Errorf("<%s> unsupported %s: %d for reader with ID: %s",
+						utils.ERs, utils.MQTTQoS, *rdr.Opts.MQTT.QoS, rdr.ID)
+				}
+			case utils.MetaFileXML, utils.MetaFileFWV, utils.MetaFileJSON,
+				utils.MetaFileParquet, utils.MetaFileAvro:
 				for _, dir := range []string{rdr.ProcessedPath, rdr.SourcePath} {
 					if _, err := os.Stat(dir); err != nil && os.IsNotExist(err) {
 						return fmt.Errorf("<%s> nonexistent folder: %s for reader with ID: %s", utils.ERs, dir, rdr.ID)
@@ -908,6 +1054,17 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 			}
 
 			switch exp.Type {
+			case utils.MetaKafkajsonMap:
+				if exp.Opts.Kafka != nil && !isValidKafkaSASLMechanism(exp.Opts.Kafka.SASLMechanism) {
+					return fmt.Errorf("<%s> unsupported %s: %s for exporter with ID: %s",
+						utils.EEs, utils.KafkaSASLMechanism, *exp.Opts.Kafka.SASLMechanism, exp.ID)
+				}
+			case utils.MetaMQTTjsonMap:
+				if exp.Opts.MQTT != nil && exp.Opts.MQTT.QoS != nil &&
+					(*exp.Opts.MQTT.QoS < 0 || *exp.Opts.MQTT.QoS > 2) {
+					return fmt.Errorf("<%s> unsupported %s: %d for exporter with ID: %s",
+						utils.EEs, utils.MQTTQoS, *exp.Opts.MQTT.QoS, exp.ID)
+				}
 			case utils.MetaFileCSV:
 				for _, dir := range []string{exp.ExportPath} {
 					if _, err := os.Stat(dir); err != nil && os.IsNotExist(err) {
@@ -923,6 +1080,25 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 						return fmt.Errorf("<%s> nonexistent folder: %s for exporter with ID: %s", utils.EEs, dir, exp.ID)
 					}
 				}
+			case utils.MetaFileParquet, utils.MetaFileAvro:
+				if _, err := os.Stat(exp.ExportPath); err != nil && os.IsNotExist(err) {
+					return fmt.Errorf("<%s> nonexistent folder: %s for exporter with ID: %s", utils.EEs, exp.ExportPath, exp.ID)
+				}
+				if len(exp.ContentFields()) == 0 {
+					return fmt.Errorf("<%s> empty content fields for exporter with ID: %s", utils.EEs, exp.ID)
+				}
+				for _, field := range exp.ContentFields() {
+					switch field.ValueType {
+					case utils.EmptyString, utils.MetaString, utils.MetaInt64, utils.MetaFloat64,
+						utils.MetaTimestamp, utils.MetaDuration:
+					default:
+						return fmt.Errorf("<%s> unsupported %s: %s for exporter with ID: %s at %s",
+							utils.EEs, utils.ValueTypeCfg, field.ValueType, exp.ID, field.Tag)
+					}
+				}
+				if exp.Opts.RowGroupSize != nil && *exp.Opts.RowGroupSize < 0 {
+					return fmt.Errorf("<%s> negative %s for exporter with ID: %s", utils.EEs, utils.RowGroupSizeOpt, exp.ID)
+				}
 			case utils.MetaSQL:
 				if len(exp.ContentFields()) == 0 {
 					return fmt.Errorf("<%s> empty content fields for exporter with ID: %s", utils.EEs, exp.ID)
@@ -950,6 +1126,18 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 					}
 				}
 			}
+			if exp.RetryQueueDir != utils.EmptyString && exp.RetryQueueDir != utils.MetaNone {
+				if _, err := os.Stat(exp.RetryQueueDir); err != nil && os.IsNotExist(err) {
+					return fmt.Errorf("<%s> nonexistent folder: %s for exporter with ID: %s", utils.EEs, exp.RetryQueueDir, exp.ID)
+				}
+				if exp.RetryBackoff <= 0 {
+					return fmt.Errorf("<%s> %s should be bigger than 0 for exporter with ID: %s", utils.EEs, utils.RetryBackoffCfg, exp.ID)
+				}
+				if exp.RetryMaxBackoff < exp.RetryBackoff {
+					return fmt.Errorf("<%s> %s should not be smaller than %s for exporter with ID: %s",
+						utils.EEs, utils.RetryMaxBackoffCfg, utils.RetryBackoffCfg, exp.ID)
+				}
+			}
 			for _, field := range exp.Fields {
 				if field.Type != utils.MetaNone && field.Path == utils.EmptyString {
 					return fmt.Errorf("<%s> %s for %s at %s", utils.EEs, utils.NewErrMandatoryIeMissing(utils.Path), exp.ID, field.Tag)
@@ -1012,6 +1200,12 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 		if cfg.thresholdSCfg.Enabled && cfg.thresholdSCfg.StoreInterval != -1 {
 			return fmt.Errorf("<%s> the StoreInterval field needs to be -1 when DataBD is *internal, received : %d", utils.ThresholdS, cfg.thresholdSCfg.StoreInterval)
 		}
+		if cfg.dataDbCfg.Opts.InternalDBDumpPath != utils.EmptyString &&
+			cfg.storDbCfg.Type == utils.MetaInternal &&
+			cfg.dataDbCfg.Opts.InternalDBDumpPath == cfg.storDbCfg.Opts.InternalDBDumpPath {
+			return fmt.Errorf("<%s> %s needs to be different than the one of the StorDB, received : %s",
+				utils.DataDB, utils.InternalDBDumpPathCfg, cfg.dataDbCfg.Opts.InternalDBDumpPath)
+		}
 		// if cfg.sessionSCfg.Enabled && cfg.sessionSCfg.BackupInterval != -1 {
 		// 	return fmt.Errorf("<%s> the BackupInterval field needs to be -1 when DataBD is *internal, received : %d", utils.SessionS, cfg.sessionSCfg.BackupInterval)
 		// }
@@ -1046,6 +1240,48 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 			}
 		}
 	}
+	for _, connID := range cfg.dataDbCfg.EEsConns {
+		if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.eesCfg.Enabled {
+			return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.EEs, utils.DataDB)
+		}
+		if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+			return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.DataDB, connID)
+		}
+	}
+	// CoreS sanity checks
+	rateLimitIDs := make(utils.StringSet)
+	for _, rl := range cfg.coreSCfg.RateLimits {
+		if rl.ID == utils.EmptyString {
+			return fmt.Errorf("<%s> rate limit without ID", utils.CoreS)
+		}
+		if rateLimitIDs.Has(rl.ID) {
+			return fmt.Errorf("<%s> duplicated rate limit ID <%s>", utils.CoreS, rl.ID)
+		}
+		rateLimitIDs.Add(rl.ID)
+		if rl.Rate <= 0 {
+			return fmt.Errorf("<%s> rate of the <%s> rate limit should be bigger than 0", utils.CoreS, rl.ID)
+		}
+		if rl.Burst < 1 {
+			return fmt.Errorf("<%s> burst of the <%s> rate limit should be at least 1", utils.CoreS, rl.ID)
+		}
+	}
+	// General sanity checks
+	if cfg.generalCfg.Logger == utils.MetaEEs && len(cfg.generalCfg.LoggerEEsConns) == 0 {
+		return fmt.Errorf("<%s> %s required by the %s logger", GENERAL_JSN, utils.LoggerEEsConnsCfg, utils.MetaEEs)
+	}
+	for subsystem, lvl := range cfg.generalCfg.LogLevels {
+		if lvl < utils.LOGLEVEL_EMERGENCY || lvl > utils.LOGLEVEL_DEBUG {
+			return fmt.Errorf("<%s> wrong log level %d for subsystem <%s>", GENERAL_JSN, lvl, subsystem)
+		}
+	}
+	for _, connID := range cfg.generalCfg.LoggerEEsConns {
+		if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.eesCfg.Enabled {
+			return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.EEs, GENERAL_JSN)
+		}
+		if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+			return fmt.Errorf("<%s> connection with id: <%s> not defined", GENERAL_JSN, connID)
+		}
+	}
 	// APIer sanity checks
 	for _, connID := range cfg.apier.AttributeSConns {
 		if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.attributeSCfg.Enabled {
@@ -1073,6 +1309,17 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.DispatcherS, connID)
 			}
 		}
+		if cfg.dispatcherSCfg.HealthCheckInterval > 0 {
+			if cfg.dispatcherSCfg.HealthCheckMethod != utils.CoreSv1Ping &&
+				cfg.dispatcherSCfg.HealthCheckMethod != utils.CoreSv1Status {
+				return fmt.Errorf("<%s> unsupported %s: <%s>", utils.DispatcherS,
+					utils.HealthCheckMethodCfg, cfg.dispatcherSCfg.HealthCheckMethod)
+			}
+			if cfg.dispatcherSCfg.UnhealthyThreshold < 1 || cfg.dispatcherSCfg.HealthyThreshold < 1 {
+				return fmt.Errorf("<%s> %s and %s should be at least 1", utils.DispatcherS,
+					utils.UnhealthyThresholdCfg, utils.HealthyThresholdCfg)
+			}
+		}
 	}
 	// Cache check
 	for _, connID := range cfg.cacheCfg.ReplicationConns {
@@ -1203,3 +1450,16 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 
 	return nil
 }
+
+// isValidKafkaSASLMechanism checks the kafkaSASLMechanism option, nil or empty disabling the SASL authentication
+func isValidKafkaSASLMechanism(mechanism *string) bool {
+	if mechanism == nil {
+		return true
+	}
+	switch *mechanism {
+	case utils.EmptyString, utils.KafkaSASLPlain,
+		utils.KafkaSASLScramSHA256, utils.KafkaSASLScramSHA512:
+		return true
+	}
+	return false
+}
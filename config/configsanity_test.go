@@ -19,6 +19,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
@@ -169,6 +170,32 @@ func TestConfigSanityLoaders(t *testing.T) {
 		t.Errorf("Expecting: %+q received: %+q", expected, err)
 	}
 
+	cfg.loaderCfg = LoaderSCfgs{
+		&LoaderSCfg{
+			Enabled:    true,
+			SourceType: "*ftp",
+			TpInDir:    "ftp://127.0.0.1/tp",
+		},
+	}
+	expected = "<LoaderS> unsupported source_type *ftp"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q received: %+q", expected, err)
+	}
+
+	cfg.loaderCfg = LoaderSCfgs{
+		&LoaderSCfg{
+			Enabled:    true,
+			SourceType: utils.MetaS3,
+			TpInDir:    "http://s3.us-east-2.amazonaws.com",
+			TpOutDir:   "processed",
+			RunDelay:   -1,
+		},
+	}
+	expected = "<LoaderS> run_delay -1 is not supported for source_type *s3"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q received: %+q", expected, err)
+	}
+
 	cfg.loaderCfg = LoaderSCfgs{
 		&LoaderSCfg{
 			Enabled:  true,
@@ -246,6 +273,12 @@ func TestConfigSanityLoaders(t *testing.T) {
 	}
 	cfg.loaderCfg[0].Data[0].Fields[0].Filters = []string{"~req.Valid.Field"}
 
+	cfg.loaderCfg[0].ID = "LDR_SQL"
+	expected = "<LoaderS> empty field_separator for loader with ID: LDR_SQL"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.loaderCfg[0].FieldSeparator = utils.FieldsSep
 }
 
 func TestConfigSanitySessionS(t *testing.T) {
@@ -497,6 +530,22 @@ func TestConfigSanityDAgent(t *testing.T) {
 	}
 
 	cfg.rpcConns["test"] = nil
+	expected = "<DiameterAgent> no listeners defined"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.diameterAgentCfg.Listeners = []DiameterListener{{Address: "127.0.0.1:3868", Network: utils.UDP}}
+	expected = "<DiameterAgent> unsupported network <udp> for listener <127.0.0.1:3868>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.diameterAgentCfg.Listeners[0].Network = utils.TLSNoCaps
+	expected = "<DiameterAgent> missing server certificate or key in tls section for listener <127.0.0.1:3868>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.diameterAgentCfg.Listeners[0].Network = utils.SCTP
+
 	expected = "<DiameterAgent> MANDATORY_IE_MISSING: [Path] for template *ees at SessionId"
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
@@ -586,6 +635,57 @@ func TestConfigSanityDAgent(t *testing.T) {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
 	}
 	cfg.diameterAgentCfg.RequestProcessors[0].Filters = []string{"*string:~*req.Valid.Field"}
+
+	cfg.diameterAgentCfg.RequestProcessors[0].Filters = nil
+	cfg.diameterAgentCfg.RequestProcessors[0].Flags = utils.FlagsWithParamsFromSlice([]string{utils.MetaRelay})
+	expected = "<DiameterAgent> no peers defined for *relay processor cgrates"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.diameterAgentCfg.Peers = []*DiameterPeer{{ID: "pcrf1"}}
+	expected = "<DiameterAgent> peer without id or address"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.diameterAgentCfg.Peers[0].Address = "127.0.0.1:3869"
+	expected = "<DiameterAgent> unsupported network <> for peer <pcrf1>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.diameterAgentCfg.Peers[0].Network = utils.SCTP
+	cfg.diameterAgentCfg.Peers = append(cfg.diameterAgentCfg.Peers, cfg.diameterAgentCfg.Peers[0])
+	expected = "<DiameterAgent> duplicated peer with id: <pcrf1>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.diameterAgentCfg.Peers = cfg.diameterAgentCfg.Peers[:1]
+	expected = "<DiameterAgent> watchdog_interval should be greater than 0"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.diameterAgentCfg.WatchdogInterval = 5 * time.Second
+	cfg.diameterAgentCfg.ReconnectInterval = 5 * time.Second
+	cfg.diameterAgentCfg.RelayTimeout = 3 * time.Second
+	if err := cfg.checkConfigSanity(); err != nil {
+		t.Error(err)
+	}
+	cfg.diameterAgentCfg.WatchdogInterval = 0
+	expected = "<DiameterAgent> watchdog_interval should be greater than 0"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.diameterAgentCfg.WatchdogInterval = 5 * time.Second
+	cfg.diameterAgentCfg.ReconnectInterval = -time.Second
+	expected = "<DiameterAgent> reconnect_interval should be greater than 0"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.diameterAgentCfg.ReconnectInterval = 5 * time.Second
+	cfg.diameterAgentCfg.RelayTimeout = 0
+	expected = "<DiameterAgent> relay_timeout should be greater than 0"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
 }
 
 func TestConfigSanityRadiusAgent(t *testing.T) {
@@ -634,6 +734,32 @@ func TestConfigSanityRadiusAgent(t *testing.T) {
 	}
 
 	cfg.rpcConns["test"] = nil
+	cfg.radiusAgentCfg.Listeners = []RadiusListener{{AuthAddr: "127.0.0.1:2083", AcctAddr: "127.0.0.1:2083", Network: utils.SCTP}}
+	expected = "<RadiusAgent> unsupported network <sctp> for listener <127.0.0.1:2083>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.radiusAgentCfg.Listeners[0].Network = utils.TLSNoCaps
+	expected = "<RadiusAgent> missing server certificate or key in tls section for listener <127.0.0.1:2083>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.tlsCfg.ServerCerificate = "/usr/share/cgrates/tls/server.crt"
+	cfg.tlsCfg.ServerKey = "/usr/share/cgrates/tls/server.key"
+	expected = "<RadiusAgent> missing ca certificate in tls section for listener <127.0.0.1:2083>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.tlsCfg.CaCertificate = "/usr/share/cgrates/tls/ca.crt"
+	cfg.radiusAgentCfg.ClientDaAddresses = map[string]DAClientOpts{
+		"127.0.0.1": {Transport: utils.TLSNoCaps, Host: "127.0.0.1", Port: 2083},
+	}
+	expected = "<RadiusAgent> missing client certificate or key in tls section for client_da_addresses <127.0.0.1>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.radiusAgentCfg.ClientDaAddresses = nil
+
 	expected = "<RadiusAgent> MANDATORY_IE_MISSING: [Path] for cgrates at SessionId"
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
@@ -1222,6 +1348,24 @@ func TestConfigSanityScheduler(t *testing.T) {
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
 	}
+	cfg.schedulerCfg.Filters = []string{}
+
+	cfg.schedulerCfg.CatchUpPolicy = "*run_twice"
+	expected = "<SchedulerS> unsupported catchup_policy <*run_twice>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.schedulerCfg.CatchUpPolicy = utils.MetaRunAll
+	expected = "<SchedulerS> catchup_policy <*run_all> requires leader_election"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.schedulerCfg.LeaderElection = true
+	cfg.schedulerCfg.LeaseTTL = 0
+	expected = "<SchedulerS> lease_ttl should be greater than 0"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
 }
 
 func TestConfigSanityEventReader(t *testing.T) {
@@ -1323,6 +1467,16 @@ func TestConfigSanityEventReader(t *testing.T) {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
 	}
 
+	cfg.ersCfg.Readers[0].Type = utils.MetaFileParquet
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+
+	cfg.ersCfg.Readers[0].Type = utils.MetaFileAvro
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+
 	cfg.ersCfg = &ERsCfg{
 		Enabled: true,
 		Readers: []*EventReaderCfg{
@@ -1534,6 +1688,89 @@ func TestConfigSanityEventExporter(t *testing.T) {
 	}
 }
 
+func TestConfigSanityEventExporterRetryQueue(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.eesCfg = &EEsCfg{
+		Enabled: true,
+		Exporters: []*EventExporterCfg{
+			{
+				ID:            "EXP_KAFKA",
+				Type:          utils.MetaKafkajsonMap,
+				Opts:          &EventExporterOpts{},
+				RetryQueueDir: "randomPath",
+			},
+		},
+	}
+	expected := "<EEs> nonexistent folder: randomPath for exporter with ID: EXP_KAFKA"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.eesCfg.Exporters[0].RetryQueueDir = "/"
+	expected = "<EEs> retry_backoff should be bigger than 0 for exporter with ID: EXP_KAFKA"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.eesCfg.Exporters[0].RetryBackoff = time.Minute
+	cfg.eesCfg.Exporters[0].RetryMaxBackoff = time.Second
+	expected = "<EEs> retry_max_backoff should not be smaller than retry_backoff for exporter with ID: EXP_KAFKA"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.eesCfg.Exporters[0].RetryMaxBackoff = time.Hour
+	if err := cfg.CheckConfigSanity(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConfigSanityEventExporterTypedFile(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.eesCfg = &EEsCfg{
+		Enabled: true,
+		Exporters: []*EventExporterCfg{
+			{
+				ID:         "CDR_LAKE",
+				Type:       utils.MetaFileParquet,
+				ExportPath: "randomPath",
+				Opts:       &EventExporterOpts{},
+			},
+		},
+	}
+	expected := "<EEs> nonexistent folder: randomPath for exporter with ID: CDR_LAKE"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+
+	cfg.eesCfg.Exporters[0].Type = utils.MetaFileAvro
+	cfg.eesCfg.Exporters[0].ExportPath = "/"
+	expected = "<EEs> empty content fields for exporter with ID: CDR_LAKE"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+
+	cfg.eesCfg.Exporters[0].Fields = []*FCTemplate{
+		{Tag: "Cost", Path: "*exp.Cost", Type: utils.MetaVariable, ValueType: "*bool",
+			Value: NewRSRParsersMustCompile("~*req.Cost", utils.InfieldSep)},
+	}
+	cfg.eesCfg.Exporters[0].Fields[0].ComputePath()
+	cfg.eesCfg.Exporters[0].ComputeFields()
+	expected = "<EEs> unsupported value_type: *bool for exporter with ID: CDR_LAKE at Cost"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+
+	cfg.eesCfg.Exporters[0].Fields[0].ValueType = utils.MetaFloat64
+	cfg.eesCfg.Exporters[0].Opts.RowGroupSize = utils.IntPointer(-1)
+	expected = "<EEs> negative rowGroupSize for exporter with ID: CDR_LAKE"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+
+	cfg.eesCfg.Exporters[0].Opts.RowGroupSize = utils.IntPointer(500)
+	if err := cfg.CheckConfigSanity(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestConfigSanityCache(t *testing.T) {
 	cfg := NewDefaultCGRConfig()
 
@@ -1783,6 +2020,15 @@ func TestConfigSanityDataDB(t *testing.T) {
 	}
 	cfg.thresholdSCfg.Enabled = false
 
+	cfg.dataDbCfg.Opts.InternalDBDumpPath = "/var/lib/cgrates/internal_db"
+	cfg.storDbCfg.Type = utils.MetaInternal
+	cfg.storDbCfg.Opts.InternalDBDumpPath = "/var/lib/cgrates/internal_db"
+	expected = "<data_db> internalDBDumpPath needs to be different than the one of the StorDB, received : /var/lib/cgrates/internal_db"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.storDbCfg.Type = utils.MetaMySQL
+
 	cfg.dataDbCfg.Items = map[string]*ItemOpt{
 		"test1": {
 			Remote: true,
@@ -1838,6 +2084,44 @@ func TestConfigSanityDataDB(t *testing.T) {
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
 	}
+	cfg.dataDbCfg.RmtConns = []string{}
+	//EEsConns
+	cfg.dataDbCfg.EEsConns = []string{utils.MetaInternal}
+	expected = "<EEs> not enabled but requested by <data_db> component"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dataDbCfg.EEsConns = []string{"test3"}
+	expected = "<data_db> connection with id: <test3> not defined"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+}
+
+func TestConfigSanityGeneral(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.generalCfg.Logger = utils.MetaEEs
+	expected := "<general> logger_ees_conns required by the *ees logger"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.generalCfg.LoggerEEsConns = []string{utils.MetaInternal}
+	expected = "<EEs> not enabled but requested by <general> component"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.generalCfg.LoggerEEsConns = []string{"test"}
+	expected = "<general> connection with id: <test> not defined"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.generalCfg.LoggerEEsConns = nil
+	cfg.generalCfg.Logger = utils.MetaStdLog
+	cfg.generalCfg.LogLevels = map[string]int{utils.SessionS: 8}
+	expected = "<general> wrong log level 8 for subsystem <SessionS>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
 }
 
 func TestConfigSanityAPIer(t *testing.T) {
@@ -1880,6 +2164,18 @@ func TestConfigSanityDispatcher(t *testing.T) {
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
 	}
+	cfg.dispatcherSCfg.AttributeSConns = []string{}
+	cfg.dispatcherSCfg.HealthCheckInterval = time.Second
+	cfg.dispatcherSCfg.HealthCheckMethod = utils.CoreSv1Sleep
+	expected = "<DispatcherS> unsupported health_check_method: <CoreSv1.Sleep>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dispatcherSCfg.HealthCheckMethod = utils.CoreSv1Status
+	expected = "<DispatcherS> unhealthy_threshold and healthy_threshold should be at least 1"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
 }
 
 func TestConfigSanityCacheS(t *testing.T) {
@@ -1896,6 +2192,31 @@ func TestConfigSanityCacheS(t *testing.T) {
 	}
 }
 
+func TestConfigSanityCoreSRateLimits(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+
+	cfg.coreSCfg.RateLimits = []*RateLimitCfg{{Rate: 1, Burst: 1}}
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != "<CoreS> rate limit without ID" {
+		t.Error(err)
+	}
+	cfg.coreSCfg.RateLimits = []*RateLimitCfg{{ID: "RL1", Rate: 1, Burst: 1}, {ID: "RL1", Rate: 1, Burst: 1}}
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != "<CoreS> duplicated rate limit ID <RL1>" {
+		t.Error(err)
+	}
+	cfg.coreSCfg.RateLimits = []*RateLimitCfg{{ID: "RL1", Burst: 1}}
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != "<CoreS> rate of the <RL1> rate limit should be bigger than 0" {
+		t.Error(err)
+	}
+	cfg.coreSCfg.RateLimits = []*RateLimitCfg{{ID: "RL1", Rate: 1}}
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != "<CoreS> burst of the <RL1> rate limit should be at least 1" {
+		t.Error(err)
+	}
+	cfg.coreSCfg.RateLimits = []*RateLimitCfg{{ID: "RL1", Rate: 1, Burst: 1}}
+	if err := cfg.checkConfigSanity(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestConfigSanityFilterS(t *testing.T) {
 	cfg := NewDefaultCGRConfig()
 	cfg.filterSCfg.StatSConns = []string{utils.MetaInternal}
@@ -2116,3 +2437,55 @@ func TestConfigSanityERsXmlRootPath(t *testing.T) {
 		t.Errorf("expected: %s, received: %s", experr, err)
 	}
 }
+
+func TestConfigSanityEventExporterKafkaSASL(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.eesCfg = &EEsCfg{
+		Enabled: true,
+		Exporters: []*EventExporterCfg{
+			{
+				ID:   "EXP_KAFKA",
+				Type: utils.MetaKafkajsonMap,
+				Opts: &EventExporterOpts{
+					Kafka: &KafkaOpts{
+						SASLMechanism: utils.StringPointer("gssapi"),
+					},
+				},
+			},
+		},
+	}
+	expected := "<EEs> unsupported kafkaSASLMechanism: gssapi for exporter with ID: EXP_KAFKA"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.eesCfg.Exporters[0].Opts.Kafka.SASLMechanism = utils.StringPointer(utils.KafkaSASLScramSHA512)
+	if err := cfg.CheckConfigSanity(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConfigSanityEventExporterMQTTQoS(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.eesCfg = &EEsCfg{
+		Enabled: true,
+		Exporters: []*EventExporterCfg{
+			{
+				ID:   "EXP_MQTT",
+				Type: utils.MetaMQTTjsonMap,
+				Opts: &EventExporterOpts{
+					MQTT: &MQTTOpts{
+						QoS: utils.IntPointer(3),
+					},
+				},
+			},
+		},
+	}
+	expected := "<EEs> unsupported mqttQoS: 3 for exporter with ID: EXP_MQTT"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.eesCfg.Exporters[0].Opts.MQTT.QoS = utils.IntPointer(2)
+	if err := cfg.CheckConfigSanity(); err != nil {
+		t.Error(err)
+	}
+}
@@ -19,6 +19,7 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package config
 
 import (
+	"slices"
 	"time"
 
 	"github.com/cgrates/cgrates/utils"
@@ -30,6 +31,7 @@ type CoreSCfg struct {
 	CapsStrategy      string
 	CapsStatsInterval time.Duration
 	ShutdownTimeout   time.Duration
+	RateLimits        []*RateLimitCfg
 }
 
 func (cS *CoreSCfg) loadFromJSONCfg(jsnCfg *CoreSJsonCfg) (err error) {
@@ -52,9 +54,32 @@ func (cS *CoreSCfg) loadFromJSONCfg(jsnCfg *CoreSJsonCfg) (err error) {
 			return
 		}
 	}
+	if jsnCfg.Rate_limits != nil {
+		cS.appendRateLimits(*jsnCfg.Rate_limits)
+	}
 	return
 }
 
+// appendRateLimits updates the limits with the same ID and appends the new ones
+func (cS *CoreSCfg) appendRateLimits(jsnLimits []*RateLimitJsonCfg) {
+	for _, jsnLimit := range jsnLimits {
+		var rl *RateLimitCfg
+		if jsnLimit.Id != nil {
+			for _, limit := range cS.RateLimits {
+				if limit.ID == *jsnLimit.Id {
+					rl = limit
+					break
+				}
+			}
+		}
+		if rl == nil {
+			rl = new(RateLimitCfg)
+			cS.RateLimits = append(cS.RateLimits, rl)
+		}
+		rl.loadFromJSONCfg(jsnLimit)
+	}
+}
+
 // AsMapInterface returns the config as a map[string]any
 func (cS *CoreSCfg) AsMapInterface() map[string]any {
 	mp := map[string]any{
@@ -69,15 +94,97 @@ func (cS *CoreSCfg) AsMapInterface() map[string]any {
 	if cS.ShutdownTimeout == 0 {
 		mp[utils.ShutdownTimeoutCfg] = "0"
 	}
+	rateLimits := make([]map[string]any, len(cS.RateLimits))
+	for i, rl := range cS.RateLimits {
+		rateLimits[i] = rl.AsMapInterface()
+	}
+	mp[utils.RateLimitsCfg] = rateLimits
 	return mp
 }
 
 // Clone returns a deep copy of CoreSCfg
-func (cS CoreSCfg) Clone() *CoreSCfg {
-	return &CoreSCfg{
+func (cS CoreSCfg) Clone() (cln *CoreSCfg) {
+	cln = &CoreSCfg{
 		Caps:              cS.Caps,
 		CapsStrategy:      cS.CapsStrategy,
 		CapsStatsInterval: cS.CapsStatsInterval,
 		ShutdownTimeout:   cS.ShutdownTimeout,
 	}
+	if cS.RateLimits != nil {
+		cln.RateLimits = make([]*RateLimitCfg, len(cS.RateLimits))
+		for i, rl := range cS.RateLimits {
+			cln.RateLimits[i] = rl.Clone()
+		}
+	}
+	return
+}
+
+// RateLimitCfg is the token bucket limit applied to the API requests
+type RateLimitCfg struct {
+	ID            string
+	Tenants       []string // the tenants the limit applies to, empty for all
+	APIs          []string // the API methods the limit applies to, empty for all
+	PerTenant     bool     // separate bucket for each tenant
+	PerAPI        bool     // separate bucket for each API method
+	PerConnection bool     // separate bucket for each client connection
+	Rate          float64  // requests allowed per second
+	Burst         int      // maximum requests allowed at once
+}
+
+func (rl *RateLimitCfg) loadFromJSONCfg(jsnCfg *RateLimitJsonCfg) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Id != nil {
+		rl.ID = *jsnCfg.Id
+	}
+	if jsnCfg.Tenants != nil {
+		rl.Tenants = slices.Clone(*jsnCfg.Tenants)
+	}
+	if jsnCfg.Apis != nil {
+		rl.APIs = slices.Clone(*jsnCfg.Apis)
+	}
+	if jsnCfg.Per_tenant != nil {
+		rl.PerTenant = *jsnCfg.Per_tenant
+	}
+	if jsnCfg.Per_api != nil {
+		rl.PerAPI = *jsnCfg.Per_api
+	}
+	if jsnCfg.Per_connection != nil {
+		rl.PerConnection = *jsnCfg.Per_connection
+	}
+	if jsnCfg.Rate != nil {
+		rl.Rate = *jsnCfg.Rate
+	}
+	if jsnCfg.Burst != nil {
+		rl.Burst = *jsnCfg.Burst
+	}
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (rl *RateLimitCfg) AsMapInterface() map[string]any {
+	return map[string]any{
+		utils.IDCfg:            rl.ID,
+		utils.TenantsCfg:       append(make([]string, 0, len(rl.Tenants)), rl.Tenants...),
+		utils.APIsCfg:          append(make([]string, 0, len(rl.APIs)), rl.APIs...),
+		utils.PerTenantCfg:     rl.PerTenant,
+		utils.PerAPICfg:        rl.PerAPI,
+		utils.PerConnectionCfg: rl.PerConnection,
+		utils.RateCfg:          rl.Rate,
+		utils.BurstCfg:         rl.Burst,
+	}
+}
+
+// Clone returns a deep copy of RateLimitCfg
+func (rl *RateLimitCfg) Clone() *RateLimitCfg {
+	return &RateLimitCfg{
+		ID:            rl.ID,
+		Tenants:       slices.Clone(rl.Tenants),
+		APIs:          slices.Clone(rl.APIs),
+		PerTenant:     rl.PerTenant,
+		PerAPI:        rl.PerAPI,
+		PerConnection: rl.PerConnection,
+		Rate:          rl.Rate,
+		Burst:         rl.Burst,
+	}
 }
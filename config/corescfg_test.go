@@ -42,13 +42,31 @@ func TestCoreSloadFromJsonCfg(t *testing.T) {
 		"cores": {
 			"caps": 10,							// maximum concurrent request allowed ( 0 to disabled )
 			"caps_strategy": "*busy",			// strategy in case in case of concurrent requests reached	
-			"caps_stats_interval": "0"			// the interval we sample for caps stats ( 0 to disabled )
+			"caps_stats_interval": "0",			// the interval we sample for caps stats ( 0 to disabled )
+			"rate_limits": [
+				{
+					"id": "RL_AUTH",
+					"tenants": ["cgrates.org"],
+					"apis": ["SessionSv1.AuthorizeEvent"],
+					"per_connection": true,
+					"rate": 10,
+					"burst": 20
+				}
+			]
 		},
 }`
 	expected = CoreSCfg{
 		Caps:              10,
 		CapsStrategy:      utils.MetaBusy,
 		CapsStatsInterval: 0,
+		RateLimits: []*RateLimitCfg{{
+			ID:            "RL_AUTH",
+			Tenants:       []string{"cgrates.org"},
+			APIs:          []string{utils.SessionSv1AuthorizeEvent},
+			PerConnection: true,
+			Rate:          10,
+			Burst:         20,
+		}},
 	}
 	if jsnCfg, err := NewCgrJsonCfgFromBytes([]byte(cfgJSONStr)); err != nil {
 		t.Error(err)
@@ -90,6 +108,7 @@ func TestCoreSAsMapInterface(t *testing.T) {
 		utils.CapsStrategyCfg:      utils.MetaBusy,
 		utils.CapsStatsIntervalCfg: "0",
 		utils.ShutdownTimeoutCfg:   "0",
+		utils.RateLimitsCfg:        []map[string]any{},
 	}
 	if jsnCfg, err := NewCgrJsonCfgFromBytes([]byte(cfgJSONStr)); err != nil {
 		t.Error(err)
@@ -119,6 +138,12 @@ func TestCoreSCfgClone(t *testing.T) {
 		CapsStatsInterval: time.Second,
 		ShutdownTimeout:   time.Second,
 		CapsStrategy:      utils.MetaBusy,
+		RateLimits: []*RateLimitCfg{{
+			ID:      "RL_TENANT",
+			Tenants: []string{"cgrates.org"},
+			Rate:    1,
+			Burst:   1,
+		}},
 	}
 	rcv := cS.Clone()
 	if !reflect.DeepEqual(cS, rcv) {
@@ -127,4 +152,7 @@ func TestCoreSCfgClone(t *testing.T) {
 	if rcv.Caps = 1; cS.Caps != 0 {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
+	if rcv.RateLimits[0].Tenants[0] = "itsyscom.com"; cS.RateLimits[0].Tenants[0] != "cgrates.org" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
 }
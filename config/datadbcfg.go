@@ -45,6 +45,10 @@ type DataDBOpts struct {
 	RedisCACertificate      string
 	MongoQueryTimeout       time.Duration
 	MongoConnScheme         string
+
+	InternalDBDumpPath         string        // the directory where the *internal DataDB is persisted, empty to disable
+	InternalDBFsyncInterval    time.Duration // 0 to sync the log after each change
+	InternalDBSnapshotInterval time.Duration // 0 to take the snapshots only on demand
 }
 
 // DataDbCfg Database config
@@ -60,6 +64,8 @@ type DataDbCfg struct {
 	RplConns    []string // Replication connIDs
 	RplFiltered bool
 	RplCache    string
+	EEsConns    []string // the conns where the item changes are exported
+	EEsExpIDs   []string
 	Items       map[string]*ItemOpt
 	Opts        *DataDBOpts
 }
@@ -133,6 +139,19 @@ func (dbOpts *DataDBOpts) loadFromJSONCfg(jsnCfg *DBOptsJson) (err error) {
 	if jsnCfg.MongoConnScheme != nil {
 		dbOpts.MongoConnScheme = *jsnCfg.MongoConnScheme
 	}
+	if jsnCfg.InternalDBDumpPath != nil {
+		dbOpts.InternalDBDumpPath = *jsnCfg.InternalDBDumpPath
+	}
+	if jsnCfg.InternalDBFsyncInterval != nil {
+		if dbOpts.InternalDBFsyncInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.InternalDBFsyncInterval); err != nil {
+			return
+		}
+	}
+	if jsnCfg.InternalDBSnapshotInterval != nil {
+		if dbOpts.InternalDBSnapshotInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.InternalDBSnapshotInterval); err != nil {
+			return
+		}
+	}
 	return
 }
 
@@ -187,6 +206,18 @@ func (dbcfg *DataDbCfg) loadFromJSONCfg(jsnDbCfg *DbJsonCfg) (err error) {
 			dbcfg.RplConns[idx] = rplConn
 		}
 	}
+	if jsnDbCfg.Ees_conns != nil {
+		dbcfg.EEsConns = make([]string, len(*jsnDbCfg.Ees_conns))
+		for idx, connID := range *jsnDbCfg.Ees_conns {
+			dbcfg.EEsConns[idx] = connID
+			if connID == utils.MetaInternal {
+				dbcfg.EEsConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)
+			}
+		}
+	}
+	if jsnDbCfg.Ees_exporter_ids != nil {
+		dbcfg.EEsExpIDs = append(dbcfg.EEsExpIDs, *jsnDbCfg.Ees_exporter_ids...)
+	}
 	if jsnDbCfg.Items != nil {
 		for kJsn, vJsn := range *jsnDbCfg.Items {
 			val, has := dbcfg.Items[kJsn]
@@ -233,6 +264,10 @@ func (dbOpts *DataDBOpts) Clone() *DataDBOpts {
 		RedisCACertificate:      dbOpts.RedisCACertificate,
 		MongoQueryTimeout:       dbOpts.MongoQueryTimeout,
 		MongoConnScheme:         dbOpts.MongoConnScheme,
+
+		InternalDBDumpPath:         dbOpts.InternalDBDumpPath,
+		InternalDBFsyncInterval:    dbOpts.InternalDBFsyncInterval,
+		InternalDBSnapshotInterval: dbOpts.InternalDBSnapshotInterval,
 	}
 }
 
@@ -262,6 +297,14 @@ func (dbcfg *DataDbCfg) Clone() (cln *DataDbCfg) {
 		cln.RplConns = make([]string, len(dbcfg.RplConns))
 		copy(cln.RplConns, dbcfg.RplConns)
 	}
+	if dbcfg.EEsConns != nil {
+		cln.EEsConns = make([]string, len(dbcfg.EEsConns))
+		copy(cln.EEsConns, dbcfg.EEsConns)
+	}
+	if dbcfg.EEsExpIDs != nil {
+		cln.EEsExpIDs = make([]string, len(dbcfg.EEsExpIDs))
+		copy(cln.EEsExpIDs, dbcfg.EEsExpIDs)
+	}
 	return
 }
 
@@ -285,6 +328,10 @@ func (dbcfg *DataDbCfg) AsMapInterface() (mp map[string]any) {
 		utils.RedisCACertificate:         dbcfg.Opts.RedisCACertificate,
 		utils.MongoQueryTimeoutCfg:       dbcfg.Opts.MongoQueryTimeout.String(),
 		utils.MongoConnSchemeCfg:         dbcfg.Opts.MongoConnScheme,
+
+		utils.InternalDBDumpPathCfg:         dbcfg.Opts.InternalDBDumpPath,
+		utils.InternalDBFsyncIntervalCfg:    dbcfg.Opts.InternalDBFsyncInterval.String(),
+		utils.InternalDBSnapshotIntervalCfg: dbcfg.Opts.InternalDBSnapshotInterval.String(),
 	}
 	mp = map[string]any{
 		utils.DataDbTypeCfg:          dbcfg.Type,
@@ -299,6 +346,19 @@ func (dbcfg *DataDbCfg) AsMapInterface() (mp map[string]any) {
 		utils.ReplicationCache:       dbcfg.RplCache,
 		utils.OptsCfg:                opts,
 	}
+	if dbcfg.EEsConns != nil {
+		eesConns := make([]string, len(dbcfg.EEsConns))
+		for i, item := range dbcfg.EEsConns {
+			eesConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs) {
+				eesConns[i] = utils.MetaInternal
+			}
+		}
+		mp[utils.EEsConnsCfg] = eesConns
+	}
+	eesExporterIDs := make([]string, len(dbcfg.EEsExpIDs))
+	copy(eesExporterIDs, dbcfg.EEsExpIDs)
+	mp[utils.EEsExporterIDsCfg] = eesExporterIDs
 	if dbcfg.Items != nil {
 		items := make(map[string]any)
 		for key, item := range dbcfg.Items {
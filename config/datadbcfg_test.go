@@ -612,6 +612,36 @@ func TestCloneDataDB(t *testing.T) {
 				utils.ToJSON(jsnCfg.dataDbCfg.Opts.RedisSentinel))
 		} else if !reflect.DeepEqual(rcv.RplConns, jsnCfg.dataDbCfg.RplConns) {
 			t.Errorf("Expected %+v \n, received %+v", rcv.RplConns, jsnCfg.dataDbCfg.RplConns)
+		} else if !reflect.DeepEqual(rcv.EEsConns, jsnCfg.dataDbCfg.EEsConns) {
+			t.Errorf("Expected %+v \n, received %+v", rcv.EEsConns, jsnCfg.dataDbCfg.EEsConns)
 		}
 	}
 }
+
+func TestDataDbCfgEEsConns(t *testing.T) {
+	jsonCfg := &DbJsonCfg{
+		Ees_conns:        &[]string{utils.MetaInternal, "*conn1"},
+		Ees_exporter_ids: &[]string{"CRM_KAFKA"},
+	}
+	jsnCfg := NewDefaultCGRConfig()
+	if err := jsnCfg.dataDbCfg.loadFromJSONCfg(jsonCfg); err != nil {
+		t.Fatal(err)
+	}
+	expConns := []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs), "*conn1"}
+	if !reflect.DeepEqual(expConns, jsnCfg.dataDbCfg.EEsConns) {
+		t.Errorf("Expected %+v, received %+v", expConns, jsnCfg.dataDbCfg.EEsConns)
+	}
+	if exp := []string{"CRM_KAFKA"}; !reflect.DeepEqual(exp, jsnCfg.dataDbCfg.EEsExpIDs) {
+		t.Errorf("Expected %+v, received %+v", exp, jsnCfg.dataDbCfg.EEsExpIDs)
+	}
+	rcv := jsnCfg.dataDbCfg.AsMapInterface()
+	if exp := []string{utils.MetaInternal, "*conn1"}; !reflect.DeepEqual(exp, rcv[utils.EEsConnsCfg]) {
+		t.Errorf("Expected %+v, received %+v", exp, rcv[utils.EEsConnsCfg])
+	}
+	if exp := []string{"CRM_KAFKA"}; !reflect.DeepEqual(exp, rcv[utils.EEsExporterIDsCfg]) {
+		t.Errorf("Expected %+v, received %+v", exp, rcv[utils.EEsExporterIDsCfg])
+	}
+	if cln := jsnCfg.dataDbCfg.Clone(); !reflect.DeepEqual(cln.EEsExpIDs, jsnCfg.dataDbCfg.EEsExpIDs) {
+		t.Errorf("Expected %+v, received %+v", jsnCfg.dataDbCfg.EEsExpIDs, cln.EEsExpIDs)
+	}
+}
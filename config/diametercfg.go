@@ -19,15 +19,31 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package config
 
 import (
+	"slices"
+	"time"
+
 	"github.com/cgrates/cgrates/utils"
 	"github.com/cgrates/rpcclient"
 )
 
+// DiameterListener describes one address where the Diameter Agent listens for requests
+type DiameterListener struct {
+	Address string // address where to listen for diameter requests <x.y.z.y/x1.y1.z1.y1:1234>
+	Network string // tcp, sctp or tls
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (lstn *DiameterListener) AsMapInterface() map[string]any {
+	return map[string]any{
+		utils.AddressCfg: lstn.Address,
+		utils.NetworkCfg: lstn.Network,
+	}
+}
+
 // DiameterAgentCfg the config section that describes the Diameter Agent
 type DiameterAgentCfg struct {
-	Enabled           bool   // enables the diameter agent: <true|false>
-	ListenNet         string // sctp or tcp
-	Listen            string // address where to listen for diameter requests <x.y.z.y:1234>
+	Enabled           bool // enables the diameter agent: <true|false>
+	Listeners         []DiameterListener
 	DictionariesPath  string
 	SessionSConns     []string
 	OriginHost        string
@@ -38,9 +54,67 @@ type DiameterAgentCfg struct {
 	ASRTemplate       string
 	RARTemplate       string
 	ForcedDisconnect  string
+	Peers             []*DiameterPeer // outbound connections used to relay requests
+	WatchdogInterval  time.Duration
+	ReconnectInterval time.Duration
+	RelayTimeout      time.Duration
 	RequestProcessors []*RequestProcessor
 }
 
+// DiameterPeer describes an outbound connection towards a Diameter peer
+type DiameterPeer struct {
+	ID             string
+	Address        string
+	Network        string   // sctp or tcp
+	Realm          string   // Destination-Realm served by the peer, empty for the one received in CEA
+	ApplicationIDs []uint32 // applications routed to the peer, empty for the ones received in CEA
+}
+
+func (dp *DiameterPeer) loadFromJSONCfg(jsnCfg *DiameterPeerJsnCfg) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Id != nil {
+		dp.ID = *jsnCfg.Id
+	}
+	if jsnCfg.Address != nil {
+		dp.Address = *jsnCfg.Address
+	}
+	if jsnCfg.Network != nil {
+		dp.Network = *jsnCfg.Network
+	}
+	if jsnCfg.Realm != nil {
+		dp.Realm = *jsnCfg.Realm
+	}
+	if jsnCfg.Application_ids != nil {
+		dp.ApplicationIDs = slices.Clone(*jsnCfg.Application_ids)
+	}
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (dp *DiameterPeer) AsMapInterface() map[string]any {
+	appIDs := make([]uint32, len(dp.ApplicationIDs))
+	copy(appIDs, dp.ApplicationIDs)
+	return map[string]any{
+		utils.IDCfg:             dp.ID,
+		utils.AddressCfg:        dp.Address,
+		utils.NetworkCfg:        dp.Network,
+		utils.RealmCfg:          dp.Realm,
+		utils.ApplicationIDsCfg: appIDs,
+	}
+}
+
+// Clone returns a deep copy of DiameterPeer
+func (dp *DiameterPeer) Clone() *DiameterPeer {
+	return &DiameterPeer{
+		ID:             dp.ID,
+		Address:        dp.Address,
+		Network:        dp.Network,
+		Realm:          dp.Realm,
+		ApplicationIDs: slices.Clone(dp.ApplicationIDs),
+	}
+}
+
 func (da *DiameterAgentCfg) loadFromJSONCfg(jsnCfg *DiameterAgentJsonCfg, separator string) (err error) {
 	if jsnCfg == nil {
 		return nil
@@ -48,11 +122,31 @@ func (da *DiameterAgentCfg) loadFromJSONCfg(jsnCfg *DiameterAgentJsonCfg, separa
 	if jsnCfg.Enabled != nil {
 		da.Enabled = *jsnCfg.Enabled
 	}
-	if jsnCfg.Listen != nil {
-		da.Listen = *jsnCfg.Listen
-	}
-	if jsnCfg.Listen_net != nil {
-		da.ListenNet = *jsnCfg.Listen_net
+	if jsnCfg.Listeners != nil {
+		da.Listeners = make([]DiameterListener, 0, len(*jsnCfg.Listeners))
+		for _, listnr := range *jsnCfg.Listeners {
+			ls := DiameterListener{Network: utils.TCP}
+			if listnr.Address != nil {
+				ls.Address = *listnr.Address
+			}
+			if listnr.Network != nil {
+				ls.Network = *listnr.Network
+			}
+			da.Listeners = append(da.Listeners, ls)
+		}
+	} else if jsnCfg.Listen != nil || jsnCfg.Listen_net != nil {
+		// deprecated options, converted to one listener overwriting the existing ones
+		ls := DiameterListener{Network: utils.TCP}
+		if len(da.Listeners) != 0 {
+			ls = da.Listeners[0]
+		}
+		if jsnCfg.Listen != nil {
+			ls.Address = *jsnCfg.Listen
+		}
+		if jsnCfg.Listen_net != nil {
+			ls.Network = *jsnCfg.Listen_net
+		}
+		da.Listeners = []DiameterListener{ls}
 	}
 	if jsnCfg.Dictionaries_path != nil {
 		da.DictionariesPath = *jsnCfg.Dictionaries_path
@@ -92,6 +186,28 @@ func (da *DiameterAgentCfg) loadFromJSONCfg(jsnCfg *DiameterAgentJsonCfg, separa
 	if jsnCfg.Forced_disconnect != nil {
 		da.ForcedDisconnect = *jsnCfg.Forced_disconnect
 	}
+	if jsnCfg.Peers != nil {
+		da.Peers = make([]*DiameterPeer, len(*jsnCfg.Peers))
+		for i, peerJsn := range *jsnCfg.Peers {
+			da.Peers[i] = &DiameterPeer{Network: utils.TCP}
+			da.Peers[i].loadFromJSONCfg(peerJsn)
+		}
+	}
+	if jsnCfg.Watchdog_interval != nil {
+		if da.WatchdogInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.Watchdog_interval); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Reconnect_interval != nil {
+		if da.ReconnectInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.Reconnect_interval); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Relay_timeout != nil {
+		if da.RelayTimeout, err = utils.ParseDurationWithNanosecs(*jsnCfg.Relay_timeout); err != nil {
+			return
+		}
+	}
 	if jsnCfg.Request_processors != nil {
 		for _, reqProcJsn := range *jsnCfg.Request_processors {
 			rp := new(RequestProcessor)
@@ -117,19 +233,32 @@ func (da *DiameterAgentCfg) loadFromJSONCfg(jsnCfg *DiameterAgentJsonCfg, separa
 // AsMapInterface returns the config as a map[string]any
 func (da *DiameterAgentCfg) AsMapInterface(separator string) (initialMP map[string]any) {
 	initialMP = map[string]any{
-		utils.EnabledCfg:          da.Enabled,
-		utils.ListenNetCfg:        da.ListenNet,
-		utils.ListenCfg:           da.Listen,
-		utils.DictionariesPathCfg: da.DictionariesPath,
-		utils.OriginHostCfg:       da.OriginHost,
-		utils.OriginRealmCfg:      da.OriginRealm,
-		utils.VendorIDCfg:         da.VendorID,
-		utils.ProductNameCfg:      da.ProductName,
-		utils.SyncedConnReqsCfg:   da.SyncedConnReqs,
-		utils.ASRTemplateCfg:      da.ASRTemplate,
-		utils.RARTemplateCfg:      da.RARTemplate,
-		utils.ForcedDisconnectCfg: da.ForcedDisconnect,
+		utils.EnabledCfg:           da.Enabled,
+		utils.DictionariesPathCfg:  da.DictionariesPath,
+		utils.OriginHostCfg:        da.OriginHost,
+		utils.OriginRealmCfg:       da.OriginRealm,
+		utils.VendorIDCfg:          da.VendorID,
+		utils.ProductNameCfg:       da.ProductName,
+		utils.SyncedConnReqsCfg:    da.SyncedConnReqs,
+		utils.ASRTemplateCfg:       da.ASRTemplate,
+		utils.RARTemplateCfg:       da.RARTemplate,
+		utils.ForcedDisconnectCfg:  da.ForcedDisconnect,
+		utils.WatchdogIntervalCfg:  da.WatchdogInterval.String(),
+		utils.ReconnectIntervalCfg: da.ReconnectInterval.String(),
+		utils.RelayTimeoutCfg:      da.RelayTimeout.String(),
+	}
+
+	listeners := make([]map[string]any, len(da.Listeners))
+	for i, item := range da.Listeners {
+		listeners[i] = item.AsMapInterface()
 	}
+	initialMP[utils.ListenersCfg] = listeners
+
+	peers := make([]map[string]any, len(da.Peers))
+	for i, peer := range da.Peers {
+		peers[i] = peer.AsMapInterface()
+	}
+	initialMP[utils.PeersCfg] = peers
 
 	requestProcessors := make([]map[string]any, len(da.RequestProcessors))
 	for i, item := range da.RequestProcessors {
@@ -155,23 +284,33 @@ func (da *DiameterAgentCfg) AsMapInterface(separator string) (initialMP map[stri
 // Clone returns a deep copy of DiameterAgentCfg
 func (da DiameterAgentCfg) Clone() (cln *DiameterAgentCfg) {
 	cln = &DiameterAgentCfg{
-		Enabled:          da.Enabled,
-		ListenNet:        da.ListenNet,
-		Listen:           da.Listen,
-		DictionariesPath: da.DictionariesPath,
-		OriginHost:       da.OriginHost,
-		OriginRealm:      da.OriginRealm,
-		VendorID:         da.VendorID,
-		ProductName:      da.ProductName,
-		SyncedConnReqs:   da.SyncedConnReqs,
-		ASRTemplate:      da.ASRTemplate,
-		RARTemplate:      da.RARTemplate,
-		ForcedDisconnect: da.ForcedDisconnect,
+		Enabled:           da.Enabled,
+		DictionariesPath:  da.DictionariesPath,
+		OriginHost:        da.OriginHost,
+		OriginRealm:       da.OriginRealm,
+		VendorID:          da.VendorID,
+		ProductName:       da.ProductName,
+		SyncedConnReqs:    da.SyncedConnReqs,
+		ASRTemplate:       da.ASRTemplate,
+		RARTemplate:       da.RARTemplate,
+		ForcedDisconnect:  da.ForcedDisconnect,
+		WatchdogInterval:  da.WatchdogInterval,
+		ReconnectInterval: da.ReconnectInterval,
+		RelayTimeout:      da.RelayTimeout,
+	}
+	if da.Listeners != nil {
+		cln.Listeners = slices.Clone(da.Listeners)
 	}
 	if da.SessionSConns != nil {
 		cln.SessionSConns = make([]string, len(da.SessionSConns))
 		copy(cln.SessionSConns, da.SessionSConns)
 	}
+	if da.Peers != nil {
+		cln.Peers = make([]*DiameterPeer, len(da.Peers))
+		for i, peer := range da.Peers {
+			cln.Peers[i] = peer.Clone()
+		}
+	}
 	if da.RequestProcessors != nil {
 		cln.RequestProcessors = make([]*RequestProcessor, len(da.RequestProcessors))
 		for i, req := range da.RequestProcessors {
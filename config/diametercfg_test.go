@@ -20,6 +20,7 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 	"github.com/cgrates/rpcclient"
@@ -27,9 +28,17 @@ import (
 
 func TestDiameterAgentCfgloadFromJsonCfg(t *testing.T) {
 	jsonCFG := &DiameterAgentJsonCfg{
-		Enabled:              utils.BoolPointer(true),
-		Listen_net:           utils.StringPointer("tcp"),
-		Listen:               utils.StringPointer("127.0.0.1:3868"),
+		Enabled: utils.BoolPointer(true),
+		Listeners: &[]*DiamListenerJsnCfg{
+			{
+				Address: utils.StringPointer("127.0.0.1:3868"),
+				Network: utils.StringPointer("tcp"),
+			},
+			{
+				Address: utils.StringPointer("127.0.0.1:3869"),
+				Network: utils.StringPointer("tls"),
+			},
+		},
 		Dictionaries_path:    utils.StringPointer("/usr/share/cgrates/diameter/dict/"),
 		Sessions_conns:       &[]string{utils.MetaInternal, "*conn1"},
 		Origin_host:          utils.StringPointer("CGR-DA"),
@@ -40,6 +49,17 @@ func TestDiameterAgentCfgloadFromJsonCfg(t *testing.T) {
 		Asr_template:         utils.StringPointer("randomTemplate"),
 		Rar_template:         utils.StringPointer("randomTemplate"),
 		Forced_disconnect:    utils.StringPointer("forced"),
+		Peers: &[]*DiameterPeerJsnCfg{
+			{
+				Id:              utils.StringPointer("pcrf1"),
+				Address:         utils.StringPointer("127.0.0.1:3869"),
+				Realm:           utils.StringPointer("pcrf.org"),
+				Application_ids: &[]uint32{4, 16777238},
+			},
+		},
+		Watchdog_interval:  utils.StringPointer("10s"),
+		Reconnect_interval: utils.StringPointer("1s"),
+		Relay_timeout:      utils.StringPointer("500ms"),
 		Request_processors: &[]*ReqProcessorJsnCfg{
 			{
 				ID:       utils.StringPointer(utils.CGRateSLwr),
@@ -48,9 +68,11 @@ func TestDiameterAgentCfgloadFromJsonCfg(t *testing.T) {
 		},
 	}
 	expected := &DiameterAgentCfg{
-		Enabled:          true,
-		ListenNet:        "tcp",
-		Listen:           "127.0.0.1:3868",
+		Enabled: true,
+		Listeners: []DiameterListener{
+			{Address: "127.0.0.1:3868", Network: "tcp"},
+			{Address: "127.0.0.1:3869", Network: "tls"},
+		},
 		DictionariesPath: "/usr/share/cgrates/diameter/dict/",
 		SessionSConns:    []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS), "*conn1"},
 		OriginHost:       "CGR-DA",
@@ -61,6 +83,18 @@ func TestDiameterAgentCfgloadFromJsonCfg(t *testing.T) {
 		ASRTemplate:      "randomTemplate",
 		RARTemplate:      "randomTemplate",
 		ForcedDisconnect: "forced",
+		Peers: []*DiameterPeer{
+			{
+				ID:             "pcrf1",
+				Address:        "127.0.0.1:3869",
+				Network:        utils.TCP,
+				Realm:          "pcrf.org",
+				ApplicationIDs: []uint32{4, 16777238},
+			},
+		},
+		WatchdogInterval:  10 * time.Second,
+		ReconnectInterval: time.Second,
+		RelayTimeout:      500 * time.Millisecond,
 		RequestProcessors: []*RequestProcessor{
 			{
 				ID:       "cgrates",
@@ -76,6 +110,46 @@ func TestDiameterAgentCfgloadFromJsonCfg(t *testing.T) {
 	}
 }
 
+func TestDiameterAgentCfgloadFromJsonCfgDeprecatedListen(t *testing.T) {
+	cfgJSONStr := `{
+	"diameter_agent": {
+		"listen": "127.0.0.1:3869",
+		"listen_net": "sctp",
+	},
+}`
+	expected := []DiameterListener{{Address: "127.0.0.1:3869", Network: utils.SCTP}}
+	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, cgrCfg.diameterAgentCfg.Listeners) {
+		t.Errorf("Expected %+v \n, received %+v", expected, cgrCfg.diameterAgentCfg.Listeners)
+	}
+	// the network defaults to the one of the default listener
+	cfgJSONStr = `{
+	"diameter_agent": {
+		"listen": "127.0.0.1:3869",
+	},
+}`
+	expected = []DiameterListener{{Address: "127.0.0.1:3869", Network: utils.TCP}}
+	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, cgrCfg.diameterAgentCfg.Listeners) {
+		t.Errorf("Expected %+v \n, received %+v", expected, cgrCfg.diameterAgentCfg.Listeners)
+	}
+	// listeners take precedence over the deprecated options
+	cfgJSONStr = `{
+	"diameter_agent": {
+		"listen": "127.0.0.1:3869",
+		"listeners": [{"address": "127.0.0.1:3870", "network": "tls"}],
+	},
+}`
+	expected = []DiameterListener{{Address: "127.0.0.1:3870", Network: utils.TLSNoCaps}}
+	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, cgrCfg.diameterAgentCfg.Listeners) {
+		t.Errorf("Expected %+v \n, received %+v", expected, cgrCfg.diameterAgentCfg.Listeners)
+	}
+}
+
 func TestRequestProcessorloadFromJsonCfg1(t *testing.T) {
 	cfgJSON := &DiameterAgentJsonCfg{
 		Request_processors: &[]*ReqProcessorJsnCfg{
@@ -119,7 +193,12 @@ func TestDiameterAgentCfgAsMapInterface(t *testing.T) {
 	cfgJSONStr := `{
 	"diameter_agent": {
 		"enabled": false,											
-		"listen": "127.0.0.1:3868",									
+		"listeners": [
+			{
+				"address": "127.0.0.1:3868",
+				"network": "tcp"
+			}
+		],
 		"dictionaries_path": "/usr/share/cgrates/diameter/dict/",	
 		"sessions_conns": ["*birpc_internal","*internal", "*conn1"],
 		"origin_host": "CGR-DA",									
@@ -127,6 +206,10 @@ func TestDiameterAgentCfgAsMapInterface(t *testing.T) {
 		"vendor_id": 0,												
 		"product_name": "CGRateS",									
 		"synced_conn_requests": true,
+		"peers": [
+			{"id": "pcrf1", "address": "127.0.0.1:3869", "network": "sctp", "application_ids": [16777238]},
+		],
+		"relay_timeout": "1s",
 		"request_processors": [
                         {
                          "id": "cgrates", 
@@ -148,15 +231,28 @@ func TestDiameterAgentCfgAsMapInterface(t *testing.T) {
 		utils.DictionariesPathCfg: "/usr/share/cgrates/diameter/dict/",
 		utils.EnabledCfg:          false,
 		utils.ForcedDisconnectCfg: "*none",
-		utils.ListenCfg:           "127.0.0.1:3868",
-		utils.ListenNetCfg:        "tcp",
-		utils.OriginHostCfg:       "CGR-DA",
-		utils.OriginRealmCfg:      "cgrates.org",
-		utils.ProductNameCfg:      "CGRateS",
-		utils.RARTemplateCfg:      "",
-		utils.SessionSConnsCfg:    []string{rpcclient.BiRPCInternal, utils.MetaInternal, "*conn1"},
-		utils.SyncedConnReqsCfg:   true,
-		utils.VendorIDCfg:         0,
+		utils.ListenersCfg: []map[string]any{
+			{utils.AddressCfg: "127.0.0.1:3868", utils.NetworkCfg: "tcp"},
+		},
+		utils.OriginHostCfg:     "CGR-DA",
+		utils.OriginRealmCfg:    "cgrates.org",
+		utils.ProductNameCfg:    "CGRateS",
+		utils.RARTemplateCfg:    "",
+		utils.SessionSConnsCfg:  []string{rpcclient.BiRPCInternal, utils.MetaInternal, "*conn1"},
+		utils.SyncedConnReqsCfg: true,
+		utils.VendorIDCfg:       0,
+		utils.PeersCfg: []map[string]any{
+			{
+				utils.IDCfg:             "pcrf1",
+				utils.AddressCfg:        "127.0.0.1:3869",
+				utils.NetworkCfg:        utils.SCTP,
+				utils.RealmCfg:          "",
+				utils.ApplicationIDsCfg: []uint32{16777238},
+			},
+		},
+		utils.WatchdogIntervalCfg:  "5s",
+		utils.ReconnectIntervalCfg: "5s",
+		utils.RelayTimeoutCfg:      "1s",
 		utils.RequestProcessorsCfg: []map[string]any{
 			{
 				utils.IDCfg:       utils.CGRateSLwr,
@@ -204,12 +300,13 @@ func TestDiameterAgentCfgAsMapInterface1(t *testing.T) {
 	},
 }`
 	eMap := map[string]any{
-		utils.ASRTemplateCfg:       "",
-		utils.DictionariesPathCfg:  "/usr/share/cgrates/diameter",
-		utils.EnabledCfg:           true,
-		utils.ForcedDisconnectCfg:  "*none",
-		utils.ListenCfg:            "127.0.0.1:3868",
-		utils.ListenNetCfg:         "tcp",
+		utils.ASRTemplateCfg:      "",
+		utils.DictionariesPathCfg: "/usr/share/cgrates/diameter",
+		utils.EnabledCfg:          true,
+		utils.ForcedDisconnectCfg: "*none",
+		utils.ListenersCfg: []map[string]any{
+			{utils.AddressCfg: "127.0.0.1:3868", utils.NetworkCfg: utils.TCP},
+		},
 		utils.OriginHostCfg:        "CGR-DA",
 		utils.OriginRealmCfg:       "cgrates.org",
 		utils.ProductNameCfg:       "CGRateS",
@@ -217,6 +314,10 @@ func TestDiameterAgentCfgAsMapInterface1(t *testing.T) {
 		utils.SessionSConnsCfg:     []string{rpcclient.BiRPCInternal},
 		utils.SyncedConnReqsCfg:    false,
 		utils.VendorIDCfg:          0,
+		utils.PeersCfg:             []map[string]any{},
+		utils.WatchdogIntervalCfg:  "5s",
+		utils.ReconnectIntervalCfg: "5s",
+		utils.RelayTimeoutCfg:      "3s",
 		utils.RequestProcessorsCfg: []map[string]any{},
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
@@ -229,8 +330,7 @@ func TestDiameterAgentCfgAsMapInterface1(t *testing.T) {
 func TestDiameterAgentCfgClone(t *testing.T) {
 	ban := &DiameterAgentCfg{
 		Enabled:          true,
-		ListenNet:        "tcp",
-		Listen:           "127.0.0.1:3868",
+		Listeners:        []DiameterListener{{Address: "127.0.0.1:3868", Network: "tcp"}},
 		DictionariesPath: "/usr/share/cgrates/diameter/dict/",
 		SessionSConns:    []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS), "*conn1"},
 		OriginHost:       "CGR-DA",
@@ -241,6 +341,15 @@ func TestDiameterAgentCfgClone(t *testing.T) {
 		ASRTemplate:      "randomTemplate",
 		RARTemplate:      "randomTemplate",
 		ForcedDisconnect: "forced",
+		Peers: []*DiameterPeer{
+			{
+				ID:             "pcrf1",
+				Address:        "127.0.0.1:3869",
+				Network:        utils.TCP,
+				ApplicationIDs: []uint32{4},
+			},
+		},
+		RelayTimeout: time.Second,
 		RequestProcessors: []*RequestProcessor{
 			{
 				ID:       "cgrates",
@@ -258,4 +367,7 @@ func TestDiameterAgentCfgClone(t *testing.T) {
 	if rcv.RequestProcessors[0].ID = ""; ban.RequestProcessors[0].ID != "cgrates" {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
+	if rcv.Peers[0].ApplicationIDs[0] = 0; ban.Peers[0].ApplicationIDs[0] != 4 {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
 }
@@ -19,6 +19,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package config
 
 import (
+	"time"
+
 	"github.com/cgrates/cgrates/utils"
 )
 
@@ -33,6 +35,10 @@ type DispatcherSCfg struct {
 	NestedFields        bool
 	AnySubsystem        bool
 	PreventLoop         bool
+	HealthCheckInterval time.Duration // 0 disables the health checking of the hosts
+	HealthCheckMethod   string
+	UnhealthyThreshold  int
+	HealthyThreshold    int
 }
 
 func (dps *DispatcherSCfg) loadFromJSONCfg(jsnCfg *DispatcherSJsonCfg) (err error) {
@@ -79,17 +85,35 @@ func (dps *DispatcherSCfg) loadFromJSONCfg(jsnCfg *DispatcherSJsonCfg) (err erro
 	if jsnCfg.Prevent_loop != nil {
 		dps.PreventLoop = *jsnCfg.Prevent_loop
 	}
+	if jsnCfg.Health_check_interval != nil {
+		if dps.HealthCheckInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.Health_check_interval); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Health_check_method != nil {
+		dps.HealthCheckMethod = *jsnCfg.Health_check_method
+	}
+	if jsnCfg.Unhealthy_threshold != nil {
+		dps.UnhealthyThreshold = *jsnCfg.Unhealthy_threshold
+	}
+	if jsnCfg.Healthy_threshold != nil {
+		dps.HealthyThreshold = *jsnCfg.Healthy_threshold
+	}
 	return nil
 }
 
 // AsMapInterface returns the config as a map[string]any
 func (dps *DispatcherSCfg) AsMapInterface() (mp map[string]any) {
 	mp = map[string]any{
-		utils.EnabledCfg:        dps.Enabled,
-		utils.IndexedSelectsCfg: dps.IndexedSelects,
-		utils.NestedFieldsCfg:   dps.NestedFields,
-		utils.AnySubsystemCfg:   dps.AnySubsystem,
-		utils.PreventLoopCfg:    dps.PreventLoop,
+		utils.EnabledCfg:             dps.Enabled,
+		utils.IndexedSelectsCfg:      dps.IndexedSelects,
+		utils.NestedFieldsCfg:        dps.NestedFields,
+		utils.AnySubsystemCfg:        dps.AnySubsystem,
+		utils.PreventLoopCfg:         dps.PreventLoop,
+		utils.HealthCheckIntervalCfg: dps.HealthCheckInterval.String(),
+		utils.HealthCheckMethodCfg:   dps.HealthCheckMethod,
+		utils.UnhealthyThresholdCfg:  dps.UnhealthyThreshold,
+		utils.HealthyThresholdCfg:    dps.HealthyThreshold,
 	}
 	if dps.StringIndexedFields != nil {
 		stringIndexedFields := make([]string, len(*dps.StringIndexedFields))
@@ -122,11 +146,15 @@ func (dps *DispatcherSCfg) AsMapInterface() (mp map[string]any) {
 // Clone returns a deep copy of DispatcherSCfg
 func (dps DispatcherSCfg) Clone() (cln *DispatcherSCfg) {
 	cln = &DispatcherSCfg{
-		Enabled:        dps.Enabled,
-		IndexedSelects: dps.IndexedSelects,
-		NestedFields:   dps.NestedFields,
-		AnySubsystem:   dps.AnySubsystem,
-		PreventLoop:    dps.PreventLoop,
+		Enabled:             dps.Enabled,
+		IndexedSelects:      dps.IndexedSelects,
+		NestedFields:        dps.NestedFields,
+		AnySubsystem:        dps.AnySubsystem,
+		PreventLoop:         dps.PreventLoop,
+		HealthCheckInterval: dps.HealthCheckInterval,
+		HealthCheckMethod:   dps.HealthCheckMethod,
+		UnhealthyThreshold:  dps.UnhealthyThreshold,
+		HealthyThreshold:    dps.HealthyThreshold,
 	}
 
 	if dps.AttributeSConns != nil {
@@ -21,6 +21,7 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
@@ -35,6 +36,10 @@ func TestDispatcherSCfgloadFromJsonCfg(t *testing.T) {
 		Attributes_conns:      &[]string{utils.MetaInternal, "*conn1"},
 		Nested_fields:         utils.BoolPointer(true),
 		Any_subsystem:         utils.BoolPointer(true),
+		Health_check_interval: utils.StringPointer("5s"),
+		Health_check_method:   utils.StringPointer(utils.CoreSv1Status),
+		Unhealthy_threshold:   utils.IntPointer(2),
+		Healthy_threshold:     utils.IntPointer(5),
 	}
 	expected := &DispatcherSCfg{
 		Enabled:             true,
@@ -45,6 +50,10 @@ func TestDispatcherSCfgloadFromJsonCfg(t *testing.T) {
 		AttributeSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaAttributes), "*conn1"},
 		NestedFields:        true,
 		AnySubsystem:        true,
+		HealthCheckInterval: 5 * time.Second,
+		HealthCheckMethod:   utils.CoreSv1Status,
+		UnhealthyThreshold:  2,
+		HealthyThreshold:    5,
 	}
 	jsnCfg := NewDefaultCGRConfig()
 	if err := jsnCfg.dispatcherSCfg.loadFromJSONCfg(jsonCfg); err != nil {
@@ -75,6 +84,10 @@ func TestDispatcherSCfgAsMapInterface(t *testing.T) {
 		utils.AttributeSConnsCfg:     []string{},
 		utils.AnySubsystemCfg:        true,
 		utils.PreventLoopCfg:         false,
+		utils.HealthCheckIntervalCfg: "0s",
+		utils.HealthCheckMethodCfg:   utils.CoreSv1Ping,
+		utils.UnhealthyThresholdCfg:  1,
+		utils.HealthyThresholdCfg:    3,
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -93,7 +106,9 @@ func TestDispatcherSCfgAsMapInterface1(t *testing.T) {
             "suffix_indexed_fields": ["*req.prefix"],
 			"nested_fields": false,
 			"attributes_conns": ["*internal:*attributes", "*conn1"],
-			"prevent_loop": true
+			"prevent_loop": true,
+			"health_check_interval": "10s",
+			"healthy_threshold": 2,
 		},
 		
 }`
@@ -107,6 +122,10 @@ func TestDispatcherSCfgAsMapInterface1(t *testing.T) {
 		utils.AttributeSConnsCfg:     []string{"*internal", "*conn1"},
 		utils.AnySubsystemCfg:        true,
 		utils.PreventLoopCfg:         true,
+		utils.HealthCheckIntervalCfg: "10s",
+		utils.HealthCheckMethodCfg:   utils.CoreSv1Ping,
+		utils.UnhealthyThresholdCfg:  1,
+		utils.HealthyThresholdCfg:    2,
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -128,6 +147,10 @@ func TestDispatcherSCfgAsMapInterface2(t *testing.T) {
 		utils.AttributeSConnsCfg:     []string{},
 		utils.AnySubsystemCfg:        true,
 		utils.PreventLoopCfg:         false,
+		utils.HealthCheckIntervalCfg: "0s",
+		utils.HealthCheckMethodCfg:   utils.CoreSv1Ping,
+		utils.UnhealthyThresholdCfg:  1,
+		utils.HealthyThresholdCfg:    3,
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -231,6 +231,19 @@ type NATSOpts struct {
 	JetStreamMaxWait     *time.Duration
 }
 
+type MQTTOpts struct {
+	Topic                *string
+	QoS                  *int
+	ClientID             *string
+	Username             *string
+	Password             *string
+	Retained             *bool
+	CertificateAuthority *string
+	ClientCertificate    *string
+	ClientKey            *string
+	SkipTLSVerify        *bool
+}
+
 type RPCOpts struct {
 	RPCCodec        *string
 	ServiceMethod   *string
@@ -250,15 +263,22 @@ type KafkaOpts struct {
 	TLS           *bool
 	CAPath        *string
 	SkipTLSVerify *bool
+	SASLMechanism *string
+	SASLUsername  *string
+	SASLPassword  *string
+	KeyField      *string   // exported field used as message key
+	Headers       *[]string // *opts fields attached as message headers
 }
 
 type EventExporterOpts struct {
 	CSVFieldSeparator *string
+	RowGroupSize      *int
 	Els               *ElsOpts
 	SQL               *SQLOpts
 	AMQP              *AMQPOpts
 	AWS               *AWSOpts
 	NATS              *NATSOpts
+	MQTT              *MQTTOpts
 	RPC               *RPCOpts
 	Kafka             *KafkaOpts
 }
@@ -277,6 +297,9 @@ type EventExporterCfg struct {
 	Synchronous        bool
 	Attempts           int
 	FailedPostsDir     string
+	RetryQueueDir      string        // directory of the durable queue retrying the failed exports, *none to disable
+	RetryBackoff       time.Duration // delay before the first retry, doubled after each failed retry
+	RetryMaxBackoff    time.Duration // maximum delay between two retries
 	ConcurrentRequests int
 	Fields             []*FCTemplate
 	headerFields       []*FCTemplate
@@ -396,6 +419,23 @@ func (kafkaOpts *KafkaOpts) loadFromJSONCfg(jsnCfg *EventExporterOptsJson) (err
 	if jsnCfg.KafkaSkipTLSVerify != nil {
 		kafkaOpts.SkipTLSVerify = jsnCfg.KafkaSkipTLSVerify
 	}
+	if jsnCfg.KafkaSASLMechanism != nil {
+		kafkaOpts.SASLMechanism = jsnCfg.KafkaSASLMechanism
+	}
+	if jsnCfg.KafkaSASLUsername != nil {
+		kafkaOpts.SASLUsername = jsnCfg.KafkaSASLUsername
+	}
+	if jsnCfg.KafkaSASLPassword != nil {
+		kafkaOpts.SASLPassword = jsnCfg.KafkaSASLPassword
+	}
+	if jsnCfg.KafkaKeyField != nil {
+		kafkaOpts.KeyField = jsnCfg.KafkaKeyField
+	}
+	if jsnCfg.KafkaHeaders != nil {
+		hdrs := make([]string, len(*jsnCfg.KafkaHeaders))
+		copy(hdrs, *jsnCfg.KafkaHeaders)
+		kafkaOpts.Headers = &hdrs
+	}
 	return
 }
 
@@ -507,6 +547,40 @@ func (natsOpts *NATSOpts) loadFromJSONCfg(jsnCfg *EventExporterOptsJson) (err er
 	}
 	return
 }
+
+func (mqttOpts *MQTTOpts) loadFromJSONCfg(jsnCfg *EventExporterOptsJson) (err error) {
+	if jsnCfg.MQTTTopic != nil {
+		mqttOpts.Topic = jsnCfg.MQTTTopic
+	}
+	if jsnCfg.MQTTQoS != nil {
+		mqttOpts.QoS = jsnCfg.MQTTQoS
+	}
+	if jsnCfg.MQTTClientID != nil {
+		mqttOpts.ClientID = jsnCfg.MQTTClientID
+	}
+	if jsnCfg.MQTTUsername != nil {
+		mqttOpts.Username = jsnCfg.MQTTUsername
+	}
+	if jsnCfg.MQTTPassword != nil {
+		mqttOpts.Password = jsnCfg.MQTTPassword
+	}
+	if jsnCfg.MQTTRetained != nil {
+		mqttOpts.Retained = jsnCfg.MQTTRetained
+	}
+	if jsnCfg.MQTTCertificateAuthority != nil {
+		mqttOpts.CertificateAuthority = jsnCfg.MQTTCertificateAuthority
+	}
+	if jsnCfg.MQTTClientCertificate != nil {
+		mqttOpts.ClientCertificate = jsnCfg.MQTTClientCertificate
+	}
+	if jsnCfg.MQTTClientKey != nil {
+		mqttOpts.ClientKey = jsnCfg.MQTTClientKey
+	}
+	if jsnCfg.MQTTSkipTLSVerify != nil {
+		mqttOpts.SkipTLSVerify = jsnCfg.MQTTSkipTLSVerify
+	}
+	return
+}
 func (rpcOpts *RPCOpts) loadFromJSONCfg(jsnCfg *EventExporterOptsJson) (err error) {
 	if jsnCfg.RPCCodec != nil {
 		rpcOpts.RPCCodec = jsnCfg.RPCCodec
@@ -558,6 +632,9 @@ func (eeOpts *EventExporterOpts) loadFromJSONCfg(jsnCfg *EventExporterOptsJson)
 	if jsnCfg.CSVFieldSeparator != nil {
 		eeOpts.CSVFieldSeparator = jsnCfg.CSVFieldSeparator
 	}
+	if jsnCfg.RowGroupSize != nil {
+		eeOpts.RowGroupSize = jsnCfg.RowGroupSize
+	}
 	if err = eeOpts.Els.loadFromJSONCfg(jsnCfg); err != nil {
 		return
 	}
@@ -576,6 +653,9 @@ func (eeOpts *EventExporterOpts) loadFromJSONCfg(jsnCfg *EventExporterOptsJson)
 	if err = eeOpts.NATS.loadFromJSONCfg(jsnCfg); err != nil {
 		return
 	}
+	if err = eeOpts.MQTT.loadFromJSONCfg(jsnCfg); err != nil {
+		return
+	}
 	if err = eeOpts.RPC.loadFromJSONCfg(jsnCfg); err != nil {
 		return
 	}
@@ -638,6 +718,19 @@ func (eeC *EventExporterCfg) loadFromJSONCfg(jsnEec *EventExporterJsonCfg, msgTe
 	if jsnEec.Failed_posts_dir != nil {
 		eeC.FailedPostsDir = *jsnEec.Failed_posts_dir
 	}
+	if jsnEec.Retry_queue_dir != nil {
+		eeC.RetryQueueDir = *jsnEec.Retry_queue_dir
+	}
+	if jsnEec.Retry_backoff != nil {
+		if eeC.RetryBackoff, err = utils.ParseDurationWithNanosecs(*jsnEec.Retry_backoff); err != nil {
+			return
+		}
+	}
+	if jsnEec.Retry_max_backoff != nil {
+		if eeC.RetryMaxBackoff, err = utils.ParseDurationWithNanosecs(*jsnEec.Retry_max_backoff); err != nil {
+			return
+		}
+	}
 	if jsnEec.Opts != nil {
 		err = eeC.Opts.loadFromJSONCfg(jsnEec.Opts)
 	}
@@ -796,6 +889,27 @@ func (kafkaOpts *KafkaOpts) Clone() *KafkaOpts {
 		cln.SkipTLSVerify = new(bool)
 		*cln.SkipTLSVerify = *kafkaOpts.SkipTLSVerify
 	}
+	if kafkaOpts.SASLMechanism != nil {
+		cln.SASLMechanism = new(string)
+		*cln.SASLMechanism = *kafkaOpts.SASLMechanism
+	}
+	if kafkaOpts.SASLUsername != nil {
+		cln.SASLUsername = new(string)
+		*cln.SASLUsername = *kafkaOpts.SASLUsername
+	}
+	if kafkaOpts.SASLPassword != nil {
+		cln.SASLPassword = new(string)
+		*cln.SASLPassword = *kafkaOpts.SASLPassword
+	}
+	if kafkaOpts.KeyField != nil {
+		cln.KeyField = new(string)
+		*cln.KeyField = *kafkaOpts.KeyField
+	}
+	if kafkaOpts.Headers != nil {
+		hdrs := make([]string, len(*kafkaOpts.Headers))
+		copy(hdrs, *kafkaOpts.Headers)
+		cln.Headers = &hdrs
+	}
 	return cln
 }
 
@@ -931,6 +1045,51 @@ func (natsOpts *NATSOpts) Clone() *NATSOpts {
 	return cln
 }
 
+func (mqttOpts *MQTTOpts) Clone() *MQTTOpts {
+	cln := &MQTTOpts{}
+	if mqttOpts.Topic != nil {
+		cln.Topic = new(string)
+		*cln.Topic = *mqttOpts.Topic
+	}
+	if mqttOpts.QoS != nil {
+		cln.QoS = new(int)
+		*cln.QoS = *mqttOpts.QoS
+	}
+	if mqttOpts.ClientID != nil {
+		cln.ClientID = new(string)
+		*cln.ClientID = *mqttOpts.ClientID
+	}
+	if mqttOpts.Username != nil {
+		cln.Username = new(string)
+		*cln.Username = *mqttOpts.Username
+	}
+	if mqttOpts.Password != nil {
+		cln.Password = new(string)
+		*cln.Password = *mqttOpts.Password
+	}
+	if mqttOpts.Retained != nil {
+		cln.Retained = new(bool)
+		*cln.Retained = *mqttOpts.Retained
+	}
+	if mqttOpts.CertificateAuthority != nil {
+		cln.CertificateAuthority = new(string)
+		*cln.CertificateAuthority = *mqttOpts.CertificateAuthority
+	}
+	if mqttOpts.ClientCertificate != nil {
+		cln.ClientCertificate = new(string)
+		*cln.ClientCertificate = *mqttOpts.ClientCertificate
+	}
+	if mqttOpts.ClientKey != nil {
+		cln.ClientKey = new(string)
+		*cln.ClientKey = *mqttOpts.ClientKey
+	}
+	if mqttOpts.SkipTLSVerify != nil {
+		cln.SkipTLSVerify = new(bool)
+		*cln.SkipTLSVerify = *mqttOpts.SkipTLSVerify
+	}
+	return cln
+}
+
 func (rpcOpts *RPCOpts) Clone() *RPCOpts {
 	cln := &RPCOpts{}
 	if rpcOpts.RPCCodec != nil {
@@ -982,6 +1141,10 @@ func (eeOpts *EventExporterOpts) Clone() *EventExporterOpts {
 		cln.CSVFieldSeparator = new(string)
 		*cln.CSVFieldSeparator = *eeOpts.CSVFieldSeparator
 	}
+	if eeOpts.RowGroupSize != nil {
+		cln.RowGroupSize = new(int)
+		*cln.RowGroupSize = *eeOpts.RowGroupSize
+	}
 	if eeOpts.Els != nil {
 		cln.Els = eeOpts.Els.Clone()
 	}
@@ -1000,6 +1163,9 @@ func (eeOpts *EventExporterOpts) Clone() *EventExporterOpts {
 	if eeOpts.NATS != nil {
 		cln.NATS = eeOpts.NATS.Clone()
 	}
+	if eeOpts.MQTT != nil {
+		cln.MQTT = eeOpts.MQTT.Clone()
+	}
 	if eeOpts.RPC != nil {
 		cln.RPC = eeOpts.RPC.Clone()
 	}
@@ -1024,6 +1190,9 @@ func (eeC EventExporterCfg) Clone() (cln *EventExporterCfg) {
 		trailerFields:      make([]*FCTemplate, len(eeC.trailerFields)),
 		Opts:               eeC.Opts.Clone(),
 		FailedPostsDir:     eeC.FailedPostsDir,
+		RetryQueueDir:      eeC.RetryQueueDir,
+		RetryBackoff:       eeC.RetryBackoff,
+		RetryMaxBackoff:    eeC.RetryMaxBackoff,
 	}
 
 	if eeC.Filters != nil {
@@ -1056,6 +1225,9 @@ func (eeC *EventExporterCfg) AsMapInterface(separator string) (initialMP map[str
 	if eeC.Opts.CSVFieldSeparator != nil {
 		opts[utils.CSVFieldSepOpt] = *eeC.Opts.CSVFieldSeparator
 	}
+	if eeC.Opts.RowGroupSize != nil {
+		opts[utils.RowGroupSizeOpt] = *eeC.Opts.RowGroupSize
+	}
 	if elsOpts := eeC.Opts.Els; elsOpts != nil {
 		if elsOpts.Index != nil {
 			opts[utils.ElsIndex] = *elsOpts.Index
@@ -1166,6 +1338,23 @@ func (eeC *EventExporterCfg) AsMapInterface(separator string) (initialMP map[str
 		if kafkaOpts.SkipTLSVerify != nil {
 			opts[utils.KafkaSkipTLSVerify] = *kafkaOpts.SkipTLSVerify
 		}
+		if kafkaOpts.SASLMechanism != nil {
+			opts[utils.KafkaSASLMechanism] = *kafkaOpts.SASLMechanism
+		}
+		if kafkaOpts.SASLUsername != nil {
+			opts[utils.KafkaSASLUsername] = *kafkaOpts.SASLUsername
+		}
+		if kafkaOpts.SASLPassword != nil {
+			opts[utils.KafkaSASLPassword] = *kafkaOpts.SASLPassword
+		}
+		if kafkaOpts.KeyField != nil {
+			opts[utils.KafkaKeyField] = *kafkaOpts.KeyField
+		}
+		if kafkaOpts.Headers != nil {
+			hdrs := make([]string, len(*kafkaOpts.Headers))
+			copy(hdrs, *kafkaOpts.Headers)
+			opts[utils.KafkaHeaders] = hdrs
+		}
 	}
 	if amOpts := eeC.Opts.AMQP; amOpts != nil {
 		if amOpts.QueueID != nil {
@@ -1236,6 +1425,38 @@ func (eeC *EventExporterCfg) AsMapInterface(separator string) (initialMP map[str
 			opts[utils.NatsJetStreamMaxWait] = natOpts.JetStreamMaxWait.String()
 		}
 	}
+	if mqttOpts := eeC.Opts.MQTT; mqttOpts != nil {
+		if mqttOpts.Topic != nil {
+			opts[utils.MQTTTopic] = *mqttOpts.Topic
+		}
+		if mqttOpts.QoS != nil {
+			opts[utils.MQTTQoS] = *mqttOpts.QoS
+		}
+		if mqttOpts.ClientID != nil {
+			opts[utils.MQTTClientID] = *mqttOpts.ClientID
+		}
+		if mqttOpts.Username != nil {
+			opts[utils.MQTTUsername] = *mqttOpts.Username
+		}
+		if mqttOpts.Password != nil {
+			opts[utils.MQTTPassword] = *mqttOpts.Password
+		}
+		if mqttOpts.Retained != nil {
+			opts[utils.MQTTRetained] = *mqttOpts.Retained
+		}
+		if mqttOpts.CertificateAuthority != nil {
+			opts[utils.MQTTCertificateAuthority] = *mqttOpts.CertificateAuthority
+		}
+		if mqttOpts.ClientCertificate != nil {
+			opts[utils.MQTTClientCertificate] = *mqttOpts.ClientCertificate
+		}
+		if mqttOpts.ClientKey != nil {
+			opts[utils.MQTTClientKey] = *mqttOpts.ClientKey
+		}
+		if mqttOpts.SkipTLSVerify != nil {
+			opts[utils.MQTTSkipTLSVerify] = *mqttOpts.SkipTLSVerify
+		}
+	}
 	if rpcOpts := eeC.Opts.RPC; rpcOpts != nil {
 		if rpcOpts.RPCCodec != nil {
 			opts[utils.RpcCodec] = *rpcOpts.RPCCodec
@@ -1286,6 +1507,9 @@ func (eeC *EventExporterCfg) AsMapInterface(separator string) (initialMP map[str
 		utils.AttemptsCfg:           eeC.Attempts,
 		utils.ConcurrentRequestsCfg: eeC.ConcurrentRequests,
 		utils.FailedPostsDirCfg:     eeC.FailedPostsDir,
+		utils.RetryQueueDirCfg:      eeC.RetryQueueDir,
+		utils.RetryBackoffCfg:       eeC.RetryBackoff.String(),
+		utils.RetryMaxBackoffCfg:    eeC.RetryMaxBackoff.String(),
 		utils.OptsCfg:               opts,
 	}
 
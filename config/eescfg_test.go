@@ -121,6 +121,16 @@ func TestEESClone(t *testing.T) {
 				Precache:  false,
 				Replicate: false,
 			},
+			utils.MetaFileParquet: {
+				Limit:     -1,
+				TTL:       5 * time.Second,
+				StaticTTL: false,
+			},
+			utils.MetaFileAvro: {
+				Limit:     -1,
+				TTL:       5 * time.Second,
+				StaticTTL: false,
+			},
 		},
 		Exporters: []*EventExporterCfg{
 			{
@@ -144,22 +154,29 @@ func TestEESClone(t *testing.T) {
 					RPC:   &RPCOpts{},
 					Els:   &ElsOpts{},
 					Kafka: &KafkaOpts{},
+					MQTT:  &MQTTOpts{},
 					NATS:  &NATSOpts{},
 					SQL:   &SQLOpts{},
 				},
-				FailedPostsDir: "/var/spool/cgrates/failed_posts",
+				FailedPostsDir:  "/var/spool/cgrates/failed_posts",
+				RetryQueueDir:   utils.MetaNone,
+				RetryBackoff:    time.Second,
+				RetryMaxBackoff: 5 * time.Minute,
 			},
 			{
-				ID:             utils.CGRateSLwr,
-				Type:           utils.MetaNone,
-				Synchronous:    false,
-				ExportPath:     "/var/spool/cgrates/ees",
-				Attempts:       2,
-				Timezone:       "local",
-				Filters:        []string{"randomFiletrs"},
-				AttributeSIDs:  []string{"randomID"},
-				Flags:          utils.FlagsWithParams{},
-				FailedPostsDir: "/var/spool/cgrates/failed_posts",
+				ID:              utils.CGRateSLwr,
+				Type:            utils.MetaNone,
+				Synchronous:     false,
+				ExportPath:      "/var/spool/cgrates/ees",
+				Attempts:        2,
+				Timezone:        "local",
+				Filters:         []string{"randomFiletrs"},
+				AttributeSIDs:   []string{"randomID"},
+				Flags:           utils.FlagsWithParams{},
+				FailedPostsDir:  "/var/spool/cgrates/failed_posts",
+				RetryQueueDir:   utils.MetaNone,
+				RetryBackoff:    time.Second,
+				RetryMaxBackoff: 5 * time.Minute,
 				Fields: []*FCTemplate{
 					{
 						Tag:    utils.CGRID,
@@ -260,6 +277,7 @@ func TestEESClone(t *testing.T) {
 						S3BucketID:   utils.StringPointer("s3"),
 						SQSQueueID:   utils.StringPointer("sqsid"),
 					},
+					MQTT: &MQTTOpts{},
 					NATS: &NATSOpts{
 						JetStream:            utils.BoolPointer(true),
 						Subject:              utils.StringPointer("nat"),
@@ -419,6 +437,7 @@ func TestEventExporterOptsloadFromJsonCfg(t *testing.T) {
 		AMQP:  &AMQPOpts{},
 		Kafka: &KafkaOpts{},
 		RPC:   &RPCOpts{},
+		MQTT:  &MQTTOpts{},
 		NATS: &NATSOpts{
 			JetStream:            utils.BoolPointer(true),
 			Subject:              utils.StringPointer("nat"),
@@ -435,6 +454,7 @@ func TestEventExporterOptsloadFromJsonCfg(t *testing.T) {
 			Els:   &ElsOpts{},
 			Kafka: &KafkaOpts{},
 			AMQP:  &AMQPOpts{},
+			MQTT:  &MQTTOpts{},
 			NATS:  &NATSOpts{},
 			SQL:   &SQLOpts{},
 			RPC:   &RPCOpts{},
@@ -498,6 +518,16 @@ func TestEventExporterSameID(t *testing.T) {
 				TTL:       5 * time.Second,
 				StaticTTL: false,
 			},
+			utils.MetaFileParquet: {
+				Limit:     -1,
+				TTL:       5 * time.Second,
+				StaticTTL: false,
+			},
+			utils.MetaFileAvro: {
+				Limit:     -1,
+				TTL:       5 * time.Second,
+				StaticTTL: false,
+			},
 		},
 		Exporters: []*EventExporterCfg{
 			{
@@ -519,10 +549,14 @@ func TestEventExporterSameID(t *testing.T) {
 					RPC:   &RPCOpts{},
 					Els:   &ElsOpts{},
 					Kafka: &KafkaOpts{},
+					MQTT:  &MQTTOpts{},
 					NATS:  &NATSOpts{},
 					SQL:   &SQLOpts{},
 				},
-				FailedPostsDir: "/var/spool/cgrates/failed_posts",
+				FailedPostsDir:  "/var/spool/cgrates/failed_posts",
+				RetryQueueDir:   utils.MetaNone,
+				RetryBackoff:    time.Second,
+				RetryMaxBackoff: 5 * time.Minute,
 			},
 			{
 				ID:            "file_exporter1",
@@ -549,10 +583,14 @@ func TestEventExporterSameID(t *testing.T) {
 					RPC:   &RPCOpts{},
 					Els:   &ElsOpts{},
 					Kafka: &KafkaOpts{},
+					MQTT:  &MQTTOpts{},
 					NATS:  &NATSOpts{},
 					SQL:   &SQLOpts{},
 				},
-				FailedPostsDir: "/var/spool/cgrates/failed_posts",
+				FailedPostsDir:  "/var/spool/cgrates/failed_posts",
+				RetryQueueDir:   utils.MetaNone,
+				RetryBackoff:    time.Second,
+				RetryMaxBackoff: 5 * time.Minute,
 			},
 		},
 	}
@@ -636,6 +674,16 @@ func TestEEsCfgloadFromJsonCfgCase1(t *testing.T) {
 				TTL:       time.Second,
 				StaticTTL: false,
 			},
+			utils.MetaFileParquet: {
+				Limit:     -1,
+				TTL:       5 * time.Second,
+				StaticTTL: false,
+			},
+			utils.MetaFileAvro: {
+				Limit:     -1,
+				TTL:       5 * time.Second,
+				StaticTTL: false,
+			},
 		},
 		Exporters: []*EventExporterCfg{
 			{
@@ -658,9 +706,13 @@ func TestEEsCfgloadFromJsonCfgCase1(t *testing.T) {
 					Kafka: &KafkaOpts{},
 					RPC:   &RPCOpts{},
 					Els:   &ElsOpts{},
+					MQTT:  &MQTTOpts{},
 					NATS:  &NATSOpts{},
 				},
-				FailedPostsDir: "/var/spool/cgrates/failed_posts",
+				FailedPostsDir:  "/var/spool/cgrates/failed_posts",
+				RetryQueueDir:   utils.MetaNone,
+				RetryBackoff:    time.Second,
+				RetryMaxBackoff: 5 * time.Minute,
 			},
 			{
 				ID:            "CSVExporter",
@@ -690,12 +742,16 @@ func TestEEsCfgloadFromJsonCfgCase1(t *testing.T) {
 					Kafka: &KafkaOpts{},
 					RPC:   &RPCOpts{},
 					Els:   &ElsOpts{},
+					MQTT:  &MQTTOpts{},
 					NATS:  &NATSOpts{},
 				},
 				Fields: []*FCTemplate{
 					{Tag: utils.CGRID, Path: "*exp.CGRID", Type: utils.MetaVariable, Value: NewRSRParsersMustCompile("~*req.CGRID", utils.InfieldSep), Layout: time.RFC3339},
 				},
-				FailedPostsDir: "/var/spool/cgrates/failed_posts",
+				FailedPostsDir:  "/var/spool/cgrates/failed_posts",
+				RetryQueueDir:   utils.MetaNone,
+				RetryBackoff:    time.Second,
+				RetryMaxBackoff: 5 * time.Minute,
 			},
 		},
 	}
@@ -765,6 +821,16 @@ func TestEEsCfgloadFromJsonCfgCase2(t *testing.T) {
 				TTL:       time.Second,
 				StaticTTL: false,
 			},
+			utils.MetaFileParquet: {
+				Limit:     -1,
+				TTL:       5 * time.Second,
+				StaticTTL: false,
+			},
+			utils.MetaFileAvro: {
+				Limit:     -1,
+				TTL:       5 * time.Second,
+				StaticTTL: false,
+			},
 		},
 		Exporters: []*EventExporterCfg{
 			{
@@ -786,10 +852,14 @@ func TestEEsCfgloadFromJsonCfgCase2(t *testing.T) {
 					AMQP:  &AMQPOpts{},
 					SQL:   &SQLOpts{},
 					AWS:   &AWSOpts{},
+					MQTT:  &MQTTOpts{},
 					NATS:  &NATSOpts{},
 					RPC:   &RPCOpts{},
 				},
-				FailedPostsDir: "/var/spool/cgrates/failed_posts",
+				FailedPostsDir:  "/var/spool/cgrates/failed_posts",
+				RetryQueueDir:   utils.MetaNone,
+				RetryBackoff:    time.Second,
+				RetryMaxBackoff: 5 * time.Minute,
 			},
 			{
 				ID:            "CSVExporter",
@@ -812,7 +882,10 @@ func TestEEsCfgloadFromJsonCfgCase2(t *testing.T) {
 						Layout: time.RFC3339,
 					},
 				},
-				FailedPostsDir: "/var/spool/cgrates/failed_posts",
+				FailedPostsDir:  "/var/spool/cgrates/failed_posts",
+				RetryQueueDir:   utils.MetaNone,
+				RetryBackoff:    time.Second,
+				RetryMaxBackoff: 5 * time.Minute,
 				Opts: &EventExporterOpts{
 					AMQP:  &AMQPOpts{},
 					AWS:   &AWSOpts{},
@@ -820,6 +893,7 @@ func TestEEsCfgloadFromJsonCfgCase2(t *testing.T) {
 					Kafka: &KafkaOpts{},
 					RPC:   &RPCOpts{},
 					Els:   &ElsOpts{},
+					MQTT:  &MQTTOpts{},
 					NATS:  &NATSOpts{},
 				},
 				Fields: []*FCTemplate{
@@ -928,6 +1002,7 @@ func TestEEsCfgAsMapInterface(t *testing.T) {
 					"rpcConnTimeout":"1m",
 					"rpcReplyTimeout":"1m",
 					"csvFieldSeparator":",",
+					"rowGroupSize":5000,
 					"mysqlDSNParams":{
 						"key":"param",
 					},	
@@ -961,6 +1036,22 @@ func TestEEsCfgAsMapInterface(t *testing.T) {
 				utils.TTLCfg:       "1s",
 				utils.StaticTTLCfg: false,
 			},
+			utils.MetaFileParquet: map[string]any{
+				utils.LimitCfg:     -1,
+				utils.PrecacheCfg:  false,
+				utils.ReplicateCfg: false,
+				utils.RemoteCfg:    false,
+				utils.TTLCfg:       "5s",
+				utils.StaticTTLCfg: false,
+			},
+			utils.MetaFileAvro: map[string]any{
+				utils.LimitCfg:     -1,
+				utils.PrecacheCfg:  false,
+				utils.ReplicateCfg: false,
+				utils.RemoteCfg:    false,
+				utils.TTLCfg:       "5s",
+				utils.StaticTTLCfg: false,
+			},
 		},
 		utils.ExportersCfg: []map[string]any{
 			{
@@ -1011,6 +1102,7 @@ func TestEEsCfgAsMapInterface(t *testing.T) {
 					utils.RpcConnTimeout:           "1m0s",
 					utils.RpcReplyTimeout:          "1m0s",
 					utils.CSVFieldSepOpt:           ",",
+					utils.RowGroupSizeOpt:          5000,
 					utils.MYSQLDSNParams: map[string]string{
 						"key": "param",
 					},
@@ -1034,7 +1126,10 @@ func TestEEsCfgAsMapInterface(t *testing.T) {
 						utils.ValueCfg: "~*req.CGRID",
 					},
 				},
-				utils.FailedPostsDirCfg: "/var/spool/cgrates/failed_posts",
+				utils.FailedPostsDirCfg:  "/var/spool/cgrates/failed_posts",
+				utils.RetryQueueDirCfg:   utils.MetaNone,
+				utils.RetryBackoffCfg:    "1s",
+				utils.RetryMaxBackoffCfg: "5m0s",
 			},
 		},
 	}
@@ -1302,6 +1397,9 @@ func TestEventExporterCfgAsMapInterface(t *testing.T) {
 		utils.AttemptsCfg:           eeC.Attempts,
 		utils.ConcurrentRequestsCfg: eeC.ConcurrentRequests,
 		utils.FailedPostsDirCfg:     eeC.FailedPostsDir,
+		utils.RetryQueueDirCfg:      eeC.RetryQueueDir,
+		utils.RetryBackoffCfg:       "0s",
+		utils.RetryMaxBackoffCfg:    "0s",
 		utils.OptsCfg:               opts,
 	}
 	rcv := eeC.AsMapInterface("")
@@ -1406,3 +1504,114 @@ func TestKafkaOptsClone(t *testing.T) {
 		t.Errorf("Expected cloned CAPath to be separate, got %s", *clonedOpts.CAPath)
 	}
 }
+
+func TestKafkaOptsSASLKeyHeaders(t *testing.T) {
+	jsonCfg := &EventExporterOptsJson{
+		KafkaSASLMechanism: utils.StringPointer(utils.KafkaSASLPlain),
+		KafkaSASLUsername:  utils.StringPointer("user"),
+		KafkaSASLPassword:  utils.StringPointer("pass"),
+		KafkaKeyField:      utils.StringPointer(utils.AccountField),
+		KafkaHeaders:       &[]string{"Origin", "Node"},
+	}
+	kafkaOpts := &KafkaOpts{}
+	if err := kafkaOpts.loadFromJSONCfg(jsonCfg); err != nil {
+		t.Fatal(err)
+	}
+	exp := &KafkaOpts{
+		SASLMechanism: utils.StringPointer(utils.KafkaSASLPlain),
+		SASLUsername:  utils.StringPointer("user"),
+		SASLPassword:  utils.StringPointer("pass"),
+		KeyField:      utils.StringPointer(utils.AccountField),
+		Headers:       &[]string{"Origin", "Node"},
+	}
+	if !reflect.DeepEqual(kafkaOpts, exp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(kafkaOpts))
+	}
+	cln := kafkaOpts.Clone()
+	if !reflect.DeepEqual(cln, exp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(cln))
+	}
+	(*kafkaOpts.Headers)[0] = "Modified"
+	if (*cln.Headers)[0] != "Origin" {
+		t.Errorf("expected cloned headers to be separate, got %v", *cln.Headers)
+	}
+
+	eeCfg := &EventExporterCfg{
+		Opts: &EventExporterOpts{
+			Kafka: cln,
+		},
+	}
+	expMp := map[string]any{
+		utils.KafkaSASLMechanism: utils.KafkaSASLPlain,
+		utils.KafkaSASLUsername:  "user",
+		utils.KafkaSASLPassword:  "pass",
+		utils.KafkaKeyField:      utils.AccountField,
+		utils.KafkaHeaders:       []string{"Origin", "Node"},
+	}
+	if rcv := eeCfg.AsMapInterface(utils.InfieldSep)[utils.OptsCfg]; !reflect.DeepEqual(rcv, expMp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(expMp), utils.ToJSON(rcv))
+	}
+}
+
+func TestMQTTOptsLoadCloneAsMap(t *testing.T) {
+	jsonCfg := &EventExporterOptsJson{
+		MQTTTopic:                utils.StringPointer("cdrs"),
+		MQTTQoS:                  utils.IntPointer(1),
+		MQTTClientID:             utils.StringPointer("cgr_ee"),
+		MQTTUsername:             utils.StringPointer("user"),
+		MQTTPassword:             utils.StringPointer("pass"),
+		MQTTRetained:             utils.BoolPointer(true),
+		MQTTCertificateAuthority: utils.StringPointer("/ca.pem"),
+		MQTTClientCertificate:    utils.StringPointer("/cert.pem"),
+		MQTTClientKey:            utils.StringPointer("/key.pem"),
+		MQTTSkipTLSVerify:        utils.BoolPointer(false),
+	}
+	mqttOpts := &MQTTOpts{}
+	if err := mqttOpts.loadFromJSONCfg(jsonCfg); err != nil {
+		t.Fatal(err)
+	}
+	exp := &MQTTOpts{
+		Topic:                utils.StringPointer("cdrs"),
+		QoS:                  utils.IntPointer(1),
+		ClientID:             utils.StringPointer("cgr_ee"),
+		Username:             utils.StringPointer("user"),
+		Password:             utils.StringPointer("pass"),
+		Retained:             utils.BoolPointer(true),
+		CertificateAuthority: utils.StringPointer("/ca.pem"),
+		ClientCertificate:    utils.StringPointer("/cert.pem"),
+		ClientKey:            utils.StringPointer("/key.pem"),
+		SkipTLSVerify:        utils.BoolPointer(false),
+	}
+	if !reflect.DeepEqual(mqttOpts, exp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(mqttOpts))
+	}
+	cln := mqttOpts.Clone()
+	if !reflect.DeepEqual(cln, exp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(cln))
+	}
+	*mqttOpts.Topic = "modified"
+	if *cln.Topic != "cdrs" {
+		t.Errorf("expected cloned topic to be separate, got %s", *cln.Topic)
+	}
+
+	eeCfg := &EventExporterCfg{
+		Opts: &EventExporterOpts{
+			MQTT: cln,
+		},
+	}
+	expMp := map[string]any{
+		utils.MQTTTopic:                "cdrs",
+		utils.MQTTQoS:                  1,
+		utils.MQTTClientID:             "cgr_ee",
+		utils.MQTTUsername:             "user",
+		utils.MQTTPassword:             "pass",
+		utils.MQTTRetained:             true,
+		utils.MQTTCertificateAuthority: "/ca.pem",
+		utils.MQTTClientCertificate:    "/cert.pem",
+		utils.MQTTClientKey:            "/key.pem",
+		utils.MQTTSkipTLSVerify:        false,
+	}
+	if rcv := eeCfg.AsMapInterface(utils.InfieldSep)[utils.OptsCfg]; !reflect.DeepEqual(rcv, expMp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(expMp), utils.ToJSON(rcv))
+	}
+}
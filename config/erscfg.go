@@ -227,6 +227,9 @@ type KafkaROpts struct {
 	TLS           *bool
 	CAPath        *string
 	SkipTLSVerify *bool
+	SASLMechanism *string
+	SASLUsername  *string
+	SASLPassword  *string
 }
 
 func (kafkaROpts *KafkaROpts) loadFromJSONCfg(jsnCfg *EventReaderOptsJson) (err error) {
@@ -252,6 +255,15 @@ func (kafkaROpts *KafkaROpts) loadFromJSONCfg(jsnCfg *EventReaderOptsJson) (err
 	if jsnCfg.KafkaSkipTLSVerify != nil {
 		kafkaROpts.SkipTLSVerify = jsnCfg.KafkaSkipTLSVerify
 	}
+	if jsnCfg.KafkaSASLMechanism != nil {
+		kafkaROpts.SASLMechanism = jsnCfg.KafkaSASLMechanism
+	}
+	if jsnCfg.KafkaSASLUsername != nil {
+		kafkaROpts.SASLUsername = jsnCfg.KafkaSASLUsername
+	}
+	if jsnCfg.KafkaSASLPassword != nil {
+		kafkaROpts.SASLPassword = jsnCfg.KafkaSASLPassword
+	}
 	return
 }
 
@@ -367,6 +379,53 @@ func (natsOpts *NATSROpts) loadFromJSONCfg(jsnCfg *EventReaderOptsJson) (err err
 	return
 }
 
+type MQTTROpts struct {
+	Topic                *string
+	QoS                  *int
+	ClientID             *string
+	Username             *string
+	Password             *string
+	SharedGroup          *string
+	CertificateAuthority *string
+	ClientCertificate    *string
+	ClientKey            *string
+	SkipTLSVerify        *bool
+}
+
+func (mqttOpts *MQTTROpts) loadFromJSONCfg(jsnCfg *EventReaderOptsJson) (err error) {
+	if jsnCfg.MQTTTopic != nil {
+		mqttOpts.Topic = jsnCfg.MQTTTopic
+	}
+	if jsnCfg.MQTTQoS != nil {
+		mqttOpts.QoS = jsnCfg.MQTTQoS
+	}
+	if jsnCfg.MQTTClientID != nil {
+		mqttOpts.ClientID = jsnCfg.MQTTClientID
+	}
+	if jsnCfg.MQTTUsername != nil {
+		mqttOpts.Username = jsnCfg.MQTTUsername
+	}
+	if jsnCfg.MQTTPassword != nil {
+		mqttOpts.Password = jsnCfg.MQTTPassword
+	}
+	if jsnCfg.MQTTSharedGroup != nil {
+		mqttOpts.SharedGroup = jsnCfg.MQTTSharedGroup
+	}
+	if jsnCfg.MQTTCertificateAuthority != nil {
+		mqttOpts.CertificateAuthority = jsnCfg.MQTTCertificateAuthority
+	}
+	if jsnCfg.MQTTClientCertificate != nil {
+		mqttOpts.ClientCertificate = jsnCfg.MQTTClientCertificate
+	}
+	if jsnCfg.MQTTClientKey != nil {
+		mqttOpts.ClientKey = jsnCfg.MQTTClientKey
+	}
+	if jsnCfg.MQTTSkipTLSVerify != nil {
+		mqttOpts.SkipTLSVerify = jsnCfg.MQTTSkipTLSVerify
+	}
+	return
+}
+
 type CSVROpts struct {
 	PartialCSVFieldSeparator *string
 	RowLength                *int
@@ -403,6 +462,7 @@ type EventReaderOpts struct {
 	AMQP               *AMQPROpts
 	AWS                *AWSROpts
 	NATS               *NATSROpts
+	MQTT               *MQTTROpts
 	Kafka              *KafkaROpts
 	SQL                *SQLROpts
 }
@@ -453,6 +513,9 @@ func (erOpts *EventReaderOpts) loadFromJSONCfg(jsnCfg *EventReaderOptsJson) (err
 	if err = erOpts.NATS.loadFromJSONCfg(jsnCfg); err != nil {
 		return
 	}
+	if err = erOpts.MQTT.loadFromJSONCfg(jsnCfg); err != nil {
+		return
+	}
 	if err = erOpts.SQL.loadFromJSONCfg(jsnCfg); err != nil {
 		return
 	}
@@ -653,6 +716,18 @@ func (kafkaOpts *KafkaROpts) Clone() *KafkaROpts {
 		cln.SkipTLSVerify = new(bool)
 		*cln.SkipTLSVerify = *kafkaOpts.SkipTLSVerify
 	}
+	if kafkaOpts.SASLMechanism != nil {
+		cln.SASLMechanism = new(string)
+		*cln.SASLMechanism = *kafkaOpts.SASLMechanism
+	}
+	if kafkaOpts.SASLUsername != nil {
+		cln.SASLUsername = new(string)
+		*cln.SASLUsername = *kafkaOpts.SASLUsername
+	}
+	if kafkaOpts.SASLPassword != nil {
+		cln.SASLPassword = new(string)
+		*cln.SASLPassword = *kafkaOpts.SASLPassword
+	}
 	return cln
 }
 
@@ -755,6 +830,51 @@ func (natOpts *NATSROpts) Clone() *NATSROpts {
 	return cln
 }
 
+func (mqttOpts *MQTTROpts) Clone() *MQTTROpts {
+	cln := &MQTTROpts{}
+	if mqttOpts.Topic != nil {
+		cln.Topic = new(string)
+		*cln.Topic = *mqttOpts.Topic
+	}
+	if mqttOpts.QoS != nil {
+		cln.QoS = new(int)
+		*cln.QoS = *mqttOpts.QoS
+	}
+	if mqttOpts.ClientID != nil {
+		cln.ClientID = new(string)
+		*cln.ClientID = *mqttOpts.ClientID
+	}
+	if mqttOpts.Username != nil {
+		cln.Username = new(string)
+		*cln.Username = *mqttOpts.Username
+	}
+	if mqttOpts.Password != nil {
+		cln.Password = new(string)
+		*cln.Password = *mqttOpts.Password
+	}
+	if mqttOpts.SharedGroup != nil {
+		cln.SharedGroup = new(string)
+		*cln.SharedGroup = *mqttOpts.SharedGroup
+	}
+	if mqttOpts.CertificateAuthority != nil {
+		cln.CertificateAuthority = new(string)
+		*cln.CertificateAuthority = *mqttOpts.CertificateAuthority
+	}
+	if mqttOpts.ClientCertificate != nil {
+		cln.ClientCertificate = new(string)
+		*cln.ClientCertificate = *mqttOpts.ClientCertificate
+	}
+	if mqttOpts.ClientKey != nil {
+		cln.ClientKey = new(string)
+		*cln.ClientKey = *mqttOpts.ClientKey
+	}
+	if mqttOpts.SkipTLSVerify != nil {
+		cln.SkipTLSVerify = new(bool)
+		*cln.SkipTLSVerify = *mqttOpts.SkipTLSVerify
+	}
+	return cln
+}
+
 func (erOpts *EventReaderOpts) Clone() *EventReaderOpts {
 	cln := &EventReaderOpts{}
 	if erOpts.PartialPath != nil {
@@ -782,6 +902,9 @@ func (erOpts *EventReaderOpts) Clone() *EventReaderOpts {
 	if erOpts.NATS != nil {
 		cln.NATS = erOpts.NATS.Clone()
 	}
+	if erOpts.MQTT != nil {
+		cln.MQTT = erOpts.MQTT.Clone()
+	}
 	if erOpts.Kafka != nil {
 		cln.Kafka = erOpts.Kafka.Clone()
 	}
@@ -913,6 +1036,15 @@ func (er *EventReaderCfg) AsMapInterface(separator string) (initialMP map[string
 		if kafkaOpts.SkipTLSVerify != nil {
 			opts[utils.KafkaSkipTLSVerify] = *kafkaOpts.SkipTLSVerify
 		}
+		if kafkaOpts.SASLMechanism != nil {
+			opts[utils.KafkaSASLMechanism] = *kafkaOpts.SASLMechanism
+		}
+		if kafkaOpts.SASLUsername != nil {
+			opts[utils.KafkaSASLUsername] = *kafkaOpts.SASLUsername
+		}
+		if kafkaOpts.SASLPassword != nil {
+			opts[utils.KafkaSASLPassword] = *kafkaOpts.SASLPassword
+		}
 	}
 
 	if sqlOpts := er.Opts.SQL; sqlOpts != nil {
@@ -988,6 +1120,39 @@ func (er *EventReaderCfg) AsMapInterface(separator string) (initialMP map[string
 			opts[utils.NatsJetStreamMaxWait] = natsOpts.JetStreamMaxWait.String()
 		}
 	}
+
+	if mqttOpts := er.Opts.MQTT; mqttOpts != nil {
+		if mqttOpts.Topic != nil {
+			opts[utils.MQTTTopic] = *mqttOpts.Topic
+		}
+		if mqttOpts.QoS != nil {
+			opts[utils.MQTTQoS] = *mqttOpts.QoS
+		}
+		if mqttOpts.ClientID != nil {
+			opts[utils.MQTTClientID] = *mqttOpts.ClientID
+		}
+		if mqttOpts.Username != nil {
+			opts[utils.MQTTUsername] = *mqttOpts.Username
+		}
+		if mqttOpts.Password != nil {
+			opts[utils.MQTTPassword] = *mqttOpts.Password
+		}
+		if mqttOpts.SharedGroup != nil {
+			opts[utils.MQTTSharedGroup] = *mqttOpts.SharedGroup
+		}
+		if mqttOpts.CertificateAuthority != nil {
+			opts[utils.MQTTCertificateAuthority] = *mqttOpts.CertificateAuthority
+		}
+		if mqttOpts.ClientCertificate != nil {
+			opts[utils.MQTTClientCertificate] = *mqttOpts.ClientCertificate
+		}
+		if mqttOpts.ClientKey != nil {
+			opts[utils.MQTTClientKey] = *mqttOpts.ClientKey
+		}
+		if mqttOpts.SkipTLSVerify != nil {
+			opts[utils.MQTTSkipTLSVerify] = *mqttOpts.SkipTLSVerify
+		}
+	}
 	initialMP = map[string]any{
 		utils.IDCfg:                   er.ID,
 		utils.TypeCfg:                 er.Type,
@@ -118,6 +118,7 @@ func TestERSClone(t *testing.T) {
 					Kafka:              &KafkaROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -161,6 +162,7 @@ func TestERSClone(t *testing.T) {
 					Kafka:              &KafkaROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -294,6 +296,7 @@ func TestERSLoadFromjsonCfg(t *testing.T) {
 					SQL:                &SQLROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -352,6 +355,7 @@ func TestERSLoadFromjsonCfg(t *testing.T) {
 					Kafka:              &KafkaROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -550,6 +554,7 @@ func TestERSloadFromJsonCase3(t *testing.T) {
 					SQL:                &SQLROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -592,6 +597,7 @@ func TestERSloadFromJsonCase3(t *testing.T) {
 					Kafka:              &KafkaROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -711,6 +717,7 @@ func TestERSloadFromJsonCase4(t *testing.T) {
 					SQL:                &SQLROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -753,6 +760,7 @@ func TestERSloadFromJsonCase4(t *testing.T) {
 					Kafka:              &KafkaROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -865,6 +873,7 @@ func TestEventReaderSameID(t *testing.T) {
 					SQL:                &SQLROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -903,6 +912,7 @@ func TestEventReaderSameID(t *testing.T) {
 					Kafka:              &KafkaROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -1343,6 +1353,7 @@ func TestERsloadFromJsonCfg(t *testing.T) {
 					SQL:                &SQLROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -1387,6 +1398,7 @@ func TestERsloadFromJsonCfg(t *testing.T) {
 					Kafka:              &KafkaROpts{},
 					PartialOrderField:  utils.StringPointer("~*req.AnswerTime"),
 					PartialCacheAction: utils.StringPointer(utils.MetaNone),
+					MQTT:               &MQTTROpts{},
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
@@ -1452,6 +1464,7 @@ func TestEventReaderOptsCfg(t *testing.T) {
 			CSV:   &CSVROpts{},
 			AMQP:  &AMQPROpts{},
 			AWS:   &AWSROpts{},
+			MQTT:  &MQTTROpts{},
 			NATS:  &NATSROpts{},
 			Kafka: &KafkaROpts{},
 			SQL:   &SQLROpts{},
@@ -1521,6 +1534,7 @@ func TestEventReaderCfgClone(t *testing.T) {
 				SQSQueueID: utils.StringPointer("SQSQueue"),
 				S3BucketID: utils.StringPointer("S3BucketID"),
 			},
+			MQTT: &MQTTROpts{},
 			NATS: &NATSROpts{
 				JetStream:            utils.BoolPointer(false),
 				ConsumerName:         utils.StringPointer("user"),
@@ -1920,3 +1934,51 @@ func TestKafkaROptsClone(t *testing.T) {
 		t.Errorf("Expected cloned CAPath to be separate, got %s", *clonedOpts.CAPath)
 	}
 }
+
+func TestKafkaROptsSASL(t *testing.T) {
+	jsonCfg := &EventReaderOptsJson{
+		KafkaSASLMechanism: utils.StringPointer(utils.KafkaSASLScramSHA256),
+		KafkaSASLUsername:  utils.StringPointer("user"),
+		KafkaSASLPassword:  utils.StringPointer("pass"),
+	}
+	kafkaOpts := &KafkaROpts{}
+	if err := kafkaOpts.loadFromJSONCfg(jsonCfg); err != nil {
+		t.Fatal(err)
+	}
+	exp := &KafkaROpts{
+		SASLMechanism: utils.StringPointer(utils.KafkaSASLScramSHA256),
+		SASLUsername:  utils.StringPointer("user"),
+		SASLPassword:  utils.StringPointer("pass"),
+	}
+	if !reflect.DeepEqual(kafkaOpts, exp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(kafkaOpts))
+	}
+	if cln := kafkaOpts.Clone(); !reflect.DeepEqual(cln, exp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(cln))
+	}
+}
+
+func TestMQTTROptsLoadClone(t *testing.T) {
+	jsonCfg := &EventReaderOptsJson{
+		MQTTTopic:       utils.StringPointer("usage/#"),
+		MQTTQoS:         utils.IntPointer(1),
+		MQTTClientID:    utils.StringPointer("cgr_er"),
+		MQTTSharedGroup: utils.StringPointer("cgr"),
+	}
+	mqttOpts := &MQTTROpts{}
+	if err := mqttOpts.loadFromJSONCfg(jsonCfg); err != nil {
+		t.Fatal(err)
+	}
+	exp := &MQTTROpts{
+		Topic:       utils.StringPointer("usage/#"),
+		QoS:         utils.IntPointer(1),
+		ClientID:    utils.StringPointer("cgr_er"),
+		SharedGroup: utils.StringPointer("cgr"),
+	}
+	if !reflect.DeepEqual(mqttOpts, exp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(mqttOpts))
+	}
+	if cln := mqttOpts.Clone(); !reflect.DeepEqual(cln, exp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(cln))
+	}
+}
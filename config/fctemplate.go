@@ -91,6 +91,9 @@ func NewFCTemplateFromFCTemplateJSONCfg(jsnCfg *FcTemplateJsonCfg, separator str
 	if jsnCfg.Mask_length != nil {
 		fcTmp.MaskLen = *jsnCfg.Mask_length
 	}
+	if jsnCfg.Value_type != nil {
+		fcTmp.ValueType = *jsnCfg.Value_type
+	}
 	return fcTmp, nil
 }
 
@@ -114,6 +117,7 @@ type FCTemplate struct {
 	RoundingDecimals *int
 	MaskDestID       string
 	MaskLen          int
+	ValueType        string   // column type used by the typed file exporters
 	pathSlice        []string // Field identifier
 }
 
@@ -179,6 +183,7 @@ func (fc FCTemplate) Clone() (cln *FCTemplate) {
 		CostShiftDigits: fc.CostShiftDigits,
 		MaskDestID:      fc.MaskDestID,
 		MaskLen:         fc.MaskLen,
+		ValueType:       fc.ValueType,
 	}
 	if fc.RoundingDecimals != nil {
 		cln.RoundingDecimals = new(int)
@@ -265,6 +270,9 @@ func (fc *FCTemplate) AsMapInterface(separator string) (mp map[string]any) {
 	if fc.MaskLen != 0 {
 		mp[utils.MaskLenCfg] = fc.MaskLen
 	}
+	if fc.ValueType != utils.EmptyString {
+		mp[utils.ValueTypeCfg] = fc.ValueType
+	}
 	return
 }
 
@@ -339,6 +339,7 @@ func TestFCTemplateClone(t *testing.T) {
 		Filters:          []string{"Filter1", "Filter2"},
 		Value:            NewRSRParsersMustCompile("cgrates.org", utils.InfieldSep),
 		RoundingDecimals: utils.IntPointer(2),
+		ValueType:        utils.MetaFloat64,
 	}
 	smpl.ComputePath()
 	cloned := smpl.Clone()
@@ -352,6 +353,7 @@ func TestFCTemplateClone(t *testing.T) {
 		Filters:          []string{"Filter1", "Filter2"},
 		Value:            NewRSRParsersMustCompile("cgrates.org", utils.InfieldSep),
 		RoundingDecimals: utils.IntPointer(2),
+		ValueType:        utils.MetaFloat64,
 	}
 	initialSmpl.ComputePath()
 	smpl.Filters = []string{"SingleFilter"}
@@ -384,6 +386,7 @@ func TestFCTemplateAsMapInterface(t *testing.T) {
                 "rounding_decimals": 1,
                 "mask_destinationd_id": "randomVal",
                 "mask_length": 10,
+                "value_type": "*int64",
             },
            ],
      }
@@ -409,6 +412,7 @@ func TestFCTemplateAsMapInterface(t *testing.T) {
 				utils.RoundingDecimalsCfg: 1,
 				utils.MaskDestIDCfg:       "randomVal",
 				utils.MaskLenCfg:          10,
+				utils.ValueTypeCfg:        "*int64",
 			},
 		},
 	}
@@ -27,9 +27,12 @@ import (
 
 // GeneralCfg is the general config section
 type GeneralCfg struct {
-	NodeID               string        // Identifier for this engine instance
-	Logger               string        // dictates the way logs are displayed/stored
-	LogLevel             int           // system wide log level, nothing higher than this will be logged
+	NodeID               string         // Identifier for this engine instance
+	Logger               string         // dictates the way logs are displayed/stored
+	LogLevel             int            // system wide log level, nothing higher than this will be logged
+	LogLevels            map[string]int // log level overwrites per subsystem
+	LoggerEEsConns       []string       // the conns used by the *ees logger
+	LoggerEEsExpIDs      []string
 	RoundingDecimals     int           // Number of decimals to round end prices at
 	DBDataEncoding       string        // The encoding used to store object data in strings: <msgpack|json>
 	TpExportPath         string        // Path towards export folder for offline Tariff Plans
@@ -68,6 +71,26 @@ func (gencfg *GeneralCfg) loadFromJSONCfg(jsnGeneralCfg *GeneralJsonCfg) (err er
 	if jsnGeneralCfg.Log_level != nil {
 		gencfg.LogLevel = *jsnGeneralCfg.Log_level
 	}
+	if jsnGeneralCfg.Log_levels != nil {
+		if gencfg.LogLevels == nil {
+			gencfg.LogLevels = make(map[string]int)
+		}
+		for subsystem, lvl := range jsnGeneralCfg.Log_levels {
+			gencfg.LogLevels[subsystem] = lvl
+		}
+	}
+	if jsnGeneralCfg.Logger_ees_conns != nil {
+		gencfg.LoggerEEsConns = make([]string, len(*jsnGeneralCfg.Logger_ees_conns))
+		for idx, connID := range *jsnGeneralCfg.Logger_ees_conns {
+			gencfg.LoggerEEsConns[idx] = connID
+			if connID == utils.MetaInternal {
+				gencfg.LoggerEEsConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)
+			}
+		}
+	}
+	if jsnGeneralCfg.Logger_exporter_ids != nil {
+		gencfg.LoggerEEsExpIDs = append(gencfg.LoggerEEsExpIDs, *jsnGeneralCfg.Logger_exporter_ids...)
+	}
 
 	if jsnGeneralCfg.Dbdata_encoding != nil {
 		gencfg.DBDataEncoding = strings.TrimPrefix(*jsnGeneralCfg.Dbdata_encoding, "*")
@@ -204,12 +227,28 @@ func (gencfg *GeneralCfg) AsMapInterface() (initialMP map[string]any) {
 	if gencfg.CachingDelay != 0 {
 		initialMP[utils.CachingDlayCfg] = gencfg.CachingDelay.String()
 	}
+	logLevels := make(map[string]any, len(gencfg.LogLevels))
+	for subsystem, lvl := range gencfg.LogLevels {
+		logLevels[subsystem] = lvl
+	}
+	initialMP[utils.LogLevelsCfg] = logLevels
+	loggerEEsConns := make([]string, len(gencfg.LoggerEEsConns))
+	for i, item := range gencfg.LoggerEEsConns {
+		loggerEEsConns[i] = item
+		if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs) {
+			loggerEEsConns[i] = utils.MetaInternal
+		}
+	}
+	initialMP[utils.LoggerEEsConnsCfg] = loggerEEsConns
+	loggerEEsExpIDs := make([]string, len(gencfg.LoggerEEsExpIDs))
+	copy(loggerEEsExpIDs, gencfg.LoggerEEsExpIDs)
+	initialMP[utils.LoggerExporterIDsCfg] = loggerEEsExpIDs
 	return
 }
 
 // Clone returns a deep copy of GeneralCfg
-func (gencfg GeneralCfg) Clone() *GeneralCfg {
-	return &GeneralCfg{
+func (gencfg GeneralCfg) Clone() (cln *GeneralCfg) {
+	cln = &GeneralCfg{
 		NodeID:               gencfg.NodeID,
 		Logger:               gencfg.Logger,
 		LogLevel:             gencfg.LogLevel,
@@ -235,4 +274,19 @@ func (gencfg GeneralCfg) Clone() *GeneralCfg {
 		RSRSep:               gencfg.RSRSep,
 		MaxParallelConns:     gencfg.MaxParallelConns,
 	}
+	if gencfg.LogLevels != nil {
+		cln.LogLevels = make(map[string]int, len(gencfg.LogLevels))
+		for subsystem, lvl := range gencfg.LogLevels {
+			cln.LogLevels[subsystem] = lvl
+		}
+	}
+	if gencfg.LoggerEEsConns != nil {
+		cln.LoggerEEsConns = make([]string, len(gencfg.LoggerEEsConns))
+		copy(cln.LoggerEEsConns, gencfg.LoggerEEsConns)
+	}
+	if gencfg.LoggerEEsExpIDs != nil {
+		cln.LoggerEEsExpIDs = make([]string, len(gencfg.LoggerEEsExpIDs))
+		copy(cln.LoggerEEsExpIDs, gencfg.LoggerEEsExpIDs)
+	}
+	return
 }
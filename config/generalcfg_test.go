@@ -44,12 +44,18 @@ func TestGeneralCfgloadFromJsonCfg(t *testing.T) {
 		Digest_separator:     utils.StringPointer(","),
 		Digest_equal:         utils.StringPointer(":"),
 		Failed_posts_ttl:     utils.StringPointer("2"),
+		Log_levels:           map[string]int{"SessionS": 7},
+		Logger_ees_conns:     &[]string{utils.MetaInternal},
+		Logger_exporter_ids:  &[]string{"exporter1"},
 	}
 
 	expected := &GeneralCfg{
 		NodeID:           "randomID",
 		Logger:           utils.MetaSysLog,
 		LogLevel:         6,
+		LogLevels:        map[string]int{"SessionS": 7},
+		LoggerEEsConns:   []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)},
+		LoggerEEsExpIDs:  []string{"exporter1"},
 		RoundingDecimals: 5,
 		DBDataEncoding:   "msgpack",
 		TpExportPath:     "/var/spool/cgrates/tpe",
@@ -126,6 +132,9 @@ func TestGeneralCfgAsMapInterface(t *testing.T) {
 			"node_id": "cgrates",											
 			"logger":"*syslog",										
 			"log_level": 6,											
+			"log_levels": {"SessionS": 7},
+			"logger_ees_conns": ["*internal"],
+			"logger_exporter_ids": ["exporter1"],
 			"rounding_decimals": 5,									
 			"dbdata_encoding": "*msgpack",							
 			"tpexport_dir": "/var/spool/cgrates/tpe",				
@@ -176,6 +185,9 @@ func TestGeneralCfgAsMapInterface(t *testing.T) {
 		utils.DigestEqualCfg:          ":",
 		utils.RSRSepCfg:               ";",
 		utils.MaxParallelConnsCfg:     100,
+		utils.LogLevelsCfg:            map[string]any{"SessionS": 7},
+		utils.LoggerEEsConnsCfg:       []string{utils.MetaInternal},
+		utils.LoggerExporterIDsCfg:    []string{"exporter1"},
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -221,6 +233,9 @@ func TestGeneralCfgAsMapInterface1(t *testing.T) {
 		utils.DigestEqualCfg:          ":",
 		utils.RSRSepCfg:               ";",
 		utils.MaxParallelConnsCfg:     100,
+		utils.LogLevelsCfg:            map[string]any{},
+		utils.LoggerEEsConnsCfg:       []string{},
+		utils.LoggerExporterIDsCfg:    []string{},
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -234,6 +249,9 @@ func TestGeneralCfgClone(t *testing.T) {
 		NodeID:           "randomID",
 		Logger:           utils.MetaSysLog,
 		LogLevel:         6,
+		LogLevels:        map[string]int{"SessionS": 7},
+		LoggerEEsConns:   []string{utils.MetaInternal},
+		LoggerEEsExpIDs:  []string{"exporter1"},
 		RoundingDecimals: 5,
 		DBDataEncoding:   "msgpack",
 		TpExportPath:     "/var/spool/cgrates/tpe",
@@ -261,6 +279,12 @@ func TestGeneralCfgClone(t *testing.T) {
 	if rcv.NodeID = ""; ban.NodeID != "randomID" {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
+	if rcv.LogLevels["SessionS"] = 3; ban.LogLevels["SessionS"] != 7 {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	if rcv.LoggerEEsConns[0] = ""; ban.LoggerEEsConns[0] != utils.MetaInternal {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
 }
 
 func TestCachingDelay(t *testing.T) {
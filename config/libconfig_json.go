@@ -27,6 +27,9 @@ type GeneralJsonCfg struct {
 	Node_id                *string
 	Logger                 *string
 	Log_level              *int
+	Log_levels             map[string]int
+	Logger_ees_conns       *[]string
+	Logger_exporter_ids    *[]string
 	Rounding_decimals      *int
 	Dbdata_encoding        *string
 	Tpexport_dir           *string
@@ -59,6 +62,7 @@ type ListenJsonCfg struct {
 	Rpc_json_tls *string
 	Rpc_gob_tls  *string
 	Http_tls     *string
+	Grpc         *string
 }
 
 type HTTPClientOptsJson struct {
@@ -132,6 +136,10 @@ type DBOptsJson struct {
 	PgSSLRootCert           *string           `json:"pgSSLRootCert"`
 	PgSchema                *string           `json:"pgSchema"`
 	MySQLLocation           *string           `json:"mysqlLocation"`
+
+	InternalDBDumpPath         *string `json:"internalDBDumpPath"`
+	InternalDBFsyncInterval    *string `json:"internalDBFsyncInterval"`
+	InternalDBSnapshotInterval *string `json:"internalDBSnapshotInterval"`
 }
 
 // Database config
@@ -149,6 +157,8 @@ type DbJsonCfg struct {
 	Replication_conns     *[]string
 	Replication_filtered  *bool
 	Replication_cache     *string
+	Ees_conns             *[]string
+	Ees_exporter_ids      *[]string
 	Items                 *map[string]*ItemOptJson
 	Opts                  *DBOptsJson
 }
@@ -185,6 +195,7 @@ type RalsJsonCfg struct {
 	Max_increments             *int
 	Fallback_depth             *int
 	Balance_rating_subject     *map[string]string
+	Decimal_balances           *bool
 }
 
 // Scheduler config section
@@ -195,6 +206,9 @@ type SchedulerJsonCfg struct {
 	Stats_conns             *[]string
 	Filters                 *[]string
 	Dynaprepaid_actionplans *[]string
+	Leader_election         *bool
+	Lease_ttl               *string
+	Catchup_policy          *string
 }
 
 // Cdrs config section
@@ -246,6 +260,9 @@ type EventReaderOptsJson struct {
 	KafkaTLS                 *bool     `json:"kafkaTLS"`
 	KafkaCAPath              *string   `json:"kafkaCAPath"`
 	KafkaSkipTLSVerify       *bool     `json:"kafkaSkipTLSVerify"`
+	KafkaSASLMechanism       *string   `json:"kafkaSASLMechanism"`
+	KafkaSASLUsername        *string   `json:"kafkaSASLUsername"`
+	KafkaSASLPassword        *string   `json:"kafkaSASLPassword"`
 	SQLDBName                *string   `json:"sqlDBName"`
 	SQLTableName             *string   `json:"sqlTableName"`
 	SQLDeleteIndexedFields   *[]string `json:"sqlDeleteIndexedFields"`
@@ -267,6 +284,16 @@ type EventReaderOptsJson struct {
 	NATSClientCertificate    *string   `json:"natsClientCertificate"`
 	NATSClientKey            *string   `json:"natsClientKey"`
 	NATSJetStreamMaxWait     *string   `json:"natsJetStreamMaxWait"`
+	MQTTTopic                *string   `json:"mqttTopic"`
+	MQTTQoS                  *int      `json:"mqttQoS"`
+	MQTTClientID             *string   `json:"mqttClientID"`
+	MQTTUsername             *string   `json:"mqttUsername"`
+	MQTTPassword             *string   `json:"mqttPassword"`
+	MQTTSharedGroup          *string   `json:"mqttSharedGroup"`
+	MQTTCertificateAuthority *string   `json:"mqttCertificateAuthority"`
+	MQTTClientCertificate    *string   `json:"mqttClientCertificate"`
+	MQTTClientKey            *string   `json:"mqttClientKey"`
+	MQTTSkipTLSVerify        *bool     `json:"mqttSkipTLSVerify"`
 }
 
 // EventReaderSJsonCfg is the configuration of a single EventReader
@@ -302,6 +329,7 @@ type EEsJsonCfg struct {
 
 type EventExporterOptsJson struct {
 	CSVFieldSeparator           *string           `json:"csvFieldSeparator"`
+	RowGroupSize                *int              `json:"rowGroupSize"`
 	ElsCloud                    *bool             `json:"elsCloud"`
 	ElsAPIKey                   *string           `json:"elsApiKey"`
 	ElsServiceToken             *string           `json:"elsServiceToken"`
@@ -337,6 +365,11 @@ type EventExporterOptsJson struct {
 	KafkaTLS                    *bool             `json:"kafkaTLS"`
 	KafkaCAPath                 *string           `json:"kafkaCAPath"`
 	KafkaSkipTLSVerify          *bool             `json:"kafkaSkipTLSVerify"`
+	KafkaSASLMechanism          *string           `json:"kafkaSASLMechanism"`
+	KafkaSASLUsername           *string           `json:"kafkaSASLUsername"`
+	KafkaSASLPassword           *string           `json:"kafkaSASLPassword"`
+	KafkaKeyField               *string           `json:"kafkaKeyField"`
+	KafkaHeaders                *[]string         `json:"kafkaHeaders"`
 	AMQPQueueID                 *string           `json:"amqpQueueID"`
 	AMQPRoutingKey              *string           `json:"amqpRoutingKey"`
 	AMQPExchange                *string           `json:"amqpExchange"`
@@ -358,6 +391,16 @@ type EventExporterOptsJson struct {
 	NATSClientCertificate       *string           `json:"natsClientCertificate"`
 	NATSClientKey               *string           `json:"natsClientKey"`
 	NATSJetStreamMaxWait        *string           `json:"natsJetStreamMaxWait"`
+	MQTTTopic                   *string           `json:"mqttTopic"`
+	MQTTQoS                     *int              `json:"mqttQoS"`
+	MQTTClientID                *string           `json:"mqttClientID"`
+	MQTTUsername                *string           `json:"mqttUsername"`
+	MQTTPassword                *string           `json:"mqttPassword"`
+	MQTTRetained                *bool             `json:"mqttRetained"`
+	MQTTCertificateAuthority    *string           `json:"mqttCertificateAuthority"`
+	MQTTClientCertificate       *string           `json:"mqttClientCertificate"`
+	MQTTClientKey               *string           `json:"mqttClientKey"`
+	MQTTSkipTLSVerify           *bool             `json:"mqttSkipTLSVerify"`
 	RPCCodec                    *string           `json:"rpcCodec"`
 	ServiceMethod               *string           `json:"serviceMethod"`
 	KeyPath                     *string           `json:"keyPath"`
@@ -384,6 +427,9 @@ type EventExporterJsonCfg struct {
 	Synchronous         *bool
 	Attempts            *int
 	Failed_posts_dir    *string
+	Retry_queue_dir     *string
+	Retry_backoff       *string
+	Retry_max_backoff   *string
 	Concurrent_requests *int
 	Fields              *[]*FcTemplateJsonCfg
 }
@@ -528,8 +574,9 @@ type OsipsConnJsonCfg struct {
 // DiameterAgent configuration
 type DiameterAgentJsonCfg struct {
 	Enabled              *bool
-	Listen               *string
-	Listen_net           *string
+	Listen               *string // deprecated, replaced by Listeners
+	Listen_net           *string // deprecated, replaced by Listeners
+	Listeners            *[]*DiamListenerJsnCfg
 	Dictionaries_path    *string
 	Sessions_conns       *[]string
 	Origin_host          *string
@@ -540,9 +587,26 @@ type DiameterAgentJsonCfg struct {
 	Asr_template         *string
 	Rar_template         *string
 	Forced_disconnect    *string
+	Peers                *[]*DiameterPeerJsnCfg
+	Watchdog_interval    *string
+	Reconnect_interval   *string
+	Relay_timeout        *string
 	Request_processors   *[]*ReqProcessorJsnCfg
 }
 
+type DiamListenerJsnCfg struct {
+	Address *string
+	Network *string
+}
+
+type DiameterPeerJsnCfg struct {
+	Id              *string
+	Address         *string
+	Network         *string
+	Realm           *string
+	Application_ids *[]uint32
+}
+
 type RadiListenerJsnCfg struct {
 	Network      *string
 	Auth_Address *string
@@ -760,9 +824,23 @@ type LoaderJsonCfg struct {
 	Field_separator *string
 	Tp_in_dir       *string
 	Tp_out_dir      *string
+	Source_type     *string
+	Opts            *LoaderOptsJson
 	Data            *[]*LoaderJsonDataType
 }
 
+type LoaderOptsJson struct {
+	AWSRegion    *string              `json:"awsRegion"`
+	AWSKey       *string              `json:"awsKey"`
+	AWSSecret    *string              `json:"awsSecret"`
+	AWSToken     *string              `json:"awsToken"`
+	S3BucketID   *string              `json:"s3BucketID"`
+	S3FolderPath *string              `json:"s3FolderPath"`
+	SQLDBName    *string              `json:"sqlDBName"`
+	SQLColumns   *map[string][]string `json:"sqlColumns"`
+	PgSSLMode    *string              `json:"pgSSLMode"`
+}
+
 // Mailer config section
 type MailerJsonCfg struct {
 	Server        *string
@@ -811,6 +889,10 @@ type DispatcherSJsonCfg struct {
 	Attributes_conns      *[]string
 	Any_subsystem         *bool
 	Prevent_loop          *bool
+	Health_check_interval *string
+	Health_check_method   *string
+	Unhealthy_threshold   *int
+	Healthy_threshold     *int
 }
 
 type RegistrarCJsonCfg struct {
@@ -873,6 +955,7 @@ type FcTemplateJsonCfg struct {
 	Rounding_decimals    *int
 	Mask_destinationd_id *string
 	Mask_length          *int
+	Value_type           *string
 }
 
 // Analyzer service json config section
@@ -919,6 +1002,17 @@ type JanusAgentJsonCfg struct {
 	RequestProcessors *[]*ReqProcessorJsnCfg `json:"request_processors"`
 }
 
+type PrometheusAgentJsonCfg struct {
+	Enabled         *bool     `json:"enabled"`
+	Stats_conns     *[]string `json:"stats_conns"`
+	Stat_queue_ids  *[]string `json:"stat_queue_ids"`
+	Resources_conns *[]string `json:"resources_conns"`
+	Resource_ids    *[]string `json:"resource_ids"`
+	Trends_conns    *[]string `json:"trends_conns"`
+	Trend_ids       *[]string `json:"trend_ids"`
+	Ees_conns       *[]string `json:"ees_conns"`
+}
+
 type JanusConnJsonCfg struct {
 	Address       *string `json:"address"`
 	Type          *string `json:"type"`
@@ -952,4 +1046,17 @@ type CoreSJsonCfg struct {
 	Caps_strategy       *string
 	Caps_stats_interval *string
 	Shutdown_timeout    *string
+	Rate_limits         *[]*RateLimitJsonCfg
+}
+
+// RateLimitJsonCfg is the token bucket limit applied to the API requests
+type RateLimitJsonCfg struct {
+	Id             *string
+	Tenants        *[]string
+	Apis           *[]string
+	Per_tenant     *bool
+	Per_api        *bool
+	Per_connection *bool
+	Rate           *float64
+	Burst          *int
 }
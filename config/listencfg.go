@@ -28,6 +28,7 @@ type ListenCfg struct {
 	RPCJSONTLSListen string // RPC JSON TLS listening address
 	RPCGOBTLSListen  string // RPC GOB TLS listening address
 	HTTPTLSListen    string // HTTP TLS listening address
+	GRPCListen       string // gRPC listening address
 }
 
 // loadFromJSONCfg loads Database config from JsonCfg
@@ -53,6 +54,9 @@ func (lstcfg *ListenCfg) loadFromJSONCfg(jsnListenCfg *ListenJsonCfg) (err error
 	if jsnListenCfg.Http_tls != nil && *jsnListenCfg.Http_tls != "" {
 		lstcfg.HTTPTLSListen = *jsnListenCfg.Http_tls
 	}
+	if jsnListenCfg.Grpc != nil {
+		lstcfg.GRPCListen = *jsnListenCfg.Grpc
+	}
 	return nil
 }
 
@@ -65,6 +69,7 @@ func (lstcfg *ListenCfg) AsMapInterface() map[string]any {
 		utils.RPCJSONTLSListenCfg: lstcfg.RPCJSONTLSListen,
 		utils.RPCGOBTLSListenCfg:  lstcfg.RPCGOBTLSListen,
 		utils.HTTPTLSListenCfg:    lstcfg.HTTPTLSListen,
+		utils.GRPCListenCfg:       lstcfg.GRPCListen,
 	}
 }
 
@@ -77,5 +82,6 @@ func (lstcfg ListenCfg) Clone() *ListenCfg {
 		RPCJSONTLSListen: lstcfg.RPCJSONTLSListen,
 		RPCGOBTLSListen:  lstcfg.RPCGOBTLSListen,
 		HTTPTLSListen:    lstcfg.HTTPTLSListen,
+		GRPCListen:       lstcfg.GRPCListen,
 	}
 }
@@ -22,6 +22,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/cgrates/cgrates/utils"
@@ -33,6 +34,9 @@ func NewDfltLoaderSCfg() *LoaderSCfg {
 		return new(LoaderSCfg)
 	}
 	dfltVal := *dfltLoaderConfig
+	if dfltLoaderConfig.Opts != nil {
+		dfltVal.Opts = dfltLoaderConfig.Opts.Clone()
+	}
 	return &dfltVal
 }
 
@@ -79,9 +83,111 @@ type LoaderSCfg struct {
 	FieldSeparator string
 	TpInDir        string
 	TpOutDir       string
+	SourceType     string // <*local|*s3|*http|*sql>
+	Opts           *LoaderSOpts
 	Data           []*LoaderDataType
 }
 
+// LoaderSOpts are the connection options for the remote sources, shared with the ERs
+type LoaderSOpts struct {
+	AWS          *AWSROpts
+	S3FolderPath *string
+	SQL          *SQLROpts
+	SQLColumns   map[string][]string // the columns read out of each table, all of them if not defined
+}
+
+func (lOpts *LoaderSOpts) loadFromJSONCfg(jsnCfg *LoaderOptsJson) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if err = lOpts.AWS.loadFromJSONCfg(&EventReaderOptsJson{
+		AWSRegion:  jsnCfg.AWSRegion,
+		AWSKey:     jsnCfg.AWSKey,
+		AWSSecret:  jsnCfg.AWSSecret,
+		AWSToken:   jsnCfg.AWSToken,
+		S3BucketID: jsnCfg.S3BucketID,
+	}); err != nil {
+		return
+	}
+	if err = lOpts.SQL.loadFromJSONCfg(&EventReaderOptsJson{
+		SQLDBName: jsnCfg.SQLDBName,
+		PgSSLMode: jsnCfg.PgSSLMode,
+	}); err != nil {
+		return
+	}
+	if jsnCfg.S3FolderPath != nil {
+		lOpts.S3FolderPath = jsnCfg.S3FolderPath
+	}
+	if jsnCfg.SQLColumns != nil {
+		lOpts.SQLColumns = cloneSQLColumns(*jsnCfg.SQLColumns)
+	}
+	return
+}
+
+// cloneSQLColumns returns a deep copy of the columns configured per table
+func cloneSQLColumns(sqlCols map[string][]string) (cln map[string][]string) {
+	cln = make(map[string][]string, len(sqlCols))
+	for table, cols := range sqlCols {
+		cln[table] = slices.Clone(cols)
+	}
+	return
+}
+
+// AsMapInterface returns the options as a map[string]any
+func (lOpts *LoaderSOpts) AsMapInterface() (opts map[string]any) {
+	opts = make(map[string]any)
+	if awsOpts := lOpts.AWS; awsOpts != nil {
+		if awsOpts.Region != nil {
+			opts[utils.AWSRegion] = *awsOpts.Region
+		}
+		if awsOpts.Key != nil {
+			opts[utils.AWSKey] = *awsOpts.Key
+		}
+		if awsOpts.Secret != nil {
+			opts[utils.AWSSecret] = *awsOpts.Secret
+		}
+		if awsOpts.Token != nil {
+			opts[utils.AWSToken] = *awsOpts.Token
+		}
+		if awsOpts.S3BucketID != nil {
+			opts[utils.S3Bucket] = *awsOpts.S3BucketID
+		}
+	}
+	if lOpts.S3FolderPath != nil {
+		opts[utils.S3FolderPath] = *lOpts.S3FolderPath
+	}
+	if sqlOpts := lOpts.SQL; sqlOpts != nil {
+		if sqlOpts.DBName != nil {
+			opts[utils.SQLDBNameOpt] = *sqlOpts.DBName
+		}
+		if sqlOpts.PgSSLMode != nil {
+			opts[utils.PgSSLModeCfg] = *sqlOpts.PgSSLMode
+		}
+	}
+	if lOpts.SQLColumns != nil {
+		opts[utils.SQLColumnsOpt] = cloneSQLColumns(lOpts.SQLColumns)
+	}
+	return
+}
+
+// Clone returns a deep copy of LoaderSOpts
+func (lOpts *LoaderSOpts) Clone() (cln *LoaderSOpts) {
+	cln = new(LoaderSOpts)
+	if lOpts.AWS != nil {
+		cln.AWS = lOpts.AWS.Clone()
+	}
+	if lOpts.S3FolderPath != nil {
+		cln.S3FolderPath = utils.StringPointer(*lOpts.S3FolderPath)
+	}
+	if lOpts.SQL != nil {
+		cln.SQL = lOpts.SQL.Clone()
+	}
+	if lOpts.SQLColumns != nil {
+		cln.SQLColumns = cloneSQLColumns(lOpts.SQLColumns)
+	}
+	return
+}
+
 // LoaderDataType the template for profile loading
 type LoaderDataType struct {
 	Type     string
@@ -160,6 +266,20 @@ func (l *LoaderSCfg) loadFromJSONCfg(jsnCfg *LoaderJsonCfg, msgTemplates map[str
 	if jsnCfg.Lockfile_path != nil {
 		l.LockFilePath = *jsnCfg.Lockfile_path
 	}
+	if jsnCfg.Source_type != nil {
+		l.SourceType = *jsnCfg.Source_type
+	}
+	if jsnCfg.Opts != nil {
+		if l.Opts == nil {
+			l.Opts = &LoaderSOpts{
+				AWS: new(AWSROpts),
+				SQL: new(SQLROpts),
+			}
+		}
+		if err = l.Opts.loadFromJSONCfg(jsnCfg.Opts); err != nil {
+			return
+		}
+	}
 	if jsnCfg.Data != nil {
 		data := make([]*LoaderDataType, len(*jsnCfg.Data))
 		for idx, jsnLoCfg := range *jsnCfg.Data {
@@ -177,6 +297,9 @@ func (l *LoaderSCfg) loadFromJSONCfg(jsnCfg *LoaderJsonCfg, msgTemplates map[str
 func (l LoaderSCfg) GetLockFilePath() (pathL string) {
 	pathL = l.LockFilePath
 	if !filepath.IsAbs(pathL) {
+		if l.SourceType != utils.EmptyString && l.SourceType != utils.MetaLocal {
+			return utils.EmptyString // relative to a remote source, no locking
+		}
 		pathL = path.Join(l.TpInDir, pathL)
 	}
 
@@ -213,8 +336,12 @@ func (l LoaderSCfg) Clone() (cln *LoaderSCfg) {
 		FieldSeparator: l.FieldSeparator,
 		TpInDir:        l.TpInDir,
 		TpOutDir:       l.TpOutDir,
+		SourceType:     l.SourceType,
 		Data:           make([]*LoaderDataType, len(l.Data)),
 	}
+	if l.Opts != nil {
+		cln.Opts = l.Opts.Clone()
+	}
 
 	copy(cln.CacheSConns, l.CacheSConns)
 
@@ -251,8 +378,12 @@ func (l *LoaderSCfg) AsMapInterface(separator string) (initialMP map[string]any)
 		utils.FieldSepCfg:     l.FieldSeparator,
 		utils.TpInDirCfg:      l.TpInDir,
 		utils.TpOutDirCfg:     l.TpOutDir,
+		utils.SourceTypeCfg:   l.SourceType,
 		utils.RunDelayCfg:     "0",
 	}
+	if l.Opts != nil {
+		initialMP[utils.OptsCfg] = l.Opts.AsMapInterface()
+	}
 	if l.Data != nil {
 		data := make([]map[string]any, len(l.Data))
 		for i, item := range l.Data {
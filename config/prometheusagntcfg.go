@@ -0,0 +1,157 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"slices"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// PrometheusAgentCfg the config for the Prometheus Agent
+type PrometheusAgentCfg struct {
+	Enabled        bool
+	StatSConns     []string
+	StatQueueIDs   []string // <[tenant:]ID> of the StatQueues to export
+	ResourceSConns []string
+	ResourceIDs    []string // <[tenant:]ID> of the Resources to export
+	TrendSConns    []string
+	TrendIDs       []string // <[tenant:]ID> of the Trends to export
+	EEsConns       []string
+}
+
+func (pCfg *PrometheusAgentCfg) loadFromJSONCfg(jsnCfg *PrometheusAgentJsonCfg) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Enabled != nil {
+		pCfg.Enabled = *jsnCfg.Enabled
+	}
+	if jsnCfg.Stats_conns != nil {
+		pCfg.StatSConns = make([]string, len(*jsnCfg.Stats_conns))
+		for idx, connID := range *jsnCfg.Stats_conns {
+			pCfg.StatSConns[idx] = connID
+			if connID == utils.MetaInternal {
+				pCfg.StatSConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats)
+			}
+		}
+	}
+	if jsnCfg.Stat_queue_ids != nil {
+		pCfg.StatQueueIDs = slices.Clone(*jsnCfg.Stat_queue_ids)
+	}
+	if jsnCfg.Resources_conns != nil {
+		pCfg.ResourceSConns = make([]string, len(*jsnCfg.Resources_conns))
+		for idx, connID := range *jsnCfg.Resources_conns {
+			pCfg.ResourceSConns[idx] = connID
+			if connID == utils.MetaInternal {
+				pCfg.ResourceSConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources)
+			}
+		}
+	}
+	if jsnCfg.Resource_ids != nil {
+		pCfg.ResourceIDs = slices.Clone(*jsnCfg.Resource_ids)
+	}
+	if jsnCfg.Trends_conns != nil {
+		pCfg.TrendSConns = make([]string, len(*jsnCfg.Trends_conns))
+		for idx, connID := range *jsnCfg.Trends_conns {
+			pCfg.TrendSConns[idx] = connID
+			if connID == utils.MetaInternal {
+				pCfg.TrendSConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaTrends)
+			}
+		}
+	}
+	if jsnCfg.Trend_ids != nil {
+		pCfg.TrendIDs = slices.Clone(*jsnCfg.Trend_ids)
+	}
+	if jsnCfg.Ees_conns != nil {
+		pCfg.EEsConns = make([]string, len(*jsnCfg.Ees_conns))
+		for idx, connID := range *jsnCfg.Ees_conns {
+			pCfg.EEsConns[idx] = connID
+			if connID == utils.MetaInternal {
+				pCfg.EEsConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)
+			}
+		}
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (pCfg *PrometheusAgentCfg) AsMapInterface() (initialMP map[string]any) {
+	initialMP = map[string]any{
+		utils.EnabledCfg:      pCfg.Enabled,
+		utils.StatQueueIDsCfg: slices.Clone(pCfg.StatQueueIDs),
+		utils.ResourceIDsCfg:  slices.Clone(pCfg.ResourceIDs),
+		utils.TrendIDsCfg:     slices.Clone(pCfg.TrendIDs),
+	}
+	if pCfg.StatSConns != nil {
+		statSConns := make([]string, len(pCfg.StatSConns))
+		for i, item := range pCfg.StatSConns {
+			statSConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats) {
+				statSConns[i] = utils.MetaInternal
+			}
+		}
+		initialMP[utils.StatSConnsCfg] = statSConns
+	}
+	if pCfg.ResourceSConns != nil {
+		resourceSConns := make([]string, len(pCfg.ResourceSConns))
+		for i, item := range pCfg.ResourceSConns {
+			resourceSConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources) {
+				resourceSConns[i] = utils.MetaInternal
+			}
+		}
+		initialMP[utils.ResourceSConnsCfg] = resourceSConns
+	}
+	if pCfg.TrendSConns != nil {
+		trendSConns := make([]string, len(pCfg.TrendSConns))
+		for i, item := range pCfg.TrendSConns {
+			trendSConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaTrends) {
+				trendSConns[i] = utils.MetaInternal
+			}
+		}
+		initialMP[utils.TrendSConnsCfg] = trendSConns
+	}
+	if pCfg.EEsConns != nil {
+		eesConns := make([]string, len(pCfg.EEsConns))
+		for i, item := range pCfg.EEsConns {
+			eesConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs) {
+				eesConns[i] = utils.MetaInternal
+			}
+		}
+		initialMP[utils.EEsConnsCfg] = eesConns
+	}
+	return
+}
+
+// Clone returns a deep copy of PrometheusAgentCfg
+func (pCfg *PrometheusAgentCfg) Clone() *PrometheusAgentCfg {
+	return &PrometheusAgentCfg{
+		Enabled:        pCfg.Enabled,
+		StatSConns:     slices.Clone(pCfg.StatSConns),
+		StatQueueIDs:   slices.Clone(pCfg.StatQueueIDs),
+		ResourceSConns: slices.Clone(pCfg.ResourceSConns),
+		ResourceIDs:    slices.Clone(pCfg.ResourceIDs),
+		TrendSConns:    slices.Clone(pCfg.TrendSConns),
+		TrendIDs:       slices.Clone(pCfg.TrendIDs),
+		EEsConns:       slices.Clone(pCfg.EEsConns),
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestPrometheusAgentCfgloadFromJsonCfg(t *testing.T) {
+	jsnCfg := &PrometheusAgentJsonCfg{
+		Enabled:         utils.BoolPointer(true),
+		Stats_conns:     &[]string{utils.MetaInternal, "conn1"},
+		Stat_queue_ids:  &[]string{"SQ_1", "cgrates.net:SQ_2"},
+		Resources_conns: &[]string{utils.MetaInternal},
+		Resource_ids:    &[]string{"RES_1"},
+		Trends_conns:    &[]string{utils.MetaInternal},
+		Trend_ids:       &[]string{"TR_1"},
+		Ees_conns:       &[]string{utils.MetaInternal},
+	}
+	exp := &PrometheusAgentCfg{
+		Enabled:        true,
+		StatSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "conn1"},
+		StatQueueIDs:   []string{"SQ_1", "cgrates.net:SQ_2"},
+		ResourceSConns: []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources)},
+		ResourceIDs:    []string{"RES_1"},
+		TrendSConns:    []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaTrends)},
+		TrendIDs:       []string{"TR_1"},
+		EEsConns:       []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)},
+	}
+	jsonCfg := NewDefaultCGRConfig()
+	if err := jsonCfg.prometheusAgentCfg.loadFromJSONCfg(jsnCfg); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(exp, jsonCfg.prometheusAgentCfg) {
+		t.Errorf("Expected %+v \n, received %+v", utils.ToJSON(exp), utils.ToJSON(jsonCfg.prometheusAgentCfg))
+	}
+	if err := jsonCfg.prometheusAgentCfg.loadFromJSONCfg(nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPrometheusAgentCfgAsMapInterface(t *testing.T) {
+	cfgJSONStr := `{
+	"prometheus_agent": {
+		"enabled": true,
+		"stats_conns": ["*internal"],
+		"stat_queue_ids": ["SQ_1"],
+		"trends_conns": ["conn1"],
+		"trend_ids": ["TR_1"],
+	},
+}`
+	eMap := map[string]any{
+		utils.EnabledCfg:        true,
+		utils.StatSConnsCfg:     []string{utils.MetaInternal},
+		utils.StatQueueIDsCfg:   []string{"SQ_1"},
+		utils.ResourceSConnsCfg: []string{},
+		utils.ResourceIDsCfg:    []string{},
+		utils.TrendSConnsCfg:    []string{"conn1"},
+		utils.TrendIDsCfg:       []string{"TR_1"},
+		utils.EEsConnsCfg:       []string{},
+	}
+	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
+		t.Error(err)
+	} else if rcv := cgrCfg.prometheusAgentCfg.AsMapInterface(); !reflect.DeepEqual(eMap, rcv) {
+		t.Errorf("Expected %+v, received %+v", eMap, rcv)
+	}
+}
+
+func TestPrometheusAgentCfgClone(t *testing.T) {
+	ban := &PrometheusAgentCfg{
+		Enabled:      true,
+		StatSConns:   []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats)},
+		StatQueueIDs: []string{"SQ_1"},
+		ResourceIDs:  []string{"RES_1"},
+	}
+	rcv := ban.Clone()
+	if !reflect.DeepEqual(ban, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(ban), utils.ToJSON(rcv))
+	}
+	if rcv.StatSConns[0] = ""; ban.StatSConns[0] != utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats) {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	if rcv.StatQueueIDs[0] = ""; ban.StatQueueIDs[0] != "SQ_1" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+}
+
+func TestPrometheusAgentCfgSanity(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.prometheusAgentCfg.Enabled = true
+	cfg.prometheusAgentCfg.StatSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats)}
+	expected := "<Stats> not enabled but requested by <PrometheusAgent> component"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("expected: %s, received: %v", expected, err)
+	}
+	cfg.prometheusAgentCfg.StatSConns = nil
+	cfg.prometheusAgentCfg.EEsConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)}
+	expected = "<EEs> not enabled but requested by <PrometheusAgent> component"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("expected: %s, received: %v", expected, err)
+	}
+	cfg.httpCfg.PrometheusURL = utils.EmptyString
+	expected = "<PrometheusAgent> requires prometheus_url to be defined in the http section"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("expected: %s, received: %v", expected, err)
+	}
+}
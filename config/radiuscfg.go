@@ -28,7 +28,7 @@ import (
 type RadiusListener struct {
 	AuthAddr string
 	AcctAddr string
-	Network  string // udp or tcp
+	Network  string // udp, tcp or tls
 }
 
 // RadiusAgentCfg the config section that describes the Radius Agent
@@ -246,7 +246,7 @@ func (ra RadiusAgentCfg) Clone() (cln *RadiusAgentCfg) {
 }
 
 type DAClientOpts struct {
-	Transport string                // transport protocol for Dynamic Authorization requests <UDP|TCP>.
+	Transport string                // transport protocol for Dynamic Authorization requests <UDP|TCP|TLS>.
 	Host      string                // alternative host for DA requests
 	Port      int                   // port for Dynamic Authorization requests
 	Flags     utils.FlagsWithParams // flags (only *log for now)
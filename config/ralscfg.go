@@ -37,6 +37,7 @@ type RalsCfg struct {
 	BalanceRatingSubject    map[string]string
 	MaxIncrements           int
 	FallbackDepth           int
+	DecimalBalances         bool // store and debit *monetary balances using arbitrary-precision decimals
 }
 
 // loadFromJSONCfg loads Rals config from JsonCfg
@@ -101,6 +102,9 @@ func (ralsCfg *RalsCfg) loadFromJSONCfg(jsnRALsCfg *RalsJsonCfg) (err error) {
 			ralsCfg.BalanceRatingSubject[k] = v
 		}
 	}
+	if jsnRALsCfg.Decimal_balances != nil {
+		ralsCfg.DecimalBalances = *jsnRALsCfg.Decimal_balances
+	}
 
 	return nil
 }
@@ -113,6 +117,7 @@ func (ralsCfg *RalsCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.RemoveExpiredCfg:           ralsCfg.RemoveExpired,
 		utils.MaxIncrementsCfg:           ralsCfg.MaxIncrements,
 		utils.FallbackDepthCfg:           ralsCfg.FallbackDepth,
+		utils.DecimalBalancesCfg:         ralsCfg.DecimalBalances,
 	}
 	if ralsCfg.ThresholdSConns != nil {
 		threSholds := make([]string, len(ralsCfg.ThresholdSConns))
@@ -169,6 +174,7 @@ func (ralsCfg RalsCfg) Clone() (cln *RalsCfg) {
 		RemoveExpired:           ralsCfg.RemoveExpired,
 		MaxIncrements:           ralsCfg.MaxIncrements,
 		FallbackDepth:           ralsCfg.FallbackDepth,
+		DecimalBalances:         ralsCfg.DecimalBalances,
 
 		MaxComputedUsage:     make(map[string]time.Duration),
 		BalanceRatingSubject: make(map[string]string),
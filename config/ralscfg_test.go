@@ -117,8 +117,9 @@ func TestRalsCfgAsMapInterfaceCase1(t *testing.T) {
 			"*sms":   "5000",
 			"*mms":   "10000",
 		},
-		utils.MaxIncrementsCfg: 1000000,
-		utils.FallbackDepthCfg: 3,
+		utils.MaxIncrementsCfg:   1000000,
+		utils.FallbackDepthCfg:   3,
+		utils.DecimalBalancesCfg: false,
 		utils.BalanceRatingSubjectCfg: map[string]string{
 			"*any":   "*zero1ns",
 			"*voice": "*zero1s",
@@ -152,8 +153,9 @@ func TestRalsCfgAsMapInterfaceCase2(t *testing.T) {
 			"*sms":   "10000",
 			"*mms":   "10000",
 		},
-		utils.MaxIncrementsCfg: 1000000,
-		utils.FallbackDepthCfg: 3,
+		utils.MaxIncrementsCfg:   1000000,
+		utils.FallbackDepthCfg:   3,
+		utils.DecimalBalancesCfg: false,
 		utils.BalanceRatingSubjectCfg: map[string]string{
 			"*any":   "*zero1ns",
 			"*voice": "*zero1s",
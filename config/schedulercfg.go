@@ -19,6 +19,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package config
 
 import (
+	"time"
+
 	"github.com/cgrates/cgrates/utils"
 )
 
@@ -30,6 +32,9 @@ type SchedulerCfg struct {
 	StatSConns             []string
 	Filters                []string
 	DynaprepaidActionPlans []string
+	LeaderElection         bool          // elect through DataDB the node executing the actions
+	LeaseTTL               time.Duration // interval after which the leadership expires if not renewed
+	CatchUpPolicy          string        // how to handle the executions missed while no leader was running
 }
 
 func (schdcfg *SchedulerCfg) loadFromJSONCfg(jsnCfg *SchedulerJsonCfg) error {
@@ -77,6 +82,18 @@ func (schdcfg *SchedulerCfg) loadFromJSONCfg(jsnCfg *SchedulerJsonCfg) error {
 		schdcfg.DynaprepaidActionPlans = make([]string, len(*jsnCfg.Dynaprepaid_actionplans))
 		copy(schdcfg.DynaprepaidActionPlans, *jsnCfg.Dynaprepaid_actionplans)
 	}
+	if jsnCfg.Leader_election != nil {
+		schdcfg.LeaderElection = *jsnCfg.Leader_election
+	}
+	if jsnCfg.Lease_ttl != nil {
+		var err error
+		if schdcfg.LeaseTTL, err = utils.ParseDurationWithNanosecs(*jsnCfg.Lease_ttl); err != nil {
+			return err
+		}
+	}
+	if jsnCfg.Catchup_policy != nil {
+		schdcfg.CatchUpPolicy = *jsnCfg.Catchup_policy
+	}
 	return nil
 }
 
@@ -86,6 +103,9 @@ func (schdcfg *SchedulerCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.EnabledCfg:                schdcfg.Enabled,
 		utils.FiltersCfg:                schdcfg.Filters,
 		utils.DynaprepaidActionplansCfg: schdcfg.DynaprepaidActionPlans,
+		utils.LeaderElectionCfg:         schdcfg.LeaderElection,
+		utils.LeaseTTLCfg:               schdcfg.LeaseTTL.String(),
+		utils.CatchUpPolicyCfg:          schdcfg.CatchUpPolicy,
 	}
 	if schdcfg.CDRsConns != nil {
 		cdrsConns := make([]string, len(schdcfg.CDRsConns))
@@ -123,7 +143,10 @@ func (schdcfg *SchedulerCfg) AsMapInterface() (initialMP map[string]any) {
 // Clone returns a deep copy of SchedulerCfg
 func (schdcfg SchedulerCfg) Clone() (cln *SchedulerCfg) {
 	cln = &SchedulerCfg{
-		Enabled: schdcfg.Enabled,
+		Enabled:        schdcfg.Enabled,
+		LeaderElection: schdcfg.LeaderElection,
+		LeaseTTL:       schdcfg.LeaseTTL,
+		CatchUpPolicy:  schdcfg.CatchUpPolicy,
 	}
 	if schdcfg.CDRsConns != nil {
 		cln.CDRsConns = make([]string, len(schdcfg.CDRsConns))
@@ -20,6 +20,7 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
@@ -32,6 +33,9 @@ func TestSchedulerCfgloadFromJsonCfg(t *testing.T) {
 		Stats_conns:             &[]string{utils.MetaInternal, "*conn1"},
 		Filters:                 &[]string{"randomFilter"},
 		Dynaprepaid_actionplans: &[]string{"randomPlan"},
+		Leader_election:         utils.BoolPointer(true),
+		Lease_ttl:               utils.StringPointer("30s"),
+		Catchup_policy:          utils.StringPointer(utils.MetaRunOnce),
 	}
 	expected := &SchedulerCfg{
 		Enabled:                true,
@@ -40,6 +44,9 @@ func TestSchedulerCfgloadFromJsonCfg(t *testing.T) {
 		StatSConns:             []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
 		Filters:                []string{"randomFilter"},
 		DynaprepaidActionPlans: []string{"randomPlan"},
+		LeaderElection:         true,
+		LeaseTTL:               30 * time.Second,
+		CatchUpPolicy:          utils.MetaRunOnce,
 	}
 	jsonCfg := NewDefaultCGRConfig()
 	if err := jsonCfg.schedulerCfg.loadFromJSONCfg(cfgJSONS); err != nil {
@@ -60,6 +67,9 @@ func TestSchedulerCfgAsMapInterface(t *testing.T) {
 		utils.StatSConnsCfg:             []string{},
 		utils.FiltersCfg:                []string{},
 		utils.DynaprepaidActionplansCfg: []string{},
+		utils.LeaderElectionCfg:         false,
+		utils.LeaseTTLCfg:               "10s",
+		utils.CatchUpPolicyCfg:          utils.MetaSkip,
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -78,6 +88,9 @@ func TestSchedulerCfgAsMapInterface1(t *testing.T) {
 	   "stats_conns": ["*internal", "*conn1"],
        "filters": ["randomFilter"],
 		"dynaprepaid_actionplans":["randomPlan"],
+		"leader_election": true,
+		"lease_ttl": "1m",
+		"catchup_policy": "*run_all",
     },
 }`
 	eMap := map[string]any{
@@ -87,6 +100,9 @@ func TestSchedulerCfgAsMapInterface1(t *testing.T) {
 		utils.StatSConnsCfg:             []string{utils.MetaInternal, "*conn1"},
 		utils.FiltersCfg:                []string{"randomFilter"},
 		utils.DynaprepaidActionplansCfg: []string{"randomPlan"},
+		utils.LeaderElectionCfg:         true,
+		utils.LeaseTTLCfg:               "1m0s",
+		utils.CatchUpPolicyCfg:          utils.MetaRunAll,
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -104,6 +120,9 @@ func TestSchedulerCfgClone(t *testing.T) {
 		StatSConns:             []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
 		Filters:                []string{"randomFilter"},
 		DynaprepaidActionPlans: []string{"plan"},
+		LeaderElection:         true,
+		LeaseTTL:               time.Minute,
+		CatchUpPolicy:          utils.MetaRunAll,
 	}
 	rcv := ban.Clone()
 	if !reflect.DeepEqual(ban, rcv) {
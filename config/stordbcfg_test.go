@@ -87,6 +87,9 @@ func TestStoreDbCfgloadFromJsonCfgCase1(t *testing.T) {
 			PgSSLMode:          "disable",
 			MySQLLocation:      "UTC",
 			MySQLDSNParams:     make(map[string]string),
+
+			InternalDBFsyncInterval:    time.Second,
+			InternalDBSnapshotInterval: time.Hour,
 		},
 	}
 	jsonCfg := NewDefaultCGRConfig()
@@ -249,6 +252,10 @@ func TestStorDbCfgAsMapInterface(t *testing.T) {
 			utils.PgSSLModeCfg:          "disable",
 			utils.MysqlLocation:         "UTC",
 			utils.PgSchema:              "",
+
+			utils.InternalDBDumpPathCfg:         "",
+			utils.InternalDBFsyncIntervalCfg:    "1s",
+			utils.InternalDBSnapshotIntervalCfg: "1h0m0s",
 		},
 		utils.ItemsCfg: map[string]any{
 			utils.SessionCostsTBL: map[string]any{utils.RemoteCfg: false, utils.ReplicateCfg: false},
@@ -20,10 +20,12 @@ package cores
 
 import (
 	"net"
+	"reflect"
 
 	"github.com/cgrates/birpc"
 	"github.com/cgrates/birpc/jsonrpc"
 	"github.com/cgrates/cgrates/analyzers"
+	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 	"github.com/cgrates/rpcclient"
@@ -37,8 +39,37 @@ type conn interface {
 	RemoteAddr() net.Addr
 }
 
+// connID returns the identifier of the client connection used by the rate limits
+func connID(conn conn) string {
+	if from := conn.RemoteAddr(); from != nil {
+		return from.String()
+	}
+	return utils.EmptyString
+}
+
+// argsTenant returns the tenant out of the request arguments
+// falling back on the default tenant if not populated
+func argsTenant(args any) string {
+	v := reflect.ValueOf(args)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			break
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		if fld, has := v.Type().FieldByName(utils.Tenant); has &&
+			fld.Type.Kind() == reflect.String {
+			if fv, err := v.FieldByIndexErr(fld.Index); err == nil && fv.String() != utils.EmptyString {
+				return fv.String()
+			}
+		}
+	}
+	return config.CgrConfig().GeneralCfg().DefaultTenant
+}
+
 func newCapsGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.ServerCodec) {
-	r = newCapsServerCodec(birpc.NewServerCodec(conn), caps)
+	r = newCapsServerCodec(birpc.NewServerCodec(conn), caps, connID(conn))
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -56,7 +87,7 @@ func newCapsGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerServic
 }
 
 func newCapsJSONCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.ServerCodec) {
-	r = newCapsServerCodec(jsonrpc.NewServerCodec(conn), caps)
+	r = newCapsServerCodec(jsonrpc.NewServerCodec(conn), caps, connID(conn))
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -73,35 +104,47 @@ func newCapsJSONCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerServi
 	return
 }
 
-func newCapsServerCodec(sc birpc.ServerCodec, caps *engine.Caps) birpc.ServerCodec {
-	if !caps.IsLimited() {
+func newCapsServerCodec(sc birpc.ServerCodec, caps *engine.Caps, connID string) birpc.ServerCodec {
+	if !caps.IsLimited() && !caps.IsRateLimited() {
 		return sc
 	}
 	return &capsServerCodec{
-		sc:   sc,
-		caps: caps,
+		sc:     sc,
+		caps:   caps,
+		connID: connID,
 	}
 }
 
 type capsServerCodec struct {
-	sc   birpc.ServerCodec
-	caps *engine.Caps
+	sc     birpc.ServerCodec
+	caps   *engine.Caps
+	connID string
+	method string // the method of the request being read
 }
 
-func (c *capsServerCodec) ReadRequestHeader(r *birpc.Request) error {
-	return c.sc.ReadRequestHeader(r)
+func (c *capsServerCodec) ReadRequestHeader(r *birpc.Request) (err error) {
+	err = c.sc.ReadRequestHeader(r)
+	c.method = r.ServiceMethod
+	return
 }
 
-func (c *capsServerCodec) ReadRequestBody(x any) error {
-	if err := c.caps.Allocate(); err != nil {
-		return err
+func (c *capsServerCodec) ReadRequestBody(x any) (err error) {
+	if c.caps.IsLimited() {
+		if err = c.caps.Allocate(); err != nil {
+			return
+		}
+	}
+	if err = c.sc.ReadRequestBody(x); err != nil ||
+		x == nil { // the body is discarded
+		return
 	}
-	return c.sc.ReadRequestBody(x)
+	// the tenant is known only after reading the body
+	return c.caps.AllowRate(argsTenant(x), c.method, c.connID)
 }
 func (c *capsServerCodec) WriteResponse(r *birpc.Response, x any) error {
 	if r.Error == utils.ErrMaxConcurrentRPCExceededNoCaps.Error() {
 		r.Error = utils.ErrMaxConcurrentRPCExceeded.Error()
-	} else {
+	} else if c.caps.IsLimited() {
 		defer c.caps.Deallocate()
 	}
 	return c.sc.WriteResponse(r, x)
@@ -109,7 +152,7 @@ func (c *capsServerCodec) WriteResponse(r *birpc.Response, x any) error {
 func (c *capsServerCodec) Close() error { return c.sc.Close() }
 
 func newCapsBiRPCGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.BirpcCodec) {
-	r = newCapsBiRPCCodec(birpc.NewGobBirpcCodec(conn), caps)
+	r = newCapsBiRPCCodec(birpc.NewGobBirpcCodec(conn), caps, connID(conn))
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -127,7 +170,7 @@ func newCapsBiRPCGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerS
 }
 
 func newCapsBiRPCJSONCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.BirpcCodec) {
-	r = newCapsBiRPCCodec(jsonrpc.NewJSONBirpcCodec(conn), caps)
+	r = newCapsBiRPCCodec(jsonrpc.NewJSONBirpcCodec(conn), caps, connID(conn))
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -144,38 +187,51 @@ func newCapsBiRPCJSONCodec(conn conn, caps *engine.Caps, anz *analyzers.Analyzer
 	return
 }
 
-func newCapsBiRPCCodec(sc birpc.BirpcCodec, caps *engine.Caps) birpc.BirpcCodec {
-	if !caps.IsLimited() {
+func newCapsBiRPCCodec(sc birpc.BirpcCodec, caps *engine.Caps, connID string) birpc.BirpcCodec {
+	if !caps.IsLimited() && !caps.IsRateLimited() {
 		return sc
 	}
 	return &capsBiRPCCodec{
-		sc:   sc,
-		caps: caps,
+		sc:     sc,
+		caps:   caps,
+		connID: connID,
 	}
 }
 
 type capsBiRPCCodec struct {
-	sc   birpc.BirpcCodec
-	caps *engine.Caps
+	sc     birpc.BirpcCodec
+	caps   *engine.Caps
+	connID string
+	method string // the method of the request being read, empty if already rejected
 }
 
 // ReadHeader must read a message and populate either the request
 // or the response by inspecting the incoming message.
 func (c *capsBiRPCCodec) ReadHeader(req *birpc.Request, resp *birpc.Response) (err error) {
+	c.method = utils.EmptyString
 	if err = c.sc.ReadHeader(req, resp); err != nil ||
 		req.ServiceMethod == utils.EmptyString { // caps will not process replies
 		return
 	}
-	if err = c.caps.Allocate(); err != nil {
-		req.ServiceMethod = utils.SessionSv1CapsError
-		err = nil
+	if c.caps.IsLimited() {
+		if err = c.caps.Allocate(); err != nil {
+			req.ServiceMethod = utils.SessionSv1CapsError
+			return nil
+		}
 	}
+	c.method = req.ServiceMethod
 	return
 }
 
 // ReadRequestBody into args argument of handler function.
+// The rate limits are checked here since the tenant is known only after reading the body,
+// the error is sent back by the server as reply to the request.
 func (c *capsBiRPCCodec) ReadRequestBody(x any) (err error) {
-	return c.sc.ReadRequestBody(x)
+	if err = c.sc.ReadRequestBody(x); err != nil ||
+		c.method == utils.EmptyString || x == nil {
+		return
+	}
+	return c.caps.AllowRate(argsTenant(x), c.method, c.connID)
 }
 
 // ReadResponseBody into reply argument of handler function.
@@ -190,9 +246,10 @@ func (c *capsBiRPCCodec) WriteRequest(req *birpc.Request, x any) error {
 
 // WriteResponse must be safe for concurrent use by multiple goroutines.
 func (c *capsBiRPCCodec) WriteResponse(r *birpc.Response, x any) error {
-	if r.Error == utils.ErrMaxConcurrentRPCExceededNoCaps.Error() {
+	switch {
+	case r.Error == utils.ErrMaxConcurrentRPCExceededNoCaps.Error():
 		r.Error = utils.ErrMaxConcurrentRPCExceeded.Error()
-	} else {
+	case c.caps.IsLimited():
 		defer c.caps.Deallocate()
 	}
 	return c.sc.WriteResponse(r, x)
@@ -26,6 +26,7 @@ import (
 	"github.com/cgrates/birpc"
 	"github.com/cgrates/birpc/jsonrpc"
 	"github.com/cgrates/cgrates/analyzers"
+	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 	"github.com/cgrates/rpcclient"
@@ -50,7 +51,7 @@ func (c *mockServerCodec) Close() error { return nil }
 func TestNewCapsServerCodec(t *testing.T) {
 	mk := new(mockServerCodec)
 	cr := engine.NewCaps(0, utils.MetaBusy)
-	if r := newCapsServerCodec(mk, cr); !reflect.DeepEqual(mk, r) {
+	if r := newCapsServerCodec(mk, cr, utils.EmptyString); !reflect.DeepEqual(mk, r) {
 		t.Errorf("Expected: %v ,received:%v", mk, r)
 	}
 	cr = engine.NewCaps(1, utils.MetaBusy)
@@ -58,7 +59,7 @@ func TestNewCapsServerCodec(t *testing.T) {
 		sc:   mk,
 		caps: cr,
 	}
-	codec := newCapsServerCodec(mk, cr)
+	codec := newCapsServerCodec(mk, cr, utils.EmptyString)
 	if !reflect.DeepEqual(exp, codec) {
 		t.Errorf("Expected: %v ,received:%v", exp, codec)
 	}
@@ -138,7 +139,7 @@ func (mockBiRPCCodec) Close() error                             { return nil }
 func TestNewCapsBiRPCCodec(t *testing.T) {
 	mk := new(mockBiRPCCodec)
 	cr := engine.NewCaps(0, utils.MetaBusy)
-	if r := newCapsBiRPCCodec(mk, cr); !reflect.DeepEqual(mk, r) {
+	if r := newCapsBiRPCCodec(mk, cr, utils.EmptyString); !reflect.DeepEqual(mk, r) {
 		t.Errorf("Expected: %v ,received:%v", mk, r)
 	}
 	cr = engine.NewCaps(1, utils.MetaBusy)
@@ -146,7 +147,7 @@ func TestNewCapsBiRPCCodec(t *testing.T) {
 		sc:   mk,
 		caps: cr,
 	}
-	codec := newCapsBiRPCCodec(mk, cr)
+	codec := newCapsBiRPCCodec(mk, cr, utils.EmptyString)
 	if !reflect.DeepEqual(exp, codec) {
 		t.Errorf("Expected: %v ,received:%v", exp, codec)
 	}
@@ -230,3 +231,111 @@ func TestNewCapsJSONBiRPCCodec(t *testing.T) {
 		t.Errorf("Expected: %v ,received:%v", exp, r)
 	}
 }
+
+type mockRateServerCodec struct{ mockServerCodec }
+
+func (c *mockRateServerCodec) ReadRequestBody(x any) (err error) { return }
+
+func TestCapsServerCodecRateLimit(t *testing.T) {
+	mk := new(mockRateServerCodec)
+	cr := engine.NewCaps(0, utils.MetaBusy)
+	cr.SetRateLimiter(engine.NewRateLimiter([]*config.RateLimitCfg{{
+		ID:     "RL_PING",
+		APIs:   []string{utils.CoreSv1Ping},
+		Rate:   0.001,
+		Burst:  1,
+		PerAPI: true,
+	}}))
+	codec := newCapsServerCodec(mk, cr, "127.0.0.1:2012")
+	if _, canCast := codec.(*capsServerCodec); !canCast {
+		t.Fatalf("Expected the rate limited codec, received: %T", codec)
+	}
+	r := new(birpc.Request)
+	if err := codec.ReadRequestHeader(r); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.ReadRequestBody(&utils.CGREvent{Tenant: "cgrates.org"}); err != nil {
+		t.Error(err)
+	}
+	if err := codec.ReadRequestBody(&utils.CGREvent{Tenant: "cgrates.org"}); err != utils.ErrRateLimitExceeded {
+		t.Errorf("Expected error: %v ,received: %v ", utils.ErrRateLimitExceeded, err)
+	}
+	// discarded bodies are not counted
+	if err := codec.ReadRequestBody(nil); err != nil {
+		t.Error(err)
+	}
+	if err := codec.WriteResponse(&birpc.Response{
+		Error: utils.ErrRateLimitExceeded.Error(),
+	}, "reply"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type mockRateBiRPCCodec struct{ mockBiRPCCodec }
+
+func (mockRateBiRPCCodec) ReadRequestBody(any) error { return nil }
+
+func TestCapsBiRPCCodecRateLimit(t *testing.T) {
+	mk := new(mockRateBiRPCCodec)
+	cr := engine.NewCaps(0, utils.MetaBusy)
+	cr.SetRateLimiter(engine.NewRateLimiter([]*config.RateLimitCfg{{
+		ID:            "RL_CONN",
+		Tenants:       []string{"cgrates.org"},
+		Rate:          0.001,
+		Burst:         1,
+		PerConnection: true,
+	}}))
+	codec := newCapsBiRPCCodec(mk, cr, "127.0.0.1:2014")
+	r := new(birpc.Request)
+	if err := codec.ReadHeader(r, nil); err != nil {
+		t.Fatal(err)
+	} else if r.ServiceMethod != utils.CoreSv1Ping {
+		t.Errorf("Expected: %q ,received: %q", utils.CoreSv1Ping, r.ServiceMethod)
+	}
+	if err := codec.ReadRequestBody(&utils.CGREvent{Tenant: "cgrates.org"}); err != nil {
+		t.Error(err)
+	}
+	if err := codec.ReadHeader(r, nil); err != nil {
+		t.Fatal(err)
+	}
+	// the limit applies only to the tenant from the request body
+	if err := codec.ReadRequestBody(&utils.CGREvent{Tenant: "itsyscom.com"}); err != nil {
+		t.Error(err)
+	}
+	if err := codec.ReadHeader(r, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.ReadRequestBody(&utils.CGREvent{Tenant: "cgrates.org"}); err != utils.ErrRateLimitExceeded {
+		t.Errorf("Expected error: %v ,received: %v ", utils.ErrRateLimitExceeded, err)
+	}
+	// a new connection has its own bucket
+	codec = newCapsBiRPCCodec(mk, cr, "127.0.0.1:2015")
+	if err := codec.ReadHeader(r, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.ReadRequestBody(&utils.CGREvent{Tenant: "cgrates.org"}); err != nil {
+		t.Error(err)
+	}
+	if err := codec.WriteResponse(&birpc.Response{
+		Error: utils.ErrRateLimitExceeded.Error(),
+	}, "reply"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArgsTenant(t *testing.T) {
+	if rcv := argsTenant(&utils.CGREvent{Tenant: "itsyscom.com"}); rcv != "itsyscom.com" {
+		t.Errorf("Expected: %q ,received: %q", "itsyscom.com", rcv)
+	}
+	dft := config.CgrConfig().GeneralCfg().DefaultTenant
+	if rcv := argsTenant(&utils.CGREvent{}); rcv != dft {
+		t.Errorf("Expected: %q ,received: %q", dft, rcv)
+	}
+	if rcv := argsTenant("args"); rcv != dft {
+		t.Errorf("Expected: %q ,received: %q", dft, rcv)
+	}
+	var ev *utils.CGREvent
+	if rcv := argsTenant(ev); rcv != dft {
+		t.Errorf("Expected: %q ,received: %q", dft, rcv)
+	}
+}
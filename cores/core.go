@@ -301,6 +301,9 @@ func (cS *CoreService) V1Status(_ *context.Context, params *V1StatusParams, repl
 			metrics.CapsStats.Peak = &peak
 		}
 	}
+	if cS.caps.IsRateLimited() {
+		metrics.RateLimitStats = cS.caps.RateLimiter().Stats()
+	}
 	debug := false
 	timezone := cS.cfg.GeneralCfg().DefaultTimezone
 	if params != nil {
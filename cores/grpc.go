@@ -0,0 +1,145 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package cores
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/cgrates/cgrates/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// CallJSON executes the API through the registered services as a JSON-RPC request,
+// so the caps and the analyzer apply the same as for the other transports
+func (s *Server) CallJSON(rmtAddr net.Addr, serviceMethod string, args, reply any) (err error) {
+	if rmtAddr == nil {
+		rmtAddr = utils.LocalAddr()
+	}
+	var req []byte
+	if req, err = json.Marshal(map[string]any{
+		"method": serviceMethod,
+		"params": []any{args},
+		"id":     0,
+	}); err != nil {
+		return
+	}
+	var rsp struct {
+		Result json.RawMessage
+		Error  *string
+	}
+	if err = json.NewDecoder(newRPCRequest(s.rpcSrv, io.NopCloser(bytes.NewReader(req)),
+		rmtAddr, s.caps, s.anz).Call()).Decode(&rsp); err != nil {
+		return
+	}
+	if rsp.Error != nil {
+		return errors.New(*rsp.Error)
+	}
+	return json.Unmarshal(rsp.Result, reply)
+}
+
+// NewGRPCServer creates the gRPC server with the services added by the register function
+// the returned server should be stopped on shutdown
+func NewGRPCServer(useBasicAuth bool, userList map[string]string,
+	register func(grpc.ServiceRegistrar)) *grpc.Server {
+	var opts []grpc.ServerOption
+	if useBasicAuth {
+		utils.Logger.Info("<gRPC> enabling basic auth")
+		opts = append(opts,
+			grpc.UnaryInterceptor(func(ctx context.Context, req any, _ *grpc.UnaryServerInfo,
+				handler grpc.UnaryHandler) (any, error) {
+				if err := grpcBasicAuth(ctx, userList); err != nil {
+					return nil, err
+				}
+				return handler(ctx, req)
+			}),
+			grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo,
+				handler grpc.StreamHandler) error {
+				if err := grpcBasicAuth(ss.Context(), userList); err != nil {
+					return err
+				}
+				return handler(srv, ss)
+			}))
+	}
+	gSrv := grpc.NewServer(opts...)
+	register(gSrv)
+	return gSrv
+}
+
+// ServeGRPC starts serving the gRPC requests until the gRPC server is stopped
+func (s *Server) ServeGRPC(gSrv *grpc.Server, addr string, shdChan *utils.SyncedChan) {
+	s.RLock()
+	enabled := s.rpcEnabled
+	s.RUnlock()
+	if !enabled {
+		return
+	}
+	l, err := net.Listen(utils.TCP, addr)
+	if err != nil {
+		log.Printf("ServeGRPC listen error: %s", err)
+		shdChan.CloseOnce()
+		return
+	}
+	utils.Logger.Info(fmt.Sprintf("Starting CGRateS %s server at <%s>.", utils.GRPCCaps, addr))
+	if err = gSrv.Serve(l); err != nil {
+		log.Printf("<gRPC>Error: %s when serving", err)
+		shdChan.CloseOnce()
+	}
+}
+
+// grpcBasicAuth validates the basic authorization sent within the request metadata
+func grpcBasicAuth(ctx context.Context, userList map[string]string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	authValues := md.Get("authorization")
+	if len(authValues) == 0 {
+		utils.Logger.Warning("<BasicAuth> Missing authorization header value")
+		return status.Error(codes.Unauthenticated, "Not authorized")
+	}
+	authHeader := strings.SplitN(authValues[0], " ", 2)
+	if len(authHeader) != 2 {
+		utils.Logger.Warning("<BasicAuth> Missing authorization header value")
+		return status.Error(codes.Unauthenticated, "Not authorized")
+	}
+	authHeaderDecoded, err := base64.StdEncoding.DecodeString(authHeader[1])
+	if err != nil {
+		utils.Logger.Warning("<BasicAuth> Unable to decode authorization header")
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	userPass := strings.SplitN(string(authHeaderDecoded), ":", 2)
+	if len(userPass) != 2 {
+		utils.Logger.Warning("<BasicAuth> Unauthorized API access. Missing or extra credential components")
+		return status.Error(codes.Unauthenticated, "Not authorized")
+	}
+	if !verifyCredential(userPass[0], userPass[1], userList) {
+		utils.Logger.Warning(fmt.Sprintf("<BasicAuth> Unauthorized API access by user '%s'", userPass[0]))
+		return status.Error(codes.Unauthenticated, "Not authorized")
+	}
+	return nil
+}
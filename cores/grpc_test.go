@@ -0,0 +1,98 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package cores
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"testing"
+	"time"
+
+	bcontext "github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testGRPCSv1 struct{}
+
+func (testGRPCSv1) ProcessEvent(_ *bcontext.Context, args *utils.CGREvent, reply *map[string]any) error {
+	if args.ID == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing(utils.ID)
+	}
+	*reply = map[string]any{utils.ID: args.ID, utils.Tenant: args.Tenant}
+	return nil
+}
+
+func TestServerCallJSON(t *testing.T) {
+	srv := NewServer(engine.NewCaps(0, utils.MetaBusy))
+	srv.RpcRegisterName("TestGRPCSv1", testGRPCSv1{})
+	var reply any
+	if err := srv.CallJSON(nil, "TestGRPCSv1.ProcessEvent",
+		&utils.CGREvent{Tenant: "cgrates.org", ID: "TestCallJSON"}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	exp := map[string]any{utils.ID: "TestCallJSON", utils.Tenant: "cgrates.org"}
+	if !reflect.DeepEqual(exp, reply) {
+		t.Errorf("Expected %+v, received %+v", exp, reply)
+	}
+	expErr := utils.NewErrMandatoryIeMissing(utils.ID).Error()
+	if err := srv.CallJSON(nil, "TestGRPCSv1.ProcessEvent",
+		&utils.CGREvent{Tenant: "cgrates.org"}, &reply); err == nil || err.Error() != expErr {
+		t.Errorf("Expected error %s, received %v", expErr, err)
+	}
+}
+
+func TestGRPCBasicAuth(t *testing.T) {
+	userList := map[string]string{"1001": "MTIzNA=="}
+	if err := grpcBasicAuth(context.Background(), userList); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected %v, received %v", codes.Unauthenticated, err)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization",
+		"Basic "+base64.StdEncoding.EncodeToString([]byte("1001:1234"))))
+	if err := grpcBasicAuth(ctx, userList); err != nil {
+		t.Error(err)
+	}
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization",
+		"Basic "+base64.StdEncoding.EncodeToString([]byte("1001:4321"))))
+	if err := grpcBasicAuth(ctx, userList); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected %v, received %v", codes.Unauthenticated, err)
+	}
+}
+
+func TestServeGRPCGracefulStop(t *testing.T) {
+	srv := NewServer(engine.NewCaps(0, utils.MetaBusy))
+	srv.RpcRegisterName("TestGRPCSv1", testGRPCSv1{})
+	gSrv := NewGRPCServer(false, nil, func(grpc.ServiceRegistrar) {})
+	served := make(chan struct{})
+	go func() {
+		srv.ServeGRPC(gSrv, "127.0.0.1:0", utils.NewSyncedChan())
+		close(served)
+	}()
+	gSrv.GracefulStop()
+	select {
+	case <-served:
+	case <-time.After(time.Second):
+		t.Fatal("expected the gRPC server to stop serving")
+	}
+}
@@ -25,21 +25,23 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 	"github.com/prometheus/procfs"
 )
 
 type StatusMetrics struct {
-	PID             int             `json:"pid"`
-	GoVersion       string          `json:"go_version"`
-	NodeID          string          `json:"node_id"`
-	Version         string          `json:"version"`
-	Goroutines      int             `json:"goroutines"`
-	Threads         int             `json:"threads"`
-	MemStats        GoMemStats      `json:"mem_stats"`
-	GCDurationStats GCDurationStats `json:"gc_duration_stats"`
-	ProcStats       ProcStats       `json:"proc_stats"`
-	CapsStats       *CapsStats      `json:"caps_stats"`
+	PID             int                               `json:"pid"`
+	GoVersion       string                            `json:"go_version"`
+	NodeID          string                            `json:"node_id"`
+	Version         string                            `json:"version"`
+	Goroutines      int                               `json:"goroutines"`
+	Threads         int                               `json:"threads"`
+	MemStats        GoMemStats                        `json:"mem_stats"`
+	GCDurationStats GCDurationStats                   `json:"gc_duration_stats"`
+	ProcStats       ProcStats                         `json:"proc_stats"`
+	CapsStats       *CapsStats                        `json:"caps_stats"`
+	RateLimitStats  map[string]*engine.RateLimitStats `json:"rate_limit_stats"`
 }
 
 func (sm StatusMetrics) ToMap(debug bool, timezone string) (map[string]any, error) {
@@ -59,6 +61,9 @@ func (sm StatusMetrics) ToMap(debug bool, timezone string) (map[string]any, erro
 	if sm.CapsStats != nil {
 		m["caps_stats"] = sm.CapsStats.ToMap()
 	}
+	if sm.RateLimitStats != nil {
+		m["rate_limit_stats"] = rateLimitStatsToMap(sm.RateLimitStats)
+	}
 	return m, nil
 }
 
@@ -95,6 +100,9 @@ func (sm StatusMetrics) ToMapCondensed(timezone string) (map[string]any, error)
 			m[utils.CAPSPeak] = *sm.CapsStats.Peak
 		}
 	}
+	if sm.RateLimitStats != nil {
+		m[utils.RateLimitStats] = rateLimitStatsToMap(sm.RateLimitStats)
+	}
 	return m, nil
 }
 
@@ -209,6 +217,18 @@ func (cs *CapsStats) ToMap() map[string]any {
 	return m
 }
 
+func rateLimitStatsToMap(rls map[string]*engine.RateLimitStats) map[string]any {
+	m := make(map[string]any, len(rls))
+	for id, rl := range rls {
+		m[id] = map[string]any{
+			"allowed":  rl.Allowed,
+			"rejected": rl.Rejected,
+			"buckets":  rl.Buckets,
+		}
+	}
+	return m
+}
+
 func computeAppMetrics() (StatusMetrics, error) {
 	vers, err := utils.GetCGRVersion()
 	if err != nil {
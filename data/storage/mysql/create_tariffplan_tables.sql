@@ -18,6 +18,24 @@ CREATE TABLE `tp_timings` (
   UNIQUE KEY `tpid_tag` (`tpid`,`tag`)
 );
 
+--
+-- Table structure for table `tp_holiday_calendars`
+--
+
+DROP TABLE IF EXISTS `tp_holiday_calendars`;
+CREATE TABLE `tp_holiday_calendars` (
+  `pk` int(11) NOT NULL AUTO_INCREMENT,
+  `tpid` varchar(64) NOT NULL,
+  `tenant` varchar(64) NOT NULL,
+  `id` varchar(64) NOT NULL,
+  `date` varchar(32) NOT NULL,
+  `name` varchar(64) NOT NULL,
+  `created_at` TIMESTAMP,
+  PRIMARY KEY (`pk`),
+  KEY `tpid` (`tpid`),
+  UNIQUE KEY `unique_tp_holiday_calendars` (`tpid`,`tenant`,`id`,`date`)
+);
+
 --
 -- Table structure for table `tp_destinations`
 --
@@ -17,6 +17,23 @@ CREATE TABLE tp_timings (
 CREATE INDEX tptimings_tpid_idx ON tp_timings (tpid);
 CREATE INDEX tptimings_idx ON tp_timings (tpid,tag);
 
+--
+-- Table structure for table `tp_holiday_calendars`
+--
+
+DROP TABLE IF EXISTS tp_holiday_calendars;
+CREATE TABLE tp_holiday_calendars (
+  "pk" SERIAL PRIMARY KEY,
+  "tpid" varchar(64) NOT NULL,
+  "tenant" varchar(64) NOT NULL,
+  "id" varchar(64) NOT NULL,
+  "date" varchar(32) NOT NULL,
+  "name" varchar(64) NOT NULL,
+  "created_at" TIMESTAMP WITH TIME ZONE,
+  UNIQUE (tpid, tenant, id, date)
+);
+CREATE INDEX tp_holiday_calendars_idx ON tp_holiday_calendars (tpid);
+
 --
 -- Table structure for table `tp_destinations`
 --
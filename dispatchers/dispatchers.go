@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cgrates/birpc/context"
@@ -39,10 +40,12 @@ func NewDispatcherService(dm *engine.DataManager,
 	cfg *config.CGRConfig, fltrS *engine.FilterS,
 	connMgr *engine.ConnManager) *DispatcherService {
 	return &DispatcherService{
-		dm:      dm,
-		cfg:     cfg,
-		fltrS:   fltrS,
-		connMgr: connMgr,
+		dm:              dm,
+		cfg:             cfg,
+		fltrS:           fltrS,
+		connMgr:         connMgr,
+		loopStopped:     make(chan struct{}, 1),
+		stopHealthCheck: make(chan struct{}),
 	}
 }
 
@@ -53,11 +56,44 @@ type DispatcherService struct {
 	cfg     *config.CGRConfig
 	fltrS   *engine.FilterS
 	connMgr *engine.ConnManager
+
+	loopStopped     chan struct{}
+	stopHealthCheck chan struct{} // nil after shutdown
+	hcMux           sync.Mutex    // protects stopHealthCheck
+}
+
+// StartLoop starts the gorutine with the health check loop
+func (dS *DispatcherService) StartLoop() {
+	dS.hcMux.Lock()
+	defer dS.hcMux.Unlock()
+	if dS.stopHealthCheck == nil { // already shut down
+		return
+	}
+	go dS.runHealthCheck(dS.stopHealthCheck)
+}
+
+// Reload stops the health check loop and restarts it
+func (dS *DispatcherService) Reload() {
+	dS.hcMux.Lock()
+	defer dS.hcMux.Unlock()
+	if dS.stopHealthCheck == nil { // already shut down
+		return
+	}
+	close(dS.stopHealthCheck)
+	<-dS.loopStopped // wait until the loop is done
+	dS.stopHealthCheck = make(chan struct{})
+	go dS.runHealthCheck(dS.stopHealthCheck)
 }
 
 // Shutdown is called to shutdown the service
 func (dS *DispatcherService) Shutdown() {
 	utils.Logger.Info(fmt.Sprintf("<%s> service shutdown initialized", utils.DispatcherS))
+	dS.hcMux.Lock()
+	if dS.stopHealthCheck != nil {
+		close(dS.stopHealthCheck)
+		dS.stopHealthCheck = nil
+	}
+	dS.hcMux.Unlock()
 	utils.Logger.Info(fmt.Sprintf("<%s> service shutdown complete", utils.DispatcherS))
 }
 
@@ -132,7 +132,7 @@ func TestDispatcherCall1(t *testing.T) {
 }
 
 func TestDispatcherCall2(t *testing.T) {
-	dS := &DispatcherService{}
+	dS := NewDispatcherService(nil, config.NewDefaultCGRConfig(), nil, nil)
 	var reply string
 	if err := dS.Call(context.Background(), "DispatcherServicePing", &utils.CGREvent{}, &reply); err == nil || err.Error() != rpcclient.ErrUnsupporteServiceMethod.Error() {
 		t.Error(err)
@@ -69,3 +69,21 @@ func (dS *DispatcherService) EeSv1ProcessEvent(ctx *context.Context, args *engin
 	}
 	return dS.Dispatch(&utils.CGREvent{Tenant: tnt, Event: ev, APIOpts: opts}, utils.MetaEEs, utils.EeSv1ProcessEvent, args, reply)
 }
+
+func (dS *DispatcherService) EeSv1GetRetryQueues(ctx *context.Context, args *engine.ArgsGetRetryQueues, reply *map[string]*engine.RetryQueueStats) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args != nil && len(args.Tenant) != 0 {
+		tnt = args.Tenant
+	}
+	opts := make(map[string]any)
+	if args != nil {
+		opts = args.APIOpts
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.EeSv1GetRetryQueues, tnt,
+			utils.IfaceAsString(opts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{Tenant: tnt, APIOpts: opts}, utils.MetaEEs, utils.EeSv1GetRetryQueues, args, reply)
+}
@@ -0,0 +1,204 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package dispatchers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/cores"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+var hostsHealth = &healthMetrics{hosts: make(map[string]*HostHealth)}
+
+// HostHealth is the health state of a DispatcherHost as seen by the health checks
+type HostHealth struct {
+	Healthy              bool
+	ConsecutiveFailures  int
+	ConsecutiveSuccesses int
+	LastCheck            time.Time
+	LastError            string
+}
+
+// healthMetrics keeps the health state per dispatcher host
+type healthMetrics struct {
+	mutex sync.RWMutex
+	hosts map[string]*HostHealth
+}
+
+// isHealthy returns false only for the hosts marked as unhealthy, the unchecked ones being considered healthy
+func (hm *healthMetrics) isHealthy(tntHostID string) (healthy bool) {
+	hm.mutex.RLock()
+	hH, has := hm.hosts[tntHostID]
+	healthy = !has || hH.Healthy
+	hm.mutex.RUnlock()
+	return
+}
+
+// record updates the host state with the result of a check
+// the host is marked unhealthy after unhealthyThld consecutive failures
+// and brought back after healthyThld consecutive successes
+func (hm *healthMetrics) record(tntHostID string, err error, unhealthyThld, healthyThld int) {
+	hm.mutex.Lock()
+	defer hm.mutex.Unlock()
+	hH, has := hm.hosts[tntHostID]
+	if !has {
+		hH = &HostHealth{Healthy: true}
+		hm.hosts[tntHostID] = hH
+	}
+	hH.LastCheck = time.Now()
+	if err == nil {
+		hH.LastError = utils.EmptyString
+		hH.ConsecutiveFailures = 0
+		hH.ConsecutiveSuccesses++
+		if !hH.Healthy && hH.ConsecutiveSuccesses >= healthyThld {
+			hH.Healthy = true
+			utils.Logger.Info(fmt.Sprintf("<%s> host with identity <%q> is healthy again",
+				utils.DispatcherS, tntHostID))
+		}
+		return
+	}
+	hH.LastError = err.Error()
+	hH.ConsecutiveSuccesses = 0
+	hH.ConsecutiveFailures++
+	if hH.Healthy && hH.ConsecutiveFailures >= unhealthyThld {
+		hH.Healthy = false
+		utils.Logger.Warning(fmt.Sprintf("<%s> marking host with identity <%q> as unhealthy, error: <%s>",
+			utils.DispatcherS, tntHostID, err.Error()))
+	}
+}
+
+// keepOnly removes the state of the hosts not part of tntHostIDs
+func (hm *healthMetrics) keepOnly(tntHostIDs utils.StringSet) {
+	hm.mutex.Lock()
+	for tntHostID := range hm.hosts {
+		if !tntHostIDs.Has(tntHostID) {
+			delete(hm.hosts, tntHostID)
+		}
+	}
+	hm.mutex.Unlock()
+}
+
+// getHosts returns a copy of the health state for the hosts of the tenant, indexed on host ID
+func (hm *healthMetrics) getHosts(tnt string) (hHs map[string]*HostHealth) {
+	hHs = make(map[string]*HostHealth)
+	tntPrfx := tnt + utils.ConcatenatedKeySep
+	hm.mutex.RLock()
+	for tntHostID, hH := range hm.hosts {
+		if strings.HasPrefix(tntHostID, tntPrfx) {
+			cln := *hH
+			hHs[tntHostID[len(tntPrfx):]] = &cln
+		}
+	}
+	hm.mutex.RUnlock()
+	return
+}
+
+// recordCallFailure opens the circuit towards the host on network errors, when the health checking is active
+func recordCallFailure(tntHostID string, err error) {
+	dspCfg := config.CgrConfig().DispatcherSCfg()
+	if dspCfg.HealthCheckInterval <= 0 {
+		return
+	}
+	hostsHealth.record(tntHostID, err, dspCfg.UnhealthyThreshold, dspCfg.HealthyThreshold)
+}
+
+// runHealthCheck will regularly probe the DispatcherHosts until stopHealthCheck is closed
+func (dS *DispatcherService) runHealthCheck(stopHealthCheck chan struct{}) {
+	interval := dS.cfg.DispatcherSCfg().HealthCheckInterval
+	if interval <= 0 {
+		hostsHealth.keepOnly(utils.StringSet{}) // no health checks, consider all hosts healthy
+		dS.loopStopped <- struct{}{}
+		return
+	}
+	for {
+		dS.checkHostsHealth()
+		select {
+		case <-stopHealthCheck:
+			dS.loopStopped <- struct{}{}
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// checkHostsHealth probes in parallel all the DispatcherHosts from DataDB
+func (dS *DispatcherService) checkHostsHealth() {
+	keys, err := dS.dm.DataDB().GetKeysForPrefix(utils.DispatcherHostPrefix)
+	if err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed retrieving the hosts for health checks, error: <%s>",
+			utils.DispatcherS, err.Error()))
+		return
+	}
+	dspCfg := dS.cfg.DispatcherSCfg()
+	tntHostIDs := make(utils.StringSet)
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		tntID := utils.NewTenantID(key[len(utils.DispatcherHostPrefix):])
+		var dH *engine.DispatcherHost
+		if dH, err = dS.dm.GetDispatcherHost(tntID.Tenant, tntID.ID,
+			true, true, utils.NonTransactional); err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> failed retrieving host with identity <%q> for health checks, error: <%s>",
+				utils.DispatcherS, tntID.TenantID(), err.Error()))
+			continue
+		}
+		tntHostIDs.Add(dH.TenantID())
+		wg.Add(1)
+		go func(dH *engine.DispatcherHost) {
+			hostsHealth.record(dH.TenantID(), probeHost(dH, dspCfg.HealthCheckMethod),
+				dspCfg.UnhealthyThreshold, dspCfg.HealthyThreshold)
+			wg.Done()
+		}(dH)
+	}
+	wg.Wait()
+	hostsHealth.keepOnly(tntHostIDs)
+}
+
+// probeHost checks the host using the configured API
+func probeHost(dH *engine.DispatcherHost, method string) (err error) {
+	if method == utils.CoreSv1Status {
+		var reply map[string]any
+		return dH.Call(context.TODO(), utils.CoreSv1Status,
+			&cores.V1StatusParams{Tenant: dH.Tenant}, &reply)
+	}
+	var reply string
+	return dH.Call(context.TODO(), utils.CoreSv1Ping,
+		&utils.CGREvent{Tenant: dH.Tenant}, &reply)
+}
+
+// DispatcherSv1GetHostsHealth returns the health state of the DispatcherHosts within the tenant
+func (dS *DispatcherService) DispatcherSv1GetHostsHealth(ctx *context.Context, args *utils.TenantWithAPIOpts,
+	reply *map[string]*HostHealth) (err error) {
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = dS.cfg.GeneralCfg().DefaultTenant
+	}
+	hHs := hostsHealth.getHosts(tnt)
+	if len(hHs) == 0 {
+		return utils.ErrNotFound
+	}
+	*reply = hHs
+	return
+}
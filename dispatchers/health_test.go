@@ -0,0 +1,162 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package dispatchers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestHealthMetricsRecord(t *testing.T) {
+	hm := &healthMetrics{hosts: make(map[string]*HostHealth)}
+	if !hm.isHealthy("cgrates.org:HOST1") {
+		t.Error("expected unchecked host to be healthy")
+	}
+	hm.record("cgrates.org:HOST1", utils.ErrDisconnected, 2, 3)
+	if !hm.isHealthy("cgrates.org:HOST1") {
+		t.Error("expected host to be healthy before reaching the unhealthy threshold")
+	}
+	hm.record("cgrates.org:HOST1", utils.ErrDisconnected, 2, 3)
+	if hm.isHealthy("cgrates.org:HOST1") {
+		t.Error("expected host to be unhealthy")
+	}
+	for i := 0; i < 2; i++ {
+		hm.record("cgrates.org:HOST1", nil, 2, 3)
+		if hm.isHealthy("cgrates.org:HOST1") {
+			t.Errorf("expected host to be unhealthy after %d successful checks", i+1)
+		}
+	}
+	hm.record("cgrates.org:HOST1", nil, 2, 3)
+	if !hm.isHealthy("cgrates.org:HOST1") {
+		t.Error("expected host to be healthy again")
+	}
+	hm.record("cgrates.org:HOST1", utils.ErrDisconnected, 2, 3)
+	hm.record("cgrates.net:HOST2", nil, 2, 3)
+	rcv := hm.getHosts("cgrates.org")
+	if len(rcv) != 1 || rcv["HOST1"] == nil {
+		t.Fatalf("unexpected hosts: %s", utils.ToJSON(rcv))
+	}
+	if !rcv["HOST1"].Healthy || rcv["HOST1"].ConsecutiveFailures != 1 ||
+		rcv["HOST1"].ConsecutiveSuccesses != 0 || rcv["HOST1"].LastError != utils.ErrDisconnected.Error() {
+		t.Errorf("unexpected health: %s", utils.ToJSON(rcv["HOST1"]))
+	}
+	hm.keepOnly(utils.NewStringSet([]string{"cgrates.net:HOST2"}))
+	if rcv := hm.getHosts("cgrates.org"); len(rcv) != 0 {
+		t.Errorf("expected no hosts, received: %s", utils.ToJSON(rcv))
+	}
+}
+
+func TestHealthGetDispatcherHostsSkipUnhealthy(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	flts := engine.NewFilterS(cfg, nil, nil)
+	hosts := engine.DispatcherHostProfiles{{ID: "HLT_HOST1"}, {ID: "HLT_HOST2"}, {ID: "HLT_HOST3"}}
+	hostsHealth.record("cgrates.org:HLT_HOST2", utils.ErrDisconnected, 1, 1)
+	defer hostsHealth.keepOnly(utils.StringSet{})
+	exp := engine.DispatcherHostIDs{"HLT_HOST1", "HLT_HOST3"}
+	if hostIDs, err := getDispatcherHosts(flts, nil, "cgrates.org", hosts); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(exp, hostIDs) {
+		t.Errorf("Expected: %q, received: %q", exp, hostIDs)
+	}
+	hostsHealth.record("cgrates.org:HLT_HOST2", nil, 1, 1)
+	exp = engine.DispatcherHostIDs{"HLT_HOST1", "HLT_HOST2", "HLT_HOST3"}
+	if hostIDs, err := getDispatcherHosts(flts, nil, "cgrates.org", hosts); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(exp, hostIDs) {
+		t.Errorf("Expected: %q, received: %q", exp, hostIDs)
+	}
+}
+
+func TestHealthCheckHostsHealth(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.DispatcherSCfg().HealthCheckInterval = time.Minute
+	dataDB := engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	dm := engine.NewDataManager(dataDB, cfg.CacheCfg(), nil)
+	if err := dm.SetDispatcherHost(&engine.DispatcherHost{
+		Tenant: "cgrates.org",
+		RemoteHost: &config.RemoteHost{
+			ID:              "HLT_DOWN",
+			Address:         "127.0.0.1:1",
+			Transport:       utils.MetaJSON,
+			ConnectAttempts: 1,
+			ConnectTimeout:  10 * time.Millisecond,
+			ReplyTimeout:    10 * time.Millisecond,
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	defer hostsHealth.keepOnly(utils.StringSet{})
+	dS := NewDispatcherService(dm, cfg, nil, nil)
+	var reply map[string]*HostHealth
+	if err := dS.DispatcherSv1GetHostsHealth(context.Background(), &utils.TenantWithAPIOpts{},
+		&reply); err != utils.ErrNotFound {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+	dS.checkHostsHealth()
+	if err := dS.DispatcherSv1GetHostsHealth(context.Background(), &utils.TenantWithAPIOpts{},
+		&reply); err != nil {
+		t.Fatal(err)
+	} else if hH, has := reply["HLT_DOWN"]; !has {
+		t.Errorf("expected the health of the host, received: %s", utils.ToJSON(reply))
+	} else if hH.Healthy || hH.ConsecutiveFailures != 1 || hH.LastError == utils.EmptyString {
+		t.Errorf("unexpected health: %s", utils.ToJSON(hH))
+	}
+	if err := dm.RemoveDispatcherHost("cgrates.org", "HLT_DOWN"); err != nil {
+		t.Fatal(err)
+	}
+	dS.checkHostsHealth()
+	if err := dS.DispatcherSv1GetHostsHealth(context.Background(), &utils.TenantWithAPIOpts{},
+		&reply); err != utils.ErrNotFound {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestHealthRunHealthCheckDisabled(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	hostsHealth.record("cgrates.org:HLT_HOST1", utils.ErrDisconnected, 1, 1)
+	dS := NewDispatcherService(nil, cfg, nil, nil)
+	dS.StartLoop()
+	select {
+	case <-dS.loopStopped:
+	case <-time.After(time.Second):
+		t.Fatal("expected the health check loop to stop")
+	}
+	if !hostsHealth.isHealthy("cgrates.org:HLT_HOST1") {
+		t.Error("expected the hosts to be healthy with the health checks disabled")
+	}
+}
+
+func TestHealthShutdownReload(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	dS := NewDispatcherService(nil, cfg, nil, nil)
+	dS.StartLoop()
+	dS.Reload()
+	dS.Shutdown()
+	dS.Shutdown() // should not panic closing the channel again
+	dS.Reload()   // should not restart the health checks after shutdown
+	if dS.stopHealthCheck != nil {
+		t.Errorf("expected the health checks to remain stopped, received: %v", dS.stopHealthCheck)
+	}
+}
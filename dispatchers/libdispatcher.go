@@ -21,8 +21,10 @@ package dispatchers
 import (
 	"encoding/gob"
 	"fmt"
+	"hash/fnv"
 	"math/rand"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -33,8 +35,16 @@ import (
 	"github.com/cgrates/rpcclient"
 )
 
+const (
+	// defaultVirtualNodes is the number of points each host gets on the consistent hash ring
+	defaultVirtualNodes = 100
+	// latencyEWMAWeight is the weight of the last round-trip time within the host latency average
+	latencyEWMAWeight = 0.2
+)
+
 var (
 	internalDispatcher = &engine.DispatcherProfile{Tenant: utils.MetaInternal, ID: utils.MetaInternal}
+	hostLatencies      = &latencyMetrics{avgs: make(map[string]float64)}
 )
 
 func init() {
@@ -95,6 +105,14 @@ func newDispatcher(pfl *engine.DispatcherProfile) (d Dispatcher, err error) {
 		return newSingleDispatcher(hosts, pfl.StrategyParams, pfl.TenantID(), new(randomSort))
 	case utils.MetaRoundRobin:
 		return newSingleDispatcher(hosts, pfl.StrategyParams, pfl.TenantID(), new(roundRobinSort))
+	case utils.MetaConsistentHash:
+		var chs *consistentHashSort
+		if chs, err = newConsistentHashSort(hosts, pfl.StrategyParams); err != nil {
+			return
+		}
+		return newSingleDispatcher(hosts, pfl.StrategyParams, pfl.TenantID(), chs)
+	case utils.MetaLeastLatency:
+		return newSingleDispatcher(hosts, pfl.StrategyParams, pfl.TenantID(), new(leastLatencySort))
 	case rpcclient.PoolBroadcast,
 		rpcclient.PoolBroadcastSync,
 		rpcclient.PoolBroadcastAsync:
@@ -112,6 +130,9 @@ func newDispatcher(pfl *engine.DispatcherProfile) (d Dispatcher, err error) {
 func getDispatcherHosts(fltrs *engine.FilterS, ev utils.DataProvider, tnt string, hosts engine.DispatcherHostProfiles) (hostIDs engine.DispatcherHostIDs, err error) {
 	hostIDs = make(engine.DispatcherHostIDs, 0, len(hosts))
 	for _, host := range hosts {
+		if !hostsHealth.isHealthy(utils.ConcatenatedKey(tnt, host.ID)) {
+			continue
+		}
 		var pass bool
 		if pass, err = fltrs.Pass(tnt, host.FilterIDs, ev); err != nil {
 			return
@@ -169,6 +190,104 @@ func (rs *roundRobinSort) Sort(fltrs *engine.FilterS, ev utils.DataProvider, tnt
 	return getDispatcherHosts(fltrs, ev, tnt, dh)
 }
 
+// hashRingNode is a point on the consistent hash ring owned by a host
+type hashRingNode struct {
+	hash   uint32
+	hostID string
+}
+
+// newConsistentHashSort builds the hash ring out of the profile hosts
+func newConsistentHashSort(hosts engine.DispatcherHostProfiles, params map[string]any) (chs *consistentHashSort, err error) {
+	hashFld, has := params[utils.MetaHashField]
+	if !has {
+		hashFld, has = params["0"] // parameters loaded from .csv are positional
+	}
+	if !has {
+		return nil, fmt.Errorf("missing <%s> parameter for <%s> strategy",
+			utils.MetaHashField, utils.MetaConsistentHash)
+	}
+	vNodes := int64(defaultVirtualNodes)
+	if vn, has := params[utils.MetaVirtualNodes]; has {
+		if vNodes, err = utils.IfaceAsTInt64(vn); err != nil {
+			return
+		}
+		if vNodes < 1 {
+			return nil, fmt.Errorf("invalid <%s> parameter: %d", utils.MetaVirtualNodes, vNodes)
+		}
+	}
+	chs = &consistentHashSort{
+		hashField: utils.IfaceAsString(hashFld),
+		ring:      make([]hashRingNode, 0, len(hosts)*int(vNodes)),
+	}
+	for _, host := range hosts {
+		for i := int64(0); i < vNodes; i++ {
+			chs.ring = append(chs.ring, hashRingNode{
+				hash:   hashKey(host.ID + utils.HashtagSep + strconv.FormatInt(i, 10)),
+				hostID: host.ID,
+			})
+		}
+	}
+	sort.Slice(chs.ring, func(i, j int) bool { return chs.ring[i].hash < chs.ring[j].hash })
+	return
+}
+
+// consistentHashSort will sort the matching hosts walking the hash ring clockwise from the event key,
+// so the same key lands always on the same host and adding or removing a host moves only its own keys
+type consistentHashSort struct {
+	hashField string
+	ring      []hashRingNode
+}
+
+func (chs *consistentHashSort) Sort(fltrs *engine.FilterS, ev utils.DataProvider, tnt string, hosts engine.DispatcherHostProfiles) (hostIDs engine.DispatcherHostIDs, err error) {
+	var key string
+	if key, err = utils.DPDynamicString(chs.hashField, ev); err != nil {
+		if err != utils.ErrNotFound {
+			return
+		}
+		// nothing to hash on, fallback on the weight order
+		return getDispatcherHosts(fltrs, ev, tnt, hosts)
+	}
+	hstsByID := make(map[string]*engine.DispatcherHostProfile, len(hosts))
+	for _, host := range hosts {
+		hstsByID[host.ID] = host
+	}
+	dh := make(engine.DispatcherHostProfiles, 0, len(hosts))
+	keyHash := hashKey(key)
+	startIdx := sort.Search(len(chs.ring), func(i int) bool { return chs.ring[i].hash >= keyHash })
+	for i := 0; i < len(chs.ring) && len(hstsByID) != 0; i++ {
+		hostID := chs.ring[(startIdx+i)%len(chs.ring)].hostID
+		if host, has := hstsByID[hostID]; has {
+			dh = append(dh, host)
+			delete(hstsByID, hostID)
+		}
+	}
+	return getDispatcherHosts(fltrs, ev, tnt, dh)
+}
+
+// hashKey returns the position of the key on the hash ring
+func hashKey(key string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return h.Sum32()
+}
+
+// leastLatencySort will sort the matching hosts based on the moving average of their round-trip time,
+// the hosts without measurements being tried first
+type leastLatencySort struct{}
+
+func (leastLatencySort) Sort(fltrs *engine.FilterS, ev utils.DataProvider, tnt string, hosts engine.DispatcherHostProfiles) (hostIDs engine.DispatcherHostIDs, err error) {
+	hlp := &hostCosts{
+		hosts: make(engine.DispatcherHostProfiles, len(hosts)),
+		load:  make([]int64, len(hosts)),
+	}
+	copy(hlp.hosts, hosts)
+	for i, host := range hosts {
+		hlp.load[i] = int64(hostLatencies.getAverage(utils.ConcatenatedKey(tnt, host.ID)))
+	}
+	sort.Stable(hlp)
+	return getDispatcherHosts(fltrs, ev, tnt, hlp.hosts)
+}
+
 // newSingleDispatcher is the constructor for singleDispatcher struct
 func newSingleDispatcher(hosts engine.DispatcherHostProfiles, params map[string]any, tntID string, sorter hostSorter) (_ Dispatcher, err error) {
 	if dflt, has := params[utils.MetaDefaultRatio]; has {
@@ -209,7 +328,8 @@ type singleResultDispatcher struct {
 func (sd *singleResultDispatcher) Dispatch(dm *engine.DataManager, flts *engine.FilterS,
 	ev utils.DataProvider, tnt, routeID string, dR *DispatcherRoute,
 	serviceMethod string, args any, reply any) (err error) {
-	if dR != nil && dR.HostID != utils.EmptyString { // route to previously discovered route
+	if dR != nil && dR.HostID != utils.EmptyString &&
+		hostsHealth.isHealthy(utils.ConcatenatedKey(tnt, dR.HostID)) { // route to previously discovered route
 		return callDHwithID(tnt, dR.HostID, routeID, dR, dm,
 			serviceMethod, args, reply)
 	}
@@ -309,7 +429,8 @@ func (ld *loadDispatcher) Dispatch(dm *engine.DataManager, flts *engine.FilterS,
 	} else if lM, err = newLoadMetrics(ld.hosts, ld.defaultRatio); err != nil {
 		return
 	}
-	if dR != nil && dR.HostID != utils.EmptyString { // route to previously discovered route
+	if dR != nil && dR.HostID != utils.EmptyString &&
+		hostsHealth.isHealthy(utils.ConcatenatedKey(tnt, dR.HostID)) { // route to previously discovered route
 		lM.incrementLoad(dR.HostID, ld.tntID)
 		err = callDHwithID(tnt, dR.HostID, routeID, dR, dm,
 			serviceMethod, args, reply)
@@ -426,6 +547,31 @@ func (lM *LoadMetrics) decrementLoad(hostID, tntID string) {
 	lM.mutex.Unlock()
 }
 
+// latencyMetrics keeps the moving average of the RPC round-trip time, in nanoseconds, per dispatcher host
+type latencyMetrics struct {
+	mutex sync.RWMutex
+	avgs  map[string]float64
+}
+
+// record adds the round-trip time of a call into the host average
+func (lat *latencyMetrics) record(tntHostID string, rtt time.Duration) {
+	lat.mutex.Lock()
+	if avg, has := lat.avgs[tntHostID]; has {
+		lat.avgs[tntHostID] = avg + latencyEWMAWeight*(float64(rtt)-avg)
+	} else {
+		lat.avgs[tntHostID] = float64(rtt)
+	}
+	lat.mutex.Unlock()
+}
+
+// getAverage returns the average round-trip time of the host, 0 if not measured yet
+func (lat *latencyMetrics) getAverage(tntHostID string) (avg float64) {
+	lat.mutex.RLock()
+	avg = lat.avgs[tntHostID]
+	lat.mutex.RUnlock()
+	return
+}
+
 // lazyDH is created for the broadcast strategy so we can make sure host exists during setup phase
 type lazyDH struct {
 	dh      *engine.DispatcherHost
@@ -460,8 +606,14 @@ func callDH(dh *engine.DispatcherHost, routeID string, dR *DispatcherRoute,
 				utils.DispatcherS, err.Error(), dR))
 		}
 	}
-	if err = dh.Call(context.TODO(), method, args, reply); err != nil {
-		return
+	start := time.Now()
+	if err = dh.Call(context.TODO(), method, args, reply); rpcclient.ShouldFailover(err) {
+		recordCallFailure(dh.TenantID(), err)
+		// network errors do not reflect the round-trip time, penalize the host so it is not tried first
+		hostLatencies.record(dh.TenantID(),
+			max(time.Since(start), config.CgrConfig().GeneralCfg().ConnectTimeout))
+	} else {
+		hostLatencies.record(dh.TenantID(), time.Since(start))
 	}
 	return
 }
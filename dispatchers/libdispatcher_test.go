@@ -21,6 +21,7 @@ package dispatchers
 import (
 	"net/rpc"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
@@ -1025,3 +1026,164 @@ func TestLibDispatcherNewInternalHost(t *testing.T) {
 		t.Errorf("newInternalHost(%q) returned an unexpected value(-want +got): \n%s", tnt, diff)
 	}
 }
+
+func TestLibDispatcherNewDispatcherMetaConsistentHash(t *testing.T) {
+	pfl := &engine.DispatcherProfile{
+		Hosts:          engine.DispatcherHostProfiles{{ID: "HOST1"}, {ID: "HOST2"}},
+		Strategy:       utils.MetaConsistentHash,
+		StrategyParams: map[string]any{utils.MetaHashField: "~*req.Account", utils.MetaVirtualNodes: 10},
+	}
+	result, err := newDispatcher(pfl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chs, canCast := result.(*singleResultDispatcher).sorter.(*consistentHashSort)
+	if !canCast {
+		t.Fatalf("unexpected sorter: %T", result.(*singleResultDispatcher).sorter)
+	}
+	if chs.hashField != "~*req.Account" || len(chs.ring) != 20 {
+		t.Errorf("unexpected hash ring: %+v", chs)
+	}
+	pfl.StrategyParams = map[string]any{"0": "~*req.Account"} // loaded from .csv
+	if _, err = newDispatcher(pfl); err != nil {
+		t.Error(err)
+	}
+	pfl.StrategyParams = nil
+	expErr := "missing <*hash_field> parameter for <*consistent_hash> strategy"
+	if _, err = newDispatcher(pfl); err == nil || err.Error() != expErr {
+		t.Errorf("Expected <%+v>, received <%+v>", expErr, err)
+	}
+	pfl.StrategyParams = map[string]any{utils.MetaHashField: "~*req.Account", utils.MetaVirtualNodes: 0}
+	expErr = "invalid <*virtual_nodes> parameter: 0"
+	if _, err = newDispatcher(pfl); err == nil || err.Error() != expErr {
+		t.Errorf("Expected <%+v>, received <%+v>", expErr, err)
+	}
+}
+
+func TestLibDispatcherConsistentHashSort(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	flts := engine.NewFilterS(cfg, nil, nil)
+	hosts := engine.DispatcherHostProfiles{{ID: "HOST1"}, {ID: "HOST2"}, {ID: "HOST3"}}
+	sorter, err := newConsistentHashSort(hosts, map[string]any{utils.MetaHashField: "~*req.Account"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostFor := func(srt *consistentHashSort, hosts engine.DispatcherHostProfiles, acnt string) string {
+		hostIDs, err := srt.Sort(flts, utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.AccountField: acnt}}, "cgrates.org", hosts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hostIDs) != len(hosts) {
+			t.Fatalf("expected all the hosts, received: %q", hostIDs)
+		}
+		return hostIDs[0]
+	}
+	assigned := make(map[string]string)
+	for i := 0; i < 1000; i++ {
+		acnt := strconv.Itoa(1000 + i)
+		assigned[acnt] = hostFor(sorter, hosts, acnt)
+		if rcv := hostFor(sorter, hosts, acnt); rcv != assigned[acnt] {
+			t.Fatalf("account %s moved from %s to %s", acnt, assigned[acnt], rcv)
+		}
+	}
+	// adding a host should move only the keys towards the new host
+	hosts4 := append(hosts.Clone(), &engine.DispatcherHostProfile{ID: "HOST4"})
+	sorter4, err := newConsistentHashSort(hosts4, map[string]any{utils.MetaHashField: "~*req.Account"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var moved int
+	for acnt, hostID := range assigned {
+		if rcv := hostFor(sorter4, hosts4, acnt); rcv != hostID {
+			if rcv != "HOST4" {
+				t.Errorf("account %s moved from %s to %s", acnt, hostID, rcv)
+			}
+			moved++
+		}
+	}
+	if moved == 0 || moved > 400 {
+		t.Errorf("unexpected number of moved keys: %d", moved)
+	}
+	// removing a host should move only its own keys
+	for acnt, hostID := range assigned {
+		if rcv := hostFor(sorter, hosts[:2], acnt); hostID != "HOST3" && rcv != hostID {
+			t.Errorf("account %s moved from %s to %s", acnt, hostID, rcv)
+		}
+	}
+	// missing hash field keeps the weight order
+	if hostIDs, err := sorter.Sort(flts, utils.MapStorage{}, "cgrates.org", hosts); err != nil {
+		t.Error(err)
+	} else if exp := (engine.DispatcherHostIDs{"HOST1", "HOST2", "HOST3"}); !reflect.DeepEqual(exp, hostIDs) {
+		t.Errorf("Expected: %q, received: %q", exp, hostIDs)
+	}
+}
+
+func TestLibDispatcherLeastLatencySort(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	flts := engine.NewFilterS(cfg, nil, nil)
+	hosts := engine.DispatcherHostProfiles{{ID: "LAT_HOST1"}, {ID: "LAT_HOST2"}, {ID: "LAT_HOST3"}}
+	hostLatencies.record("cgrates.org:LAT_HOST1", 30*time.Millisecond)
+	hostLatencies.record("cgrates.org:LAT_HOST2", 10*time.Millisecond)
+	sorter := new(leastLatencySort)
+	exp := engine.DispatcherHostIDs{"LAT_HOST3", "LAT_HOST2", "LAT_HOST1"}
+	if hostIDs, err := sorter.Sort(flts, nil, "cgrates.org", hosts); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(exp, hostIDs) {
+		t.Errorf("Expected: %q, received: %q", exp, hostIDs)
+	}
+	hostLatencies.record("cgrates.org:LAT_HOST3", 50*time.Millisecond)
+	for i := 0; i < 10; i++ {
+		hostLatencies.record("cgrates.org:LAT_HOST2", 100*time.Millisecond)
+	}
+	exp = engine.DispatcherHostIDs{"LAT_HOST1", "LAT_HOST3", "LAT_HOST2"}
+	if hostIDs, err := sorter.Sort(flts, nil, "cgrates.org", hosts); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(exp, hostIDs) {
+		t.Errorf("Expected: %q, received: %q", exp, hostIDs)
+	}
+	if !reflect.DeepEqual(engine.DispatcherHostProfiles{{ID: "LAT_HOST1"}, {ID: "LAT_HOST2"}, {ID: "LAT_HOST3"}}, hosts) {
+		t.Errorf("expected the profile hosts to not be modified, received: %s", utils.ToJSON(hosts))
+	}
+}
+
+func TestLibDispatcherLeastLatencyFailingHost(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	flts := engine.NewFilterS(cfg, nil, nil)
+	hosts := engine.DispatcherHostProfiles{{ID: "LAT_FAIL1"}, {ID: "LAT_OK1"}}
+	hostLatencies.record("cgrates.org:LAT_FAIL1", time.Millisecond)
+	hostLatencies.record("cgrates.org:LAT_OK1", 10*time.Millisecond)
+	dh := &engine.DispatcherHost{
+		Tenant: "cgrates.org",
+		RemoteHost: &config.RemoteHost{
+			ID:        "LAT_FAIL1",
+			Address:   "127.0.0.1:1", // nothing listening
+			Transport: utils.MetaJSON,
+		},
+	}
+	var reply string
+	if err := callDH(dh, utils.EmptyString, nil, utils.CoreSv1Ping,
+		new(utils.CGREvent), &reply); err == nil {
+		t.Fatal("Expected error")
+	}
+	exp := engine.DispatcherHostIDs{"LAT_OK1", "LAT_FAIL1"}
+	if hostIDs, err := new(leastLatencySort).Sort(flts, nil, "cgrates.org", hosts); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(exp, hostIDs) {
+		t.Errorf("Expected: %q, received: %q", exp, hostIDs)
+	}
+}
+
+func TestLibDispatcherLatencyMetricsRecord(t *testing.T) {
+	lat := &latencyMetrics{avgs: make(map[string]float64)}
+	lat.record("cgrates.org:HOST1", 10*time.Millisecond)
+	if avg := lat.getAverage("cgrates.org:HOST1"); avg != float64(10*time.Millisecond) {
+		t.Errorf("unexpected average: %v", avg)
+	}
+	lat.record("cgrates.org:HOST1", 20*time.Millisecond)
+	if avg := lat.getAverage("cgrates.org:HOST1"); avg != float64(12*time.Millisecond) {
+		t.Errorf("unexpected average: %v", avg)
+	}
+	if avg := lat.getAverage("cgrates.org:HOST2"); avg != 0 {
+		t.Errorf("unexpected average: %v", avg)
+	}
+}
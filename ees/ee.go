@@ -39,6 +39,12 @@ type EventExporter interface {
 	PrepareOrderMap(*utils.OrderedNavigableMap) (any, error)
 }
 
+// messagePreparer is implemented by the exporters that need data out of the original
+// event besides the prepared content (e.g. for message keys or headers)
+type messagePreparer interface {
+	prepareMessage(content any, key string, ev *utils.CGREvent, expNM *utils.OrderedNavigableMap) (any, error)
+}
+
 // NewEventExporter produces exporters
 func NewEventExporter(cfg *config.EventExporterCfg, cgrCfg *config.CGRConfig, filterS *engine.FilterS,
 	connMngr *engine.ConnManager) (ee EventExporter, err error) {
@@ -53,6 +59,10 @@ func NewEventExporter(cfg *config.EventExporterCfg, cgrCfg *config.CGRConfig, fi
 		return NewFileCSVee(cfg, cgrCfg, filterS, dc)
 	case utils.MetaFileFWV:
 		return NewFileFWVee(cfg, cgrCfg, filterS, dc)
+	case utils.MetaFileParquet:
+		return NewFileParquetEE(cfg, cgrCfg, dc)
+	case utils.MetaFileAvro:
+		return NewFileAvroEE(cfg, cgrCfg, dc)
 	case utils.MetaHTTPPost:
 		return NewHTTPPostEE(cfg, cgrCfg, filterS, dc)
 	case utils.MetaHTTPjsonMap:
@@ -60,6 +70,9 @@ func NewEventExporter(cfg *config.EventExporterCfg, cgrCfg *config.CGRConfig, fi
 	case utils.MetaNatsjsonMap:
 		return NewNatsEE(cfg, cgrCfg.GeneralCfg().NodeID,
 			cgrCfg.GeneralCfg().ConnectTimeout, dc)
+	case utils.MetaMQTTjsonMap:
+		return NewMQTTEE(cfg, cgrCfg.GeneralCfg().NodeID,
+			cgrCfg.GeneralCfg().ConnectTimeout, cgrCfg.GeneralCfg().ReplyTimeout, dc)
 	case utils.MetaAMQPjsonMap:
 		return NewAMQPee(cfg, dc), nil
 	case utils.MetaAMQPV1jsonMap:
@@ -49,6 +49,9 @@ func NewEventExporterS(cfg *config.CGRConfig, filterS *engine.FilterS,
 	if err := eeS.SetupExporterCache(); err != nil {
 		return nil, fmt.Errorf("failed to set up exporter cache: %v", err)
 	}
+	if err := eeS.SetupRetryQueues(); err != nil {
+		return nil, fmt.Errorf("failed to set up retry queues: %v", err)
+	}
 	return eeS, nil
 }
 
@@ -60,6 +63,9 @@ type EventExporterS struct {
 
 	exporterCache map[string]*ltcache.Cache // map[eeType]*ltcache.Cache
 	mu            sync.RWMutex              // protects exporterCache
+
+	retryQueues map[string]*retryQueue // map[exporterID]*retryQueue
+	rqMu        sync.RWMutex           // protects retryQueues
 }
 
 // ClearExporterCache clears the cache of EventExporters.
@@ -102,6 +108,106 @@ func (eeS *EventExporterS) SetupExporterCache() error {
 	return nil
 }
 
+// SetupRetryQueues starts the retry queues of the exporters having retry_queue_dir
+// configured, resuming the exports left on disk by a previous run.
+func (eeS *EventExporterS) SetupRetryQueues() error {
+	eeS.rqMu.Lock()
+	defer eeS.rqMu.Unlock()
+	if eeS.retryQueues == nil {
+		eeS.retryQueues = make(map[string]*retryQueue)
+	}
+	for _, expCfg := range eeS.cfg.EEsNoLksCfg().Exporters {
+		if expCfg.Type == utils.MetaNone ||
+			expCfg.RetryQueueDir == utils.EmptyString ||
+			expCfg.RetryQueueDir == utils.MetaNone {
+			continue
+		}
+		if rq, has := eeS.retryQueues[expCfg.ID]; has {
+			rq.setBackoff(expCfg.RetryBackoff, expCfg.RetryMaxBackoff)
+			continue
+		}
+		rq, err := newRetryQueue(expCfg.ID, expCfg.RetryQueueDir,
+			expCfg.RetryBackoff, expCfg.RetryMaxBackoff, eeS.retryExports)
+		if err != nil {
+			return fmt.Errorf("failed to init retry queue of EventExporter %q: %v", expCfg.ID, err)
+		}
+		eeS.retryQueues[expCfg.ID] = rq
+	}
+	return nil
+}
+
+// StopRetryQueues stops retrying the queued exports, keeping them on disk for the next start
+func (eeS *EventExporterS) StopRetryQueues() {
+	eeS.rqMu.Lock()
+	defer eeS.rqMu.Unlock()
+	for expID, rq := range eeS.retryQueues {
+		rq.close()
+		delete(eeS.retryQueues, expID)
+	}
+}
+
+// retryQueue returns the retry queue of the exporter, nil if not enabled
+func (eeS *EventExporterS) retryQueue(expID string) (rq *retryQueue) {
+	eeS.rqMu.RLock()
+	rq = eeS.retryQueues[expID]
+	eeS.rqMu.RUnlock()
+	return
+}
+
+// retryExports exports in order the events waiting in the queue, stopping on the first error
+func (eeS *EventExporterS) retryExports(rq *retryQueue) (err error) {
+	eeS.cfg.RLocks(config.EEsJson)
+	eeCfg := eeS.cfg.EEsNoLksCfg().ExporterCfg(rq.exporterID)
+	eeS.cfg.RUnlocks(config.EEsJson)
+	if eeCfg == nil {
+		return fmt.Errorf("exporter with ID %q not configured", rq.exporterID)
+	}
+	var ee EventExporter
+	if ee, err = NewEventExporter(eeCfg, eeS.cfg, eeS.filterS, eeS.connMgr); err != nil {
+		return
+	}
+	defer ee.Close()
+	for {
+		seq, ev, errHead := rq.head()
+		if errHead == utils.ErrNotFound {
+			return
+		}
+		if errHead != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> Exporter <%s> discarding the queued export <%d> because err: <%s>",
+					utils.EEs, rq.exporterID, seq, errHead.Error()))
+			rq.discard(seq)
+			continue
+		}
+		if err = exportWithAttempts(ee, ev.Event, ev.Key); err != nil {
+			return
+		}
+		if err = rq.pop(seq); err != nil {
+			return
+		}
+	}
+}
+
+// V1GetRetryQueues returns the state of the retry queues
+func (eeS *EventExporterS) V1GetRetryQueues(ctx *context.Context, args *engine.ArgsGetRetryQueues,
+	rply *map[string]*engine.RetryQueueStats) error {
+	expIDs := utils.NewStringSet(args.ExporterIDs)
+	eeS.rqMu.RLock()
+	defer eeS.rqMu.RUnlock()
+	stats := make(map[string]*engine.RetryQueueStats)
+	for expID, rq := range eeS.retryQueues {
+		if expIDs.Size() != 0 && !expIDs.Has(expID) {
+			continue
+		}
+		stats[expID] = rq.stats()
+	}
+	if len(stats) == 0 {
+		return utils.ErrNotFound
+	}
+	*rply = stats
+	return nil
+}
+
 func (eeS *EventExporterS) attrSProcessEvent(cgrEv *utils.CGREvent, attrIDs []string, ctx string) (*utils.CGREvent, error) {
 	var rplyEv engine.AttrSProcessEventReply
 	cgrEv.APIOpts[utils.MetaSubsys] = utils.MetaEEs
@@ -249,7 +355,8 @@ func (eeS *EventExporterS) V1ProcessEvent(ctx *context.Context, cgrEv *engine.CG
 					utils.EEs, ee.Cfg().ID))
 		}
 		go func(evict, sync bool, ee EventExporter) {
-			if err := exportEventWithExporter(ee, exportEvent, evict, eeS.cfg, eeS.filterS); err != nil {
+			if err := exportEventWithExporter(ee, exportEvent, evict, eeS.cfg, eeS.filterS,
+				eeS.retryQueue(ee.Cfg().ID)); err != nil {
 				withErr = true
 			}
 			if sync {
@@ -293,7 +400,8 @@ func (eeS *EventExporterS) V1ProcessEvent(ctx *context.Context, cgrEv *engine.CG
 	return
 }
 
-func exportEventWithExporter(exp EventExporter, ev *utils.CGREvent, oneTime bool, cfg *config.CGRConfig, filterS *engine.FilterS) (err error) {
+func exportEventWithExporter(exp EventExporter, ev *utils.CGREvent, oneTime bool, cfg *config.CGRConfig, filterS *engine.FilterS,
+	rq *retryQueue) (err error) {
 	defer func() {
 		updateEEMetrics(exp.GetMetrics(), ev.ID, ev.Event, err != nil, utils.FirstNonEmpty(exp.Cfg().Timezone,
 			cfg.GeneralCfg().DefaultTimezone))
@@ -302,6 +410,7 @@ func exportEventWithExporter(exp EventExporter, ev *utils.CGREvent, oneTime bool
 		}
 	}()
 	var eEv any
+	var expNM *utils.OrderedNavigableMap
 
 	exp.GetMetrics().Lock()
 	exp.GetMetrics().MapStorage[utils.NumberOfEvents] = exp.GetMetrics().MapStorage[utils.NumberOfEvents].(int64) + 1
@@ -311,7 +420,7 @@ func exportEventWithExporter(exp EventExporter, ev *utils.CGREvent, oneTime bool
 			return
 		}
 	} else {
-		expNM := utils.NewOrderedNavigableMap()
+		expNM = utils.NewOrderedNavigableMap()
 		dsMap := map[string]utils.DataStorage{
 			utils.MetaReq:  utils.MapStorage(ev.Event),
 			utils.MetaDC:   exp.GetMetrics(),
@@ -340,7 +449,21 @@ func exportEventWithExporter(exp EventExporter, ev *utils.CGREvent, oneTime bool
 	}
 	key := utils.ConcatenatedKey(utils.FirstNonEmpty(engine.MapEvent(ev.Event).GetStringIgnoreErrors(utils.CGRID), utils.GenUUID()),
 		utils.FirstNonEmpty(engine.MapEvent(ev.Event).GetStringIgnoreErrors(utils.RunID), utils.MetaDefault))
+	if msgPrep, canPrep := exp.(messagePreparer); canPrep {
+		if eEv, err = msgPrep.prepareMessage(eEv, key, ev, expNM); err != nil {
+			return
+		}
+	}
 
+	if rq != nil {
+		if err = rq.export(exp, eEv, key); err != nil &&
+			err != utils.ErrDisconnected &&
+			exp.Cfg().FailedPostsDir != utils.MetaNone { // could not be queued
+			AddFailedPost(exp.Cfg().FailedPostsDir, exp.Cfg().ExportPath,
+				exp.Cfg().Type, eEv, exp.Cfg().Opts)
+		}
+		return
+	}
 	return ExportWithAttempts(exp, eEv, key)
 }
 
@@ -353,6 +476,11 @@ func ExportWithAttempts(exp EventExporter, eEv any, key string) (err error) {
 			}
 		}()
 	}
+	return exportWithAttempts(exp, eEv, key)
+}
+
+// exportWithAttempts connects and exports the event using the configured attempts
+func exportWithAttempts(exp EventExporter, eEv any, key string) (err error) {
 	fib := utils.FibDuration(time.Second, 0)
 
 	for i := 0; i < exp.Cfg().Attempts; i++ {
@@ -0,0 +1,199 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/linkedin/goavro/v2"
+)
+
+func NewFileAvroEE(cfg *config.EventExporterCfg, cgrCfg *config.CGRConfig,
+	dc *utils.SafeMapStorage) (fAvro *FileAvroEE, err error) {
+	fAvro = &FileAvroEE{
+		cfg:      cfg,
+		dc:       dc,
+		timezone: utils.FirstNonEmpty(cfg.Timezone, cgrCfg.GeneralCfg().DefaultTimezone),
+	}
+	err = fAvro.init()
+	return
+}
+
+// FileAvroEE implements EventExporter interface for .avro files
+type FileAvroEE struct {
+	cfg      *config.EventExporterCfg
+	dc       *utils.SafeMapStorage
+	timezone string
+	file     io.WriteCloser
+	writer   *goavro.OCFWriter
+	cols     []*fileColumn
+	names    []string // the avro field names for each of the cols
+	grpSize  int
+	records  []any // the records buffered for the next block
+	sync.Mutex
+	rowPreparing
+}
+
+// init will build the schema out of the fields template and create the file
+func (fAvro *FileAvroEE) init() (err error) {
+	if fAvro.cols, err = newFileColumns(fAvro.Cfg().ContentFields()); err != nil {
+		return
+	}
+	fAvro.grpSize = defaultRowGroupSize
+	if fAvro.Cfg().Opts.RowGroupSize != nil && *fAvro.Cfg().Opts.RowGroupSize > 0 {
+		fAvro.grpSize = *fAvro.Cfg().Opts.RowGroupSize
+	}
+	fAvro.names = make([]string, len(fAvro.cols))
+	fields := make([]map[string]any, len(fAvro.cols))
+	for i, col := range fAvro.cols {
+		fAvro.names[i] = avroName(col.name)
+		fields[i] = map[string]any{
+			"name":    fAvro.names[i],
+			"type":    []any{"null", avroType(col.valType)},
+			"default": nil,
+		}
+	}
+	var schema []byte
+	if schema, err = json.Marshal(map[string]any{
+		"type":   "record",
+		"name":   avroName(fAvro.Cfg().ID),
+		"fields": fields,
+	}); err != nil {
+		return
+	}
+	filePath := path.Join(fAvro.Cfg().ExportPath,
+		fAvro.Cfg().ID+utils.Underline+utils.UUIDSha1Prefix()+utils.AvroSuffix)
+	fAvro.dc.Lock()
+	fAvro.dc.MapStorage[utils.ExportPath] = filePath
+	fAvro.dc.Unlock()
+	if fAvro.file, err = os.Create(filePath); err != nil {
+		return
+	}
+	fAvro.writer, err = goavro.NewOCFWriter(goavro.OCFConfig{
+		W:      fAvro.file,
+		Schema: string(schema),
+	})
+	return
+}
+
+// avroName replaces the characters not allowed within the avro names
+func avroName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+	if name == utils.EmptyString || (name[0] >= '0' && name[0] <= '9') {
+		name = utils.Underline + name
+	}
+	return name
+}
+
+// avroType returns the avro type used for the column value type
+func avroType(valType string) any {
+	switch valType {
+	case utils.MetaInt64, utils.MetaDuration: // durations are stored as nanoseconds
+		return "long"
+	case utils.MetaFloat64:
+		return "double"
+	case utils.MetaTimestamp:
+		return map[string]any{"type": "long", "logicalType": "timestamp-micros"}
+	default:
+		return "string"
+	}
+}
+
+// avroValue returns the union value of the column
+func avroValue(val any) any {
+	switch v := val.(type) {
+	case int64:
+		return goavro.Union("long", v)
+	case float64:
+		return goavro.Union("double", v)
+	case time.Time:
+		return goavro.Union("long.timestamp-micros", v)
+	case time.Duration:
+		return goavro.Union("long", int64(v))
+	case string:
+		return goavro.Union("string", v)
+	default:
+		return nil
+	}
+}
+
+func (fAvro *FileAvroEE) Cfg() *config.EventExporterCfg { return fAvro.cfg }
+
+func (fAvro *FileAvroEE) Connect() (_ error) { return }
+
+func (fAvro *FileAvroEE) ExportEvent(ev any, _ string) (err error) {
+	rowMp := ev.(map[string]any)
+	record := make(map[string]any, len(fAvro.cols))
+	for i, col := range fAvro.cols {
+		var val any
+		if val, err = col.convert(rowMp[col.name], fAvro.timezone); err != nil {
+			return fmt.Errorf("cannot convert field <%s>: %w", col.name, err)
+		}
+		record[fAvro.names[i]] = avroValue(val)
+	}
+	fAvro.Lock() // make sure that only one event is writen in file at once
+	defer fAvro.Unlock()
+	if fAvro.records = append(fAvro.records, record); len(fAvro.records) >= fAvro.grpSize {
+		err = fAvro.flush()
+	}
+	return
+}
+
+// flush writes the buffered records as a new block
+func (fAvro *FileAvroEE) flush() (err error) {
+	if len(fAvro.records) == 0 {
+		return
+	}
+	err = fAvro.writer.Append(fAvro.records)
+	fAvro.records = nil
+	return
+}
+
+func (fAvro *FileAvroEE) Close() (err error) {
+	fAvro.Lock()
+	defer fAvro.Unlock()
+	if err = fAvro.flush(); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> Exporter with id: <%s> received error: <%s> when finalising the file",
+			utils.EEs, fAvro.Cfg().ID, err.Error()))
+	}
+	if errClose := fAvro.file.Close(); errClose != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> Exporter with id: <%s> received error: <%s> when closing the file",
+			utils.EEs, fAvro.Cfg().ID, errClose.Error()))
+		if err == nil { // keep the first error
+			err = errClose
+		}
+	}
+	return
+}
+
+func (fAvro *FileAvroEE) GetMetrics() *utils.SafeMapStorage { return fAvro.dc }
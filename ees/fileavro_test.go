@@ -0,0 +1,104 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+	"github.com/linkedin/goavro/v2"
+)
+
+func TestFileAvroExport(t *testing.T) {
+	cfg, eeCfg := newTypedFileExporterCfg(t, utils.MetaFileAvro)
+	exp, err := NewEventExporter(eeCfg, cfg, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fAvro := exp.(*FileAvroEE)
+	exportTypedFileEvents(t, cfg, fAvro)
+
+	f, err := os.Open(fAvro.dc.MapStorage[utils.ExportPath].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ocfr, err := goavro.NewOCFReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var records []any
+	for ocfr.Scan() {
+		rec, err := ocfr.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, rec)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 records, received: %+v", records)
+	}
+	exp0 := map[string]any{
+		utils.CGRID:      map[string]any{"string": utils.Sha1("0")},
+		utils.AnswerTime: map[string]any{"long.timestamp-micros": time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)},
+		utils.Usage:      map[string]any{"long": int64(time.Minute)},
+		utils.Cost:       map[string]any{"double": 1.5},
+		utils.OrderID:    map[string]any{"long": int64(1)},
+	}
+	if !reflect.DeepEqual(exp0, records[0]) {
+		t.Errorf("Expected %+v, received %+v", exp0, records[0])
+	}
+	if cost := records[2].(map[string]any)[utils.Cost]; cost != nil {
+		t.Errorf("Expected null cost, received %+v", cost)
+	}
+}
+
+func TestFileAvroName(t *testing.T) {
+	for name, exp := range map[string]string{
+		"Account":       "Account",
+		"*default":      "_default",
+		"Cost.Detail-1": "Cost_Detail_1",
+		"1Field":        "_1Field",
+	} {
+		if rcv := avroName(name); rcv != exp {
+			t.Errorf("Expected %q, received %q", exp, rcv)
+		}
+	}
+}
+
+func TestFileAvroCloseFirstError(t *testing.T) {
+	cfg, eeCfg := newTypedFileExporterCfg(t, utils.MetaFileAvro)
+	dc, _ := newEEMetrics(utils.EmptyString)
+	fAvro, err := NewFileAvroEE(eeCfg, cfg, dc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = fAvro.ExportEvent(map[string]any{utils.CGRID: "cgrid1"}, utils.EmptyString); err != nil {
+		t.Fatal(err)
+	}
+	fAvro.file.Close() // both flushing the records and closing the file will fail
+	var pathErr *os.PathError
+	if err = fAvro.Close(); !errors.As(err, &pathErr) || pathErr.Op != "write" {
+		t.Errorf("Expected the write error, received: %v", err)
+	}
+}
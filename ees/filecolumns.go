@@ -0,0 +1,103 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// defaultRowGroupSize is used when the rowGroupSize option is not configured
+const defaultRowGroupSize = 10000
+
+// fileColumn is a typed column of the schema used by the *file_parquet and *file_avro exporters
+type fileColumn struct {
+	name    string
+	valType string
+}
+
+// newFileColumns builds the file schema out of the content fields of the exporter
+func newFileColumns(fields []*config.FCTemplate) (cols []*fileColumn, err error) {
+	cols = make([]*fileColumn, 0, len(fields))
+	names := make(utils.StringSet)
+	for _, fld := range fields {
+		name := strings.TrimPrefix(fld.Path, utils.MetaExp+utils.NestingSep)
+		if names.Has(name) { // multiple fields composing the same column
+			continue
+		}
+		valType := utils.FirstNonEmpty(fld.ValueType, utils.MetaString)
+		switch valType {
+		case utils.MetaString, utils.MetaInt64, utils.MetaFloat64,
+			utils.MetaTimestamp, utils.MetaDuration:
+		default:
+			return nil, fmt.Errorf("unsupported %s <%s> for field <%s>", utils.ValueTypeCfg, valType, fld.Tag)
+		}
+		names.Add(name)
+		cols = append(cols, &fileColumn{name: name, valType: valType})
+	}
+	if len(cols) == 0 {
+		return nil, fmt.Errorf("no content fields defined")
+	}
+	return
+}
+
+// convert returns the value in the native type of the column
+// a nil value is returned for missing or empty values
+func (col *fileColumn) convert(val any, timezone string) (any, error) {
+	if val == nil {
+		return nil, nil
+	}
+	if str, isStr := val.(string); isStr && str == utils.EmptyString &&
+		col.valType != utils.MetaString {
+		return nil, nil
+	}
+	switch col.valType {
+	case utils.MetaInt64:
+		return utils.IfaceAsTInt64(val)
+	case utils.MetaFloat64:
+		return utils.IfaceAsFloat64(val)
+	case utils.MetaTimestamp:
+		return utils.IfaceAsTime(val, timezone)
+	case utils.MetaDuration:
+		return utils.IfaceAsDuration(val)
+	default:
+		return utils.IfaceAsString(val), nil
+	}
+}
+
+// rowPreparing prepares the events as rows indexed by the column name
+type rowPreparing struct{}
+
+func (rowPreparing) PrepareMap(mp *utils.CGREvent) (any, error) {
+	return map[string]any(mp.Event), nil
+}
+
+func (rowPreparing) PrepareOrderMap(mp *utils.OrderedNavigableMap) (any, error) {
+	row := make(map[string]any)
+	for el := mp.GetFirstElement(); el != nil; el = el.Next() {
+		path := el.Value
+		nmIt, _ := mp.Field(path)
+		path = path[:len(path)-1] // remove the last index
+		row[strings.Join(path, utils.NestingSep)] = nmIt.Data
+	}
+	return row, nil
+}
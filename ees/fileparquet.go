@@ -0,0 +1,170 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/parquet-go/parquet-go"
+)
+
+func NewFileParquetEE(cfg *config.EventExporterCfg, cgrCfg *config.CGRConfig,
+	dc *utils.SafeMapStorage) (fPq *FileParquetEE, err error) {
+	fPq = &FileParquetEE{
+		cfg:      cfg,
+		dc:       dc,
+		timezone: utils.FirstNonEmpty(cfg.Timezone, cgrCfg.GeneralCfg().DefaultTimezone),
+	}
+	err = fPq.init()
+	return
+}
+
+// FileParquetEE implements EventExporter interface for .parquet files
+type FileParquetEE struct {
+	cfg      *config.EventExporterCfg
+	dc       *utils.SafeMapStorage
+	timezone string
+	file     io.WriteCloser
+	writer   *parquet.Writer
+	cols     []*fileColumn
+	colIdx   []int // the index of the parquet leaf column for each of the cols
+	grpSize  int
+	grpRows  int // rows written in the current row group
+	sync.Mutex
+	rowPreparing
+}
+
+// init will build the schema out of the fields template and create the file
+func (fPq *FileParquetEE) init() (err error) {
+	if fPq.cols, err = newFileColumns(fPq.Cfg().ContentFields()); err != nil {
+		return
+	}
+	fPq.grpSize = defaultRowGroupSize
+	if fPq.Cfg().Opts.RowGroupSize != nil && *fPq.Cfg().Opts.RowGroupSize > 0 {
+		fPq.grpSize = *fPq.Cfg().Opts.RowGroupSize
+	}
+	grp := make(parquet.Group, len(fPq.cols))
+	for _, col := range fPq.cols {
+		grp[col.name] = parquet.Optional(parquetNode(col.valType))
+	}
+	schema := parquet.NewSchema(fPq.Cfg().ID, grp)
+	fPq.colIdx = make([]int, len(fPq.cols))
+	for i, col := range fPq.cols {
+		leaf, _ := schema.Lookup(col.name)
+		fPq.colIdx[i] = leaf.ColumnIndex
+	}
+	filePath := path.Join(fPq.Cfg().ExportPath,
+		fPq.Cfg().ID+utils.Underline+utils.UUIDSha1Prefix()+utils.ParquetSuffix)
+	fPq.dc.Lock()
+	fPq.dc.MapStorage[utils.ExportPath] = filePath
+	fPq.dc.Unlock()
+	if fPq.file, err = os.Create(filePath); err != nil {
+		return
+	}
+	fPq.writer = parquet.NewWriter(fPq.file, schema)
+	return
+}
+
+// parquetNode returns the parquet type used for the column value type
+func parquetNode(valType string) parquet.Node {
+	switch valType {
+	case utils.MetaInt64, utils.MetaDuration: // durations are stored as nanoseconds
+		return parquet.Int(64)
+	case utils.MetaFloat64:
+		return parquet.Leaf(parquet.DoubleType)
+	case utils.MetaTimestamp:
+		return parquet.Timestamp(parquet.Nanosecond)
+	default:
+		return parquet.String()
+	}
+}
+
+// parquetValue converts the column value to the parquet value
+func parquetValue(val any) parquet.Value {
+	switch v := val.(type) {
+	case int64:
+		return parquet.Int64Value(v)
+	case float64:
+		return parquet.DoubleValue(v)
+	case time.Time:
+		return parquet.Int64Value(v.UnixNano())
+	case time.Duration:
+		return parquet.Int64Value(int64(v))
+	case string:
+		return parquet.ByteArrayValue([]byte(v))
+	default:
+		return parquet.NullValue()
+	}
+}
+
+func (fPq *FileParquetEE) Cfg() *config.EventExporterCfg { return fPq.cfg }
+
+func (fPq *FileParquetEE) Connect() (_ error) { return }
+
+func (fPq *FileParquetEE) ExportEvent(ev any, _ string) (err error) {
+	rowMp := ev.(map[string]any)
+	row := make(parquet.Row, len(fPq.cols))
+	for i, col := range fPq.cols {
+		var val any
+		if val, err = col.convert(rowMp[col.name], fPq.timezone); err != nil {
+			return fmt.Errorf("cannot convert field <%s>: %w", col.name, err)
+		}
+		defLvl := 1
+		if val == nil {
+			defLvl = 0
+		}
+		row[fPq.colIdx[i]] = parquetValue(val).Level(0, defLvl, fPq.colIdx[i])
+	}
+	fPq.Lock() // make sure that only one event is writen in file at once
+	defer fPq.Unlock()
+	if _, err = fPq.writer.WriteRows([]parquet.Row{row}); err != nil {
+		return
+	}
+	if fPq.grpRows++; fPq.grpRows >= fPq.grpSize {
+		fPq.grpRows = 0
+		err = fPq.writer.Flush()
+	}
+	return
+}
+
+func (fPq *FileParquetEE) Close() (err error) {
+	fPq.Lock()
+	defer fPq.Unlock()
+	if err = fPq.writer.Close(); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> Exporter with id: <%s> received error: <%s> when finalising the file",
+			utils.EEs, fPq.Cfg().ID, err.Error()))
+	}
+	if errClose := fPq.file.Close(); errClose != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> Exporter with id: <%s> received error: <%s> when closing the file",
+			utils.EEs, fPq.Cfg().ID, errClose.Error()))
+		if err == nil { // keep the first error
+			err = errClose
+		}
+	}
+	return
+}
+
+func (fPq *FileParquetEE) GetMetrics() *utils.SafeMapStorage { return fPq.dc }
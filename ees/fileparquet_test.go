@@ -0,0 +1,232 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"errors"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/parquet-go/parquet-go"
+)
+
+// newTypedFileExporterCfg returns the exporter config shared by the parquet and avro tests
+func newTypedFileExporterCfg(t *testing.T, expType string) (*config.CGRConfig, *config.EventExporterCfg) {
+	cfg := config.NewDefaultCGRConfig()
+	eeCfg := cfg.EEsCfg().Exporters[0]
+	eeCfg.ID = "CDR_LAKE"
+	eeCfg.Type = expType
+	eeCfg.ExportPath = t.TempDir()
+	eeCfg.Opts.RowGroupSize = utils.IntPointer(2)
+	eeCfg.Fields = []*config.FCTemplate{
+		{Tag: utils.CGRID, Path: "*exp.CGRID", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.CGRID", utils.InfieldSep)},
+		{Tag: utils.AnswerTime, Path: "*exp.AnswerTime", Type: utils.MetaVariable, ValueType: utils.MetaTimestamp,
+			Value: config.NewRSRParsersMustCompile("~*req.AnswerTime", utils.InfieldSep)},
+		{Tag: utils.Usage, Path: "*exp.Usage", Type: utils.MetaVariable, ValueType: utils.MetaDuration,
+			Value: config.NewRSRParsersMustCompile("~*req.Usage", utils.InfieldSep)},
+		{Tag: utils.Cost, Path: "*exp.Cost", Type: utils.MetaVariable, ValueType: utils.MetaFloat64,
+			Value: config.NewRSRParsersMustCompile("~*req.Cost", utils.InfieldSep)},
+		{Tag: utils.OrderID, Path: "*exp.OrderID", Type: utils.MetaVariable, ValueType: utils.MetaInt64,
+			Value: config.NewRSRParsersMustCompile("~*req.OrderID", utils.InfieldSep)},
+	}
+	for _, field := range eeCfg.Fields {
+		field.ComputePath()
+	}
+	eeCfg.ComputeFields()
+	return cfg, eeCfg
+}
+
+// exportTypedFileEvents exports three events, the last one without cost
+func exportTypedFileEvents(t *testing.T, cfg *config.CGRConfig, exp EventExporter) {
+	filterS := engine.NewFilterS(cfg, nil, nil)
+	for i, cost := range []any{1.5, 2.25, utils.EmptyString} {
+		if err := exportEventWithExporter(exp, &utils.CGREvent{
+			Tenant: "cgrates.org",
+			ID:     utils.GenUUID(),
+			Event: map[string]any{
+				utils.CGRID:      utils.Sha1(utils.IfaceAsString(i)),
+				utils.AnswerTime: time.Date(2024, 7, 1, 10, 0, i, 0, time.UTC),
+				utils.Usage:      time.Duration(i+1) * time.Minute,
+				utils.Cost:       cost,
+				utils.OrderID:    int64(i + 1),
+			},
+		}, false, cfg, filterS, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := exp.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileParquetExport(t *testing.T) {
+	cfg, eeCfg := newTypedFileExporterCfg(t, utils.MetaFileParquet)
+	exp, err := NewEventExporter(eeCfg, cfg, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fPq := exp.(*FileParquetEE)
+	exportTypedFileEvents(t, cfg, fPq)
+
+	f, err := os.Open(fPq.dc.MapStorage[utils.ExportPath].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pqFile, err := parquet.OpenFile(f, fi.Size())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nrGrps := len(pqFile.RowGroups()); nrGrps != 2 {
+		t.Errorf("Expected 2 row groups, received: %d", nrGrps)
+	}
+	var rows []parquet.Row
+	rdr := parquet.NewReader(f, pqFile.Schema())
+	for buf := make([]parquet.Row, 1); ; {
+		n, err := rdr.ReadRows(buf)
+		if n == 1 {
+			rows = append(rows, buf[0].Clone()) // the values are reused by the next read
+		}
+		if err != nil {
+			break
+		}
+	}
+	if len(rows) != 3 {
+		t.Fatalf("Expected 3 rows, received: %d", len(rows))
+	}
+	rcv := make(map[string]any)
+	for i, col := range fPq.cols {
+		val := rows[0][fPq.colIdx[i]]
+		switch col.valType {
+		case utils.MetaString:
+			rcv[col.name] = val.String()
+		case utils.MetaFloat64:
+			rcv[col.name] = val.Double()
+		default:
+			rcv[col.name] = val.Int64()
+		}
+	}
+	exp0 := map[string]any{
+		utils.CGRID:      utils.Sha1("0"),
+		utils.AnswerTime: time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC).UnixNano(),
+		utils.Usage:      int64(time.Minute),
+		utils.Cost:       1.5,
+		utils.OrderID:    int64(1),
+	}
+	if !reflect.DeepEqual(exp0, rcv) {
+		t.Errorf("Expected %+v, received %+v", exp0, rcv)
+	}
+	for i, col := range fPq.cols {
+		if val := rows[2][fPq.colIdx[i]]; (col.name == utils.Cost) != val.IsNull() {
+			t.Errorf("Unexpected value %v for column %s", val, col.name)
+		}
+	}
+}
+
+func TestFileParquetInitErrors(t *testing.T) {
+	cfg, eeCfg := newTypedFileExporterCfg(t, utils.MetaFileParquet)
+	eeCfg.Fields[1].ValueType = "*bool"
+	eeCfg.ComputeFields()
+	dc, _ := newEEMetrics(utils.EmptyString)
+	expErr := "unsupported value_type <*bool> for field <AnswerTime>"
+	if _, err := NewFileParquetEE(eeCfg, cfg, dc); err == nil || err.Error() != expErr {
+		t.Errorf("Expected error %s, received %v", expErr, err)
+	}
+	eeCfg.Fields = nil
+	eeCfg.ComputeFields()
+	expErr = "no content fields defined"
+	if _, err := NewFileParquetEE(eeCfg, cfg, dc); err == nil || err.Error() != expErr {
+		t.Errorf("Expected error %s, received %v", expErr, err)
+	}
+}
+
+func TestFileParquetExportCached(t *testing.T) {
+	cfg, eeCfg := newTypedFileExporterCfg(t, utils.MetaFileParquet)
+	eeCfg.Synchronous = true
+	eeS, err := NewEventExporterS(cfg, engine.NewFilterS(cfg, nil, nil), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		var rply map[string]map[string]any
+		if err := eeS.V1ProcessEvent(context.Background(), &engine.CGREventWithEeIDs{
+			EeIDs: []string{eeCfg.ID},
+			CGREvent: &utils.CGREvent{
+				Tenant: "cgrates.org",
+				ID:     utils.GenUUID(),
+				Event: map[string]any{
+					utils.CGRID:   utils.Sha1(utils.IfaceAsString(i)),
+					utils.OrderID: int64(i + 1),
+				},
+			},
+		}, &rply); err != nil {
+			t.Fatal(err)
+		}
+	}
+	eeS.ClearExporterCache() // finalises the file
+	files, err := os.ReadDir(eeCfg.ExportPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("Expected the events batched into 1 file, received: %d", len(files))
+	}
+	f, err := os.Open(path.Join(eeCfg.ExportPath, files[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pqFile, err := parquet.OpenFile(f, fi.Size())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nrRows := pqFile.NumRows(); nrRows != 3 {
+		t.Errorf("Expected 3 rows, received: %d", nrRows)
+	}
+}
+
+func TestFileParquetCloseFirstError(t *testing.T) {
+	cfg, eeCfg := newTypedFileExporterCfg(t, utils.MetaFileParquet)
+	dc, _ := newEEMetrics(utils.EmptyString)
+	fPq, err := NewFileParquetEE(eeCfg, cfg, dc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fPq.file.Close() // both finalising and closing the file will fail
+	var pathErr *os.PathError
+	if err = fPq.Close(); !errors.As(err, &pathErr) || pathErr.Op != "write" {
+		t.Errorf("Expected the write error, received: %v", err)
+	}
+}
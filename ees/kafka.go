@@ -23,8 +23,10 @@ import (
 	"crypto/x509"
 	"errors"
 	"os"
+	"strings"
 
 	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 	kafka "github.com/segmentio/kafka-go"
 )
@@ -71,6 +73,21 @@ func NewKafkaEE(cfg *config.EventExporterCfg, dc *utils.SafeMapStorage) (*KafkaE
 		}
 	}
 
+	var saslMechanism, saslUsername, saslPassword string
+	if opts.SASLMechanism != nil {
+		saslMechanism = *opts.SASLMechanism
+	}
+	if opts.SASLUsername != nil {
+		saslUsername = *opts.SASLUsername
+	}
+	if opts.SASLPassword != nil {
+		saslPassword = *opts.SASLPassword
+	}
+	mechanism, err := utils.NewKafkaSASLMechanism(saslMechanism, saslUsername, saslPassword)
+	if err != nil {
+		return nil, err
+	}
+
 	pstr.writer = &kafka.Writer{
 		Addr:  kafka.TCP(pstr.Cfg().ExportPath),
 		Topic: topic,
@@ -84,26 +101,43 @@ func NewKafkaEE(cfg *config.EventExporterCfg, dc *utils.SafeMapStorage) (*KafkaE
 		// CloseIdleConnections on our Transport instance, avoiding the need to differentiate
 		// between TLS and non-TLS connections.
 		Transport: &kafka.Transport{
-			TLS: tlsCfg,
+			TLS:  tlsCfg,
+			SASL: mechanism,
 		},
 	}
 
 	if opts.BatchSize != nil {
 		pstr.writer.BatchSize = *opts.BatchSize
 	}
+	if opts.KeyField != nil {
+		pstr.keyField = *opts.KeyField
+	}
+	if opts.Headers != nil {
+		pstr.headers = *opts.Headers
+	}
 
 	return pstr, nil
 }
 
 // KafkaEE is a kafka poster
 type KafkaEE struct {
-	writer *kafka.Writer
-	cfg    *config.EventExporterCfg
-	dc     *utils.SafeMapStorage
-	reqs   *concReq
+	writer   *kafka.Writer
+	cfg      *config.EventExporterCfg
+	dc       *utils.SafeMapStorage
+	reqs     *concReq
+	keyField string   // exported field populating the message key
+	headers  []string // *opts fields attached as message headers
 	bytePreparing
 }
 
+// KafkaMessage is the content exported when the message key is taken from
+// the exported fields or headers need to be attached
+type KafkaMessage struct {
+	Key     string
+	Value   []byte
+	Headers []kafka.Header
+}
+
 func (k *KafkaEE) Cfg() *config.EventExporterCfg { return k.cfg }
 
 func (k *KafkaEE) Connect() error { return nil }
@@ -111,12 +145,56 @@ func (k *KafkaEE) Connect() error { return nil }
 func (k *KafkaEE) ExportEvent(content any, key string) (err error) {
 	k.reqs.get()
 	defer k.reqs.done()
+	msg, isMsg := content.(*KafkaMessage)
+	if !isMsg {
+		return k.writer.WriteMessages(context.Background(), kafka.Message{
+			Key:   []byte(key),
+			Value: content.([]byte),
+		})
+	}
 	return k.writer.WriteMessages(context.Background(), kafka.Message{
-		Key:   []byte(key),
-		Value: content.([]byte),
+		Key:     []byte(utils.FirstNonEmpty(msg.Key, key)),
+		Value:   msg.Value,
+		Headers: msg.Headers,
 	})
 }
 
+// prepareMessage populates the message key out of the exported fields and
+// attaches the headers out of the event options
+func (k *KafkaEE) prepareMessage(content any, key string, ev *utils.CGREvent,
+	expNM *utils.OrderedNavigableMap) (any, error) {
+	if k.keyField == utils.EmptyString && len(k.headers) == 0 {
+		return content, nil
+	}
+	msg := &KafkaMessage{
+		Key:   key,
+		Value: content.([]byte),
+	}
+	if k.keyField != utils.EmptyString {
+		var keyVal string
+		if expNM != nil {
+			// exported fields are kept as slices, use the last value
+			if leaf, err := expNM.Field(append(strings.Split(k.keyField, utils.NestingSep), "-1")); err == nil {
+				keyVal = leaf.String()
+			}
+		} else {
+			keyVal = engine.MapEvent(ev.Event).GetStringIgnoreErrors(k.keyField)
+		}
+		msg.Key = utils.FirstNonEmpty(keyVal, key)
+	}
+	for _, hdr := range k.headers {
+		val, has := ev.APIOpts[hdr]
+		if !has {
+			continue
+		}
+		msg.Headers = append(msg.Headers, kafka.Header{
+			Key:   hdr,
+			Value: []byte(utils.IfaceAsString(val)),
+		})
+	}
+	return msg, nil
+}
+
 func (k *KafkaEE) Close() error {
 
 	// Manually close idle connections to prevent them from running indefinitely
@@ -18,6 +18,7 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package ees
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/cgrates/cgrates/config"
@@ -92,3 +93,55 @@ func TestKafkaEE_ExportEvent(t *testing.T) {
 		t.Errorf("expected no error, got %v", err)
 	}
 }
+
+func TestKafkaEEPrepareMessage(t *testing.T) {
+	kafkaEE := &KafkaEE{}
+	ev := &utils.CGREvent{
+		Event: map[string]any{
+			utils.AccountField: "1001",
+		},
+		APIOpts: map[string]any{
+			"Origin": "node1",
+		},
+	}
+	content := []byte(`{"Account":"1001"}`)
+	if rcv, err := kafkaEE.prepareMessage(content, "key1", ev, nil); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(rcv, content) {
+		t.Errorf("expected %s, received %v", content, rcv)
+	}
+
+	kafkaEE.keyField = utils.AccountField
+	kafkaEE.headers = []string{"Origin", "Missing"}
+	exp := &KafkaMessage{
+		Key:   "1001",
+		Value: content,
+		Headers: []kafka.Header{
+			{Key: "Origin", Value: []byte("node1")},
+		},
+	}
+	if rcv, err := kafkaEE.prepareMessage(content, "key1", ev, nil); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(rcv, exp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+
+	expNM := utils.NewOrderedNavigableMap()
+	expNM.SetAsSlice(&utils.FullPath{PathSlice: []string{"Subject"}, Path: "Subject"},
+		[]*utils.DataNode{{Type: utils.NMDataType, Value: &utils.DataLeaf{Data: "1002"}}})
+	kafkaEE.keyField = "Subject"
+	exp.Key = "1002"
+	if rcv, err := kafkaEE.prepareMessage(content, "key1", ev, expNM); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(rcv, exp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+
+	kafkaEE.keyField = "Missing"
+	exp.Key = "key1"
+	if rcv, err := kafkaEE.prepareMessage(content, "key1", ev, expNM); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(rcv, exp) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
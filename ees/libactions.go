@@ -22,6 +22,7 @@ import (
 	"encoding/gob"
 	"encoding/json"
 	"net/http"
+	"net/url"
 
 	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
@@ -31,6 +32,11 @@ import (
 func init() {
 	gob.Register(new(HTTPPosterRequest))
 	gob.Register(new(sqlPosterRequest))
+	gob.Register(new(KafkaMessage))
+	// payloads queued by the retry queues
+	gob.Register(new(utils.CGREvent))
+	gob.Register(url.Values{})
+	gob.Register([]any{})
 
 	engine.RegisterActionFunc(utils.MetaHTTPPost, callURL)
 	engine.RegisterActionFunc(utils.HttpPostAsync, callURLAsync)
@@ -0,0 +1,142 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// NewMQTTEE creates a MQTT poster
+func NewMQTTEE(cfg *config.EventExporterCfg, nodeID string, connTimeout, replyTimeout time.Duration,
+	dc *utils.SafeMapStorage) (pstr *MQTTEE, err error) {
+	pstr = &MQTTEE{
+		cfg:          cfg,
+		dc:           dc,
+		topic:        utils.DefaultQueueID,
+		replyTimeout: replyTimeout,
+		reqs:         newConcReq(cfg.ConcurrentRequests),
+	}
+	err = pstr.parseOpts(cfg.Opts.MQTT, nodeID, connTimeout)
+	return
+}
+
+// MQTTEE publishes the events on a MQTT topic
+type MQTTEE struct {
+	topic        string
+	qos          byte
+	retained     bool
+	replyTimeout time.Duration
+	clientOpts   *mqtt.ClientOptions
+
+	client mqtt.Client
+
+	cfg          *config.EventExporterCfg
+	dc           *utils.SafeMapStorage
+	reqs         *concReq
+	sync.RWMutex // protect client
+	bytePreparing
+}
+
+func (pstr *MQTTEE) parseOpts(opts *config.MQTTOpts, nodeID string, connTimeout time.Duration) (err error) {
+	connOpts := &utils.MQTTConnOpts{
+		BrokerURL:      pstr.cfg.ExportPath,
+		ClientID:       utils.CGRateSLwr + nodeID + pstr.cfg.ID,
+		ConnectTimeout: connTimeout,
+	}
+	if opts != nil {
+		if opts.Topic != nil {
+			pstr.topic = *opts.Topic
+		}
+		if opts.QoS != nil {
+			pstr.qos = byte(*opts.QoS)
+		}
+		if opts.Retained != nil {
+			pstr.retained = *opts.Retained
+		}
+		if opts.ClientID != nil {
+			connOpts.ClientID = *opts.ClientID
+		}
+		if opts.Username != nil {
+			connOpts.Username = *opts.Username
+		}
+		if opts.Password != nil {
+			connOpts.Password = *opts.Password
+		}
+		if opts.CertificateAuthority != nil {
+			connOpts.CertificateAuthority = *opts.CertificateAuthority
+		}
+		if opts.ClientCertificate != nil {
+			connOpts.ClientCertificate = *opts.ClientCertificate
+		}
+		if opts.ClientKey != nil {
+			connOpts.ClientKey = *opts.ClientKey
+		}
+		if opts.SkipTLSVerify != nil {
+			connOpts.SkipTLSVerify = *opts.SkipTLSVerify
+		}
+	}
+	pstr.clientOpts, err = connOpts.ClientOptions()
+	return
+}
+
+func (pstr *MQTTEE) Cfg() *config.EventExporterCfg { return pstr.cfg }
+
+func (pstr *MQTTEE) Connect() error {
+	pstr.Lock()
+	defer pstr.Unlock()
+	if pstr.client != nil {
+		return nil
+	}
+	client := mqtt.NewClient(pstr.clientOpts)
+	if err := utils.WaitMQTTToken(client.Connect(), pstr.clientOpts.ConnectTimeout); err != nil {
+		return err
+	}
+	pstr.client = client
+	return nil
+}
+
+func (pstr *MQTTEE) ExportEvent(content any, _ string) error {
+	pstr.reqs.get()
+	defer pstr.reqs.done()
+	pstr.RLock()
+	defer pstr.RUnlock()
+	if pstr.client == nil {
+		return utils.ErrDisconnected
+	}
+	return utils.WaitMQTTToken(pstr.client.Publish(pstr.topic, pstr.qos, pstr.retained, content.([]byte)),
+		pstr.replyTimeout)
+}
+
+func (pstr *MQTTEE) Close() error {
+	pstr.Lock()
+	defer pstr.Unlock()
+	if pstr.client == nil {
+		return nil
+	}
+	pstr.client.Disconnect(250) // milliseconds given to the in flight messages
+	pstr.client = nil
+	return nil
+}
+
+func (pstr *MQTTEE) GetMetrics() *utils.SafeMapStorage { return pstr.dc }
@@ -0,0 +1,85 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestNewMQTTEE(t *testing.T) {
+	cfg := config.NewEventExporterCfg("mqtt", utils.MetaMQTTjsonMap, "tcp://127.0.0.1:1883",
+		utils.MetaNone, 1, nil)
+	pstr, err := NewMQTTEE(cfg, "node1", time.Second, time.Second, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pstr.topic != utils.DefaultQueueID || pstr.qos != 0 || pstr.retained {
+		t.Errorf("unexpected defaults: topic %q, qos %d, retained %v", pstr.topic, pstr.qos, pstr.retained)
+	}
+	if exp := "cgratesnode1mqtt"; pstr.clientOpts.ClientID != exp {
+		t.Errorf("expected %q, received %q", exp, pstr.clientOpts.ClientID)
+	}
+
+	cfg.Opts = &config.EventExporterOpts{
+		MQTT: &config.MQTTOpts{
+			Topic:    utils.StringPointer("cdrs"),
+			QoS:      utils.IntPointer(2),
+			ClientID: utils.StringPointer("cgr_ee"),
+			Username: utils.StringPointer("user"),
+			Password: utils.StringPointer("pass"),
+			Retained: utils.BoolPointer(true),
+		},
+	}
+	if pstr, err = NewMQTTEE(cfg, "node1", time.Second, time.Second, nil); err != nil {
+		t.Fatal(err)
+	}
+	if pstr.topic != "cdrs" || pstr.qos != 2 || !pstr.retained {
+		t.Errorf("unexpected opts: topic %q, qos %d, retained %v", pstr.topic, pstr.qos, pstr.retained)
+	}
+	if pstr.clientOpts.ClientID != "cgr_ee" ||
+		pstr.clientOpts.Username != "user" ||
+		pstr.clientOpts.Password != "pass" {
+		t.Errorf("unexpected client options: %+v", pstr.clientOpts)
+	}
+
+	cfg.Opts.MQTT.ClientKey = utils.StringPointer("/tmp/key.pem")
+	if _, err = NewMQTTEE(cfg, "node1", time.Second, time.Second, nil); err == nil ||
+		err.Error() != "has key but no certificate" {
+		t.Errorf("expected key error, received %v", err)
+	}
+}
+
+func TestMQTTEEDisconnected(t *testing.T) {
+	cfg := config.NewEventExporterCfg("mqtt", utils.MetaMQTTjsonMap, "tcp://127.0.0.1:1883",
+		utils.MetaNone, 1, nil)
+	pstr, err := NewMQTTEE(cfg, "node1", time.Second, time.Second, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = pstr.ExportEvent([]byte(`{}`), utils.EmptyString); err != utils.ErrDisconnected {
+		t.Errorf("expected %v, received %v", utils.ErrDisconnected, err)
+	}
+	if err = pstr.Close(); err != nil {
+		t.Error(err)
+	}
+}
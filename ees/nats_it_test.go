@@ -111,7 +111,7 @@ func TestNatsEEJetStream(t *testing.T) {
 			"Destination": "1002",
 		},
 	}
-	if err := exportEventWithExporter(evExp, cgrEv, true, cgrCfg, new(engine.FilterS)); err != nil {
+	if err := exportEventWithExporter(evExp, cgrEv, true, cgrCfg, new(engine.FilterS), nil); err != nil {
 		t.Fatal(err)
 	}
 	testCleanDirectory(t)
@@ -176,7 +176,7 @@ func TestNatsEE(t *testing.T) {
 			"Destination": "1002",
 		},
 	}
-	if err := exportEventWithExporter(evExp, cgrEv, true, cgrCfg, new(engine.FilterS)); err != nil {
+	if err := exportEventWithExporter(evExp, cgrEv, true, cgrCfg, new(engine.FilterS), nil); err != nil {
 		t.Fatal(err)
 	}
 	testCleanDirectory(t)
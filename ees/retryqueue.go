@@ -0,0 +1,294 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// retryEvent is the export saved on disk by the retry queue
+type retryEvent struct {
+	Key   string
+	Event any
+}
+
+// newRetryQueue loads the exports left on disk by a previous run and
+// starts retrying them in the background
+func newRetryQueue(exporterID, dir string, backoff, maxBackoff time.Duration,
+	retry func(*retryQueue) error) (rq *retryQueue, err error) {
+	rq = &retryQueue{
+		exporterID: exporterID,
+		dir:        filepath.Join(dir, exporterID),
+		minBackoff: backoff,
+		maxBackoff: maxBackoff,
+		backoff:    backoff,
+		notify:     make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+		stopped:    make(chan struct{}),
+	}
+	if err = os.MkdirAll(rq.dir, 0755); err != nil {
+		return
+	}
+	var entries []os.DirEntry
+	if entries, err = os.ReadDir(rq.dir); err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), utils.GOBSuffix) {
+			continue
+		}
+		seq, errParse := strconv.ParseInt(strings.TrimSuffix(entry.Name(), utils.GOBSuffix), 10, 64)
+		if errParse != nil {
+			continue
+		}
+		rq.seqs = append(rq.seqs, seq)
+	}
+	slices.Sort(rq.seqs)
+	if len(rq.seqs) != 0 {
+		rq.lastSeq = rq.seqs[len(rq.seqs)-1]
+	}
+	go rq.loop(retry)
+	return
+}
+
+// retryQueue is the durable queue retrying in order the failed exports of one exporter.
+// Each export is kept in its own file named after the enqueue time in nanoseconds.
+type retryQueue struct {
+	sync.RWMutex
+	exporterID string
+	dir        string
+	seqs       []int64 // the files waiting in the queue, oldest first
+	lastSeq    int64
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	backoff    time.Duration
+	nextRetry  time.Time
+
+	expMux sync.Mutex // serializes the exports so they leave in the order they came
+
+	notify  chan struct{} // signals the loop that the queue is no longer empty
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+func (rq *retryQueue) filePath(seq int64) string {
+	return filepath.Join(rq.dir, strconv.FormatInt(seq, 10)+utils.GOBSuffix)
+}
+
+// setBackoff updates the backoff limits after a config reload
+func (rq *retryQueue) setBackoff(backoff, maxBackoff time.Duration) {
+	rq.Lock()
+	rq.minBackoff = backoff
+	rq.maxBackoff = maxBackoff
+	rq.backoff = min(max(rq.backoff, backoff), maxBackoff)
+	rq.Unlock()
+}
+
+// size returns the number of exports waiting in the queue
+func (rq *retryQueue) size() (n int) {
+	rq.RLock()
+	n = len(rq.seqs)
+	rq.RUnlock()
+	return
+}
+
+// export sends the event through the exporter when nothing is waiting in the queue,
+// otherwise, or if the export fails, the event is queued to keep the ordering
+func (rq *retryQueue) export(exp EventExporter, eEv any, key string) (err error) {
+	rq.expMux.Lock()
+	defer rq.expMux.Unlock()
+	if rq.size() == 0 {
+		if err = exportWithAttempts(exp, eEv, key); err == nil ||
+			err == utils.ErrDisconnected {
+			return
+		}
+	}
+	if err = rq.push(&retryEvent{Key: key, Event: eEv}); err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> Exporter <%s> could not queue the export for retry because err: <%s>",
+				utils.EEs, rq.exporterID, err.Error()))
+	}
+	return
+}
+
+// push saves the event at the end of the queue
+func (rq *retryQueue) push(ev *retryEvent) (err error) {
+	rq.Lock()
+	defer rq.Unlock()
+	seq := time.Now().UnixNano()
+	if seq <= rq.lastSeq {
+		seq = rq.lastSeq + 1
+	}
+	fPath := rq.filePath(seq)
+	tmpPath := fPath + utils.TmpSuffix
+	var f *os.File
+	if f, err = os.Create(tmpPath); err != nil {
+		return
+	}
+	if err = gob.NewEncoder(f).Encode(ev); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return
+	}
+	if err = f.Close(); err != nil {
+		os.Remove(tmpPath)
+		return
+	}
+	if err = os.Rename(tmpPath, fPath); err != nil {
+		return
+	}
+	rq.seqs = append(rq.seqs, seq)
+	rq.lastSeq = seq
+	select {
+	case rq.notify <- struct{}{}:
+	default:
+	}
+	return
+}
+
+// head returns the oldest export in the queue
+func (rq *retryQueue) head() (seq int64, ev *retryEvent, err error) {
+	rq.RLock()
+	if len(rq.seqs) == 0 {
+		rq.RUnlock()
+		return 0, nil, utils.ErrNotFound
+	}
+	seq = rq.seqs[0]
+	rq.RUnlock()
+	var f *os.File
+	if f, err = os.Open(rq.filePath(seq)); err != nil {
+		return
+	}
+	defer f.Close()
+	ev = new(retryEvent)
+	err = gob.NewDecoder(f).Decode(ev)
+	return
+}
+
+// pop removes the oldest export from the queue once it was exported
+func (rq *retryQueue) pop(seq int64) (err error) {
+	rq.Lock()
+	defer rq.Unlock()
+	if len(rq.seqs) == 0 || rq.seqs[0] != seq {
+		return
+	}
+	rq.seqs = rq.seqs[1:]
+	if err = os.Remove(rq.filePath(seq)); os.IsNotExist(err) {
+		err = nil
+	}
+	return
+}
+
+// discard moves aside the export that could not be read so it does not block the queue
+func (rq *retryQueue) discard(seq int64) {
+	fPath := rq.filePath(seq)
+	if err := os.Rename(fPath, fPath+utils.CorruptedSuffix); err != nil && !os.IsNotExist(err) {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> Exporter <%s> could not move aside the file <%s> because err: <%s>",
+				utils.EEs, rq.exporterID, fPath, err.Error()))
+	}
+	rq.Lock()
+	if len(rq.seqs) != 0 && rq.seqs[0] == seq {
+		rq.seqs = rq.seqs[1:]
+	}
+	rq.Unlock()
+}
+
+// loop retries the queued exports with exponential backoff until stopped
+func (rq *retryQueue) loop(retry func(*retryQueue) error) {
+	defer close(rq.stopped)
+	for {
+		if rq.size() == 0 {
+			select {
+			case <-rq.stop:
+				return
+			case <-rq.notify:
+			}
+			continue
+		}
+		rq.Lock()
+		backoff := rq.backoff
+		rq.nextRetry = time.Now().Add(backoff)
+		rq.Unlock()
+		tm := time.NewTimer(backoff)
+		select {
+		case <-rq.stop:
+			tm.Stop()
+			return
+		case <-tm.C:
+		}
+		err := retry(rq)
+		rq.Lock()
+		rq.nextRetry = time.Time{}
+		if err != nil {
+			rq.backoff = min(2*rq.backoff, rq.maxBackoff)
+		} else {
+			rq.backoff = rq.minBackoff
+		}
+		rq.Unlock()
+		if err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> Exporter <%s> failed retrying the queued exports because err: <%s>, next retry in %s",
+					utils.EEs, rq.exporterID, err.Error(), rq.currentBackoff()))
+		}
+	}
+}
+
+func (rq *retryQueue) currentBackoff() (backoff time.Duration) {
+	rq.RLock()
+	backoff = rq.backoff
+	rq.RUnlock()
+	return
+}
+
+// close stops the retries keeping the queued exports on disk
+func (rq *retryQueue) close() {
+	close(rq.stop)
+	<-rq.stopped
+}
+
+// stats returns the state of the queue
+func (rq *retryQueue) stats() (st *engine.RetryQueueStats) {
+	rq.RLock()
+	defer rq.RUnlock()
+	st = &engine.RetryQueueStats{
+		Depth:   len(rq.seqs),
+		Backoff: rq.backoff,
+	}
+	if len(rq.seqs) != 0 {
+		st.OldestAge = time.Since(time.Unix(0, rq.seqs[0]))
+	}
+	if !rq.nextRetry.IsZero() {
+		nextRetry := rq.nextRetry
+		st.NextRetry = &nextRetry
+	}
+	return
+}
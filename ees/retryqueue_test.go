@@ -0,0 +1,384 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// retryMockExporter fails the exports while down, recording the successful ones
+type retryMockExporter struct {
+	mockEventExporter
+	cfg      *config.EventExporterCfg
+	mu       sync.Mutex
+	down     bool
+	exported []string
+
+	started chan struct{} // when set, the export with key "slow" signals here
+	release chan struct{} // and waits here before completing
+}
+
+func (m *retryMockExporter) Cfg() *config.EventExporterCfg { return m.cfg }
+func (m *retryMockExporter) ExportEvent(ev any, key string) error {
+	if key == "slow" && m.started != nil {
+		m.started <- struct{}{}
+		<-m.release
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.down {
+		return errors.New("connection refused")
+	}
+	m.exported = append(m.exported, key)
+	return nil
+}
+
+func (m *retryMockExporter) setDown(down bool) {
+	m.mu.Lock()
+	m.down = down
+	m.mu.Unlock()
+}
+
+func (m *retryMockExporter) exportedKeys() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string{}, m.exported...)
+}
+
+func TestRetryQueuePersistence(t *testing.T) {
+	dir := t.TempDir()
+	noRetry := func(*retryQueue) error { return errors.New("not retried") }
+	rq, err := newRetryQueue("EXP_HTTP", dir, time.Hour, time.Hour, noRetry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	evs := []*retryEvent{
+		{Key: "1", Event: []byte(`{"CGRID":"1"}`)},
+		{Key: "2", Event: "2"},
+		{Key: "3", Event: &HTTPPosterRequest{Body: []byte("3")}},
+	}
+	for _, ev := range evs {
+		if err = rq.push(ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if st := rq.stats(); st.Depth != 3 || st.OldestAge <= 0 || st.Backoff != time.Hour {
+		t.Errorf("unexpected stats: %s", utils.ToJSON(st))
+	}
+	rq.close()
+
+	// the exports are resumed from disk
+	if rq, err = newRetryQueue("EXP_HTTP", dir, time.Hour, time.Hour, noRetry); err != nil {
+		t.Fatal(err)
+	}
+	defer rq.close()
+	for _, expEv := range evs {
+		seq, ev, err := rq.head()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expEv, ev) {
+			t.Errorf("expected: %s, received: %s", utils.ToJSON(expEv), utils.ToJSON(ev))
+		}
+		if err = rq.pop(seq); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err = rq.head(); err != utils.ErrNotFound {
+		t.Errorf("expected: %v, received: %v", utils.ErrNotFound, err)
+	}
+	if files, err := os.ReadDir(filepath.Join(dir, "EXP_HTTP")); err != nil {
+		t.Error(err)
+	} else if len(files) != 0 {
+		t.Errorf("expected the files to be removed, found: %d", len(files))
+	}
+}
+
+func TestRetryQueueDiscardCorrupted(t *testing.T) {
+	dir := t.TempDir()
+	rq, err := newRetryQueue("EXP_KAFKA", dir, time.Hour, time.Hour,
+		func(*retryQueue) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rq.close()
+	if err = rq.push(&retryEvent{Key: "1", Event: "1"}); err != nil {
+		t.Fatal(err)
+	}
+	seq := rq.seqs[0]
+	if err = os.WriteFile(rq.filePath(seq), []byte("not gob"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = rq.head(); err == nil {
+		t.Fatal("expected decoding error")
+	}
+	rq.discard(seq)
+	if rq.size() != 0 {
+		t.Errorf("expected empty queue, received: %d", rq.size())
+	}
+	if _, err = os.Stat(rq.filePath(seq) + utils.CorruptedSuffix); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRetryQueueExportInOrder(t *testing.T) {
+	exp := &retryMockExporter{
+		cfg:  config.NewEventExporterCfg("EXP_KAFKA", utils.MetaVirt, utils.EmptyString, utils.MetaNone, 1, nil),
+		down: true,
+	}
+	retry := func(rq *retryQueue) error {
+		for {
+			seq, ev, err := rq.head()
+			if err == utils.ErrNotFound {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if err = exportWithAttempts(exp, ev.Event, ev.Key); err != nil {
+				return err
+			}
+			if err = rq.pop(seq); err != nil {
+				return err
+			}
+		}
+	}
+	rq, err := newRetryQueue(exp.cfg.ID, t.TempDir(), 10*time.Millisecond, 40*time.Millisecond, retry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rq.close()
+	if err = rq.export(exp, []byte("1"), "1"); err != nil {
+		t.Fatal(err)
+	}
+	exp.setDown(false)
+	// queued behind the first one even if the exporter is up again
+	if err = rq.export(exp, []byte("2"), "2"); err != nil {
+		t.Fatal(err)
+	}
+	if keys := exp.exportedKeys(); len(keys) != 0 {
+		t.Errorf("expected no exports, received: %v", keys)
+	}
+	deadline := time.Now().Add(time.Second)
+	for rq.size() != 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if keys := exp.exportedKeys(); !reflect.DeepEqual(keys, []string{"1", "2"}) {
+		t.Errorf("expected: %v, received: %v", []string{"1", "2"}, keys)
+	}
+	// exported directly once the queue is empty
+	if err = rq.export(exp, []byte("3"), "3"); err != nil {
+		t.Fatal(err)
+	}
+	if keys := exp.exportedKeys(); !reflect.DeepEqual(keys, []string{"1", "2", "3"}) {
+		t.Errorf("expected: %v, received: %v", []string{"1", "2", "3"}, keys)
+	}
+}
+
+func TestRetryQueueBackoff(t *testing.T) {
+	var mu sync.Mutex
+	var retries int
+	rq, err := newRetryQueue("EXP_AMQP", t.TempDir(), time.Millisecond, 4*time.Millisecond,
+		func(*retryQueue) error {
+			mu.Lock()
+			retries++
+			mu.Unlock()
+			return errors.New("connection refused")
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rq.close()
+	if err = rq.push(&retryEvent{Key: "1", Event: "1"}); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for {
+		mu.Lock()
+		done := retries >= 4
+		mu.Unlock()
+		if done || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if backoff := rq.currentBackoff(); backoff != 4*time.Millisecond {
+		t.Errorf("expected: %v, received: %v", 4*time.Millisecond, backoff)
+	}
+	rq.setBackoff(time.Second, 2*time.Second)
+	if backoff := rq.currentBackoff(); backoff != time.Second {
+		t.Errorf("expected: %v, received: %v", time.Second, backoff)
+	}
+}
+
+func TestV1GetRetryQueues(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	expCfg := cfg.EEsCfg().ExporterCfg(utils.MetaDefault).Clone()
+	expCfg.ID = "EXP_HTTP"
+	expCfg.Type = utils.MetaHTTPPost
+	expCfg.RetryQueueDir = t.TempDir()
+	cfg.EEsCfg().Exporters = []*config.EventExporterCfg{expCfg}
+	eeS, err := NewEventExporterS(cfg, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eeS.StopRetryQueues()
+	var rply map[string]*engine.RetryQueueStats
+	if err = eeS.V1GetRetryQueues(context.Background(), &engine.ArgsGetRetryQueues{
+		ExporterIDs: []string{"EXP_OTHER"},
+	}, &rply); err != utils.ErrNotFound {
+		t.Errorf("expected: %v, received: %v", utils.ErrNotFound, err)
+	}
+	exp := map[string]*engine.RetryQueueStats{
+		"EXP_HTTP": {Backoff: time.Second},
+	}
+	if err = eeS.V1GetRetryQueues(context.Background(), &engine.ArgsGetRetryQueues{}, &rply); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(exp, rply) {
+		t.Errorf("expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rply))
+	}
+}
+
+func TestRetryQueueExporterEventTypes(t *testing.T) {
+	expCfg := config.NewDefaultCGRConfig().EEsCfg().ExporterCfg(utils.MetaDefault)
+	cgrEv := &utils.CGREvent{
+		Tenant: "cgrates.org",
+		ID:     "EV1",
+		Event: map[string]any{
+			utils.CGRID:      "cgrid1",
+			utils.AnswerTime: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+			utils.Usage:      time.Minute,
+			utils.Cost:       1.25,
+			"Nested":         map[string]any{"Tags": []any{"a", "b"}},
+		},
+		APIOpts: map[string]any{},
+	}
+	onm := utils.NewOrderedNavigableMap()
+	onm.Append(&utils.FullPath{PathSlice: []string{utils.CGRID}, Path: utils.CGRID},
+		&utils.DataLeaf{Data: "cgrid1"})
+	onm.Append(&utils.FullPath{PathSlice: []string{utils.AnswerTime}, Path: utils.AnswerTime},
+		&utils.DataLeaf{Data: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)})
+	onm.Append(&utils.FullPath{PathSlice: []string{utils.Cost}, Path: utils.Cost},
+		&utils.DataLeaf{Data: 1.25})
+	preparers := map[string]EventExporter{
+		utils.MetaHTTPPost:    &HTTPPostEE{cfg: expCfg, hdr: make(http.Header)},
+		utils.MetaHTTPjsonMap: &HTTPjsonMapEE{cfg: expCfg, hdr: make(http.Header)},
+		utils.MetaElastic:     &ElasticEE{cfg: expCfg},
+		utils.MetaLog:         &LogEE{cfg: expCfg},
+		utils.MetaVirt:        &VirtualEE{cfg: expCfg},
+		utils.MetaRPC:         &RPCee{cfg: expCfg},
+		utils.MetaSQL:         &SQLEe{cfg: expCfg, tableName: "cdrs"},
+		utils.MetaFileCSV:     &FileCSVee{cfg: expCfg},
+		utils.MetaFileParquet: &FileParquetEE{cfg: expCfg},
+		utils.MetaKafkajsonMap: &KafkaEE{cfg: expCfg, keyField: utils.CGRID,
+			headers: []string{utils.MetaExporterID}},
+	}
+	rq, err := newRetryQueue("EXP_TYPES", t.TempDir(), time.Hour, time.Hour,
+		func(*retryQueue) error { return errors.New("not retried") })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rq.close()
+	for expType, exp := range preparers {
+		var evs []any
+		if eEv, err := exp.PrepareMap(cgrEv); err != nil {
+			t.Fatalf("%s: %v", expType, err)
+		} else {
+			evs = append(evs, eEv)
+		}
+		if expType != utils.MetaSQL {
+			if eEv, err := exp.PrepareOrderMap(onm); err != nil {
+				t.Fatalf("%s: %v", expType, err)
+			} else {
+				evs = append(evs, eEv)
+			}
+		}
+		if msgPrep, canPrep := exp.(messagePreparer); canPrep {
+			cgrEv.APIOpts[utils.MetaExporterID] = "EXP_TYPES"
+			for i, eEv := range evs {
+				if evs[i], err = msgPrep.prepareMessage(eEv, "cgrid1", cgrEv, nil); err != nil {
+					t.Fatalf("%s: %v", expType, err)
+				}
+			}
+		}
+		for _, eEv := range evs {
+			if eEv == nil {
+				continue
+			}
+			if err = rq.push(&retryEvent{Key: "cgrid1", Event: eEv}); err != nil {
+				t.Fatalf("%s: could not queue %T: %v", expType, eEv, err)
+			}
+			seq, rcv, err := rq.head()
+			if err != nil {
+				t.Fatalf("%s: could not read back %T: %v", expType, eEv, err)
+			}
+			if reflect.TypeOf(rcv.Event) != reflect.TypeOf(eEv) {
+				t.Errorf("%s: expected %T, received %T", expType, eEv, rcv.Event)
+			}
+			if err = rq.pop(seq); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestRetryQueueExportConcurrentOrder(t *testing.T) {
+	exp := &retryMockExporter{
+		cfg:     config.NewEventExporterCfg("EXP_KAFKA", utils.MetaVirt, utils.EmptyString, utils.MetaNone, 1, nil),
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	rq, err := newRetryQueue(exp.cfg.ID, t.TempDir(), time.Hour, time.Hour,
+		func(*retryQueue) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rq.close()
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		rq.export(exp, []byte("slow"), "slow")
+	}()
+	<-exp.started // the first export is in flight
+	go func() {
+		defer wg.Done()
+		rq.export(exp, []byte("fast"), "fast")
+	}()
+	time.Sleep(20 * time.Millisecond)
+	if keys := exp.exportedKeys(); len(keys) != 0 {
+		t.Errorf("expected no exports before the first one completes, received: %v", keys)
+	}
+	close(exp.release)
+	wg.Wait()
+	if keys := exp.exportedKeys(); !reflect.DeepEqual(keys, []string{"slow", "fast"}) {
+		t.Errorf("expected: %v, received: %v", []string{"slow", "fast"}, keys)
+	}
+}
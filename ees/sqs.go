@@ -23,10 +23,10 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 )
 
@@ -86,20 +86,8 @@ func (pstr *SQSee) Connect() (err error) {
 	pstr.Lock()
 	defer pstr.Unlock()
 	if pstr.session == nil {
-		cfg := aws.Config{Endpoint: aws.String(pstr.Cfg().ExportPath)}
-		if len(pstr.awsRegion) != 0 {
-			cfg.Region = aws.String(pstr.awsRegion)
-		}
-		if len(pstr.awsID) != 0 &&
-			len(pstr.awsKey) != 0 {
-			cfg.Credentials = credentials.NewStaticCredentials(pstr.awsID, pstr.awsKey, pstr.awsToken)
-		}
-		pstr.session, err = session.NewSessionWithOptions(
-			session.Options{
-				Config: cfg,
-			},
-		)
-		if err != nil {
+		if pstr.session, err = engine.NewAWSSession(pstr.Cfg().ExportPath,
+			pstr.awsRegion, pstr.awsID, pstr.awsKey, pstr.awsToken); err != nil {
 			return
 		}
 	}
@@ -583,6 +583,9 @@ func (acc *Account) GetDefaultMoneyBalance() *Balance {
 		Uuid: utils.GenUUID(),
 		ID:   utils.MetaDefault,
 	} // minimum weight
+	if decimalBalances {
+		defaultBalance.DecimalValue = utils.NewDecimal(0, 0)
+	}
 	if acc.BalanceMap == nil {
 		acc.BalanceMap = make(map[string]Balances)
 	}
@@ -590,6 +593,18 @@ func (acc *Account) GetDefaultMoneyBalance() *Balance {
 	return defaultBalance
 }
 
+// InitDecimalBalances populates the DecimalValue of the *monetary balances,
+// resyncing it for the ones modified while decimals were disabled
+func (acc *Account) InitDecimalBalances() {
+	for _, b := range acc.BalanceMap[utils.MetaMonetary] {
+		if b.DecimalValue == nil {
+			b.DecimalValue = utils.NewDecimalFromFloat64(b.Value)
+			continue
+		}
+		b.decimalValue()
+	}
+}
+
 // ExecuteActionTriggers scans the action triggers and execute the actions for which trigger is met
 func (acc *Account) ExecuteActionTriggers(a *Action, fltrS *FilterS) {
 	if acc.executingTriggers {
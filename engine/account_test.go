@@ -3553,3 +3553,31 @@ func TestAccountFieldAsInterfaceNilAccount(t *testing.T) {
 		t.Errorf("Expected error %v, got %v", utils.ErrNotFound, err)
 	}
 }
+
+func TestAccountInitDecimalBalances(t *testing.T) {
+	acc := &Account{
+		ID: "cgrates.org:decimal",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {
+				{ID: "MONETARY1", Value: 10.5},
+				{ID: "MONETARY2", Value: 3, DecimalValue: utils.NewDecimalFromFloat64(2)},
+			},
+			utils.MetaVoice: {{ID: "VOICE1", Value: float64(time.Minute)}},
+		},
+	}
+	acc.InitDecimalBalances()
+	if d := acc.BalanceMap[utils.MetaMonetary][0].DecimalValue; d == nil || d.String() != "10.5" {
+		t.Errorf("unexpected decimal value: %v", d)
+	}
+	if d := acc.BalanceMap[utils.MetaMonetary][1].DecimalValue; d == nil || d.String() != "3" {
+		t.Errorf("unexpected decimal value: %v", d)
+	}
+	if d := acc.BalanceMap[utils.MetaVoice][0].DecimalValue; d != nil {
+		t.Errorf("expected no decimal value for *voice balances, received: %v", d)
+	}
+	SetDecimalBalances(true)
+	defer SetDecimalBalances(false)
+	if d := acc.GetDefaultMoneyBalance().DecimalValue; d == nil || d.String() != "0" {
+		t.Errorf("unexpected decimal value for the *default balance: %v", d)
+	}
+}
@@ -162,9 +162,33 @@ func (at *ActionTiming) GetNextStartTime(refTime time.Time) time.Time {
 			}
 		}
 	}
+	if len(rateIvl.Timing.HolidayCalendarIDs) != 0 {
+		at.stCache = nextHolidayStartTime(rateIvl.Timing, at.stCache)
+	}
 	return at.stCache
 }
 
+// holidaysLookupDays limits the search of the next holiday
+const holidaysLookupDays = 3660
+
+// nextHolidayStartTime skips the days which are not holidays starting with the received time
+// returns the zero time if no holiday is found within the lookup interval
+func nextHolidayStartTime(rit *RITiming, t time.Time) time.Time {
+	hcs := rit.holidayCalendars()
+	if len(hcs) == 0 {
+		return time.Time{}
+	}
+	expr := cronexpr.MustParse(rit.CronString())
+	for i := 0; i < holidaysLookupDays && !t.IsZero(); i++ {
+		if isHolidayIn(hcs, t) {
+			return t
+		}
+		year, month, day := t.Date()
+		t = expr.Next(time.Date(year, month, day+1, 0, 0, 0, 0, t.Location()).Add(-time.Nanosecond))
+	}
+	return time.Time{}
+}
+
 func (at *ActionTiming) ResetStartTimeCache() {
 	at.stCache = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
 }
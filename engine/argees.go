@@ -21,6 +21,7 @@ package engine
 import (
 	"encoding/json"
 	"slices"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
@@ -115,3 +116,19 @@ func (cgr *CGREventWithEeIDs) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+
+// ArgsGetRetryQueues selects the exporters queried by EeSv1.GetRetryQueues,
+// all the retry queues being returned when ExporterIDs is empty
+type ArgsGetRetryQueues struct {
+	Tenant      string
+	ExporterIDs []string
+	APIOpts     map[string]any
+}
+
+// RetryQueueStats is the state of the retry queue of one exporter
+type RetryQueueStats struct {
+	Depth     int           // number of exports waiting in the queue
+	OldestAge time.Duration // age of the oldest export in the queue
+	Backoff   time.Duration // delay used before the next retry
+	NextRetry *time.Time    // time of the next retry, nil when the queue is idle
+}
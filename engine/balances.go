@@ -35,6 +35,7 @@ type Balance struct {
 	Uuid           string // system wide unique
 	ID             string // account wide unique
 	Value          float64
+	DecimalValue   *utils.Decimal `json:",omitempty"` // exact Value of *monetary balances, used with rals decimal_balances
 	ExpirationDate time.Time
 	Weight         float64
 	DestinationIDs utils.StringMap
@@ -190,6 +191,9 @@ func (b *Balance) Clone() *Balance {
 	if b.DestinationIDs != nil {
 		n.DestinationIDs = b.DestinationIDs.Clone()
 	}
+	if b.DecimalValue != nil {
+		n.DecimalValue = b.DecimalValue.Clone()
+	}
 	return n
 }
 
@@ -286,19 +290,51 @@ func (b *Balance) GetValue() float64 {
 }
 
 func (b *Balance) AddValue(amount float64) {
+	if b.isDecimal() {
+		b.setDecimalValue(utils.SumDecimal(b.decimalValue(), utils.NewDecimalFromFloat64(amount)))
+		return
+	}
 	b.SetValue(b.GetValue() + amount)
 }
 
 func (b *Balance) SubtractValue(amount float64) {
+	if b.isDecimal() {
+		b.setDecimalValue(utils.SubstractDecimal(b.decimalValue(), utils.NewDecimalFromFloat64(amount)))
+		return
+	}
 	b.SetValue(b.GetValue() - amount)
 }
 
 func (b *Balance) SetValue(amount float64) {
+	if b.isDecimal() {
+		b.setDecimalValue(utils.NewDecimalFromFloat64(amount))
+		return
+	}
 	b.Value = amount
 	b.Value = utils.Round(b.GetValue(), globalRoundingDecimals, utils.MetaRoundingMiddle)
 	b.dirty = true
 }
 
+// isDecimal returns true if the balance should be debited using the DecimalValue
+func (b *Balance) isDecimal() bool {
+	return decimalBalances && b.DecimalValue != nil
+}
+
+// decimalValue returns the DecimalValue, resyncing it if the Value was overwritten directly
+func (b *Balance) decimalValue() *utils.Decimal {
+	if f, _ := b.DecimalValue.Float64(); f != b.Value {
+		b.DecimalValue = utils.NewDecimalFromFloat64(b.Value)
+	}
+	return b.DecimalValue
+}
+
+// setDecimalValue stores the value rounded as the float64 one, keeping Value as its closest float64
+func (b *Balance) setDecimalValue(d *utils.Decimal) {
+	b.DecimalValue = utils.RoundDecimal(d, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	b.Value, _ = b.DecimalValue.Float64()
+	b.dirty = true
+}
+
 func (b *Balance) SetDirty() {
 	b.dirty = true
 }
@@ -532,7 +568,7 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 			//log.Printf("INCREMENET: %+v", inc)
 			amount := float64(inc.Duration)
 			if bFactor != 1 {
-				amount = utils.Round(amount*bFactor, globalRoundingDecimals, utils.MetaRoundingUp)
+				amount = applyFactor(amount, bFactor)
 			}
 			if b.GetValue() >= amount {
 				b.SubtractValue(amount)
@@ -676,7 +712,7 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 			var moneyBal *Balance
 			if isUnitBal {
 				if bFactor != 1 {
-					amount = utils.Round(amount*bFactor, globalRoundingDecimals, utils.MetaRoundingUp)
+					amount = applyFactor(amount, bFactor)
 				}
 				for _, mb := range moneyBalances {
 					if mb.GetValue() >= cost {
@@ -1076,3 +1076,42 @@ func TestFieldAsStringCase(t *testing.T) {
 		})
 	}
 }
+
+func TestBalanceDecimalValue(t *testing.T) {
+	SetDecimalBalances(true)
+	defer SetDecimalBalances(false)
+	b := &Balance{Value: 10, DecimalValue: utils.NewDecimalFromFloat64(10)}
+	for i := 0; i < 1000; i++ {
+		b.SubtractValue(0.001)
+	}
+	if b.GetValue() != 9 || b.DecimalValue.String() != "9.000000" {
+		t.Errorf("unexpected balance value: %v, decimal: %s", b.GetValue(), b.DecimalValue)
+	}
+	b.AddValue(0.1)
+	b.AddValue(0.2)
+	if b.GetValue() != 9.3 {
+		t.Errorf("unexpected balance value: %v", b.GetValue())
+	}
+	// Value overwritten directly should resync the decimal one
+	b.Value = 5
+	b.AddValue(0.7)
+	if b.GetValue() != 5.7 || b.DecimalValue.String() != "5.700000" {
+		t.Errorf("unexpected balance value: %v, decimal: %s", b.GetValue(), b.DecimalValue)
+	}
+	b.SetValue(1.23)
+	if b.GetValue() != 1.23 || b.DecimalValue.String() != "1.230000" {
+		t.Errorf("unexpected balance value: %v, decimal: %s", b.GetValue(), b.DecimalValue)
+	}
+	if cln := b.Clone(); cln.DecimalValue == b.DecimalValue ||
+		cln.DecimalValue.Compare(b.DecimalValue) != 0 {
+		t.Errorf("expected an independent decimal value, received: %s", cln.DecimalValue)
+	}
+}
+
+func TestBalanceDecimalValueDisabled(t *testing.T) {
+	b := &Balance{Value: 10, DecimalValue: utils.NewDecimalFromFloat64(10)}
+	b.SubtractValue(0.25)
+	if b.GetValue() != 9.75 || b.DecimalValue.String() != "10" {
+		t.Errorf("unexpected balance value: %v, decimal: %s", b.GetValue(), b.DecimalValue)
+	}
+}
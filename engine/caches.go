@@ -84,6 +84,10 @@ func init() {
 	gob.Register(new(StatSum))
 	gob.Register(new(StatAverage))
 	gob.Register(new(StatDistinct))
+	gob.Register(new(StatPercentile))
+	gob.Register(new(StatMedian))
+	gob.Register(new(StatStdDev))
+	gob.Register(new(StatHistogram))
 
 	// others
 	gob.Register([]any{})
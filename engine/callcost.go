@@ -34,6 +34,7 @@ type CallCost struct {
 	Destination        string
 	ToR                string
 	Cost               float64
+	DecimalCost        *utils.Decimal `json:",omitempty"` // exact Cost, used with rals decimal_balances
 	Timespans          TimeSpans
 	RatedUsage         float64
 	AccountSummary     *AccountSummary
@@ -159,6 +160,16 @@ func (cc *CallCost) UpdateCost() {
 }
 
 func (cc *CallCost) updateCost() {
+	if decimalBalances {
+		cost := utils.NewDecimal(0, 0)
+		for _, ts := range cc.Timespans {
+			ts.Cost = ts.CalculateCost()
+			cost = utils.SumDecimal(cost, ts.calculateDecimalCost())
+		}
+		cc.DecimalCost = utils.RoundDecimal(cost, globalRoundingDecimals, utils.MetaRoundingMiddle)
+		cc.Cost, _ = cc.DecimalCost.Float64()
+		return
+	}
 	cost := 0.0
 	//if cc.deductConnectFee { // add back the connectFee
 	//	cost += cc.GetConnectFee()
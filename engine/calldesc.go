@@ -37,6 +37,7 @@ const (
 
 var (
 	globalRoundingDecimals       = 6
+	decimalBalances              bool // store and debit *monetary balances as decimals
 	rpSubjectPrefixMatching      bool
 	rpSubjectPrefixMatchingMutex sync.RWMutex // used to reload rpSubjectPrefixMatching
 )
@@ -46,6 +47,27 @@ func SetRoundingDecimals(rd int) {
 	globalRoundingDecimals = rd
 }
 
+// SetDecimalBalances enables the arbitrary-precision arithmetic for *monetary balances and costs
+func SetDecimalBalances(flag bool) {
+	decimalBalances = flag
+}
+
+// decimalCost returns the exact cost of compressFactor items of the given cost
+func decimalCost(cost *utils.Decimal, compressFactor int) *utils.Decimal {
+	return utils.MultiplyDecimal(cost, utils.NewDecimal(int64(compressFactor), 0))
+}
+
+// applyFactor multiplies the amount debited from a unit balance with its ValueFactor,
+// rounding up in decimals if decimalBalances is enabled
+func applyFactor(amount, factor float64) float64 {
+	if !decimalBalances {
+		return utils.Round(amount*factor, globalRoundingDecimals, utils.MetaRoundingUp)
+	}
+	f, _ := utils.RoundDecimal(utils.MultiplyDecimal(utils.NewDecimalFromFloat64(amount),
+		utils.NewDecimalFromFloat64(factor)), globalRoundingDecimals, utils.MetaRoundingUp).Float64()
+	return f
+}
+
 // SetRpSubjectPrefixMatching sets rpSubjectPrefixMatching (is thread safe)
 func SetRpSubjectPrefixMatching(flag bool) {
 	rpSubjectPrefixMatchingMutex.Lock()
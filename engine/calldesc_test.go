@@ -2822,3 +2822,44 @@ func TestCallDescriptorgetgetRatingPlansForPrefix(t *testing.T) {
 	}
 	Cache.Clear(nil)
 }
+
+func TestCallDescApplyFactorDecimal(t *testing.T) {
+	if rcv := applyFactor(60, 8.64); rcv != 518.400001 {
+		t.Errorf("expected float64 drift rounded up, received: %v", rcv)
+	}
+	SetDecimalBalances(true)
+	defer SetDecimalBalances(false)
+	if rcv := applyFactor(60, 8.64); rcv != 518.4 {
+		t.Errorf("expected 518.4, received: %v", rcv)
+	}
+	if rcv := applyFactor(1, 0.0000001); rcv != 0.000001 {
+		t.Errorf("expected 0.000001, received: %v", rcv)
+	}
+}
+
+func TestCallCostDecimalCost(t *testing.T) {
+	cc := &CallCost{
+		Timespans: TimeSpans{
+			{Increments: Increments{{Cost: 0.01, CompressFactor: 30}, {Cost: 0.1, CompressFactor: 1}}},
+			{Increments: Increments{{Cost: 0.2, CompressFactor: 1}}, CompressFactor: 3},
+		},
+	}
+	cc.updateCost()
+	if cc.DecimalCost != nil {
+		t.Errorf("expected no decimal cost, received: %s", cc.DecimalCost)
+	}
+	SetDecimalBalances(true)
+	defer SetDecimalBalances(false)
+	cc.updateCost()
+	if cc.Cost != 1 || cc.DecimalCost == nil || cc.DecimalCost.String() != "1.000000" {
+		t.Errorf("unexpected cost: %v, decimal: %s", cc.Cost, cc.DecimalCost)
+	}
+	ec := NewEventCostFromCallCost(cc, "cgrID", utils.MetaDefault)
+	ec.ResetCounters()
+	if cost := ec.GetCost(); cost != 1 || ec.DecimalCost == nil || ec.DecimalCost.String() != "1.000000" {
+		t.Errorf("unexpected cost: %v, decimal: %s", cost, ec.DecimalCost)
+	}
+	if cln := ec.Clone(); cln.DecimalCost == ec.DecimalCost || cln.DecimalCost.Compare(ec.DecimalCost) != 0 {
+		t.Errorf("expected an independent decimal cost, received: %s", cln.DecimalCost)
+	}
+}
@@ -28,8 +28,9 @@ import (
 
 // Caps the structure that allocs requests for API
 type Caps struct {
-	strategy string
-	aReqs    chan struct{}
+	strategy    string
+	aReqs       chan struct{}
+	rateLimiter *RateLimiter
 }
 
 // NewCaps creates a new caps
@@ -45,6 +46,29 @@ func (cR *Caps) IsLimited() bool {
 	return cap(cR.aReqs) != 0
 }
 
+// SetRateLimiter sets the token bucket limits checked besides the concurrent requests
+func (cR *Caps) SetRateLimiter(rl *RateLimiter) {
+	cR.rateLimiter = rl
+}
+
+// RateLimiter returns the token bucket limits
+func (cR *Caps) RateLimiter() *RateLimiter {
+	return cR.rateLimiter
+}
+
+// IsRateLimited returns true if there are rate limits configured
+func (cR *Caps) IsRateLimited() bool {
+	return cR.rateLimiter.IsLimited()
+}
+
+// AllowRate checks the rate limits for the request
+func (cR *Caps) AllowRate(tenant, api, connID string) error {
+	if !cR.IsRateLimited() {
+		return nil
+	}
+	return cR.rateLimiter.Allow(tenant, api, connID)
+}
+
 // Allocated returns the number of requests actively serviced
 func (cR *Caps) Allocated() int {
 	return len(cR.aReqs)
@@ -0,0 +1,123 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// newDataDBUpdateEvent builds the event sent to EEs for a changed DataDB item
+// a nil value marks the removal of the item
+func newDataDBUpdateEvent(itmType, tenant, id string, value any) (ev *utils.CGREvent, err error) {
+	ev = &utils.CGREvent{
+		Tenant: utils.FirstNonEmpty(tenant, config.CgrConfig().GeneralCfg().DefaultTenant),
+		ID:     utils.GenUUID(),
+		Event: map[string]any{
+			utils.ItemType: itmType,
+			utils.ID:       id,
+			utils.Action:   utils.MetaRemove,
+		},
+		APIOpts: map[string]any{
+			utils.MetaEventType: utils.DataDBUpdate,
+		},
+	}
+	if value == nil {
+		return
+	}
+	// snapshot the item as plain data so the exporters can template it
+	// without racing with later changes of the same object
+	var b []byte
+	if b, err = json.Marshal(value); err != nil {
+		return
+	}
+	var val any
+	if err = json.Unmarshal(b, &val); err != nil {
+		return
+	}
+	ev.Event[utils.Action] = utils.MetaUpdate
+	ev.Event[utils.Value] = val
+	return
+}
+
+// dataDBFeedQueueLen is the number of DataDB changes waiting to be sent to EEs
+// before the new ones are dropped
+const dataDBFeedQueueLen = 1024
+
+// newDataDBFeed returns the queue of changes sent asynchronously to EEs
+func newDataDBFeed(queueLen int) *dataDBFeed {
+	return &dataDBFeed{evs: make(chan *CGREventWithEeIDs, queueLen)}
+}
+
+// dataDBFeed decouples the DataDB writes from the EEs round-trip
+type dataDBFeed struct {
+	evs chan *CGREventWithEeIDs
+}
+
+// push queues the event without blocking, dropping it if the queue is full
+func (f *dataDBFeed) push(ev *CGREventWithEeIDs) {
+	select {
+	case f.evs <- ev:
+	default:
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> export queue full, dropping change of %s item <%s>",
+				utils.DataDB, ev.Event[utils.ItemType], ev.Event[utils.ID]))
+	}
+}
+
+// run sends the queued events to the ees_conns from data_db
+func (f *dataDBFeed) run(connMgr *ConnManager) {
+	for ev := range f.evs {
+		var reply map[string]map[string]any
+		if err := connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().EEsConns,
+			utils.EeSv1ProcessEvent, ev, &reply); err != nil &&
+			err.Error() != utils.ErrNotFound.Error() {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> error: %q processing event %+v with EEs.",
+					utils.DataDB, err.Error(), ev.CGREvent))
+		}
+	}
+}
+
+// exportDataDBUpdate will queue the changed DataDB item for the ees_conns from data_db
+func (dm *DataManager) exportDataDBUpdate(itmType, tenant, id string, value any) {
+	dbCfg := config.CgrConfig().DataDbCfg()
+	if len(dbCfg.EEsConns) == 0 || dm.connMgr == nil {
+		return
+	}
+	ev, err := newDataDBUpdateEvent(itmType, tenant, id, value)
+	if err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> error: %s building the update event for %s item <%s>",
+				utils.DataDB, err.Error(), itmType, id))
+		return
+	}
+	dm.feedOnce.Do(func() {
+		dm.feed = newDataDBFeed(dataDBFeedQueueLen)
+		go dm.feed.run(dm.connMgr)
+	})
+	dm.feed.push(&CGREventWithEeIDs{
+		EeIDs:    dbCfg.EEsExpIDs,
+		CGREvent: ev,
+	})
+}
@@ -0,0 +1,117 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestDataManagerExportDataDBUpdate(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.DataDbCfg().EEsConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)}
+	cfg.DataDbCfg().EEsExpIDs = []string{"CRM_KAFKA"}
+	config.SetCgrConfig(cfg)
+	defer config.SetCgrConfig(config.NewDefaultCGRConfig())
+	evsChan := make(chan *CGREventWithEeIDs, 2)
+	clientConn := make(chan birpc.ClientConnector, 1)
+	clientConn <- &ccMock{
+		calls: map[string]func(ctx *context.Context, args, reply any) error{
+			utils.EeSv1ProcessEvent: func(ctx *context.Context, args, reply any) error {
+				evsChan <- args.(*CGREventWithEeIDs)
+				return nil
+			},
+		},
+	}
+	connMgr := NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs): clientConn,
+	})
+	data := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	dm := NewDataManager(data, cfg.CacheCfg(), connMgr)
+
+	acc := &Account{
+		ID: "cgrates.org:1001",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{ID: "MONETARY", Value: 10}},
+		},
+	}
+	if err := dm.SetAccount(acc); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.RemoveAccount(acc.ID); err != nil {
+		t.Fatal(err)
+	}
+	var evs []*CGREventWithEeIDs
+	for len(evs) != 2 {
+		select {
+		case ev := <-evsChan:
+			evs = append(evs, ev)
+		case <-time.After(time.Second):
+			t.Fatalf("Expected 2 events, received: %s", utils.ToJSON(evs))
+		}
+	}
+	for _, ev := range evs {
+		if !reflect.DeepEqual([]string{"CRM_KAFKA"}, ev.EeIDs) {
+			t.Errorf("Unexpected exporter IDs: %+v", ev.EeIDs)
+		} else if ev.Tenant != "cgrates.org" {
+			t.Errorf("Unexpected tenant: %q", ev.Tenant)
+		} else if ev.APIOpts[utils.MetaEventType] != utils.DataDBUpdate {
+			t.Errorf("Unexpected event type: %+v", ev.APIOpts)
+		} else if ev.Event[utils.ItemType] != utils.MetaAccounts ||
+			ev.Event[utils.ID] != "1001" {
+			t.Errorf("Unexpected event: %s", utils.ToJSON(ev.Event))
+		}
+	}
+	if evs[0].Event[utils.Action] != utils.MetaUpdate {
+		t.Errorf("Expected %q, received %q", utils.MetaUpdate, evs[0].Event[utils.Action])
+	} else if val, canCast := evs[0].Event[utils.Value].(map[string]any); !canCast || val[utils.ID] != acc.ID {
+		t.Errorf("Unexpected value: %s", utils.ToJSON(evs[0].Event[utils.Value]))
+	}
+	if evs[1].Event[utils.Action] != utils.MetaRemove {
+		t.Errorf("Expected %q, received %q", utils.MetaRemove, evs[1].Event[utils.Action])
+	} else if _, has := evs[1].Event[utils.Value]; has {
+		t.Errorf("Unexpected value for removed item: %s", utils.ToJSON(evs[1].Event))
+	}
+}
+
+func TestDataDBFeedPushQueueFull(t *testing.T) {
+	feed := newDataDBFeed(1)
+	ev1 := &CGREventWithEeIDs{CGREvent: &utils.CGREvent{ID: "ev1"}}
+	ev2 := &CGREventWithEeIDs{CGREvent: &utils.CGREvent{
+		ID: "ev2",
+		Event: map[string]any{
+			utils.ItemType: utils.MetaAccounts,
+			utils.ID:       "1001",
+		},
+	}}
+	feed.push(ev1)
+	feed.push(ev2) // must not block
+	if len(feed.evs) != 1 {
+		t.Fatalf("Expected 1 queued event, received: %d", len(feed.evs))
+	}
+	if rcv := <-feed.evs; rcv != ev1 {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(ev1), utils.ToJSON(rcv))
+	}
+}
@@ -19,6 +19,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package engine
 
 import (
+	"time"
+
 	"github.com/cgrates/cgrates/utils"
 )
 
@@ -222,6 +224,22 @@ func (dbM *DataDBMock) PopTask() (*Task, error) {
 	return nil, utils.ErrNotImplemented
 }
 
+func (dbM *DataDBMock) GetLeaseDrv(id string) (*Lease, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) AcquireLeaseDrv(lse *Lease) (bool, error) {
+	return false, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetActionTimingLastRunDrv(id string) (time.Time, error) {
+	return time.Time{}, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetActionTimingLastRunDrv(id string, lastRun time.Time) error {
+	return utils.ErrNotImplemented
+}
+
 func (dbM *DataDBMock) GetAccountDrv(id string) (*Account, error) {
 	if dbM.GetAccountDrvF != nil {
 		return dbM.GetAccountDrvF(id)
@@ -336,6 +354,18 @@ func (dbM *DataDBMock) RemStatQueueProfileDrv(tenant, id string) (err error) {
 	return utils.ErrNotImplemented
 }
 
+func (dbM *DataDBMock) GetHolidayCalendarDrv(string, string) (*HolidayCalendar, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetHolidayCalendarDrv(*HolidayCalendar) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveHolidayCalendarDrv(string, string) error {
+	return utils.ErrNotImplemented
+}
+
 func (dbM *DataDBMock) GetRankingProfileDrv(tenant, id string) (sg *RankingProfile, err error) {
 	if dbM.GetStatQueueProfileDrvF != nil {
 		return dbM.GetRankingProfileDrvF(tenant, id)
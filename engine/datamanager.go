@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 
 	"github.com/cgrates/baningo"
 	"github.com/cgrates/birpc/context"
@@ -55,6 +56,7 @@ var (
 		utils.SharedGroupPrefix:        {},
 		utils.ResourceProfilesPrefix:   {},
 		utils.TimingsPrefix:            {},
+		utils.HolidayCalendarPrefix:    {},
 		utils.ResourcesPrefix:          {},
 		utils.StatQueuePrefix:          {},
 		utils.StatQueueProfilePrefix:   {},
@@ -102,6 +104,8 @@ type DataManager struct {
 	cacheCfg *config.CacheCfg
 	connMgr  *ConnManager
 	ms       Marshaler
+	feed     *dataDBFeed // started on first export towards EEs
+	feedOnce sync.Once
 }
 
 // DataDB exports access to dataDB
@@ -199,6 +203,9 @@ func (dm *DataManager) CacheDataFromDB(prfx string, ids []string, mustBeCached b
 			_, err = dm.GetRanking(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
 		case utils.TimingsPrefix:
 			_, err = dm.GetTiming(dataID, true, utils.NonTransactional)
+		case utils.HolidayCalendarPrefix:
+			tntID := utils.NewTenantID(dataID)
+			_, err = dm.GetHolidayCalendar(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
 		case utils.ThresholdProfilePrefix:
 			tntID := utils.NewTenantID(dataID)
 			lkID := guardian.Guardian.GuardIDs("", config.CgrConfig().GeneralCfg().LockingTimeout, thresholdProfileLockKey(tntID.Tenant, tntID.ID))
@@ -409,6 +416,7 @@ func (dm *DataManager) SetDestination(dest *Destination, transactionID string) (
 	if err = dm.dataDB.SetDestinationDrv(dest, transactionID); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaDestinations, utils.EmptyString, dest.Id, dest)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDestinations]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -451,6 +459,7 @@ func (dm *DataManager) RemoveDestination(destID string, transactionID string) (e
 		dm.GetReverseDestination(prfx, false, true, transactionID) // it will recache the destination
 	}
 
+	dm.exportDataDBUpdate(utils.MetaDestinations, utils.EmptyString, destID, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDestinations]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -472,6 +481,7 @@ func (dm *DataManager) SetReverseDestination(destID string, prefixes []string, t
 	if err = dm.dataDB.SetReverseDestinationDrv(destID, prefixes, transactionID); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaReverseDestinations, utils.EmptyString, destID, prefixes)
 	if config.CgrConfig().DataDbCfg().Items[utils.MetaReverseDestinations].Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -602,6 +612,9 @@ func (dm *DataManager) GetAccount(id string) (acc *Account, err error) {
 			return nil, err
 		}
 	}
+	if decimalBalances {
+		acc.InitDecimalBalances()
+	}
 	return
 }
 
@@ -609,9 +622,14 @@ func (dm *DataManager) SetAccount(acc *Account) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
+	if decimalBalances {
+		acc.InitDecimalBalances()
+	}
 	if err = dm.dataDB.SetAccountDrv(acc); err != nil {
 		return
 	}
+	acntTntID := utils.NewTenantID(acc.ID)
+	dm.exportDataDBUpdate(utils.MetaAccounts, acntTntID.Tenant, acntTntID.ID, acc)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAccounts]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -632,6 +650,8 @@ func (dm *DataManager) RemoveAccount(id string) (err error) {
 	if err = dm.dataDB.RemoveAccountDrv(id); err != nil {
 		return
 	}
+	acntTntID := utils.NewTenantID(id)
+	dm.exportDataDBUpdate(utils.MetaAccounts, acntTntID.Tenant, acntTntID.ID, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAccounts]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -723,6 +743,7 @@ func (dm *DataManager) SetFilter(fltr *Filter, withIndex bool) (err error) {
 			return
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaFilters, fltr.Tenant, fltr.ID, fltr)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaFilters]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -766,6 +787,7 @@ func (dm *DataManager) RemoveFilter(tenant, id string, withIndex bool) (err erro
 	if oldFlt == nil {
 		return utils.ErrNotFound
 	}
+	dm.exportDataDBUpdate(utils.MetaFilters, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaFilters]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -834,6 +856,7 @@ func (dm *DataManager) SetThreshold(th *Threshold) (err error) {
 	if err = dm.DataDB().SetThresholdDrv(th); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaThresholds, th.Tenant, th.ID, th)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaThresholds]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -854,6 +877,7 @@ func (dm *DataManager) RemoveThreshold(tenant, id string) (err error) {
 	if err = dm.DataDB().RemoveThresholdDrv(tenant, id); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaThresholds, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaThresholds]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -944,6 +968,7 @@ func (dm *DataManager) SetThresholdProfile(th *ThresholdProfile, withIndex bool)
 			return err
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaThresholdProfiles, th.Tenant, th.ID, th)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaThresholdProfiles]; itm.Replicate {
 		if err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1000,6 +1025,7 @@ func (dm *DataManager) RemoveThresholdProfile(tenant, id string, withIndex bool)
 			return
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaThresholdProfiles, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaThresholdProfiles]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1085,6 +1111,7 @@ func (dm *DataManager) SetStatQueue(sq *StatQueue) (err error) {
 	if err = dm.dataDB.SetStatQueueDrv(ssq, sq); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaStatQueues, sq.Tenant, sq.ID, sq)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaStatQueues]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1106,6 +1133,7 @@ func (dm *DataManager) RemoveStatQueue(tenant, id string) (err error) {
 	if err = dm.dataDB.RemStatQueueDrv(tenant, id); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaStatQueues, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaStatQueues]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1197,6 +1225,7 @@ func (dm *DataManager) SetStatQueueProfile(sqp *StatQueueProfile, withIndex bool
 			return err
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaStatQueueProfiles, sqp.Tenant, sqp.ID, sqp)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaStatQueueProfiles]; itm.Replicate {
 		if err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1285,6 +1314,7 @@ func (dm *DataManager) RemoveStatQueueProfile(tenant, id string, withIndex bool)
 			return
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaStatQueueProfiles, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaStatQueueProfiles]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1373,6 +1403,7 @@ func (dm *DataManager) SetTrend(tr *Trend) (err error) {
 	if err = dm.DataDB().SetTrendDrv(tr); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaTrends, tr.Tenant, tr.ID, tr)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTrends]; itm.Replicate {
 		if err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1396,6 +1427,7 @@ func (dm *DataManager) RemoveTrend(tenant, id string) (err error) {
 	if err = dm.DataDB().RemoveTrendDrv(tenant, id); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaTrends, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTrends]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1502,6 +1534,7 @@ func (dm *DataManager) SetTrendProfile(trp *TrendProfile) (err error) {
 	if err = dm.DataDB().SetTrendProfileDrv(trp); err != nil {
 		return err
 	}
+	dm.exportDataDBUpdate(utils.MetaTrendProfiles, trp.Tenant, trp.ID, trp)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTrendProfiles]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1537,6 +1570,7 @@ func (dm *DataManager) RemoveTrendProfile(tenant, id string) (err error) {
 	if oldTrs == nil {
 		return utils.ErrNotFound
 	}
+	dm.exportDataDBUpdate(utils.MetaTrendProfiles, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRankingProfiles]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1550,6 +1584,131 @@ func (dm *DataManager) RemoveTrendProfile(tenant, id string) (err error) {
 	return dm.RemoveTrend(tenant, id)
 }
 
+func (dm *DataManager) GetHolidayCalendar(tenant, id string, cacheRead, cacheWrite bool, transactionID string) (hc *HolidayCalendar, err error) {
+	tntID := utils.ConcatenatedKey(tenant, id)
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CacheHolidayCalendars, tntID); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*HolidayCalendar), nil
+		}
+	}
+	if dm == nil {
+		err = utils.ErrNoDatabaseConn
+		return
+	}
+	hc, err = dm.dataDB.GetHolidayCalendarDrv(tenant, id)
+	if err != nil {
+		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaHolidayCalendars]; err == utils.ErrNotFound && itm.Remote {
+			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
+				utils.ReplicatorSv1GetHolidayCalendar,
+				&utils.TenantIDWithAPIOpts{
+					TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+					APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID, utils.EmptyString,
+						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
+							config.CgrConfig().GeneralCfg().NodeID)),
+				}, &hc); err == nil {
+				err = dm.dataDB.SetHolidayCalendarDrv(hc)
+			}
+		}
+		if err != nil {
+			err = utils.CastRPCErr(err)
+			if err == utils.ErrNotFound && cacheWrite {
+				if errCh := Cache.Set(utils.CacheHolidayCalendars, tntID, nil, nil,
+					cacheCommit(transactionID), transactionID); errCh != nil {
+					return nil, errCh
+				}
+			}
+			return nil, err
+		}
+	}
+	if err = hc.Compile(); err != nil {
+		return nil, err
+	}
+	if cacheWrite {
+		if errCh := Cache.Set(utils.CacheHolidayCalendars, tntID, hc, nil,
+			cacheCommit(transactionID), transactionID); errCh != nil {
+			return nil, errCh
+		}
+	}
+	return
+}
+
+func (dm *DataManager) GetHolidayCalendarIDs(tenants []string) (hcs map[string][]string, err error) {
+	prfx := utils.HolidayCalendarPrefix
+	var keys []string
+	if len(tenants) == 0 {
+		if keys, err = dm.dataDB.GetKeysForPrefix(prfx); err != nil {
+			return
+		}
+	} else {
+		for _, tenant := range tenants {
+			var tntkeys []string
+			if tntkeys, err = dm.dataDB.GetKeysForPrefix(prfx + tenant + utils.ConcatenatedKeySep); err != nil {
+				return
+			}
+			keys = append(keys, tntkeys...)
+		}
+	}
+	hcs = make(map[string][]string)
+	for _, key := range keys {
+		indx := strings.Index(key, utils.ConcatenatedKeySep)
+		tenant := key[len(prfx):indx]
+		hcs[tenant] = append(hcs[tenant], key[indx+1:])
+	}
+	return
+}
+
+func (dm *DataManager) SetHolidayCalendar(hc *HolidayCalendar) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if err = dm.DataDB().SetHolidayCalendarDrv(hc); err != nil {
+		return
+	}
+	dm.exportDataDBUpdate(utils.MetaHolidayCalendars, hc.Tenant, hc.ID, hc)
+	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaHolidayCalendars]; itm.Replicate {
+		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
+			config.CgrConfig().DataDbCfg().RplFiltered,
+			utils.HolidayCalendarPrefix, hc.TenantID(), // this are used to get the host IDs from cache
+			utils.ReplicatorSv1SetHolidayCalendar,
+			&HolidayCalendarWithAPIOpts{
+				HolidayCalendar: hc,
+				APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+					config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString)})
+	}
+	return
+}
+
+func (dm *DataManager) RemoveHolidayCalendar(tenant, id string) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	oldHC, err := dm.GetHolidayCalendar(tenant, id, true, false, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return err
+	}
+	if err = dm.DataDB().RemoveHolidayCalendarDrv(tenant, id); err != nil {
+		return
+	}
+	if oldHC == nil {
+		return utils.ErrNotFound
+	}
+	dm.exportDataDBUpdate(utils.MetaHolidayCalendars, tenant, id, nil)
+	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaHolidayCalendars]; itm.Replicate {
+		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
+			config.CgrConfig().DataDbCfg().RplFiltered,
+			utils.HolidayCalendarPrefix, utils.ConcatenatedKey(tenant, id), // this are used to get the host IDs from cache
+			utils.ReplicatorSv1RemoveHolidayCalendar,
+			&utils.TenantIDWithAPIOpts{
+				TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+				APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+					config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString)})
+	}
+	return
+}
+
 func (dm *DataManager) GetRankingProfile(tenant, id string, cacheRead, cacheWrite bool, transactionID string) (rgp *RankingProfile, err error) {
 	tntID := utils.ConcatenatedKey(tenant, id)
 	if cacheRead {
@@ -1641,6 +1800,7 @@ func (dm *DataManager) SetRankingProfile(rnp *RankingProfile) (err error) {
 	if err = dm.DataDB().SetRankingProfileDrv(rnp); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaRankingProfiles, rnp.Tenant, rnp.ID, rnp)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRankingProfiles]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1674,6 +1834,7 @@ func (dm *DataManager) RemoveRankingProfile(tenant, id string) (err error) {
 	if oldSgs == nil {
 		return utils.ErrNotFound
 	}
+	dm.exportDataDBUpdate(utils.MetaRankingProfiles, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRankingProfiles]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1742,6 +1903,7 @@ func (dm *DataManager) SetRanking(rn *Ranking) (err error) {
 	if err = dm.DataDB().SetRankingDrv(rn); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaRankings, rn.Tenant, rn.ID, rn)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRankings]; itm.Replicate {
 		if err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1765,6 +1927,7 @@ func (dm *DataManager) RemoveRanking(tenant, id string) (err error) {
 	if err = dm.DataDB().RemoveRankingDrv(tenant, id); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaRankings, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRankings]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1835,6 +1998,7 @@ func (dm *DataManager) SetTiming(t *utils.TPTiming) (err error) {
 	if err = dm.CacheDataFromDB(utils.TimingsPrefix, []string{t.ID}, true); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaTimings, utils.EmptyString, t.ID, t)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTimings]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1859,6 +2023,7 @@ func (dm *DataManager) RemoveTiming(id, transactionID string) (err error) {
 		cacheCommit(transactionID), transactionID); errCh != nil {
 		return errCh
 	}
+	dm.exportDataDBUpdate(utils.MetaTimings, utils.EmptyString, id, nil)
 	if config.CgrConfig().DataDbCfg().Items[utils.MetaTimings].Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1926,6 +2091,7 @@ func (dm *DataManager) SetResource(rs *Resource) (err error) {
 	if err = dm.DataDB().SetResourceDrv(rs); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaResources, rs.Tenant, rs.ID, rs)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaResources]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -1946,6 +2112,7 @@ func (dm *DataManager) RemoveResource(tenant, id string) (err error) {
 	if err = dm.DataDB().RemoveResourceDrv(tenant, id); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaResources, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaResources]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2037,6 +2204,7 @@ func (dm *DataManager) SetResourceProfile(rp *ResourceProfile, withIndex bool) (
 		}
 		Cache.Clear([]string{utils.CacheEventResources})
 	}
+	dm.exportDataDBUpdate(utils.MetaResourceProfile, rp.Tenant, rp.ID, rp)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaResourceProfile]; itm.Replicate {
 		if err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2092,6 +2260,7 @@ func (dm *DataManager) RemoveResourceProfile(tenant, id string, withIndex bool)
 			return
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaResourceProfile, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaResourceProfile]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2162,6 +2331,7 @@ func (dm *DataManager) RemoveActionTriggers(id, transactionID string) (err error
 		cacheCommit(transactionID), transactionID); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaActionTriggers, utils.EmptyString, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaActionTriggers]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2194,6 +2364,7 @@ func (dm *DataManager) SetActionTriggers(key string, attr ActionTriggers) (err e
 	if err = dm.CacheDataFromDB(utils.ActionTriggerPrefix, []string{key}, true); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaActionTriggers, utils.EmptyString, key, attr)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaActionTriggers]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2266,6 +2437,7 @@ func (dm *DataManager) SetSharedGroup(sg *SharedGroup) (err error) {
 		[]string{sg.Id}, true); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaSharedGroups, utils.EmptyString, sg.Id, sg)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaSharedGroups]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2291,6 +2463,7 @@ func (dm *DataManager) RemoveSharedGroup(id, transactionID string) (err error) {
 		cacheCommit(transactionID), transactionID); errCh != nil {
 		return errCh
 	}
+	dm.exportDataDBUpdate(utils.MetaSharedGroups, utils.EmptyString, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaSharedGroups]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2368,6 +2541,7 @@ func (dm *DataManager) SetActions(key string, as Actions) (err error) {
 	if err = dm.DataDB().SetActionsDrv(key, as); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaActions, utils.EmptyString, key, as)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaActions]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2390,6 +2564,7 @@ func (dm *DataManager) RemoveActions(key string) (err error) {
 	if err = dm.DataDB().RemoveActionsDrv(key); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaActions, utils.EmptyString, key, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaActions]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2485,6 +2660,7 @@ func (dm *DataManager) SetActionPlan(key string, ats *ActionPlan,
 	if err = dm.dataDB.SetActionPlanDrv(key, ats); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaActionPlans, utils.EmptyString, key, ats)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaActionPlans]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2531,6 +2707,7 @@ func (dm *DataManager) RemoveActionPlan(key string, transactionID string) (err e
 	if err = dm.dataDB.RemoveActionPlanDrv(key); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaActionPlans, utils.EmptyString, key, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaActionPlans]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2618,6 +2795,7 @@ func (dm *DataManager) SetAccountActionPlans(acntID string, aPlIDs []string, ove
 	if err = dm.dataDB.SetAccountActionPlansDrv(acntID, aPlIDs); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaAccountActionPlans, utils.EmptyString, acntID, aPlIDs)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAccountActionPlans]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2663,6 +2841,7 @@ func (dm *DataManager) RemAccountActionPlans(acntID string, apIDs []string) (err
 	if err = dm.dataDB.RemAccountActionPlansDrv(acntID); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaAccountActionPlans, utils.EmptyString, acntID, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAccountActionPlans]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2732,6 +2911,7 @@ func (dm *DataManager) SetRatingPlan(rp *RatingPlan) (err error) {
 	if err = dm.DataDB().SetRatingPlanDrv(rp); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaRatingPlans, utils.EmptyString, rp.Id, rp)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRatingPlans]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2753,6 +2933,7 @@ func (dm *DataManager) RemoveRatingPlan(key string, transactionID string) (err e
 	if err = dm.DataDB().RemoveRatingPlanDrv(key); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaRatingPlans, utils.EmptyString, key, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRatingPlans]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2824,6 +3005,7 @@ func (dm *DataManager) SetRatingProfile(rpf *RatingProfile) (err error) {
 	if err = dm.DataDB().SetRatingProfileDrv(rpf); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaRatingProfiles, utils.EmptyString, rpf.Id, rpf)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRatingProfiles]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2845,6 +3027,7 @@ func (dm *DataManager) RemoveRatingProfile(key string) (err error) {
 	if err = dm.DataDB().RemoveRatingProfileDrv(key); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaRatingProfiles, utils.EmptyString, key, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRatingProfiles]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2948,6 +3131,7 @@ func (dm *DataManager) SetRouteProfile(rpp *RouteProfile, withIndex bool) (err e
 			return err
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaRouteProfiles, rpp.Tenant, rpp.ID, rpp)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRouteProfiles]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -2984,6 +3168,7 @@ func (dm *DataManager) RemoveRouteProfile(tenant, id string, withIndex bool) (er
 			return
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaRouteProfiles, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRouteProfiles]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -3087,6 +3272,7 @@ func (dm *DataManager) SetAttributeProfile(ap *AttributeProfile, withIndex bool)
 			return
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaAttributeProfiles, ap.Tenant, ap.ID, ap)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAttributeProfiles]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -3125,6 +3311,7 @@ func (dm *DataManager) RemoveAttributeProfile(tenant, id string, withIndex bool)
 			}
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaAttributeProfiles, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAttributeProfiles]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -3216,6 +3403,7 @@ func (dm *DataManager) SetChargerProfile(cpp *ChargerProfile, withIndex bool) (e
 			return err
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaChargerProfiles, cpp.Tenant, cpp.ID, cpp)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaChargerProfiles]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -3252,6 +3440,7 @@ func (dm *DataManager) RemoveChargerProfile(tenant, id string, withIndex bool) (
 			return
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaChargerProfiles, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaChargerProfiles]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -3347,6 +3536,7 @@ func (dm *DataManager) SetDispatcherProfile(dpp *DispatcherProfile, withIndex bo
 			return
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaDispatcherProfiles, dpp.Tenant, dpp.ID, dpp)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDispatcherProfiles]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -3385,6 +3575,7 @@ func (dm *DataManager) RemoveDispatcherProfile(tenant, id string, withIndex bool
 			}
 		}
 	}
+	dm.exportDataDBUpdate(utils.MetaDispatcherProfiles, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDispatcherProfiles]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -3455,6 +3646,7 @@ func (dm *DataManager) SetDispatcherHost(dpp *DispatcherHost) (err error) {
 	if err = dm.DataDB().SetDispatcherHostDrv(dpp); err != nil {
 		return
 	}
+	dm.exportDataDBUpdate(utils.MetaDispatcherHosts, dpp.Tenant, dpp.ID, dpp)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDispatcherHosts]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -3482,6 +3674,7 @@ func (dm *DataManager) RemoveDispatcherHost(tenant, id string) (err error) {
 	if oldDpp == nil {
 		return utils.ErrDSPHostNotFound
 	}
+	dm.exportDataDBUpdate(utils.MetaDispatcherHosts, tenant, id, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDispatcherHosts]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -3644,6 +3837,10 @@ func (dm *DataManager) SetIndexes(idxItmType, tntCtx string,
 		indexes, commit, transactionID); err != nil {
 		return
 	}
+	if transactionID == utils.EmptyString { // the transactional indexes are not visible until commit
+		idxTntID := utils.NewTenantID(tntCtx)
+		dm.exportDataDBUpdate(idxItmType, idxTntID.Tenant, idxTntID.ID, indexes)
+	}
 	if itm := config.CgrConfig().DataDbCfg().Items[idxItmType]; itm.Replicate {
 		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -3667,6 +3864,11 @@ func (dm *DataManager) RemoveIndexes(idxItmType, tntCtx, idxKey string) (err err
 	if err = dm.DataDB().RemoveIndexesDrv(idxItmType, tntCtx, idxKey); err != nil {
 		return
 	}
+	idxTntID := utils.NewTenantID(tntCtx)
+	if idxKey != utils.EmptyString {
+		idxTntID.ID = utils.ConcatenatedKey(idxTntID.ID, idxKey)
+	}
+	dm.exportDataDBUpdate(idxItmType, idxTntID.Tenant, idxTntID.ID, nil)
 	if itm := config.CgrConfig().DataDbCfg().Items[idxItmType]; itm.Replicate {
 		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
 			config.CgrConfig().DataDbCfg().RplFiltered,
@@ -159,7 +159,8 @@ type EventCost struct {
 	RunID          string
 	StartTime      time.Time
 	Usage          *time.Duration
-	Cost           *float64 // pointer so we can nil it when dirty
+	Cost           *float64       // pointer so we can nil it when dirty
+	DecimalCost    *utils.Decimal `json:",omitempty"` // exact Cost, used with rals decimal_balances
 	Charges        []*ChargingInterval
 	AccountSummary *AccountSummary // Account summary at the end of the event calculation
 	Rating         Rating
@@ -264,6 +265,9 @@ func (ec *EventCost) Clone() (cln *EventCost) {
 	if ec.Cost != nil {
 		cln.Cost = utils.Float64Pointer(*ec.Cost)
 	}
+	if ec.DecimalCost != nil {
+		cln.DecimalCost = ec.DecimalCost.Clone()
+	}
 	if ec.Charges != nil {
 		cln.Charges = make([]*ChargingInterval, len(ec.Charges))
 		for i, cIl := range ec.Charges {
@@ -301,6 +305,7 @@ func (ec *EventCost) Compute() {
 // ResetCounters will reset all the computed cached values
 func (ec *EventCost) ResetCounters() {
 	ec.Cost = nil
+	ec.DecimalCost = nil
 	ec.Usage = nil
 	for _, cIl := range ec.Charges {
 		cIl.cost = nil
@@ -311,6 +316,15 @@ func (ec *EventCost) ResetCounters() {
 
 // GetCost iterates through Charges, computing EventCost.Cost
 func (ec *EventCost) GetCost() float64 {
+	if decimalBalances && (ec.Cost == nil || ec.DecimalCost == nil) {
+		dCost := utils.NewDecimal(0, 0)
+		for _, ci := range ec.Charges {
+			dCost = utils.SumDecimal(dCost, ci.decimalTotalCost())
+		}
+		ec.DecimalCost = utils.RoundDecimal(dCost, globalRoundingDecimals, utils.MetaRoundingMiddle)
+		cost, _ := ec.DecimalCost.Float64()
+		ec.Cost = &cost
+	}
 	if ec.Cost == nil {
 		var cost float64
 		for _, ci := range ec.Charges {
@@ -431,6 +445,9 @@ func (ec *EventCost) AsCallCost(tor string) *CallCost {
 		RatedUsage:     float64(ec.GetUsage().Nanoseconds()),
 		AccountSummary: ec.AccountSummary,
 	}
+	if ec.DecimalCost != nil {
+		cc.DecimalCost = ec.DecimalCost.Clone()
+	}
 	cc.Timespans = make(TimeSpans, len(ec.Charges))
 	for i, cIl := range ec.Charges {
 		ts := &TimeSpan{
@@ -877,6 +894,7 @@ func (ec *EventCost) Trim(atUsage time.Duration) (srplusEC *EventCost, err error
 	ec.Charges = ec.Charges[:*lastActiveCIlIdx+1]
 	ec.Usage = nil
 	ec.Cost = nil
+	ec.DecimalCost = nil
 	if lastActiveCIl.CompressFactor != 1 &&
 		*lastActiveCIl.ecUsageIdx+*lastActiveCIl.TotalUsage() > atUsage { // Split based on compress factor if needed
 		var laCF int
@@ -897,6 +915,7 @@ func (ec *EventCost) Trim(atUsage time.Duration) (srplusEC *EventCost, err error
 			lastActiveCIl.CompressFactor = laCF                                           // correct compress factor
 			ec.Usage = nil
 			ec.Cost = nil
+			ec.DecimalCost = nil
 		}
 	}
 	if atUsage != ec.GetUsage() { // lastInterval covering more than needed, need split
@@ -925,6 +944,7 @@ func (ec *EventCost) Trim(atUsage time.Duration) (srplusEC *EventCost, err error
 			lastActiveCIts = lastActiveCIts[:*lastActiveCItIdx+1]
 			ec.Usage = nil
 			ec.Cost = nil
+			ec.DecimalCost = nil
 		}
 		var laItCF int
 		if lastIncrement.CompressFactor != 1 && atUsage != incrementsUsage {
@@ -954,6 +974,7 @@ func (ec *EventCost) Trim(atUsage time.Duration) (srplusEC *EventCost, err error
 				lastActiveCIl.CompressFactor = 1
 				ec.Usage = nil
 				ec.Cost = nil
+				ec.DecimalCost = nil
 			}
 			srplsCIl := lastActiveCIl.Clone()
 			srplsCIl.Increments = srplsIncrements
@@ -966,6 +987,7 @@ func (ec *EventCost) Trim(atUsage time.Duration) (srplusEC *EventCost, err error
 				lastActiveCIl.Increments[len(lastActiveCIl.Increments)-1].CompressFactor = laItCF // correct the compressFactor for the last increment
 				ec.Usage = nil
 				ec.Cost = nil
+				ec.DecimalCost = nil
 			}
 		}
 	}
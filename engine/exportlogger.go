@@ -0,0 +1,244 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"log/syslog"
+	"strings"
+	"sync"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// exportLoggerBuffer is the number of log records waiting to be exported
+const exportLoggerBuffer = 1024
+
+// NewExportLogger creates the logger sending the log records to EEs
+// over the logger_ees_conns from the general section
+func NewExportLogger(cfg *config.CGRConfig, connMgr *ConnManager) (el *ExportLogger) {
+	gCfg := cfg.GeneralCfg()
+	el = &ExportLogger{
+		logLevel:  gCfg.LogLevel,
+		logLevels: gCfg.LogLevels,
+		nodeID:    gCfg.NodeID,
+		tenant:    gCfg.DefaultTenant,
+		eesConns:  gCfg.LoggerEEsConns,
+		expIDs:    gCfg.LoggerEEsExpIDs,
+		connMgr:   connMgr,
+		recChan:   make(chan *exportLogRecord, exportLoggerBuffer),
+		stopChan:  make(chan struct{}),
+		doneChan:  make(chan struct{}),
+	}
+	el.fallback, _ = utils.Newlogger(utils.MetaStdLog, gCfg.NodeID)
+	el.fallback.SetLogLevel(gCfg.LogLevel)
+	el.fallback.SetLogLevels(gCfg.LogLevels)
+	go el.export()
+	return
+}
+
+// ExportLogger ships the log records as events to EEs so the logs of
+// a whole cluster can be collected without a log agent
+// when EEs cannot be reached the records are logged to stdout
+type ExportLogger struct {
+	logLevel  int
+	logLevels map[string]int // log level overwrites per subsystem
+	nodeID    string
+	tenant    string
+	eesConns  []string
+	expIDs    []string
+	connMgr   *ConnManager
+	fallback  utils.LoggerInterface
+
+	closedMux sync.RWMutex // protects closed so no record is queued after the flush
+	closed    bool
+	recChan   chan *exportLogRecord
+	stopChan  chan struct{}
+	doneChan  chan struct{}
+}
+
+// export sends the queued records to EEs until the logger is closed
+func (el *ExportLogger) export() {
+	defer close(el.doneChan)
+	for {
+		select {
+		case rec := <-el.recChan:
+			el.exportRecord(rec)
+		case <-el.stopChan:
+			for { // flush the records queued before closing
+				select {
+				case rec := <-el.recChan:
+					el.exportRecord(rec)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// exportLogRecord is a log record waiting to be exported
+type exportLogRecord struct {
+	level int
+	*utils.LogRecord
+}
+
+// asCGREvent converts the record into the event sent to EEs
+func (rec *exportLogRecord) asCGREvent(tenant string) (ev *utils.CGREvent) {
+	ev = &utils.CGREvent{
+		Tenant: tenant,
+		ID:     utils.GenUUID(),
+		Time:   utils.TimePointer(rec.Timestamp),
+		Event:  make(map[string]any, len(rec.Fields)+5),
+		APIOpts: map[string]any{
+			utils.MetaEventType: utils.LogRecordEv,
+		},
+	}
+	for k, v := range rec.Fields {
+		ev.Event[k] = v
+	}
+	ev.Event[utils.LogTimestampFld] = rec.Timestamp
+	ev.Event[utils.LogLevelFld] = rec.Level
+	ev.Event[utils.LogNodeIDFld] = rec.NodeID
+	ev.Event[utils.LogSubsystemFld] = rec.Subsystem
+	ev.Event[utils.LogMessageFld] = rec.Message
+	return
+}
+
+// exportRecord calls EEs with one log record falling back on stdout on errors
+func (el *ExportLogger) exportRecord(rec *exportLogRecord) {
+	var reply map[string]map[string]any
+	if err := el.connMgr.Call(context.TODO(), el.eesConns,
+		utils.EeSv1ProcessEvent, &CGREventWithEeIDs{
+			EeIDs:    el.expIDs,
+			CGREvent: rec.asCGREvent(el.tenant),
+		}, &reply); err != nil &&
+		err.Error() != utils.ErrNotFound.Error() {
+		el.fallback.LogFields(rec.level, rec.Subsystem, rec.Message, rec.Fields)
+		el.fallback.Warning(
+			fmt.Sprintf("<%s> error: %q exporting log record.",
+				utils.EEs, err.Error()))
+	}
+}
+
+// SetSyslog is not used by the ExportLogger
+func (el *ExportLogger) SetSyslog(*syslog.Writer) {}
+
+// SetLogLevel changes the log level
+func (el *ExportLogger) SetLogLevel(level int) {
+	el.logLevel = level
+	el.fallback.SetLogLevel(level)
+}
+
+// SetLogLevels changes the log levels per subsystem
+func (el *ExportLogger) SetLogLevels(levels map[string]int) {
+	el.logLevels = levels
+	el.fallback.SetLogLevels(levels)
+}
+
+// Close stops the logger after exporting the queued records
+func (el *ExportLogger) Close() error {
+	el.closedMux.Lock()
+	if !el.closed {
+		el.closed = true
+		close(el.stopChan)
+	}
+	el.closedMux.Unlock()
+	<-el.doneChan
+	return nil
+}
+
+// Write logs the received bytes as a message with info level
+func (el *ExportLogger) Write(p []byte) (n int, err error) {
+	if err = el.LogFields(utils.LOGLEVEL_INFO, utils.EmptyString,
+		strings.TrimSpace(string(p)), nil); err != nil {
+		return
+	}
+	return len(p), nil
+}
+
+// LogFields queues the record for export
+func (el *ExportLogger) LogFields(level int, subsystem, m string, fields map[string]any) (err error) {
+	if subsystem == utils.EmptyString {
+		subsystem, m = utils.LogSubsystem(m)
+	}
+	if utils.LogLevelFor(el.logLevel, el.logLevels, subsystem) < level {
+		return
+	}
+	if subsystem == utils.EEs { // exporting these would loop back into EEs when it is failing
+		return el.fallback.LogFields(level, subsystem, m, fields)
+	}
+	rec := &exportLogRecord{
+		level:     level,
+		LogRecord: utils.NewLogRecord(el.nodeID, level, subsystem, m, fields),
+	}
+	el.closedMux.RLock()
+	defer el.closedMux.RUnlock()
+	if el.closed {
+		return el.fallback.LogFields(level, subsystem, m, fields)
+	}
+	select {
+	case el.recChan <- rec:
+	default: // do not block the caller when EEs falls behind
+		return el.fallback.LogFields(level, subsystem, m, fields)
+	}
+	return
+}
+
+// Emerg logs with emergency level
+func (el *ExportLogger) Emerg(m string) error {
+	return el.LogFields(utils.LOGLEVEL_EMERGENCY, utils.EmptyString, m, nil)
+}
+
+// Alert logs with alert level
+func (el *ExportLogger) Alert(m string) error {
+	return el.LogFields(utils.LOGLEVEL_ALERT, utils.EmptyString, m, nil)
+}
+
+// Crit logs with critical level
+func (el *ExportLogger) Crit(m string) error {
+	return el.LogFields(utils.LOGLEVEL_CRITICAL, utils.EmptyString, m, nil)
+}
+
+// Err logs with error level
+func (el *ExportLogger) Err(m string) error {
+	return el.LogFields(utils.LOGLEVEL_ERROR, utils.EmptyString, m, nil)
+}
+
+// Warning logs with warning level
+func (el *ExportLogger) Warning(m string) error {
+	return el.LogFields(utils.LOGLEVEL_WARNING, utils.EmptyString, m, nil)
+}
+
+// Notice logs with notice level
+func (el *ExportLogger) Notice(m string) error {
+	return el.LogFields(utils.LOGLEVEL_NOTICE, utils.EmptyString, m, nil)
+}
+
+// Info logs with info level
+func (el *ExportLogger) Info(m string) error {
+	return el.LogFields(utils.LOGLEVEL_INFO, utils.EmptyString, m, nil)
+}
+
+// Debug logs with debug level
+func (el *ExportLogger) Debug(m string) error {
+	return el.LogFields(utils.LOGLEVEL_DEBUG, utils.EmptyString, m, nil)
+}
@@ -0,0 +1,170 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestExportLoggerLogFields(t *testing.T) {
+	tmp := Cache
+	defer func() { Cache = tmp }()
+	Cache.Clear(nil)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.GeneralCfg().NodeID = "node1"
+	cfg.GeneralCfg().LogLevel = utils.LOGLEVEL_INFO
+	cfg.GeneralCfg().LogLevels = map[string]int{utils.SessionS: utils.LOGLEVEL_DEBUG}
+	cfg.GeneralCfg().LoggerEEsConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)}
+	cfg.GeneralCfg().LoggerEEsExpIDs = []string{"LOGS_KAFKA"}
+	var evs []*CGREventWithEeIDs
+	clientConn := make(chan birpc.ClientConnector, 1)
+	clientConn <- &ccMock{
+		calls: map[string]func(ctx *context.Context, args, reply any) error{
+			utils.EeSv1ProcessEvent: func(ctx *context.Context, args, reply any) error {
+				evs = append(evs, args.(*CGREventWithEeIDs))
+				return nil
+			},
+		},
+	}
+	connMgr := NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs): clientConn,
+	})
+	el := NewExportLogger(cfg, connMgr)
+	el.Debug("<ChargerS> filtered")
+	el.Debug("<SessionS> session started")
+	el.LogFields(utils.LOGLEVEL_WARNING, utils.RALService, "low balance",
+		map[string]any{utils.AccountField: "1001"})
+	el.Close()
+
+	if len(evs) != 2 {
+		t.Fatalf("Expected 2 events, received: %s", utils.ToJSON(evs))
+	}
+	for _, ev := range evs {
+		if ev.EeIDs[0] != "LOGS_KAFKA" {
+			t.Errorf("Unexpected exporter IDs: %+v", ev.EeIDs)
+		} else if ev.Tenant != "cgrates.org" {
+			t.Errorf("Unexpected tenant: %q", ev.Tenant)
+		} else if ev.APIOpts[utils.MetaEventType] != utils.LogRecordEv {
+			t.Errorf("Unexpected event type: %+v", ev.APIOpts)
+		} else if ev.Event[utils.LogNodeIDFld] != "node1" {
+			t.Errorf("Unexpected event: %s", utils.ToJSON(ev.Event))
+		}
+	}
+	if ev := evs[0].Event; ev[utils.LogLevelFld] != "DEBUG" ||
+		ev[utils.LogSubsystemFld] != utils.SessionS ||
+		ev[utils.LogMessageFld] != "session started" {
+		t.Errorf("Unexpected event: %s", utils.ToJSON(ev))
+	}
+	if ev := evs[1].Event; ev[utils.LogLevelFld] != "WARNING" ||
+		ev[utils.LogSubsystemFld] != utils.RALService ||
+		ev[utils.LogMessageFld] != "low balance" ||
+		ev[utils.AccountField] != "1001" {
+		t.Errorf("Unexpected event: %s", utils.ToJSON(ev))
+	}
+}
+
+func TestExportLoggerFallback(t *testing.T) {
+	tmp := Cache
+	defer func() { Cache = tmp }()
+	Cache.Clear(nil)
+	output := new(bytes.Buffer)
+	log.SetOutput(output)
+	defer log.SetOutput(os.Stderr)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.GeneralCfg().NodeID = "node1"
+	cfg.GeneralCfg().LoggerEEsConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)}
+	clientConn := make(chan birpc.ClientConnector, 1)
+	clientConn <- &ccMock{
+		calls: map[string]func(ctx *context.Context, args, reply any) error{
+			utils.EeSv1ProcessEvent: func(ctx *context.Context, args, reply any) error {
+				return errors.New("EXPORT_FAILED")
+			},
+		},
+	}
+	connMgr := NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs): clientConn,
+	})
+	el := NewExportLogger(cfg, connMgr)
+	el.Err("<SessionS> session failed")
+	el.Close()
+	el.Info("<SessionS> after close")
+
+	rcv := output.String()
+	for _, exp := range []string{
+		"CGRateS <node1> [ERROR] <SessionS> session failed",
+		`CGRateS <node1> [WARNING] <EEs> error: "EXPORT_FAILED" exporting log record.`,
+		"CGRateS <node1> [INFO] <SessionS> after close",
+	} {
+		if !strings.Contains(rcv, exp) {
+			t.Errorf("Expected %q in %q", exp, rcv)
+		}
+	}
+}
+
+func TestExportLoggerEEsRecordsFallback(t *testing.T) {
+	tmp := Cache
+	defer func() { Cache = tmp }()
+	Cache.Clear(nil)
+	output := new(bytes.Buffer)
+	log.SetOutput(output)
+	defer log.SetOutput(os.Stderr)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.GeneralCfg().NodeID = "node1"
+	cfg.GeneralCfg().LoggerEEsConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)}
+	var evs []*CGREventWithEeIDs
+	clientConn := make(chan birpc.ClientConnector, 1)
+	clientConn <- &ccMock{
+		calls: map[string]func(ctx *context.Context, args, reply any) error{
+			utils.EeSv1ProcessEvent: func(ctx *context.Context, args, reply any) error {
+				evs = append(evs, args.(*CGREventWithEeIDs))
+				return nil
+			},
+		},
+	}
+	connMgr := NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs): clientConn,
+	})
+	el := NewExportLogger(cfg, connMgr)
+	el.Warning("<EEs> Exporter <LOGS_KAFKA> failed")
+	el.LogFields(utils.LOGLEVEL_WARNING, utils.EEs, "retry queue full", nil)
+	el.Close()
+
+	if len(evs) != 0 {
+		t.Errorf("Expected no exported records, received: %s", utils.ToJSON(evs))
+	}
+	rcv := output.String()
+	for _, exp := range []string{
+		"CGRateS <node1> [WARNING] <EEs> Exporter <LOGS_KAFKA> failed",
+		"CGRateS <node1> [WARNING] <EEs> retry queue full",
+	} {
+		if !strings.Contains(rcv, exp) {
+			t.Errorf("Expected %q in %q", exp, rcv)
+		}
+	}
+}
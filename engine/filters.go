@@ -230,19 +230,19 @@ var supportedFiltersType utils.StringSet = utils.NewStringSet([]string{
 	utils.MetaEmpty, utils.MetaExists, utils.MetaLessThan, utils.MetaLessOrEqual,
 	utils.MetaGreaterThan, utils.MetaGreaterOrEqual, utils.MetaEqual,
 	utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer, utils.MetaActivationInterval,
-	utils.MetaRegex})
+	utils.MetaRegex, utils.MetaHoliday})
 var needsFieldName utils.StringSet = utils.NewStringSet([]string{
 	utils.MetaString, utils.MetaContains, utils.MetaPrefix, utils.MetaSuffix,
 	utils.MetaTimings, utils.MetaRSR, utils.MetaDestinations, utils.MetaLessThan,
 	utils.MetaEmpty, utils.MetaExists, utils.MetaLessOrEqual, utils.MetaGreaterThan,
 	utils.MetaGreaterOrEqual, utils.MetaEqual, utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer,
 	utils.MetaActivationInterval,
-	utils.MetaRegex})
+	utils.MetaRegex, utils.MetaHoliday})
 var needsValues utils.StringSet = utils.NewStringSet([]string{utils.MetaString, utils.MetaContains, utils.MetaPrefix,
 	utils.MetaSuffix, utils.MetaTimings, utils.MetaRSR, utils.MetaDestinations,
 	utils.MetaLessThan, utils.MetaLessOrEqual, utils.MetaGreaterThan, utils.MetaGreaterOrEqual,
 	utils.MetaEqual, utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer, utils.MetaActivationInterval,
-	utils.MetaRegex})
+	utils.MetaRegex, utils.MetaHoliday})
 
 // NewFilterRule returns a new filter
 func NewFilterRule(rfType, fieldName string, vals []string) (*FilterRule, error) {
@@ -345,6 +345,8 @@ func (fltr *FilterRule) Pass(dDP utils.DataProvider) (result bool, err error) {
 		result, err = fltr.passStringSuffix(dDP)
 	case utils.MetaTimings, utils.MetaNotTimings:
 		result, err = fltr.passTimings(dDP)
+	case utils.MetaHoliday, utils.MetaNotHoliday:
+		result, err = fltr.passHoliday(dDP)
 	case utils.MetaDestinations, utils.MetaNotDestinations:
 		result, err = fltr.passDestinations(dDP)
 	case utils.MetaRSR, utils.MetaNotRSR:
@@ -538,13 +540,62 @@ func (fltr *FilterRule) passTimings(dDP utils.DataProvider) (bool, error) {
 			StartTime: tm.StartTime,
 			EndTime:   tm.EndTime,
 		}
-		if ritm.IsActiveAt(tmTime) {
+		if !ritm.IsActiveAt(tmTime) {
+			continue
+		}
+		if len(tm.HolidayCalendarIDs) == 0 ||
+			isHolidayFromAPIer(tm.HolidayCalendarIDs, tmTime) {
 			return true, nil
 		}
 	}
 	return false, nil
 }
 
+func (fltr *FilterRule) passHoliday(dDP utils.DataProvider) (bool, error) {
+	tmVal, err := fltr.rsrElement.ParseDataProviderWithInterfaces(dDP)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	tmTime, err := utils.IfaceAsTime(tmVal, config.CgrConfig().GeneralCfg().DefaultTimezone)
+	if err != nil {
+		return false, err
+	}
+	hcIDs := make([]string, 0, len(fltr.rsrValues))
+	for _, valHcIDVal := range fltr.rsrValues {
+		valHcID, err := valHcIDVal.ParseDataProvider(dDP)
+		if err != nil {
+			return false, err
+		}
+		hcIDs = append(hcIDs, valHcID)
+	}
+	return isHolidayFromAPIer(hcIDs, tmTime), nil
+}
+
+// isHolidayFromAPIer queries the HolidayCalendars(<[tenant:]ID>) over the ApierSConns
+// and returns true if the day is a holiday in one of them
+func isHolidayFromAPIer(hcIDs []string, t time.Time) bool {
+	for _, hcID := range hcIDs {
+		tntID := utils.NewTenantID(hcID)
+		if tntID.Tenant == utils.EmptyString {
+			tntID.Tenant = config.CgrConfig().GeneralCfg().DefaultTenant
+		}
+		var hc HolidayCalendar
+		if err := connMgr.Call(context.TODO(), config.CgrConfig().FilterSCfg().ApierSConns,
+			utils.APIerSv1GetHolidayCalendar,
+			&utils.TenantIDWithAPIOpts{TenantID: tntID}, &hc); err != nil ||
+			hc.Compile() != nil {
+			continue
+		}
+		if hc.IsHoliday(t) {
+			return true
+		}
+	}
+	return false
+}
+
 func (fltr *FilterRule) passDestinations(dDP utils.DataProvider) (bool, error) {
 	dst, err := fltr.rsrElement.ParseDataProvider(dDP)
 	if err != nil {
@@ -0,0 +1,36 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+package engine
+
+import (
+	"time"
+)
+
+// Lease grants its owner the exclusive right over a resource shared
+// by the nodes using the same DataDB (ie: the SchedulerS queue)
+type Lease struct {
+	ID         string
+	OwnerID    string    // the node holding the lease
+	RenewTime  time.Time // last time the lease was acquired or renewed by the owner
+	ExpiryTime time.Time // after this time the lease can be acquired by another node
+}
+
+// IsActive returns true if the lease is not expired at the given time
+func (lse *Lease) IsActive(at time.Time) bool {
+	return lse.ExpiryTime.After(at)
+}
@@ -0,0 +1,69 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestInternalDBAcquireLease(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if _, err := data.GetLeaseDrv(utils.SchedulerS); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received: %v", utils.ErrNotFound, err)
+	}
+	now := time.Now()
+	lse := &Lease{
+		ID:         utils.SchedulerS,
+		OwnerID:    "node1",
+		RenewTime:  now,
+		ExpiryTime: now.Add(time.Minute),
+	}
+	if acquired, err := data.AcquireLeaseDrv(lse); err != nil || !acquired {
+		t.Fatalf("Expected the lease to be acquired, received: %v, %v", acquired, err)
+	}
+	if acquired, err := data.AcquireLeaseDrv(&Lease{
+		ID:         utils.SchedulerS,
+		OwnerID:    "node2",
+		RenewTime:  now.Add(time.Second),
+		ExpiryTime: now.Add(time.Minute + time.Second),
+	}); err != nil || acquired {
+		t.Errorf("Expected the lease to be held by node1, received: %v, %v", acquired, err)
+	}
+	lse.RenewTime = now.Add(2 * time.Second) // renew by the same owner
+	if acquired, err := data.AcquireLeaseDrv(lse); err != nil || !acquired {
+		t.Errorf("Expected the lease to be renewed, received: %v, %v", acquired, err)
+	}
+	if acquired, err := data.AcquireLeaseDrv(&Lease{
+		ID:         utils.SchedulerS,
+		OwnerID:    "node2",
+		RenewTime:  now.Add(2 * time.Minute),
+		ExpiryTime: now.Add(3 * time.Minute),
+	}); err != nil || !acquired {
+		t.Errorf("Expected the expired lease to be taken over, received: %v, %v", acquired, err)
+	}
+	if rcv, err := data.GetLeaseDrv(utils.SchedulerS); err != nil {
+		t.Error(err)
+	} else if rcv.OwnerID != "node2" || !rcv.IsActive(now.Add(2*time.Minute)) {
+		t.Errorf("Unexpected lease: %+v", rcv)
+	}
+}
@@ -25,6 +25,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/cgrates/birpc"
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/config"
@@ -339,3 +342,17 @@ func LoadAllDataDBToCache(dm *DataManager) (err error) {
 	}
 	return
 }
+
+// NewAWSSession creates the session used by the AWS (S3/SQS) clients,
+// using static credentials only when both the key and the secret are provided
+func NewAWSSession(endpoint, region, key, secret, token string) (*session.Session, error) {
+	cfg := aws.Config{Endpoint: aws.String(endpoint)}
+	if len(region) != 0 {
+		cfg.Region = aws.String(region)
+	}
+	if len(key) != 0 &&
+		len(secret) != 0 {
+		cfg.Credentials = credentials.NewStaticCredentials(key, secret, token)
+	}
+	return session.NewSessionWithOptions(session.Options{Config: cfg})
+}
@@ -112,6 +112,17 @@ func (cIl *ChargingInterval) TotalCost() float64 {
 		globalRoundingDecimals, utils.MetaRoundingMiddle)
 }
 
+// decimalTotalCost returns the exact cost of charges, the decimal version of TotalCost
+func (cIl *ChargingInterval) decimalTotalCost() *utils.Decimal {
+	cost := utils.NewDecimal(0, 0)
+	for _, incr := range cIl.Increments {
+		cost = utils.SumDecimal(cost, decimalCost(utils.NewDecimalFromFloat64(incr.Cost), incr.CompressFactor))
+	}
+	cost = utils.RoundDecimal(cost, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	return utils.RoundDecimal(decimalCost(cost, cIl.CompressFactor),
+		globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
+
 // Clone returns a new instance of ChargingInterval with independent data
 func (cIl *ChargingInterval) Clone() (cln *ChargingInterval) {
 	cln = new(ChargingInterval)
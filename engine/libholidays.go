@@ -0,0 +1,169 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// HolidayCalendarWithAPIOpts is used in replicatorV1 for dispatcher
+type HolidayCalendarWithAPIOpts struct {
+	*HolidayCalendar
+	APIOpts map[string]any
+}
+
+// HolidayCalendar groups the public holidays of one region (e.g. a country)
+type HolidayCalendar struct {
+	Tenant   string
+	ID       string
+	Holidays []*Holiday
+	dates    []*holidayDate // the parsed dates of the Holidays, populated by Compile
+}
+
+// Holiday is one day of the HolidayCalendar
+type Holiday struct {
+	Date string // the day of the holiday: <YYYY-MM-DD|MM-DD|*easter[+-days]>
+	Name string // informative only
+}
+
+// TenantID returns the concatenated key between tenant and ID
+func (hc *HolidayCalendar) TenantID() string {
+	return utils.ConcatenatedKey(hc.Tenant, hc.ID)
+}
+
+// Compile parses the dates of the holidays
+func (hc *HolidayCalendar) Compile() (err error) {
+	dates := make([]*holidayDate, len(hc.Holidays))
+	for i, hd := range hc.Holidays {
+		if dates[i], err = parseHolidayDate(hd.Date); err != nil {
+			return fmt.Errorf("invalid holiday <%s>: %w", hd.Date, err)
+		}
+	}
+	hc.dates = dates
+	return
+}
+
+// IsHoliday returns true if the day of the received time is one of the holidays
+// the calendar needs to be compiled before
+func (hc *HolidayCalendar) IsHoliday(t time.Time) bool {
+	year, month, day := t.Date()
+	for _, hDate := range hc.dates {
+		if hDate.matches(year, month, day) {
+			return true
+		}
+	}
+	return false
+}
+
+// Clone returns a copy of the HolidayCalendar
+func (hc *HolidayCalendar) Clone() (cln *HolidayCalendar) {
+	cln = &HolidayCalendar{
+		Tenant: hc.Tenant,
+		ID:     hc.ID,
+	}
+	if hc.Holidays != nil {
+		cln.Holidays = make([]*Holiday, len(hc.Holidays))
+		for i, hd := range hc.Holidays {
+			cln.Holidays[i] = &Holiday{Date: hd.Date, Name: hd.Name}
+		}
+	}
+	if hc.dates != nil { // the parsed dates are not changed after compile
+		cln.dates = make([]*holidayDate, len(hc.dates))
+		copy(cln.dates, hc.dates)
+	}
+	return
+}
+
+// holidayDate is the parsed Date of a Holiday
+type holidayDate struct {
+	year   int // 0 in case of the holidays repeating each year
+	month  time.Month
+	day    int
+	easter bool // the day is relative to the Easter Sunday
+	offset int  // the number of days after(before if negative) the Easter Sunday
+}
+
+// parseHolidayDate parses the date of the holiday: <YYYY-MM-DD|MM-DD|*easter[+-days]>
+func parseHolidayDate(date string) (hd *holidayDate, err error) {
+	if offset, isEaster := strings.CutPrefix(date, utils.MetaEaster); isEaster {
+		hd = &holidayDate{easter: true}
+		if offset != utils.EmptyString {
+			if hd.offset, err = strconv.Atoi(offset); err != nil {
+				return nil, err
+			}
+		}
+		return
+	}
+	dateSplt := strings.Split(date, "-")
+	if len(dateSplt) == 3 { // one time only holiday
+		hd = new(holidayDate)
+		if hd.year, err = strconv.Atoi(dateSplt[0]); err != nil {
+			return nil, err
+		}
+		dateSplt = dateSplt[1:]
+	} else if len(dateSplt) == 2 {
+		hd = new(holidayDate)
+	} else {
+		return nil, fmt.Errorf("unsupported format")
+	}
+	var month int
+	if month, err = strconv.Atoi(dateSplt[0]); err != nil {
+		return nil, err
+	}
+	if hd.day, err = strconv.Atoi(dateSplt[1]); err != nil {
+		return nil, err
+	}
+	if month < 1 || month > 12 || hd.day < 1 || hd.day > 31 {
+		return nil, fmt.Errorf("day out of range")
+	}
+	hd.month = time.Month(month)
+	return
+}
+
+// matches returns true if the holiday falls on the received day
+func (hd *holidayDate) matches(year int, month time.Month, day int) bool {
+	if hd.easter {
+		hYear, hMonth, hDay := easterSunday(year).AddDate(0, 0, hd.offset).Date()
+		return hYear == year && hMonth == month && hDay == day
+	}
+	return (hd.year == 0 || hd.year == year) &&
+		hd.month == month && hd.day == day
+}
+
+// easterSunday returns the Easter Sunday of the year in the Gregorian calendar
+// using the anonymous Gregorian algorithm (Meeus/Jones/Butcher)
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,236 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestEasterSunday(t *testing.T) {
+	for year, exp := range map[int]time.Time{
+		2019: time.Date(2019, time.April, 21, 0, 0, 0, 0, time.UTC),
+		2024: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC),
+		2025: time.Date(2025, time.April, 20, 0, 0, 0, 0, time.UTC),
+		2038: time.Date(2038, time.April, 25, 0, 0, 0, 0, time.UTC),
+	} {
+		if rcv := easterSunday(year); !rcv.Equal(exp) {
+			t.Errorf("for year %d expected %v, received %v", year, exp, rcv)
+		}
+	}
+}
+
+func TestParseHolidayDate(t *testing.T) {
+	for date, exp := range map[string]*holidayDate{
+		"12-25":      {month: time.December, day: 25},
+		"2025-05-08": {year: 2025, month: time.May, day: 8},
+		"*easter":    {easter: true},
+		"*easter+1":  {easter: true, offset: 1},
+		"*easter-2":  {easter: true, offset: -2},
+	} {
+		if rcv, err := parseHolidayDate(date); err != nil {
+			t.Error(err)
+		} else if !reflect.DeepEqual(rcv, exp) {
+			t.Errorf("for date %q expected %+v, received %+v", date, exp, rcv)
+		}
+	}
+	for _, date := range []string{"", "25", "13-01", "12-32", "a-01", "*easterx", "2025-01-01-01"} {
+		if _, err := parseHolidayDate(date); err == nil {
+			t.Errorf("expecting error for date %q", date)
+		}
+	}
+}
+
+func TestHolidayCalendarCompile(t *testing.T) {
+	hc := &HolidayCalendar{
+		Tenant: "cgrates.org",
+		ID:     "DE",
+		Holidays: []*Holiday{
+			{Date: "01-01", Name: "New Year"},
+			{Date: "*easter+1", Name: "Easter Monday"},
+		},
+	}
+	if err := hc.Compile(); err != nil {
+		t.Error(err)
+	}
+	hc.Holidays = append(hc.Holidays, &Holiday{Date: "02-30-1"})
+	expErr := "invalid holiday <02-30-1>: day out of range"
+	if err := hc.Compile(); err == nil || err.Error() != expErr {
+		t.Errorf("expected error <%s>, received <%v>", expErr, err)
+	}
+}
+
+func TestHolidayCalendarIsHoliday(t *testing.T) {
+	hc := &HolidayCalendar{
+		Tenant: "cgrates.org",
+		ID:     "DE",
+		Holidays: []*Holiday{
+			{Date: "01-01", Name: "New Year"},
+			{Date: "*easter-2", Name: "Good Friday"},
+			{Date: "*easter+1", Name: "Easter Monday"},
+			{Date: "2025-05-08", Name: "Liberation Day"},
+			{Date: "12-25", Name: "Christmas Day"},
+		},
+	}
+	if err := hc.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	for _, tm := range []time.Time{
+		time.Date(2025, time.January, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2025, time.April, 18, 23, 59, 59, 0, time.UTC),
+		time.Date(2025, time.April, 21, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.April, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2025, time.May, 8, 12, 0, 0, 0, time.UTC),
+		time.Date(2030, time.December, 25, 12, 0, 0, 0, time.UTC),
+	} {
+		if !hc.IsHoliday(tm) {
+			t.Errorf("expected %v to be a holiday", tm)
+		}
+	}
+	for _, tm := range []time.Time{
+		time.Date(2025, time.January, 2, 10, 0, 0, 0, time.UTC),
+		time.Date(2025, time.April, 20, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, time.May, 8, 12, 0, 0, 0, time.UTC),
+	} {
+		if hc.IsHoliday(tm) {
+			t.Errorf("expected %v not to be a holiday", tm)
+		}
+	}
+}
+
+func TestHolidayCalendarClone(t *testing.T) {
+	hc := &HolidayCalendar{
+		Tenant:   "cgrates.org",
+		ID:       "DE",
+		Holidays: []*Holiday{{Date: "12-25", Name: "Christmas Day"}},
+	}
+	if err := hc.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	cln := hc.Clone()
+	if !reflect.DeepEqual(hc, cln) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(hc), utils.ToJSON(cln))
+	}
+	cln.Holidays[0].Date = "12-26"
+	if hc.Holidays[0].Date != "12-25" {
+		t.Error("clone modified the original calendar")
+	}
+}
+
+func TestRITimingIsActiveAtHoliday(t *testing.T) {
+	tmpDm := dm
+	defer func() {
+		dm = tmpDm
+	}()
+	cfg := config.NewDefaultCGRConfig()
+	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	dm = NewDataManager(db, cfg.CacheCfg(), nil)
+	if err := dm.SetHolidayCalendar(&HolidayCalendar{
+		Tenant: "cgrates.org",
+		ID:     "HC_HOLIDAYS",
+		Holidays: []*Holiday{
+			{Date: "12-25", Name: "Christmas Day"},
+			{Date: "*easter+1", Name: "Easter Monday"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	rit := &RITiming{
+		ID:                 "TM_HOLIDAYS",
+		Years:              utils.Years{},
+		Months:             utils.Months{},
+		MonthDays:          utils.MonthDays{},
+		WeekDays:           utils.WeekDays{},
+		StartTime:          "08:00:00",
+		HolidayCalendarIDs: []string{"HC_HOLIDAYS"},
+	}
+	if !rit.IsActiveAt(time.Date(2025, time.December, 25, 10, 0, 0, 0, time.UTC)) {
+		t.Error("expected timing to be active on holiday")
+	}
+	if rit.IsActiveAt(time.Date(2025, time.December, 25, 7, 0, 0, 0, time.UTC)) {
+		t.Error("expected timing to be inactive before the start time")
+	}
+	if rit.IsActiveAt(time.Date(2025, time.December, 24, 10, 0, 0, 0, time.UTC)) {
+		t.Error("expected timing to be inactive outside holidays")
+	}
+	exp := time.Date(2025, time.April, 21, 8, 0, 0, 0, time.UTC)
+	if rcv := nextHolidayStartTime(rit, time.Date(2025, time.April, 1, 8, 0, 0, 0, time.UTC)); !rcv.Equal(exp) {
+		t.Errorf("expected %v, received %v", exp, rcv)
+	}
+	rit.HolidayCalendarIDs = []string{"HC_MISSING"}
+	if rit.IsActiveAt(time.Date(2025, time.December, 25, 10, 0, 0, 0, time.UTC)) {
+		t.Error("expected timing to be inactive with missing calendar")
+	}
+	if rcv := nextHolidayStartTime(rit, exp); !rcv.IsZero() {
+		t.Errorf("expected zero time, received %v", rcv)
+	}
+}
+
+func TestRITimingHolidayCalendarChanges(t *testing.T) {
+	tmpDm := dm
+	defer func() {
+		dm = tmpDm
+	}()
+	cfg := config.NewDefaultCGRConfig()
+	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	dm = NewDataManager(db, cfg.CacheCfg(), nil)
+	if err := dm.SetRatingPlan(&RatingPlan{
+		Id: "RP_HC_CHANGES",
+		Timings: map[string]*RITiming{
+			"TM_HC_CHANGES": {
+				ID:                 "TM_HC_CHANGES",
+				StartTime:          "00:00:00",
+				HolidayCalendarIDs: []string{"HC_CHANGES"},
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	rp, err := dm.GetRatingPlan("RP_HC_CHANGES", true, utils.NonTransactional)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rit := rp.Timings["TM_HC_CHANGES"]
+	christmas := time.Date(2025, time.December, 25, 10, 0, 0, 0, time.UTC)
+	christmasEve := time.Date(2025, time.December, 24, 10, 0, 0, 0, time.UTC)
+	// missing calendar
+	if rit.IsActiveAt(christmas) {
+		t.Error("expected timing to be inactive without calendar")
+	}
+	// the calendar changes apply to the cached rating plan once the calendar is reloaded in cache
+	for _, hc := range []*HolidayCalendar{
+		{Tenant: "cgrates.org", ID: "HC_CHANGES", Holidays: []*Holiday{{Date: "12-25", Name: "Christmas Day"}}},
+		{Tenant: "cgrates.org", ID: "HC_CHANGES", Holidays: []*Holiday{{Date: "12-24", Name: "Christmas Eve"}}},
+	} {
+		if err := dm.SetHolidayCalendar(hc); err != nil {
+			t.Fatal(err)
+		}
+		Cache.Remove(utils.CacheHolidayCalendars, hc.TenantID(), true, utils.NonTransactional)
+		if exp := hc.Holidays[0].Date == "12-25"; rit.IsActiveAt(christmas) != exp {
+			t.Errorf("expected timing active on %v: %v", christmas, exp)
+		}
+		if exp := hc.Holidays[0].Date == "12-24"; rit.IsActiveAt(christmasEve) != exp {
+			t.Errorf("expected timing active on %v: %v", christmasEve, exp)
+		}
+	}
+}
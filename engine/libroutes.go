@@ -20,6 +20,7 @@ package engine
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 
@@ -113,18 +114,10 @@ func (sRoutes *SortedRoutes) SortQOS(params []string) {
 			if param1 == param2 {
 				continue
 			}
-			switch param {
-			default:
-				if param1 > param2 {
-					return true
-				}
-				return false
-			case utils.MetaPDD: //in case of pdd the smallest value if the best
-				if param1 < param2 {
-					return true
-				}
-				return false
+			if qosLowerIsBetter(param) { //in case of pdd the smallest value if the best
+				return param1 < param2
 			}
+			return param1 > param2
 		}
 		//in case that we have the same value for all params we sort base on weight
 		if sRoutes.Routes[i].sortingDataF64[utils.Weight] == sRoutes.Routes[j].sortingDataF64[utils.Weight] {
@@ -134,6 +127,22 @@ func (sRoutes *SortedRoutes) SortQOS(params []string) {
 	})
 }
 
+// qosLowerIsBetter returns true for the metrics where the smallest value is the best:
+// *pdd, *stddev and the percentiles or median computed on the PDD
+func qosLowerIsBetter(metricID string) bool {
+	mType, fieldName, _ := strings.Cut(metricID, utils.HashtagSep)
+	switch mType {
+	case utils.MetaPDD, utils.MetaStdDev:
+		return true
+	case utils.MetaMedian:
+		return strings.HasSuffix(fieldName, utils.PDD)
+	}
+	if _, isPct := percentileFromMetricType(mType); isPct {
+		return strings.HasSuffix(fieldName, utils.PDD)
+	}
+	return false
+}
+
 // SortResourceAscendent is part of sort interface,
 // sort ascendent based on ResourceUsage with fallback on Weight
 func (sRoutes *SortedRoutes) SortResourceAscendent() {
@@ -179,6 +188,60 @@ func (sRoutes *SortedRoutes) SortLoadDistribution() {
 	})
 }
 
+// SortComposite is part of sort interface,
+// sort descendent based on the score computed out of the composite parameters with fallback on Weight
+func (sRoutes *SortedRoutes) SortComposite(cParams []*compositeParam) {
+	// the values are normalized between the routes so the factors are applied on the same scale
+	for _, cParam := range cParams {
+		minVal, maxVal := math.MaxFloat64, -math.MaxFloat64
+		for _, sRoute := range sRoutes.Routes {
+			if val, has := cParam.value(sRoute); has {
+				minVal = math.Min(minVal, val)
+				maxVal = math.Max(maxVal, val)
+			}
+		}
+		for _, sRoute := range sRoutes.Routes {
+			scores, has := sRoute.SortingData[utils.Scores].(map[string]any)
+			if !has {
+				scores = make(map[string]any)
+				sRoute.SortingData[utils.Scores] = scores
+			}
+			val, has := cParam.value(sRoute)
+			if !has { // missing values are scored as the worst ones
+				scores[cParam.name] = 0.0
+				continue
+			}
+			norm := 1.0
+			if maxVal != minVal {
+				if cParam.lowerIsBetter() {
+					norm = (maxVal - val) / (maxVal - minVal)
+				} else {
+					norm = (val - minVal) / (maxVal - minVal)
+				}
+			}
+			scores[cParam.name] = cParam.factor * norm
+		}
+	}
+	for _, sRoute := range sRoutes.Routes {
+		var score float64
+		scores, _ := sRoute.SortingData[utils.Scores].(map[string]any)
+		for _, paramScore := range scores {
+			score += paramScore.(float64)
+		}
+		sRoute.SortingData[utils.Score] = score
+		sRoute.sortingDataF64[utils.Score] = score
+	}
+	sort.Slice(sRoutes.Routes, func(i, j int) bool {
+		if sRoutes.Routes[i].sortingDataF64[utils.Score] == sRoutes.Routes[j].sortingDataF64[utils.Score] {
+			if sRoutes.Routes[i].sortingDataF64[utils.Weight] == sRoutes.Routes[j].sortingDataF64[utils.Weight] {
+				return utils.BoolGenerator().RandomBool()
+			}
+			return sRoutes.Routes[i].sortingDataF64[utils.Weight] > sRoutes.Routes[j].sortingDataF64[utils.Weight]
+		}
+		return sRoutes.Routes[i].sortingDataF64[utils.Score] > sRoutes.Routes[j].sortingDataF64[utils.Score]
+	})
+}
+
 // Digest returns list of routeIDs + parameters for easier outside access
 // format route1:route1params,route2:route2params
 func (sRoutes *SortedRoutes) Digest() string {
@@ -232,6 +295,7 @@ func NewRouteSortDispatcher(lcrS *RouteService) (rsd RouteSortDispatcher) {
 	rsd[utils.MetaReas] = NewResourceAscendetSorter(lcrS)
 	rsd[utils.MetaReds] = NewResourceDescendentSorter(lcrS)
 	rsd[utils.MetaLoad] = NewLoadDistributionSorter(lcrS)
+	rsd[utils.MetaComposite] = NewCompositeSorter(lcrS)
 	return
 }
 
@@ -1746,3 +1746,270 @@ func TestRouteIDs(t *testing.T) {
 
 	}
 }
+
+func TestLibRoutesSortQOSPercentile(t *testing.T) {
+	p95PDD := "*p95#~*req.PDD"
+	sSpls := &SortedRoutes{
+		Routes: []*SortedRoute{
+			{
+				RouteID: "route1",
+				sortingDataF64: map[string]float64{
+					utils.Weight: 10.0,
+					p95PDD:       3.5,
+				},
+				SortingData: map[string]any{
+					utils.Weight: 10.0,
+					p95PDD:       3.5,
+				},
+			},
+			{
+				RouteID: "route2",
+				sortingDataF64: map[string]float64{
+					utils.Weight: 20.0,
+					p95PDD:       1.2,
+				},
+				SortingData: map[string]any{
+					utils.Weight: 20.0,
+					p95PDD:       1.2,
+				},
+			},
+		},
+	}
+	sSpls.SortQOS([]string{p95PDD})
+	rcv := make([]string, len(sSpls.Routes))
+	eIds := []string{"route2", "route1"}
+	for i, spl := range sSpls.Routes {
+		rcv[i] = spl.RouteID
+	}
+	if !reflect.DeepEqual(eIds, rcv) {
+		t.Errorf("Expecting: %+v, \n received: %+v",
+			eIds, rcv)
+	}
+}
+
+func TestLibRoutesQOSLowerIsBetter(t *testing.T) {
+	for metricID, exp := range map[string]bool{
+		utils.MetaPDD:             true,
+		utils.MetaASR:             false,
+		"*stddev#~*req.Usage":     true,
+		"*median#~*req.PDD":       true,
+		"*median#~*req.Usage":     false,
+		"*p99#~*req.PDD":          true,
+		"*p99#~*req.Cost":         false,
+		"*histogram#~*req.PDD#1s": false,
+	} {
+		if rcv := qosLowerIsBetter(metricID); rcv != exp {
+			t.Errorf("%s: expected %v, received %v", metricID, exp, rcv)
+		}
+	}
+}
+
+func TestLibRoutesSortComposite(t *testing.T) {
+	sSpls := &SortedRoutes{
+		Routes: []*SortedRoute{
+			{
+				RouteID: "route1",
+				sortingDataF64: map[string]float64{
+					utils.Cost:    0.1,
+					utils.Weight:  10.0,
+					utils.MetaASR: 50.0,
+					utils.MetaPDD: 3.0,
+				},
+				SortingData: map[string]any{
+					utils.Cost:    0.1,
+					utils.Weight:  10.0,
+					utils.MetaASR: 50.0,
+					utils.MetaPDD: 3.0,
+				},
+			},
+			{
+				RouteID: "route2",
+				sortingDataF64: map[string]float64{
+					utils.Cost:    0.2,
+					utils.Weight:  20.0,
+					utils.MetaASR: 80.0,
+					utils.MetaPDD: 1.0,
+				},
+				SortingData: map[string]any{
+					utils.Cost:    0.2,
+					utils.Weight:  20.0,
+					utils.MetaASR: 80.0,
+					utils.MetaPDD: 1.0,
+				},
+			},
+			{
+				// no stats so the metrics are scored as the worst ones
+				RouteID: "route3",
+				sortingDataF64: map[string]float64{
+					utils.Cost:   0.2,
+					utils.Weight: 5.0,
+				},
+				SortingData: map[string]any{
+					utils.Cost:   0.2,
+					utils.Weight: 5.0,
+				},
+			},
+		},
+	}
+	cParams, err := parseCompositeParams([]string{"*cost:0.5", "*asr:0.25", "*pdd:0.125", "*weight:0.125"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sSpls.SortComposite(cParams)
+	rcv := make([]string, len(sSpls.Routes))
+	for i, spl := range sSpls.Routes {
+		rcv[i] = spl.RouteID
+	}
+	eIds := []string{"route1", "route2", "route3"}
+	if !reflect.DeepEqual(eIds, rcv) {
+		t.Errorf("Expecting: %+v, \n received: %+v", eIds, rcv)
+	}
+	eScores := map[string]any{
+		utils.MetaCost:   0.0,
+		utils.MetaASR:    0.25,
+		utils.MetaPDD:    0.125,
+		utils.MetaWeight: 0.125,
+	}
+	if !reflect.DeepEqual(eScores, sSpls.Routes[1].SortingData[utils.Scores]) {
+		t.Errorf("Expecting: %+v, \n received: %+v", eScores, sSpls.Routes[1].SortingData[utils.Scores])
+	}
+	if sSpls.Routes[1].SortingData[utils.Score] != 0.5 {
+		t.Errorf("Expecting: 0.5, received: %+v", sSpls.Routes[1].SortingData[utils.Score])
+	}
+	if sSpls.Routes[2].SortingData[utils.Score] != 0.0 {
+		t.Errorf("Expecting: 0, received: %+v", sSpls.Routes[2].SortingData[utils.Score])
+	}
+}
+
+func TestLibRoutesCompositeSameScore(t *testing.T) {
+	sSpls := &SortedRoutes{
+		Routes: []*SortedRoute{
+			{
+				RouteID: "route1",
+				sortingDataF64: map[string]float64{
+					utils.Weight:  10.0,
+					utils.MetaACD: 60.0,
+				},
+				SortingData: map[string]any{},
+			},
+			{
+				RouteID: "route2",
+				sortingDataF64: map[string]float64{
+					utils.Weight:  20.0,
+					utils.MetaACD: 60.0,
+				},
+				SortingData: map[string]any{},
+			},
+		},
+	}
+	sSpls.SortComposite([]*compositeParam{{name: utils.MetaACD, factor: 1}})
+	if sSpls.Routes[0].RouteID != "route2" {
+		t.Errorf("Expecting route2 first, received: %+v", utils.ToJSON(sSpls.Routes))
+	}
+}
+
+func TestLibRoutesCompositeNAMetric(t *testing.T) {
+	sSpls := &SortedRoutes{
+		Routes: []*SortedRoute{
+			{
+				RouteID: "route1",
+				sortingDataF64: map[string]float64{
+					utils.Weight:  10.0,
+					utils.MetaPDD: 3.0,
+				},
+				SortingData: map[string]any{},
+			},
+			{
+				RouteID: "route2",
+				sortingDataF64: map[string]float64{
+					utils.Weight:  10.0,
+					utils.MetaPDD: 1.0,
+				},
+				SortingData: map[string]any{},
+			},
+			{
+				// no PDD data yet, reported by StatS as N/A
+				RouteID: "route3",
+				sortingDataF64: map[string]float64{
+					utils.Weight:  5.0,
+					utils.MetaPDD: utils.StatsNA,
+				},
+				SortingData: map[string]any{},
+			},
+		},
+	}
+	sSpls.SortComposite([]*compositeParam{{name: utils.MetaPDD, factor: 1}})
+	rcv := make([]string, len(sSpls.Routes))
+	for i, spl := range sSpls.Routes {
+		rcv[i] = spl.RouteID
+	}
+	if eIds := []string{"route2", "route1", "route3"}; !reflect.DeepEqual(eIds, rcv) {
+		t.Errorf("Expecting: %+v, \n received: %+v", eIds, rcv)
+	}
+	if sSpls.Routes[1].SortingData[utils.Score] != 0.0 || sSpls.Routes[2].SortingData[utils.Score] != 0.0 {
+		t.Errorf("Expecting 0 scores, received: %+v", utils.ToJSON(sSpls.Routes))
+	}
+	cParams, err := parseCompositeParams([]string{"*pdd:1:*lte:3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cParam := failedCutOff(sSpls.Routes[2], cParams); cParam == nil || cParam.name != utils.MetaPDD {
+		t.Errorf("expecting route to fail on %s, received: %+v", utils.MetaPDD, cParam)
+	}
+}
+
+func TestLibRoutesParseCompositeParams(t *testing.T) {
+	exp := []*compositeParam{
+		{name: utils.MetaCost, factor: 0.5},
+		{name: utils.MetaASR, factor: 0.3, cutOffOp: utils.MetaGreaterOrEqual, cutOffVal: 30},
+		{name: utils.MetaPDD, factor: 0, cutOffOp: utils.MetaLessThan, cutOffVal: 5},
+	}
+	if rcv, err := parseCompositeParams([]string{"*cost:0.5", "*asr:0.3:*gte:30", "*pdd:0:*lt:5"}); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expecting: %+v, \n received: %+v", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	for _, param := range []string{"*asr", "*asr:a", "*asr:0.3:*gte", "*asr:0.3:*eq:30", "*asr:0.3:*gte:a"} {
+		if _, err := parseCompositeParams([]string{param}); err == nil {
+			t.Errorf("expecting error for parameter: <%s>", param)
+		}
+	}
+	rp := &RouteProfile{
+		Sorting:           utils.MetaComposite,
+		SortingParameters: []string{"*asr"},
+	}
+	expErr := "invalid *composite sorting parameter: <*asr>"
+	if err := rp.Compile(); err == nil || err.Error() != expErr {
+		t.Errorf("Expecting error: %s, received: %v", expErr, err)
+	}
+}
+
+func TestLibRoutesCompositeCutOff(t *testing.T) {
+	cParams, err := parseCompositeParams([]string{"*cost:1", "*asr:1:*gte:30", "*pdd:1:*lte:3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srtRoute := &SortedRoute{
+		RouteID: "route1",
+		sortingDataF64: map[string]float64{
+			utils.MetaASR: 30,
+			utils.MetaPDD: 2,
+		},
+	}
+	if cParam := failedCutOff(srtRoute, cParams); cParam != nil {
+		t.Errorf("expecting route to pass, failed on: %s", cParam.name)
+	}
+	srtRoute.sortingDataF64[utils.MetaASR] = 29.9
+	if cParam := failedCutOff(srtRoute, cParams); cParam == nil || cParam.name != utils.MetaASR {
+		t.Errorf("expecting route to fail on %s, received: %+v", utils.MetaASR, cParam)
+	}
+	delete(srtRoute.sortingDataF64, utils.MetaASR)
+	if cParam := failedCutOff(srtRoute, cParams); cParam == nil || cParam.name != utils.MetaASR {
+		t.Errorf("expecting route to fail on %s, received: %+v", utils.MetaASR, cParam)
+	}
+	srtRoute.sortingDataF64[utils.MetaASR] = 50
+	srtRoute.sortingDataF64[utils.MetaPDD] = 4
+	if cParam := failedCutOff(srtRoute, cParams); cParam == nil || cParam.name != utils.MetaPDD {
+		t.Errorf("expecting route to fail on %s, received: %+v", utils.MetaPDD, cParam)
+	}
+}
@@ -460,8 +460,17 @@ func (sq *StatQueue) UnmarshalJSON(data []byte) (err error) {
 			metric = new(StatAverage)
 		case utils.MetaDistinct:
 			metric = new(StatDistinct)
+		case utils.MetaMedian:
+			metric = new(StatMedian)
+		case utils.MetaStdDev:
+			metric = new(StatStdDev)
+		case utils.MetaHistogram:
+			metric = new(StatHistogram)
 		default:
-			return fmt.Errorf("unsupported metric type <%s>", metricSplit[0])
+			if _, isPct := percentileFromMetricType(metricSplit[0]); !isPct {
+				return fmt.Errorf("unsupported metric type <%s>", metricSplit[0])
+			}
+			metric = new(StatPercentile)
 		}
 		if err = json.Unmarshal([]byte(val), metric); err != nil {
 			return
@@ -290,6 +290,7 @@ func GetDefaultEmptyCacheStats() map[string]*ltcache.CacheStats {
 		utils.CacheThresholdProfiles:       {},
 		utils.CacheThresholds:              {},
 		utils.CacheTimings:                 {},
+		utils.CacheHolidayCalendars:        {},
 		utils.CacheDiameterMessages:        {},
 		utils.CacheClosedSessions:          {},
 		utils.CacheLoadIDs:                 {},
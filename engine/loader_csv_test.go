@@ -283,6 +283,13 @@ cgrates.org,D1,,,,*first,,C2,*lt:~*req.Usage:10,10,false,192.168.56.204,
 	DispatcherHostCSVContent = `
 #Tenant[0],ID[1],Address[2],Transport[3],ConnectAttempts[4],Reconnects[5],MaxReconnectInterval[6],ConnectTimeout[7],ReplyTimeout[8],Tls[9],ClientKey[10],ClientCertificate[11],CaCertificate[12]
 cgrates.org,ALL,127.0.0.1:6012,*json,1,3,5m,1m,2m,false,,,
+`
+	HolidayCalendarsCSVContent = `
+#Tenant[0],ID[1],Date[2],Name[3]
+cgrates.org,DE,01-01,New Year
+cgrates.org,DE,*easter-2,Good Friday
+cgrates.org,DE,*easter+1,Easter Monday
+cgrates.org,DE,12-25,Christmas Day
 `
 )
 
@@ -296,7 +303,7 @@ func init() {
 		ActionsCSVContent, ActionPlansCSVContent, ActionTriggersCSVContent, AccountActionsCSVContent,
 		ResourcesCSVContent, StatsCSVContent, TrendsCSVContent, RankingsCSVContent, ThresholdsCSVContent, FiltersCSVContent,
 		RoutesCSVContent, AttributesCSVContent, ChargersCSVContent, DispatcherCSVContent,
-		DispatcherHostCSVContent), testTPID, "", nil, nil, false)
+		DispatcherHostCSVContent, HolidayCalendarsCSVContent), testTPID, "", nil, nil, false)
 	if err != nil {
 		log.Print("error when creating TpReader:", err)
 	}
@@ -366,6 +373,9 @@ func init() {
 	if err := csvr.LoadDispatcherHosts(); err != nil {
 		log.Print("error in LoadDispatcherHosts:", err)
 	}
+	if err := csvr.LoadHolidayCalendars(); err != nil {
+		log.Print("error in LoadHolidayCalendars:", err)
+	}
 	if err := csvr.WriteToDatabase(false, false); err != nil {
 		log.Print("error when writing into database ", err)
 	}
@@ -1728,3 +1738,24 @@ func TestLoadDispatcherHosts(t *testing.T) {
 		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(eDispatcherHosts), utils.ToJSON(csvr.dispatcherHosts[dphKey]))
 	}
 }
+
+func TestLoadHolidayCalendars(t *testing.T) {
+	eHC := &utils.TPHolidayCalendar{
+		TPid:   testTPID,
+		Tenant: "cgrates.org",
+		ID:     "DE",
+		Holidays: []*utils.TPHoliday{
+			{Date: "01-01", Name: "New Year"},
+			{Date: "*easter-2", Name: "Good Friday"},
+			{Date: "*easter+1", Name: "Easter Monday"},
+			{Date: "12-25", Name: "Christmas Day"},
+		},
+	}
+	hcKey := utils.TenantID{Tenant: "cgrates.org", ID: "DE"}
+	if len(csvr.holidayCalendars) != 1 {
+		t.Fatalf("Failed to load HolidayCalendars: %v", len(csvr.holidayCalendars))
+	}
+	if !reflect.DeepEqual(eHC, csvr.holidayCalendars[hcKey]) {
+		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(eHC), utils.ToJSON(csvr.holidayCalendars[hcKey]))
+	}
+}
@@ -457,13 +457,14 @@ func (tps RatingPlanMdls) AsTPRatingPlans() (result []*utils.TPRatingPlan) {
 func GetRateInterval(rpl *utils.TPRatingPlanBinding, dr *utils.DestinationRate) (i *RateInterval) {
 	i = &RateInterval{
 		Timing: &RITiming{
-			ID:        rpl.Timing().ID,
-			Years:     rpl.Timing().Years,
-			Months:    rpl.Timing().Months,
-			MonthDays: rpl.Timing().MonthDays,
-			WeekDays:  rpl.Timing().WeekDays,
-			StartTime: rpl.Timing().StartTime,
-			tag:       rpl.Timing().ID,
+			ID:                 rpl.Timing().ID,
+			Years:              rpl.Timing().Years,
+			Months:             rpl.Timing().Months,
+			MonthDays:          rpl.Timing().MonthDays,
+			WeekDays:           rpl.Timing().WeekDays,
+			StartTime:          rpl.Timing().StartTime,
+			tag:                rpl.Timing().ID,
+			HolidayCalendarIDs: rpl.Timing().HolidayCalendarIDs,
 		},
 		Weight: rpl.Weight,
 		Rating: &RIRate{
@@ -1651,6 +1652,93 @@ func RankingProfileToAPI(rg *RankingProfile) (tpRG *utils.TPRankingProfile) {
 	return
 }
 
+type HolidayCalendarMdls []*HolidayCalendarMdl
+
+func (tps HolidayCalendarMdls) CSVHeader() (result []string) {
+	return []string{"#" + utils.Tenant, utils.ID, utils.HolidayDate, utils.HolidayName}
+}
+
+func (tps HolidayCalendarMdls) AsTPHolidayCalendars() (result []*utils.TPHolidayCalendar) {
+	mhc := make(map[string]*utils.TPHolidayCalendar)
+	for _, tp := range tps {
+		tntID := utils.ConcatenatedKey(tp.Tenant, tp.ID)
+		hc, found := mhc[tntID]
+		if !found {
+			hc = &utils.TPHolidayCalendar{
+				TPid:   tp.Tpid,
+				Tenant: tp.Tenant,
+				ID:     tp.ID,
+			}
+			mhc[tntID] = hc
+			result = append(result, hc)
+		}
+		if tp.Date == utils.EmptyString {
+			continue
+		}
+		hc.Holidays = append(hc.Holidays, &utils.TPHoliday{
+			Date: tp.Date,
+			Name: tp.Name,
+		})
+	}
+	return
+}
+
+func APItoModelTPHolidayCalendar(tpHC *utils.TPHolidayCalendar) (mdls HolidayCalendarMdls) {
+	if tpHC == nil {
+		return
+	}
+	if len(tpHC.Holidays) == 0 {
+		return HolidayCalendarMdls{{
+			Tpid:   tpHC.TPid,
+			Tenant: tpHC.Tenant,
+			ID:     tpHC.ID,
+		}}
+	}
+	for _, hol := range tpHC.Holidays {
+		mdls = append(mdls, &HolidayCalendarMdl{
+			Tpid:   tpHC.TPid,
+			Tenant: tpHC.Tenant,
+			ID:     tpHC.ID,
+			Date:   hol.Date,
+			Name:   hol.Name,
+		})
+	}
+	return
+}
+
+func APItoHolidayCalendar(tpHC *utils.TPHolidayCalendar) (hc *HolidayCalendar, err error) {
+	hc = &HolidayCalendar{
+		Tenant:   tpHC.Tenant,
+		ID:       tpHC.ID,
+		Holidays: make([]*Holiday, len(tpHC.Holidays)),
+	}
+	for i, hol := range tpHC.Holidays {
+		hc.Holidays[i] = &Holiday{
+			Date: hol.Date,
+			Name: hol.Name,
+		}
+	}
+	if err = hc.Compile(); err != nil {
+		return nil, err
+	}
+	return
+}
+
+func HolidayCalendarToAPI(hc *HolidayCalendar) (tpHC *utils.TPHolidayCalendar) {
+	tpHC = &utils.TPHolidayCalendar{
+		Tenant:   hc.Tenant,
+		ID:       hc.ID,
+		Holidays: make([]*utils.TPHoliday, len(hc.Holidays)),
+	}
+	for i, hol := range hc.Holidays {
+		tpHC.Holidays[i] = &utils.TPHoliday{
+			Date: hol.Date,
+			Name: hol.Name,
+		}
+	}
+	return
+}
+
 type TrendsMdls []*TrendsMdl
 
 func (tps TrendsMdls) CSVHeader() (result []string) {
@@ -302,6 +302,20 @@ func (RankingsMdl) TableName() string {
 	return utils.TBLTPRankings
 }
 
+type HolidayCalendarMdl struct {
+	PK        uint `gorm:"primary_key"`
+	Tpid      string
+	Tenant    string `index:"0" re:".*"`
+	ID        string `index:"1" re:".*"`
+	Date      string `index:"2" re:".*"`
+	Name      string `index:"3" re:".*"`
+	CreatedAt time.Time
+}
+
+func (HolidayCalendarMdl) TableName() string {
+	return utils.TBLTPHolidayCalendars
+}
+
 type TrendsMdl struct {
 	PK              uint `gorm:"primary_key"`
 	Tpid            string
@@ -26,6 +26,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/utils"
 )
 
@@ -40,15 +41,17 @@ type RateInterval struct {
 
 // Separate structure used for rating plan size optimization
 type RITiming struct {
-	ID         string
-	Years      utils.Years
-	Months     utils.Months
-	MonthDays  utils.MonthDays
-	WeekDays   utils.WeekDays
-	StartTime  string // ##:##:## format
-	EndTime    string // ##:##:## format
-	cronString string
-	tag        string // loading validation only
+	ID        string
+	Years     utils.Years
+	Months    utils.Months
+	MonthDays utils.MonthDays
+	WeekDays  utils.WeekDays
+	StartTime string // ##:##:## format
+	EndTime   string // ##:##:## format
+	// HolidayCalendarIDs restricts the days to the holidays of these calendars (<[tenant:]ID>)
+	HolidayCalendarIDs []string
+	cronString         string
+	tag                string // loading validation only
 }
 
 func (rit *RITiming) CronString() string {
@@ -179,6 +182,10 @@ func (rit *RITiming) IsActiveAt(t time.Time) bool {
 	if len(rit.WeekDays) > 0 && !rit.WeekDays.Contains(t.Weekday()) {
 		return false
 	}
+	// check for holidays
+	if len(rit.HolidayCalendarIDs) > 0 && !rit.isHoliday(t) {
+		return false
+	}
 	//log.Print("Time: ", t)
 
 	//log.Print("Left Margin: ", rit.getLeftMargin(t))
@@ -195,6 +202,42 @@ func (rit *RITiming) IsActiveAt(t time.Time) bool {
 	return true
 }
 
+// holidayCalendars returns the HolidayCalendars referenced by the timing
+// queried on each check out of cache so the calendar changes apply once the cache is reloaded
+// the tenant defaults to the general one if missing from the ID
+func (rit *RITiming) holidayCalendars() (hcs []*HolidayCalendar) {
+	hcs = make([]*HolidayCalendar, 0, len(rit.HolidayCalendarIDs))
+	for _, hcID := range rit.HolidayCalendarIDs {
+		tntID := utils.NewTenantID(hcID)
+		if tntID.Tenant == utils.EmptyString {
+			tntID.Tenant = config.CgrConfig().GeneralCfg().DefaultTenant
+		}
+		hc, err := dm.GetHolidayCalendar(tntID.Tenant, tntID.ID, true, true, utils.NonTransactional)
+		if err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> error: %s querying HolidayCalendar <%s> for timing <%s>",
+				utils.RALService, err.Error(), tntID.TenantID(), rit.ID))
+			continue
+		}
+		hcs = append(hcs, hc)
+	}
+	return
+}
+
+// isHoliday returns true if the day is a holiday in one of the HolidayCalendars
+func (rit *RITiming) isHoliday(t time.Time) bool {
+	return isHolidayIn(rit.holidayCalendars(), t)
+}
+
+// isHolidayIn returns true if the day is a holiday in one of the received calendars
+func isHolidayIn(hcs []*HolidayCalendar, t time.Time) bool {
+	for _, hc := range hcs {
+		if hc.IsHoliday(t) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsActive returns wheter the Timing is active now
 func (rit *RITiming) IsActive() bool {
 	return rit.IsActiveAt(time.Now())
@@ -209,7 +252,13 @@ func (rit *RITiming) IsBlank() bool {
 }
 
 func (rit *RITiming) Stringify() string {
-	return utils.Sha1(fmt.Sprintf("%v", rit))[:8]
+	// keep the format of the timings without holidays so the existing tags do not change
+	str := fmt.Sprintf("&{%v %v %v %v %v %v %v %v %v}", rit.ID, rit.Years, rit.Months,
+		rit.MonthDays, rit.WeekDays, rit.StartTime, rit.EndTime, rit.cronString, rit.tag)
+	if len(rit.HolidayCalendarIDs) != 0 {
+		str += fmt.Sprintf("%v", rit.HolidayCalendarIDs)
+	}
+	return utils.Sha1(str)[:8]
 }
 
 // Separate structure used for rating plan size optimization
@@ -490,6 +539,10 @@ func (rit *RITiming) Clone() (cln *RITiming) {
 		cln.WeekDays = make(utils.WeekDays, len(rit.WeekDays))
 		copy(cln.WeekDays, rit.WeekDays)
 	}
+	if len(rit.HolidayCalendarIDs) != 0 {
+		cln.HolidayCalendarIDs = make([]string, len(rit.HolidayCalendarIDs))
+		copy(cln.HolidayCalendarIDs, rit.HolidayCalendarIDs)
+	}
 	return
 }
 
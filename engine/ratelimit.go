@@ -0,0 +1,182 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// rateLimitCleanupInterval is how often the idle buckets are removed
+const rateLimitCleanupInterval = time.Minute
+
+// NewRateLimiter creates the RateLimiter out of the rate_limits configuration
+func NewRateLimiter(cfgs []*config.RateLimitCfg) (rl *RateLimiter) {
+	rl = &RateLimiter{
+		limits: make([]*rateLimit, len(cfgs)),
+	}
+	for i, cfg := range cfgs {
+		rl.limits[i] = &rateLimit{
+			cfg:     cfg,
+			tenants: utils.NewStringSet(cfg.Tenants),
+			apis:    utils.NewStringSet(cfg.APIs),
+			buckets: make(map[string]*tokenBucket),
+		}
+	}
+	return
+}
+
+// RateLimiter enforces the token bucket limits on the API requests
+type RateLimiter struct {
+	sync.Mutex
+	limits      []*rateLimit
+	lastCleanup time.Time
+}
+
+// rateLimit is one configured limit together with its counters
+type rateLimit struct {
+	cfg      *config.RateLimitCfg
+	tenants  utils.StringSet
+	apis     utils.StringSet
+	buckets  map[string]*tokenBucket // buckets based on the tenant, API and connection
+	allowed  uint64
+	rejected uint64
+}
+
+// matches returns true if the limit applies to the request
+func (l *rateLimit) matches(tenant, api string) bool {
+	return (l.tenants.Size() == 0 || l.tenants.Has(tenant)) &&
+		(l.apis.Size() == 0 || l.apis.Has(api))
+}
+
+// bucket returns the bucket used by the request creating it if missing
+func (l *rateLimit) bucket(tenant, api, connID string, now time.Time) (tb *tokenBucket) {
+	var key []string
+	if l.cfg.PerTenant {
+		key = append(key, tenant)
+	}
+	if l.cfg.PerAPI {
+		key = append(key, api)
+	}
+	if l.cfg.PerConnection {
+		key = append(key, connID)
+	}
+	bktID := utils.ConcatenatedKey(key...)
+	var has bool
+	if tb, has = l.buckets[bktID]; !has {
+		tb = &tokenBucket{
+			tokens:     float64(l.cfg.Burst),
+			lastRefill: now,
+		}
+		l.buckets[bktID] = tb
+	}
+	return
+}
+
+// tokenBucket holds the tokens available for one bucket
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// refill adds the tokens accumulated since the last refill
+func (tb *tokenBucket) refill(rate float64, burst int, now time.Time) {
+	tb.tokens += now.Sub(tb.lastRefill).Seconds() * rate
+	if tb.tokens > float64(burst) {
+		tb.tokens = float64(burst)
+	}
+	tb.lastRefill = now
+}
+
+// IsLimited returns true if there are limits configured
+func (rl *RateLimiter) IsLimited() bool {
+	return rl != nil && len(rl.limits) != 0
+}
+
+// Allow takes one token out of the buckets of all the limits matching the request
+// the request is rejected without taking any token if one of the buckets is empty
+func (rl *RateLimiter) Allow(tenant, api, connID string) (err error) {
+	now := time.Now()
+	rl.Lock()
+	defer rl.Unlock()
+	var matched []*rateLimit
+	var buckets []*tokenBucket
+	for _, l := range rl.limits {
+		if !l.matches(tenant, api) {
+			continue
+		}
+		tb := l.bucket(tenant, api, connID, now)
+		tb.refill(l.cfg.Rate, l.cfg.Burst, now)
+		if tb.tokens < 1 {
+			l.rejected++
+			err = utils.ErrRateLimitExceeded
+			continue
+		}
+		matched = append(matched, l)
+		buckets = append(buckets, tb)
+	}
+	if err == nil {
+		for i, tb := range buckets {
+			tb.tokens--
+			matched[i].allowed++
+		}
+	}
+	if now.Sub(rl.lastCleanup) >= rateLimitCleanupInterval {
+		rl.cleanup(now)
+	}
+	return
+}
+
+// cleanup removes the buckets idle long enough to be full again
+func (rl *RateLimiter) cleanup(now time.Time) {
+	rl.lastCleanup = now
+	for _, l := range rl.limits {
+		fillDur := time.Duration(float64(l.cfg.Burst) / l.cfg.Rate * float64(time.Second))
+		for bktID, tb := range l.buckets {
+			if now.Sub(tb.lastRefill) >= fillDur {
+				delete(l.buckets, bktID)
+			}
+		}
+	}
+}
+
+// RateLimitStats are the counters of one rate limit
+type RateLimitStats struct {
+	Allowed  uint64 `json:"allowed"`
+	Rejected uint64 `json:"rejected"`
+	Buckets  int    `json:"buckets"` // number of active buckets
+}
+
+// Stats returns the counters for each limit
+func (rl *RateLimiter) Stats() (stats map[string]*RateLimitStats) {
+	rl.Lock()
+	defer rl.Unlock()
+	stats = make(map[string]*RateLimitStats, len(rl.limits))
+	for _, l := range rl.limits {
+		stats[l.cfg.ID] = &RateLimitStats{
+			Allowed:  l.allowed,
+			Rejected: l.rejected,
+			Buckets:  len(l.buckets),
+		}
+	}
+	return
+}
@@ -0,0 +1,141 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestRateLimiterNotLimited(t *testing.T) {
+	var rl *RateLimiter
+	if rl.IsLimited() {
+		t.Error("Expected nil RateLimiter to not be limited")
+	}
+	if rl = NewRateLimiter(nil); rl.IsLimited() {
+		t.Error("Expected RateLimiter without limits to not be limited")
+	}
+	cs := NewCaps(0, utils.MetaBusy)
+	if cs.IsRateLimited() {
+		t.Error("Expected caps to not be rate limited")
+	}
+	if err := cs.AllowRate("cgrates.org", utils.CoreSv1Ping, utils.EmptyString); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRateLimiterPerTenant(t *testing.T) {
+	rl := NewRateLimiter([]*config.RateLimitCfg{{
+		ID:        "RL_TENANT",
+		PerTenant: true,
+		Rate:      0.001,
+		Burst:     2,
+	}})
+	for i := 0; i < 2; i++ {
+		if err := rl.Allow("cgrates.org", utils.SessionSv1AuthorizeEvent, utils.EmptyString); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rl.Allow("cgrates.org", utils.SessionSv1AuthorizeEvent, utils.EmptyString); err != utils.ErrRateLimitExceeded {
+		t.Errorf("Expected error: %v ,received: %v", utils.ErrRateLimitExceeded, err)
+	}
+	// the other tenants are not affected
+	if err := rl.Allow("itsyscom.com", utils.SessionSv1AuthorizeEvent, utils.EmptyString); err != nil {
+		t.Error(err)
+	}
+	exp := map[string]*RateLimitStats{
+		"RL_TENANT": {Allowed: 3, Rejected: 1, Buckets: 2},
+	}
+	if rcv := rl.Stats(); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s ,received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
+
+func TestRateLimiterFilters(t *testing.T) {
+	rl := NewRateLimiter([]*config.RateLimitCfg{
+		{
+			ID:      "RL_AUTH",
+			Tenants: []string{"cgrates.org"},
+			APIs:    []string{utils.SessionSv1AuthorizeEvent},
+			Rate:    0.001,
+			Burst:   1,
+		},
+		{
+			ID:    "RL_ALL",
+			Rate:  0.001,
+			Burst: 3,
+		},
+	})
+	if err := rl.Allow("cgrates.org", utils.SessionSv1AuthorizeEvent, utils.EmptyString); err != nil {
+		t.Fatal(err)
+	}
+	// rejected requests do not consume tokens from the other limits
+	if err := rl.Allow("cgrates.org", utils.SessionSv1AuthorizeEvent, utils.EmptyString); err != utils.ErrRateLimitExceeded {
+		t.Errorf("Expected error: %v ,received: %v", utils.ErrRateLimitExceeded, err)
+	}
+	if err := rl.Allow("cgrates.org", utils.CoreSv1Ping, utils.EmptyString); err != nil {
+		t.Error(err)
+	}
+	if err := rl.Allow("itsyscom.com", utils.SessionSv1AuthorizeEvent, utils.EmptyString); err != nil {
+		t.Error(err)
+	}
+	if err := rl.Allow("itsyscom.com", utils.CoreSv1Ping, utils.EmptyString); err != utils.ErrRateLimitExceeded {
+		t.Errorf("Expected error: %v ,received: %v", utils.ErrRateLimitExceeded, err)
+	}
+	exp := map[string]*RateLimitStats{
+		"RL_AUTH": {Allowed: 1, Rejected: 1, Buckets: 1},
+		"RL_ALL":  {Allowed: 3, Rejected: 1, Buckets: 1},
+	}
+	if rcv := rl.Stats(); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s ,received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
+
+func TestTokenBucketRefill(t *testing.T) {
+	now := time.Now()
+	tb := &tokenBucket{lastRefill: now}
+	tb.refill(10, 5, now.Add(200*time.Millisecond))
+	if tb.tokens != 2 {
+		t.Errorf("Expected: %v ,received: %v", 2, tb.tokens)
+	}
+	tb.refill(10, 5, now.Add(time.Second))
+	if tb.tokens != 5 {
+		t.Errorf("Expected: %v ,received: %v", 5, tb.tokens)
+	}
+}
+
+func TestRateLimiterCleanup(t *testing.T) {
+	rl := NewRateLimiter([]*config.RateLimitCfg{{
+		ID:            "RL_CONN",
+		PerConnection: true,
+		Rate:          10,
+		Burst:         1,
+	}})
+	if err := rl.Allow("cgrates.org", utils.CoreSv1Ping, "127.0.0.1:2012"); err != nil {
+		t.Fatal(err)
+	}
+	rl.cleanup(time.Now().Add(time.Second))
+	if rcv := rl.Stats()["RL_CONN"].Buckets; rcv != 0 {
+		t.Errorf("Expected: %v ,received: %v", 0, rcv)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func NewCompositeSorter(rS *RouteService) *CompositeSorter {
+	return &CompositeSorter{rS: rS,
+		sorting: utils.MetaComposite}
+}
+
+// CompositeSorter sorts routes based on a score computed out of cost, stats and weight
+type CompositeSorter struct {
+	sorting string
+	rS      *RouteService
+}
+
+func (cs *CompositeSorter) SortRoutes(prflID string, routes map[string]*Route,
+	ev *utils.CGREvent, extraOpts *optsGetRoutes) (sortedRoutes *SortedRoutes, err error) {
+	var cParams []*compositeParam
+	if cParams, err = parseCompositeParams(extraOpts.sortingParameters); err != nil {
+		return
+	}
+	var withCost bool
+	for _, cParam := range cParams {
+		if cParam.name == utils.MetaCost {
+			withCost = true
+			break
+		}
+	}
+	sortedRoutes = &SortedRoutes{ProfileID: prflID,
+		Sorting: cs.sorting,
+		Routes:  make([]*SortedRoute, 0)}
+	for _, route := range routes {
+		if withCost &&
+			len(route.RatingPlanIDs) == 0 && len(route.AccountIDs) == 0 {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> supplier: <%s> - empty RatingPlanIDs or AccountIDs",
+					utils.RouteS, route.ID))
+			return nil, utils.NewErrMandatoryIeMissing("RatingPlanIDs or AccountIDs")
+		}
+		srtRoute, pass, err := cs.rS.populateSortingData(ev, route, extraOpts)
+		if err != nil {
+			return nil, err
+		} else if !pass || srtRoute == nil {
+			continue
+		}
+		if cParam := failedCutOff(srtRoute, cParams); cParam != nil {
+			utils.Logger.Debug(
+				fmt.Sprintf("<%s> ignoring route with ID: %s, cut-off on: %s",
+					utils.RouteS, route.ID, cParam.name))
+			continue
+		}
+		sortedRoutes.Routes = append(sortedRoutes.Routes, srtRoute)
+	}
+	sortedRoutes.SortComposite(cParams)
+	return
+}
+
+// compositeParam is one of the criteria used by the *composite strategy
+// defined in SortingParameters as <*cost|*weight|MetricID>:<Factor>[:<*gt|*gte|*lt|*lte>:<CutOff>]
+type compositeParam struct {
+	name      string  // *cost, *weight or the ID of the stat metric
+	factor    float64 // importance of the criteria within the score
+	cutOffOp  string  // optional comparison discarding the routes
+	cutOffVal float64 // value used by the cut-off comparison
+}
+
+// parseCompositeParams parses the SortingParameters of the *composite strategy
+func parseCompositeParams(params []string) (cParams []*compositeParam, err error) {
+	cParams = make([]*compositeParam, len(params))
+	for i, param := range params {
+		paramSplt := strings.Split(param, utils.ConcatenatedKeySep)
+		if len(paramSplt) != 2 && len(paramSplt) != 4 {
+			return nil, fmt.Errorf("invalid %s sorting parameter: <%s>", utils.MetaComposite, param)
+		}
+		cParam := &compositeParam{name: paramSplt[0]}
+		if cParam.factor, err = strconv.ParseFloat(paramSplt[1], 64); err != nil {
+			return nil, fmt.Errorf("invalid %s sorting parameter: <%s>, err: %s", utils.MetaComposite, param, err)
+		}
+		if len(paramSplt) == 4 {
+			switch cParam.cutOffOp = paramSplt[2]; cParam.cutOffOp {
+			case utils.MetaGreaterThan, utils.MetaGreaterOrEqual,
+				utils.MetaLessThan, utils.MetaLessOrEqual:
+			default:
+				return nil, fmt.Errorf("invalid %s sorting parameter: <%s>, unsupported cut-off: <%s>",
+					utils.MetaComposite, param, cParam.cutOffOp)
+			}
+			if cParam.cutOffVal, err = strconv.ParseFloat(paramSplt[3], 64); err != nil {
+				return nil, fmt.Errorf("invalid %s sorting parameter: <%s>, err: %s", utils.MetaComposite, param, err)
+			}
+		}
+		cParams[i] = cParam
+	}
+	return
+}
+
+// sortingDataKey returns the key of the value within the SortingData
+func (cParam *compositeParam) sortingDataKey() string {
+	switch cParam.name {
+	case utils.MetaCost:
+		return utils.Cost
+	case utils.MetaWeight:
+		return utils.Weight
+	}
+	return cParam.name
+}
+
+// value returns the value of the criteria for the route
+// the stat metrics reported as N/A (negative) are considered missing
+func (cParam *compositeParam) value(srtRoute *SortedRoute) (val float64, has bool) {
+	if val, has = srtRoute.sortingDataF64[cParam.sortingDataKey()]; has && val < 0 &&
+		cParam.name != utils.MetaCost && cParam.name != utils.MetaWeight {
+		has = false
+	}
+	return
+}
+
+// lowerIsBetter returns true if the smallest value should get the highest score
+func (cParam *compositeParam) lowerIsBetter() bool {
+	return cParam.name == utils.MetaCost ||
+		qosLowerIsBetter(cParam.name)
+}
+
+// passCutOff returns true if the value is not discarded by the cut-off
+func (cParam *compositeParam) passCutOff(val float64) bool {
+	switch cParam.cutOffOp {
+	case utils.MetaGreaterThan:
+		return val > cParam.cutOffVal
+	case utils.MetaGreaterOrEqual:
+		return val >= cParam.cutOffVal
+	case utils.MetaLessThan:
+		return val < cParam.cutOffVal
+	case utils.MetaLessOrEqual:
+		return val <= cParam.cutOffVal
+	}
+	return true
+}
+
+// failedCutOff returns the first parameter discarding the route
+// routes missing the value of a parameter with cut-off, or having it N/A, are discarded
+func failedCutOff(srtRoute *SortedRoute, cParams []*compositeParam) *compositeParam {
+	for _, cParam := range cParams {
+		if cParam.cutOffOp == utils.EmptyString {
+			continue
+		}
+		val, has := cParam.value(srtRoute)
+		if !has || !cParam.passCutOff(val) {
+			return cParam
+		}
+	}
+	return nil
+}
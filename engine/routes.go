@@ -97,6 +97,11 @@ func (rp *RouteProfile) compileCacheParameters() error {
 
 // Compile is a wrapper for convenience setting up the RouteProfile
 func (rp *RouteProfile) Compile() error {
+	if rp.Sorting == utils.MetaComposite {
+		if _, err := parseCompositeParams(rp.SortingParameters); err != nil {
+			return err
+		}
+	}
 	return rp.compileCacheParameters()
 }
 
@@ -449,16 +454,18 @@ func (rpS *RouteService) populateSortingData(ev *utils.CGREvent, route *Route,
 			}
 			//check if the route have the metric from sortingParameters
 			//in case that the metric don't exist
-			//we use 10000000 for *pdd and -1 for others
-			for _, metric := range extraOpts.sortingParameters {
-				if _, hasMetric := metricSupp[metric]; !hasMetric {
-					switch metric {
-					default:
-						sortedSpl.SortingData[metric] = -1.0
-						sortedSpl.sortingDataF64[metric] = -1.0
-					case utils.MetaPDD:
-						sortedSpl.SortingData[metric] = math.MaxFloat64
-						sortedSpl.sortingDataF64[metric] = math.MaxFloat64
+			//we use math.MaxFloat64 for *pdd alike metrics and -1 for others
+			//the *composite strategy scores the missing and N/A metrics by itself
+			if extraOpts.sortingStrategy != utils.MetaComposite {
+				for _, metric := range extraOpts.sortingParameters {
+					if _, hasMetric := metricSupp[metric]; !hasMetric {
+						if qosLowerIsBetter(metric) {
+							sortedSpl.SortingData[metric] = math.MaxFloat64
+							sortedSpl.sortingDataF64[metric] = math.MaxFloat64
+						} else {
+							sortedSpl.SortingData[metric] = -1.0
+							sortedSpl.sortingDataF64[metric] = -1.0
+						}
 					}
 				}
 			}
@@ -1627,6 +1627,112 @@ func TestLoadDistributionSorterSortRoutes(t *testing.T) {
 	utils.Logger.SetLogLevel(0)
 }
 
+func TestCompositeSorterSortRoutes(t *testing.T) {
+	tmp := Cache
+	defer func() {
+		Cache = tmp
+	}()
+	Cache.Clear(nil)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.RouteSCfg().StatSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats)}
+	clientConn := make(chan birpc.ClientConnector, 1)
+	clientConn <- &ccMock{
+		calls: map[string]func(ctx *context.Context, args any, reply any) error{
+			utils.StatSv1GetQueueFloatMetrics: func(ctx *context.Context, args, reply any) error {
+				rpl := map[string]map[string]float64{
+					"STATS_ROUTE1": {utils.MetaASR: 50, utils.MetaACD: 60},
+					"STATS_ROUTE2": {utils.MetaASR: 80, utils.MetaACD: 30},
+					"STATS_ROUTE3": {utils.MetaASR: 20, utils.MetaACD: 100},
+				}
+				*reply.(*map[string]float64) = rpl[args.(*utils.TenantIDWithAPIOpts).ID]
+				return nil
+			},
+		},
+	}
+	connMgr := NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats): clientConn,
+	})
+	cs := NewCompositeSorter(NewRouteService(nil, nil, cfg, connMgr))
+	routes := map[string]*Route{
+		"route1": {
+			ID:      "route1",
+			StatIDs: []string{"STATS_ROUTE1"},
+			Weight:  20,
+		},
+		"route2": {
+			ID:      "route2",
+			StatIDs: []string{"STATS_ROUTE2"},
+			Weight:  10,
+		},
+		"route3": {
+			ID:      "route3",
+			StatIDs: []string{"STATS_ROUTE3"},
+			Weight:  30,
+		},
+	}
+	ev := &utils.CGREvent{
+		Tenant: "cgrates.org",
+		ID:     "CompositeEvent",
+		Event:  map[string]any{},
+	}
+	extraOpts := &optsGetRoutes{
+		sortingStrategy:   utils.MetaComposite,
+		sortingParameters: []string{"*asr:0.5:*gte:30", "*acd:0.5"},
+	}
+	expSr := &SortedRoutes{
+		ProfileID: "ROUTE_COMPOSITE",
+		Sorting:   utils.MetaComposite,
+		Routes: []*SortedRoute{
+			{
+				RouteID: "route1",
+				SortingData: map[string]any{
+					utils.Weight:  20.,
+					utils.MetaASR: 50.,
+					utils.MetaACD: 60.,
+					utils.Score:   0.5,
+					utils.Scores: map[string]any{
+						utils.MetaASR: 0.,
+						utils.MetaACD: 0.5,
+					},
+				},
+			},
+			{
+				RouteID: "route2",
+				SortingData: map[string]any{
+					utils.Weight:  10.,
+					utils.MetaASR: 80.,
+					utils.MetaACD: 30.,
+					utils.Score:   0.5,
+					utils.Scores: map[string]any{
+						utils.MetaASR: 0.5,
+						utils.MetaACD: 0.,
+					},
+				},
+			},
+		},
+	}
+	if rcv, err := cs.SortRoutes("ROUTE_COMPOSITE", routes, ev, extraOpts); err != nil {
+		t.Error(err)
+	} else {
+		for _, sRoute := range rcv.Routes {
+			sRoute.sortingDataF64 = nil
+		}
+		if !reflect.DeepEqual(expSr, rcv) {
+			t.Errorf("expected %v,received %v", utils.ToJSON(expSr), utils.ToJSON(rcv))
+		}
+	}
+
+	extraOpts.sortingParameters = []string{"*cost:1"}
+	if _, err := cs.SortRoutes("ROUTE_COMPOSITE", routes, ev, extraOpts); err == nil ||
+		err.Error() != "MANDATORY_IE_MISSING: [RatingPlanIDs or AccountIDs]" {
+		t.Errorf("expected MANDATORY_IE_MISSING error, received %v", err)
+	}
+	extraOpts.sortingParameters = []string{"*asr:a"}
+	if _, err := cs.SortRoutes("ROUTE_COMPOSITE", routes, ev, extraOpts); err == nil {
+		t.Error("expected error for invalid sorting parameter")
+	}
+}
+
 func TestRouteServicePopulateSortingData(t *testing.T) {
 	defer func() {
 		config.SetCgrConfig(config.NewDefaultCGRConfig())
@@ -2495,6 +2601,7 @@ func TestNewRouteSortDispatcher(t *testing.T) {
 		utils.MetaReas,
 		utils.MetaReds,
 		utils.MetaLoad,
+		utils.MetaComposite,
 	}
 	for _, strategy := range expectedStrategies {
 		if _, found := dispatcher[strategy]; !found {
@@ -2520,6 +2627,7 @@ func TestNewRouteSortDispatcherT(t *testing.T) {
 		utils.MetaReas,
 		utils.MetaReds,
 		utils.MetaLoad,
+		utils.MetaComposite,
 	}
 	for _, strategy := range expectedStrategies {
 		if _, found := dispatcher[strategy]; !found {
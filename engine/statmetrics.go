@@ -20,6 +20,9 @@ package engine
 
 import (
 	"fmt"
+	"maps"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -44,26 +47,36 @@ type StatWithCompress struct {
 // cfg serves as general purpose container to pass config options to metric
 func NewStatMetric(metricID string, minItems int, filterIDs []string) (sm StatMetric, err error) {
 	metrics := map[string]func(int, string, []string) (StatMetric, error){
-		utils.MetaASR:      NewASR,
-		utils.MetaACD:      NewACD,
-		utils.MetaTCD:      NewTCD,
-		utils.MetaACC:      NewACC,
-		utils.MetaTCC:      NewTCC,
-		utils.MetaPDD:      NewPDD,
-		utils.MetaDDC:      NewDDC,
-		utils.MetaSum:      NewStatSum,
-		utils.MetaAverage:  NewStatAverage,
-		utils.MetaDistinct: NewStatDistinct,
+		utils.MetaASR:       NewASR,
+		utils.MetaACD:       NewACD,
+		utils.MetaTCD:       NewTCD,
+		utils.MetaACC:       NewACC,
+		utils.MetaTCC:       NewTCC,
+		utils.MetaPDD:       NewPDD,
+		utils.MetaDDC:       NewDDC,
+		utils.MetaSum:       NewStatSum,
+		utils.MetaAverage:   NewStatAverage,
+		utils.MetaDistinct:  NewStatDistinct,
+		utils.MetaMedian:    NewStatMedian,
+		utils.MetaStdDev:    NewStatStdDev,
+		utils.MetaHistogram: NewStatHistogram,
 	}
 	// split the metricID
 	// in case of *sum we have *sum#~*req.FieldName
 	metricSplit := strings.Split(metricID, utils.HashtagSep)
-	if _, has := metrics[metricSplit[0]]; !has {
-		return nil, fmt.Errorf("unsupported metric type <%s>", metricSplit[0])
-	}
 	var extraParams string
 	if len(metricSplit[1:]) > 0 {
 		extraParams = metricSplit[1]
+		if metricSplit[0] == utils.MetaHistogram { // buckets are following the field name
+			extraParams = strings.Join(metricSplit[1:], utils.HashtagSep)
+		}
+	}
+	if _, has := metrics[metricSplit[0]]; !has {
+		// the percentile is part of the metric type so it cannot be found in the map
+		if pct, isPct := percentileFromMetricType(metricSplit[0]); isPct {
+			return NewStatPercentile(pct, minItems, extraParams, filterIDs)
+		}
+		return nil, fmt.Errorf("unsupported metric type <%s>", metricSplit[0])
 	}
 	return metrics[metricSplit[0]](minItems, extraParams, filterIDs)
 }
@@ -1634,3 +1647,445 @@ func (dst *StatDistinct) GetCompressFactor(events map[string]int) map[string]int
 	}
 	return events
 }
+
+// percentileFromMetricType extracts the percentile out of *pXX metric types
+func percentileFromMetricType(mType string) (pct float64, isPct bool) {
+	if !strings.HasPrefix(mType, utils.MetaPercentile) {
+		return
+	}
+	var err error
+	if pct, err = strconv.ParseFloat(strings.TrimPrefix(mType, utils.MetaPercentile), 64); err != nil ||
+		math.IsNaN(pct) || pct <= 0 || pct > 100 {
+		return 0, false
+	}
+	return pct, true
+}
+
+// StatDistribution keeps the values of the events so metrics can be computed
+// out of their distribution, it is the base of *pXX, *median, *stddev and *histogram
+type StatDistribution struct {
+	FilterIDs []string
+	Count     int64
+	Events    map[string][]*StatWithCompress // map[EventTenantID][]{Value, Occurrences}
+	MinItems  int
+	FieldName string
+
+	sorted []*StatWithCompress // cached values, merged and sorted ascending
+	val    *float64            // cached metric value
+}
+
+func newStatDistribution(minItems int, fieldName string, filterIDs []string) StatDistribution {
+	return StatDistribution{Events: make(map[string][]*StatWithCompress),
+		MinItems: minItems, FieldName: fieldName, FilterIDs: filterIDs}
+}
+
+func (dist *StatDistribution) isNA() bool {
+	return (dist.MinItems > 0 && dist.Count < int64(dist.MinItems)) || dist.Count == 0
+}
+
+// sortedValues returns the values of all events merged and sorted ascending
+func (dist *StatDistribution) sortedValues() []*StatWithCompress {
+	if dist.sorted == nil {
+		merged := make(map[float64]int)
+		for _, vals := range dist.Events {
+			for _, v := range vals {
+				merged[v.Stat] += v.CompressFactor
+			}
+		}
+		dist.sorted = make([]*StatWithCompress, 0, len(merged))
+		for v, cf := range merged {
+			dist.sorted = append(dist.sorted, &StatWithCompress{Stat: v, CompressFactor: cf})
+		}
+		sort.Slice(dist.sorted, func(i, j int) bool { return dist.sorted[i].Stat < dist.sorted[j].Stat })
+	}
+	return dist.sorted
+}
+
+// valueAtRank returns the value found on the 1-based rank inside the sorted values
+func (dist *StatDistribution) valueAtRank(rank int64) (val float64) {
+	var cumulated int64
+	for _, v := range dist.sortedValues() {
+		val = v.Stat
+		if cumulated += int64(v.CompressFactor); cumulated >= rank {
+			break
+		}
+	}
+	return
+}
+
+func (dist *StatDistribution) getFieldVal(ev utils.DataProvider) (val float64, err error) {
+	var ival any
+	if ival, err = utils.DPDynamicInterface(dist.FieldName, ev); err != nil {
+		if err == utils.ErrNotFound {
+			err = utils.ErrPrefix(err, dist.FieldName)
+		}
+		return
+	}
+	return utils.IfaceAsFloat64(ival)
+}
+
+func (dist *StatDistribution) addValue(evID string, val float64) {
+	dist.Count++
+	dist.sorted = nil
+	dist.val = nil
+	for _, v := range dist.Events[evID] {
+		if v.Stat == val {
+			v.CompressFactor++
+			return
+		}
+	}
+	dist.Events[evID] = append(dist.Events[evID], &StatWithCompress{Stat: val, CompressFactor: 1})
+}
+
+// AddEvent is part of StatMetric interface
+func (dist *StatDistribution) AddEvent(evID string, ev utils.DataProvider) (err error) {
+	var val float64
+	if val, err = dist.getFieldVal(ev); err != nil {
+		return
+	}
+	dist.addValue(evID, val)
+	return
+}
+
+// AddOneEvent aggregates the value without keeping a reference to the event,
+// rounding it so the number of stored values stays limited
+func (dist *StatDistribution) AddOneEvent(ev utils.DataProvider) (err error) {
+	var val float64
+	if val, err = dist.getFieldVal(ev); err != nil {
+		return
+	}
+	dist.addValue(utils.MetaOneEvent, utils.Round(val,
+		config.CgrConfig().GeneralCfg().RoundingDecimals, utils.MetaRoundingMiddle))
+	return
+}
+
+// RemEvent is part of StatMetric interface
+// for compressed events the most frequent value is removed to keep the shape of the distribution
+func (dist *StatDistribution) RemEvent(evID string) {
+	vals, has := dist.Events[evID]
+	if !has {
+		return
+	}
+	idx := 0
+	for i, v := range vals {
+		if v.CompressFactor > vals[idx].CompressFactor {
+			idx = i
+		}
+	}
+	dist.Count--
+	dist.sorted = nil
+	dist.val = nil
+	if vals[idx].CompressFactor > 1 {
+		vals[idx].CompressFactor--
+		return
+	}
+	if len(vals) == 1 {
+		delete(dist.Events, evID)
+		return
+	}
+	dist.Events[evID] = append(vals[:idx], vals[idx+1:]...)
+}
+
+// GetFilterIDs is part of StatMetric interface
+func (dist *StatDistribution) GetFilterIDs() []string {
+	return dist.FilterIDs
+}
+
+// GetMinItems returns the minim items for the metric
+func (dist *StatDistribution) GetMinItems() (minIts int) { return dist.MinItems }
+
+// Compress is part of StatMetric interface
+// the values are rounded and merged under the defaultID so the distribution is kept
+func (dist *StatDistribution) Compress(queueLen int64, defaultID string, roundingDecimal int) (eventIDs []string) {
+	if dist.Count < queueLen {
+		for id := range dist.Events {
+			eventIDs = append(eventIDs, id)
+		}
+		return
+	}
+	merged := make(map[float64]int)
+	for _, vals := range dist.Events {
+		for _, v := range vals {
+			merged[utils.Round(v.Stat, roundingDecimal, utils.MetaRoundingMiddle)] += v.CompressFactor
+		}
+	}
+	compressed := make([]*StatWithCompress, 0, len(merged))
+	for v, cf := range merged {
+		compressed = append(compressed, &StatWithCompress{Stat: v, CompressFactor: cf})
+	}
+	sort.Slice(compressed, func(i, j int) bool { return compressed[i].Stat < compressed[j].Stat })
+	dist.Events = map[string][]*StatWithCompress{defaultID: compressed}
+	dist.sorted = nil
+	return []string{defaultID}
+}
+
+// GetCompressFactor is part of StatMetric interface
+func (dist *StatDistribution) GetCompressFactor(events map[string]int) map[string]int {
+	for id, vals := range dist.Events {
+		compressFactor := 0
+		for _, v := range vals {
+			compressFactor += v.CompressFactor
+		}
+		if _, has := events[id]; !has {
+			events[id] = compressFactor
+		}
+		if events[id] < compressFactor {
+			events[id] = compressFactor
+		}
+	}
+	return events
+}
+
+// NewStatPercentile instantiates the *pXX metric
+func NewStatPercentile(percentile float64, minItems int, extraParams string, filterIDs []string) (StatMetric, error) {
+	return &StatPercentile{StatDistribution: newStatDistribution(minItems, extraParams, filterIDs),
+		Percentile: percentile}, nil
+}
+
+// StatPercentile returns the value under which the given percentage of the values fall
+type StatPercentile struct {
+	StatDistribution
+	Percentile float64
+}
+
+func (pct *StatPercentile) getValue(roundingDecimal int) float64 {
+	if pct.val == nil {
+		if pct.isNA() {
+			pct.val = utils.Float64Pointer(utils.StatsNA)
+		} else { // nearest-rank method
+			rank := int64(math.Ceil(pct.Percentile / 100 * float64(pct.Count)))
+			pct.val = utils.Float64Pointer(utils.Round(pct.valueAtRank(max(rank, 1)),
+				roundingDecimal, utils.MetaRoundingMiddle))
+		}
+	}
+	return *pct.val
+}
+
+func (pct *StatPercentile) GetStringValue(roundingDecimal int) (valStr string) {
+	if val := pct.getValue(roundingDecimal); val == utils.StatsNA {
+		valStr = utils.NotAvailable
+	} else {
+		valStr = strconv.FormatFloat(val, 'f', -1, 64)
+	}
+	return
+}
+
+func (pct *StatPercentile) GetValue(roundingDecimal int) (v any) {
+	return pct.getValue(roundingDecimal)
+}
+
+func (pct *StatPercentile) GetFloat64Value(roundingDecimal int) (v float64) {
+	return pct.getValue(roundingDecimal)
+}
+
+func (pct *StatPercentile) Marshal(ms Marshaler) (marshaled []byte, err error) {
+	return ms.Marshal(pct)
+}
+
+func (pct *StatPercentile) LoadMarshaled(ms Marshaler, marshaled []byte) (err error) {
+	return ms.Unmarshal(marshaled, &pct)
+}
+
+// NewStatMedian instantiates the *median metric
+func NewStatMedian(minItems int, extraParams string, filterIDs []string) (StatMetric, error) {
+	return &StatMedian{StatDistribution: newStatDistribution(minItems, extraParams, filterIDs)}, nil
+}
+
+// StatMedian returns the middle value of the distribution
+type StatMedian struct {
+	StatDistribution
+}
+
+func (med *StatMedian) getValue(roundingDecimal int) float64 {
+	if med.val == nil {
+		if med.isNA() {
+			med.val = utils.Float64Pointer(utils.StatsNA)
+		} else {
+			val := med.valueAtRank((med.Count + 1) / 2)
+			if med.Count%2 == 0 {
+				val = (val + med.valueAtRank(med.Count/2+1)) / 2
+			}
+			med.val = utils.Float64Pointer(utils.Round(val,
+				roundingDecimal, utils.MetaRoundingMiddle))
+		}
+	}
+	return *med.val
+}
+
+func (med *StatMedian) GetStringValue(roundingDecimal int) (valStr string) {
+	if val := med.getValue(roundingDecimal); val == utils.StatsNA {
+		valStr = utils.NotAvailable
+	} else {
+		valStr = strconv.FormatFloat(val, 'f', -1, 64)
+	}
+	return
+}
+
+func (med *StatMedian) GetValue(roundingDecimal int) (v any) {
+	return med.getValue(roundingDecimal)
+}
+
+func (med *StatMedian) GetFloat64Value(roundingDecimal int) (v float64) {
+	return med.getValue(roundingDecimal)
+}
+
+func (med *StatMedian) Marshal(ms Marshaler) (marshaled []byte, err error) {
+	return ms.Marshal(med)
+}
+
+func (med *StatMedian) LoadMarshaled(ms Marshaler, marshaled []byte) (err error) {
+	return ms.Unmarshal(marshaled, &med)
+}
+
+// NewStatStdDev instantiates the *stddev metric
+func NewStatStdDev(minItems int, extraParams string, filterIDs []string) (StatMetric, error) {
+	return &StatStdDev{StatDistribution: newStatDistribution(minItems, extraParams, filterIDs)}, nil
+}
+
+// StatStdDev returns the population standard deviation of the values
+type StatStdDev struct {
+	StatDistribution
+}
+
+func (sd *StatStdDev) getValue(roundingDecimal int) float64 {
+	if sd.val == nil {
+		if sd.isNA() {
+			sd.val = utils.Float64Pointer(utils.StatsNA)
+		} else {
+			var sum, sqSum float64
+			vals := sd.sortedValues()
+			for _, v := range vals {
+				sum += v.Stat * float64(v.CompressFactor)
+			}
+			mean := sum / float64(sd.Count)
+			for _, v := range vals {
+				sqSum += (v.Stat - mean) * (v.Stat - mean) * float64(v.CompressFactor)
+			}
+			sd.val = utils.Float64Pointer(utils.Round(math.Sqrt(sqSum/float64(sd.Count)),
+				roundingDecimal, utils.MetaRoundingMiddle))
+		}
+	}
+	return *sd.val
+}
+
+func (sd *StatStdDev) GetStringValue(roundingDecimal int) (valStr string) {
+	if val := sd.getValue(roundingDecimal); val == utils.StatsNA {
+		valStr = utils.NotAvailable
+	} else {
+		valStr = strconv.FormatFloat(val, 'f', -1, 64)
+	}
+	return
+}
+
+func (sd *StatStdDev) GetValue(roundingDecimal int) (v any) {
+	return sd.getValue(roundingDecimal)
+}
+
+func (sd *StatStdDev) GetFloat64Value(roundingDecimal int) (v float64) {
+	return sd.getValue(roundingDecimal)
+}
+
+func (sd *StatStdDev) Marshal(ms Marshaler) (marshaled []byte, err error) {
+	return ms.Marshal(sd)
+}
+
+func (sd *StatStdDev) LoadMarshaled(ms Marshaler, marshaled []byte) (err error) {
+	return ms.Unmarshal(marshaled, &sd)
+}
+
+// NewStatHistogram instantiates the *histogram metric
+// extraParams are in the format: FieldName#Bucket1&Bucket2&BucketN
+func NewStatHistogram(minItems int, extraParams string, filterIDs []string) (StatMetric, error) {
+	fieldName, buckets, has := strings.Cut(extraParams, utils.HashtagSep)
+	if !has || buckets == utils.EmptyString {
+		return nil, fmt.Errorf("missing buckets for <%s> metric", utils.MetaHistogram)
+	}
+	hst := &StatHistogram{StatDistribution: newStatDistribution(minItems, fieldName, filterIDs)}
+	for _, bucket := range strings.Split(buckets, utils.ANDSep) {
+		bound, err := strconv.ParseFloat(bucket, 64)
+		if err != nil { // bucket bounds can be also durations, compared as nanoseconds
+			var dur time.Duration
+			if dur, err = utils.ParseDurationWithNanosecs(bucket); err != nil {
+				return nil, fmt.Errorf("invalid bucket <%s> for <%s> metric", bucket, utils.MetaHistogram)
+			}
+			bound = float64(dur)
+		}
+		if len(hst.Bounds) != 0 && bound <= hst.Bounds[len(hst.Bounds)-1] {
+			return nil, fmt.Errorf("buckets for <%s> metric should be ascending", utils.MetaHistogram)
+		}
+		hst.Buckets = append(hst.Buckets, bucket)
+		hst.Bounds = append(hst.Bounds, bound)
+	}
+	return hst, nil
+}
+
+// StatHistogram counts the values falling in each bucket, a value belonging
+// to the first bucket with the upper bound bigger or equal to it
+type StatHistogram struct {
+	StatDistribution
+	Buckets []string  // bucket labels, as defined in the metric
+	Bounds  []float64 // upper bounds of the buckets
+
+	counts map[string]int64 // cached bucket counts
+}
+
+// getCounts returns the number of values in each bucket, the values over
+// the last bucket being counted on +Inf
+func (hst *StatHistogram) getCounts() map[string]int64 {
+	if hst.sorted == nil || hst.counts == nil {
+		hst.counts = make(map[string]int64, len(hst.Buckets)+1)
+		for _, bucket := range hst.Buckets {
+			hst.counts[bucket] = 0
+		}
+		hst.counts[utils.PositiveInf] = 0
+		for _, v := range hst.sortedValues() {
+			bucket := utils.PositiveInf
+			for i, bound := range hst.Bounds {
+				if v.Stat <= bound {
+					bucket = hst.Buckets[i]
+					break
+				}
+			}
+			hst.counts[bucket] += int64(v.CompressFactor)
+		}
+	}
+	return hst.counts
+}
+
+// GetStringValue returns the bucket counts in the order of the buckets
+func (hst *StatHistogram) GetStringValue(roundingDecimal int) (valStr string) {
+	if hst.isNA() {
+		return utils.NotAvailable
+	}
+	counts := hst.getCounts()
+	vals := make([]string, 0, len(counts))
+	for _, bucket := range hst.Buckets {
+		vals = append(vals, bucket+utils.InInFieldSep+strconv.FormatInt(counts[bucket], 10))
+	}
+	vals = append(vals, utils.PositiveInf+utils.InInFieldSep+strconv.FormatInt(counts[utils.PositiveInf], 10))
+	return strings.Join(vals, utils.FieldsSep)
+}
+
+// GetValue returns the count of values for each bucket
+func (hst *StatHistogram) GetValue(roundingDecimal int) (v any) {
+	if hst.isNA() {
+		return utils.StatsNA
+	}
+	return maps.Clone(hst.getCounts()) // the counts are cached, do not expose them
+}
+
+// GetFloat64Value returns the number of values in the histogram
+func (hst *StatHistogram) GetFloat64Value(roundingDecimal int) (v float64) {
+	if hst.isNA() {
+		return utils.StatsNA
+	}
+	return float64(hst.Count)
+}
+
+func (hst *StatHistogram) Marshal(ms Marshaler) (marshaled []byte, err error) {
+	return ms.Marshal(hst)
+}
+
+func (hst *StatHistogram) LoadMarshaled(ms Marshaler, marshaled []byte) (err error) {
+	return ms.Unmarshal(marshaled, &hst)
+}
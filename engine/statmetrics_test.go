@@ -18,6 +18,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package engine
 
 import (
+	"encoding/json"
+	"fmt"
 	"net"
 	"reflect"
 	"sort"
@@ -3291,6 +3293,10 @@ func TestStatMetricsNewStatMetricError(t *testing.T) {
 	if err == nil || err.Error() != "unsupported metric type <>" {
 		t.Errorf("\nExpecting <unsupported metric type>,\nRecevied  <%+v>", err)
 	}
+	_, err = NewStatMetric("*pNaN#~*req.PDD", 0, []string{})
+	if err == nil || err.Error() != "unsupported metric type <*pNaN>" {
+		t.Errorf("\nExpecting <unsupported metric type <*pNaN>>,\nRecevied  <%+v>", err)
+	}
 
 }
 
@@ -4321,3 +4327,173 @@ func TestStatMetricsStatASRAddEventErr3(t *testing.T) {
 		t.Errorf("\nExpecting <%+v>,\n Recevied <%+v>", utils.ErrAccountNotFound, err)
 	}
 }
+
+func TestStatPercentileGetValue(t *testing.T) {
+	sm, err := NewStatMetric("*p90#~*req.PDD", 2, []string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strVal := sm.GetStringValue(config.CgrConfig().GeneralCfg().RoundingDecimals); strVal != utils.NotAvailable {
+		t.Errorf("wrong percentile value: %s", strVal)
+	}
+	for i := 1; i <= 10; i++ {
+		if err = sm.AddEvent(fmt.Sprintf("EVENT_%d", i),
+			utils.MapStorage{utils.MetaReq: map[string]any{utils.PDD: time.Duration(i) * time.Second}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if val := sm.GetFloat64Value(config.CgrConfig().GeneralCfg().RoundingDecimals); val != float64(9*time.Second) {
+		t.Errorf("wrong percentile value: %v", val)
+	}
+	sm.RemEvent("EVENT_9")
+	sm.RemEvent("EVENT_10")
+	if val := sm.GetFloat64Value(config.CgrConfig().GeneralCfg().RoundingDecimals); val != float64(8*time.Second) {
+		t.Errorf("wrong percentile value: %v", val)
+	}
+	if err = sm.AddEvent("EVENT_11", utils.MapStorage{utils.MetaReq: map[string]any{}}); err == nil ||
+		err.Error() != "NOT_FOUND:~*req.PDD" {
+		t.Errorf("expected NOT_FOUND:~*req.PDD, received: %v", err)
+	}
+}
+
+func TestStatMedianGetValue(t *testing.T) {
+	med, _ := NewStatMedian(2, "~*req.Cost", []string{})
+	for i, cost := range []float64{7, 1, 3} {
+		med.AddEvent(fmt.Sprintf("EVENT_%d", i), utils.MapStorage{utils.MetaReq: map[string]any{"Cost": cost}})
+	}
+	if strVal := med.GetStringValue(config.CgrConfig().GeneralCfg().RoundingDecimals); strVal != "3" {
+		t.Errorf("wrong median value: %s", strVal)
+	}
+	med.AddEvent("EVENT_3", utils.MapStorage{utils.MetaReq: map[string]any{"Cost": 4}})
+	if strVal := med.GetStringValue(config.CgrConfig().GeneralCfg().RoundingDecimals); strVal != "3.5" {
+		t.Errorf("wrong median value: %s", strVal)
+	}
+}
+
+func TestStatStdDevGetValue(t *testing.T) {
+	sd, _ := NewStatStdDev(0, "~*req.Cost", []string{})
+	for i, cost := range []float64{2, 4, 4, 4, 5, 5, 7, 9} {
+		sd.AddEvent(fmt.Sprintf("EVENT_%d", i), utils.MapStorage{utils.MetaReq: map[string]any{"Cost": cost}})
+	}
+	if val := sd.GetFloat64Value(config.CgrConfig().GeneralCfg().RoundingDecimals); val != 2 {
+		t.Errorf("wrong stddev value: %v", val)
+	}
+}
+
+func TestStatHistogramGetValue(t *testing.T) {
+	if _, err := NewStatMetric("*histogram#~*req.PDD", 0, nil); err == nil ||
+		err.Error() != "missing buckets for <*histogram> metric" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := NewStatMetric("*histogram#~*req.PDD#2s&1s", 0, nil); err == nil ||
+		err.Error() != "buckets for <*histogram> metric should be ascending" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	hst, err := NewStatMetric("*histogram#~*req.PDD#1s&3s", 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, pdd := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 5 * time.Second} {
+		hst.AddEvent(fmt.Sprintf("EVENT_%d", i), utils.MapStorage{utils.MetaReq: map[string]any{utils.PDD: pdd}})
+	}
+	if strVal := hst.GetStringValue(config.CgrConfig().GeneralCfg().RoundingDecimals); strVal != "1s:1,3s:2,+Inf:1" {
+		t.Errorf("wrong histogram value: %s", strVal)
+	}
+	exp := map[string]int64{"1s": 1, "3s": 2, utils.PositiveInf: 1}
+	if val := hst.GetValue(config.CgrConfig().GeneralCfg().RoundingDecimals); !reflect.DeepEqual(exp, val) {
+		t.Errorf("expected %v, received %v", exp, val)
+	} else {
+		val.(map[string]int64)["1s"] = 10 // should not alter the cached counts
+	}
+	if val := hst.GetValue(config.CgrConfig().GeneralCfg().RoundingDecimals); !reflect.DeepEqual(exp, val) {
+		t.Errorf("expected %v, received %v", exp, val)
+	}
+	if val := hst.GetFloat64Value(config.CgrConfig().GeneralCfg().RoundingDecimals); val != 4 {
+		t.Errorf("wrong histogram value: %v", val)
+	}
+	hst.RemEvent("EVENT_3")
+	if strVal := hst.GetStringValue(config.CgrConfig().GeneralCfg().RoundingDecimals); strVal != "1s:1,3s:2,+Inf:0" {
+		t.Errorf("wrong histogram value: %s", strVal)
+	}
+}
+
+func TestStatDistributionCompress(t *testing.T) {
+	sm, _ := NewStatMetric("*p50#~*req.Cost", 0, []string{})
+	pct := sm.(*StatPercentile)
+	for i, cost := range []float64{1.001, 1.002, 5, 3} {
+		pct.AddEvent(fmt.Sprintf("EVENT_%d", i), utils.MapStorage{utils.MetaReq: map[string]any{"Cost": cost}})
+	}
+	expIDs := []string{"EVENT_0", "EVENT_1", "EVENT_2", "EVENT_3"}
+	rply := pct.Compress(10, "EVENT_3", 2)
+	sort.Strings(rply)
+	if !reflect.DeepEqual(expIDs, rply) {
+		t.Errorf("Expected: %s , received: %s", utils.ToJSON(expIDs), utils.ToJSON(rply))
+	}
+	expIDs = []string{"EVENT_3"}
+	if rply = pct.Compress(4, "EVENT_3", 2); !reflect.DeepEqual(expIDs, rply) {
+		t.Errorf("Expected: %s , received: %s", utils.ToJSON(expIDs), utils.ToJSON(rply))
+	}
+	expEvs := map[string][]*StatWithCompress{
+		"EVENT_3": {{Stat: 1, CompressFactor: 2}, {Stat: 3, CompressFactor: 1}, {Stat: 5, CompressFactor: 1}},
+	}
+	if !reflect.DeepEqual(expEvs, pct.Events) {
+		t.Errorf("Expected: %s , received: %s", utils.ToJSON(expEvs), utils.ToJSON(pct.Events))
+	}
+	expCF := map[string]int{"EVENT_3": 4}
+	if cf := pct.GetCompressFactor(make(map[string]int)); !reflect.DeepEqual(expCF, cf) {
+		t.Errorf("Expected: %s , received: %s", utils.ToJSON(expCF), utils.ToJSON(cf))
+	}
+	if val := pct.GetFloat64Value(2); val != 1 {
+		t.Errorf("wrong percentile value: %v", val)
+	}
+	// the most frequent value is removed out of the compressed event
+	pct.RemEvent("EVENT_3")
+	pct.RemEvent("EVENT_3")
+	if val := pct.GetFloat64Value(2); val != 3 {
+		t.Errorf("wrong percentile value: %v", val)
+	}
+}
+
+func TestStatDistributionMarshal(t *testing.T) {
+	ms := NewCodecMsgpackMarshaler()
+	for _, metricID := range []string{"*p99#~*req.Usage", "*median#~*req.Usage",
+		"*stddev#~*req.Usage", "*histogram#~*req.Usage#10s&1m"} {
+		sm, err := NewStatMetric(metricID, 1, []string{"*string:~*req.Account:1001"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sm.AddEvent("EVENT_1", utils.MapStorage{utils.MetaReq: map[string]any{utils.Usage: 30 * time.Second}})
+		sm.AddEvent("EVENT_2", utils.MapStorage{utils.MetaReq: map[string]any{utils.Usage: 90 * time.Second}})
+		marshaled, err := sm.Marshal(ms)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nsm, _ := NewStatMetric(metricID, 0, []string{})
+		if err = nsm.LoadMarshaled(ms, marshaled); err != nil {
+			t.Fatal(err)
+		}
+		if exp, rcv := sm.GetStringValue(2), nsm.GetStringValue(2); exp != rcv {
+			t.Errorf("%s: expected %s, received %s", metricID, exp, rcv)
+		}
+	}
+}
+
+func TestStatQueueUnmarshalJSONDistribution(t *testing.T) {
+	sq, err := NewStatQueue("cgrates.org", "SQ_1", []*MetricWithFilters{
+		{MetricID: "*p95#~*req.PDD"}, {MetricID: "*histogram#~*req.PDD#1s&2s"}}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sm := range sq.SQMetrics {
+		sm.AddEvent("EVENT_1", utils.MapStorage{utils.MetaReq: map[string]any{utils.PDD: 1500 * time.Millisecond}})
+	}
+	var rcv StatQueue
+	if err = json.Unmarshal([]byte(utils.ToJSON(sq)), &rcv); err != nil {
+		t.Fatal(err)
+	}
+	for metricID, sm := range sq.SQMetrics {
+		if exp, val := sm.GetStringValue(2), rcv.SQMetrics[metricID].GetStringValue(2); exp != val {
+			t.Errorf("%s: expected %s, received %s", metricID, exp, val)
+		}
+	}
+}
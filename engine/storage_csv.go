@@ -67,6 +67,7 @@ type CSVStorage struct {
 	chargerProfilesFn        []string
 	dispatcherProfilesFn     []string
 	dispatcherHostsFn        []string
+	holidayCalendarsFn       []string
 }
 
 // NewCSVStorage creates a CSV storage that takes the data from the paths specified
@@ -75,7 +76,8 @@ func NewCSVStorage(sep rune,
 	destinationratetimingsFn, ratingprofilesFn, sharedgroupsFn,
 	actionsFn, actiontimingsFn, actiontriggersFn, accountactionsFn,
 	resProfilesFn, statsFn, trendsFn, rankingsFn, thresholdsFn, filterFn, routeProfilesFn,
-	attributeProfilesFn, chargerProfilesFn, dispatcherProfilesFn, dispatcherHostsFn []string) *CSVStorage {
+	attributeProfilesFn, chargerProfilesFn, dispatcherProfilesFn, dispatcherHostsFn,
+	holidayCalendarsFn []string) *CSVStorage {
 	return &CSVStorage{
 		sep:                      sep,
 		generator:                NewCsvFile,
@@ -101,6 +103,7 @@ func NewCSVStorage(sep rune,
 		chargerProfilesFn:        chargerProfilesFn,
 		dispatcherProfilesFn:     dispatcherProfilesFn,
 		dispatcherHostsFn:        dispatcherHostsFn,
+		holidayCalendarsFn:       holidayCalendarsFn,
 	}
 }
 
@@ -132,6 +135,7 @@ func NewFileCSVStorage(sep rune, dataPath string) (*CSVStorage, error) {
 	chargersPaths := appendName(allFoldersPath, utils.ChargersCsv)
 	dispatcherprofilesPaths := appendName(allFoldersPath, utils.DispatcherProfilesCsv)
 	dispatcherhostsPaths := appendName(allFoldersPath, utils.DispatcherHostsCsv)
+	holidayCalendarsPaths := appendName(allFoldersPath, utils.HolidayCalendarsCsv)
 	return NewCSVStorage(sep,
 		destinationsPaths,
 		timingsPaths,
@@ -155,6 +159,7 @@ func NewFileCSVStorage(sep rune, dataPath string) (*CSVStorage, error) {
 		chargersPaths,
 		dispatcherprofilesPaths,
 		dispatcherhostsPaths,
+		holidayCalendarsPaths,
 	), nil
 }
 
@@ -164,14 +169,16 @@ func NewStringCSVStorage(sep rune,
 	destinationratetimingsFn, ratingprofilesFn, sharedgroupsFn,
 	actionsFn, actiontimingsFn, actiontriggersFn, accountactionsFn,
 	resProfilesFn, statsFn, trendsFn, rankingsFn, thresholdsFn, filterFn, routeProfilesFn,
-	attributeProfilesFn, chargerProfilesFn, dispatcherProfilesFn, dispatcherHostsFn string) *CSVStorage {
+	attributeProfilesFn, chargerProfilesFn, dispatcherProfilesFn, dispatcherHostsFn,
+	holidayCalendarsFn string) *CSVStorage {
 	c := NewCSVStorage(sep, []string{destinationsFn}, []string{timingsFn},
 		[]string{ratesFn}, []string{destinationratesFn}, []string{destinationratetimingsFn},
 		[]string{ratingprofilesFn}, []string{sharedgroupsFn}, []string{actionsFn},
 		[]string{actiontimingsFn}, []string{actiontriggersFn}, []string{accountactionsFn},
 		[]string{resProfilesFn}, []string{statsFn}, []string{trendsFn}, []string{rankingsFn}, []string{thresholdsFn}, []string{filterFn},
 		[]string{routeProfilesFn}, []string{attributeProfilesFn}, []string{chargerProfilesFn},
-		[]string{dispatcherProfilesFn}, []string{dispatcherHostsFn})
+		[]string{dispatcherProfilesFn}, []string{dispatcherHostsFn},
+		[]string{holidayCalendarsFn})
 	c.generator = NewCsvString
 	return c
 }
@@ -215,6 +222,7 @@ func NewGoogleCSVStorage(sep rune, spreadsheetID string) (*CSVStorage, error) {
 		getIfExist(utils.Chargers),
 		getIfExist(utils.DispatcherProfiles),
 		getIfExist(utils.DispatcherHosts),
+		getIfExist(utils.HolidayCalendars),
 	)
 	c.generator = func() csvReaderCloser {
 		return &csvGoogle{
@@ -249,6 +257,7 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 	var chargersPaths []string
 	var dispatcherprofilesPaths []string
 	var dispatcherhostsPaths []string
+	var holidayCalendarsPaths []string
 
 	for _, baseURL := range strings.Split(dataPath, utils.InfieldSep) {
 		if !strings.HasSuffix(baseURL, utils.CSVSuffix) {
@@ -274,6 +283,7 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 			chargersPaths = append(chargersPaths, joinURL(baseURL, utils.ChargersCsv))
 			dispatcherprofilesPaths = append(dispatcherprofilesPaths, joinURL(baseURL, utils.DispatcherProfilesCsv))
 			dispatcherhostsPaths = append(dispatcherhostsPaths, joinURL(baseURL, utils.DispatcherHostsCsv))
+			holidayCalendarsPaths = append(holidayCalendarsPaths, joinURL(baseURL, utils.HolidayCalendarsCsv))
 			continue
 		}
 		switch {
@@ -321,6 +331,8 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 			dispatcherprofilesPaths = append(dispatcherprofilesPaths, baseURL)
 		case strings.HasSuffix(baseURL, utils.DispatcherHostsCsv):
 			dispatcherhostsPaths = append(dispatcherhostsPaths, baseURL)
+		case strings.HasSuffix(baseURL, utils.HolidayCalendarsCsv):
+			holidayCalendarsPaths = append(holidayCalendarsPaths, baseURL)
 		}
 	}
 
@@ -347,6 +359,7 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 		chargersPaths,
 		dispatcherprofilesPaths,
 		dispatcherhostsPaths,
+		holidayCalendarsPaths,
 	)
 	c.generator = func() csvReaderCloser {
 		return &csvURL{}
@@ -689,6 +702,18 @@ func (csvs *CSVStorage) GetTPDispatcherHosts(tpid, tenant, id string) ([]*utils.
 	return result, nil
 }
 
+func (csvs *CSVStorage) GetTPHolidayCalendars(tpid, tenant, id string) ([]*utils.TPHolidayCalendar, error) {
+	var tpHCs HolidayCalendarMdls
+	if err := csvs.proccesData(HolidayCalendarMdl{}, csvs.holidayCalendarsFn, func(tp any) {
+		hc := tp.(HolidayCalendarMdl)
+		hc.Tpid = tpid
+		tpHCs = append(tpHCs, &hc)
+	}); err != nil {
+		return nil, err
+	}
+	return tpHCs.AsTPHolidayCalendars(), nil
+}
+
 func (csvs *CSVStorage) GetTpIds(colName string) ([]string, error) {
 	return nil, utils.ErrNotImplemented
 }
@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 	"github.com/ugorji/go/codec"
@@ -77,6 +78,10 @@ type DataDB interface {
 	RemAccountActionPlansDrv(acntID string) (err error)
 	PushTask(*Task) error
 	PopTask() (*Task, error)
+	GetLeaseDrv(id string) (*Lease, error)
+	AcquireLeaseDrv(lse *Lease) (acquired bool, err error)
+	GetActionTimingLastRunDrv(id string) (time.Time, error)
+	SetActionTimingLastRunDrv(id string, lastRun time.Time) error
 	GetAccountDrv(string) (*Account, error)
 	SetAccountDrv(*Account) error
 	RemoveAccountDrv(string) error
@@ -89,6 +94,9 @@ type DataDB interface {
 	GetTimingDrv(string) (*utils.TPTiming, error)
 	SetTimingDrv(*utils.TPTiming) error
 	RemoveTimingDrv(string) error
+	GetHolidayCalendarDrv(string, string) (*HolidayCalendar, error)
+	SetHolidayCalendarDrv(*HolidayCalendar) error
+	RemoveHolidayCalendarDrv(string, string) error
 	GetLoadHistory(int, bool, string) ([]*utils.LoadInstance, error)
 	AddLoadHistory(*utils.LoadInstance, int, string) error
 	GetIndexesDrv(idxItmType, tntCtx, idxKey string) (indexes map[string]utils.StringSet, err error)
@@ -194,6 +202,7 @@ type LoadReader interface {
 	GetTPChargers(string, string, string) ([]*utils.TPChargerProfile, error)
 	GetTPDispatcherProfiles(string, string, string) ([]*utils.TPDispatcherProfile, error)
 	GetTPDispatcherHosts(string, string, string) ([]*utils.TPDispatcherHost, error)
+	GetTPHolidayCalendars(string, string, string) ([]*utils.TPHolidayCalendar, error)
 }
 
 type LoadWriter interface {
@@ -220,6 +229,7 @@ type LoadWriter interface {
 	SetTPChargers([]*utils.TPChargerProfile) error
 	SetTPDispatcherProfiles([]*utils.TPDispatcherProfile) error
 	SetTPDispatcherHosts([]*utils.TPDispatcherHost) error
+	SetTPHolidayCalendars([]*utils.TPHolidayCalendar) error
 }
 
 // NewMarshaler returns the marshaler type selected by mrshlerStr
@@ -34,19 +34,22 @@ import (
 // InternalDB is used as a DataDB and a StorDB
 type InternalDB struct {
 	tasks               []*Task
+	leases              map[string]*Lease
+	atLastRuns          map[string]time.Time // last execution of the scheduled action timings
 	mu                  sync.RWMutex
 	stringIndexedFields []string
 	prefixIndexedFields []string
 	indexedFieldsMutex  sync.RWMutex   // used for reload
 	cnter               *utils.Counter // used for OrderID for cdr
 	ms                  Marshaler
-	db                  *ltcache.TransCache
+	db                  *internalCache
 	isDataDB            bool
 }
 
 // NewInternalDB constructs an InternalDB
 func NewInternalDB(stringIndexedFields, prefixIndexedFields []string, isDataDB bool,
 	itmsCfg map[string]*config.ItemOpt) *InternalDB {
+	iCh := new(internalCache)
 	tcCfg := make(map[string]*ltcache.CacheConfig, len(itmsCfg))
 	for k, cPcfg := range itmsCfg {
 		tcCfg[k] = &ltcache.CacheConfig{
@@ -54,14 +57,18 @@ func NewInternalDB(stringIndexedFields, prefixIndexedFields []string, isDataDB b
 			TTL:       cPcfg.TTL,
 			StaticTTL: cPcfg.StaticTTL,
 		}
+		if cPcfg.TTL > 0 || cPcfg.Limit > 0 { // the items can be evicted
+			tcCfg[k].OnEvicted = func(itmID string, _ any) { iCh.evicted(k, itmID) }
+		}
 	}
+	iCh.TransCache = ltcache.NewTransCache(tcCfg)
 	ms, _ := NewMarshaler(config.CgrConfig().GeneralCfg().DBDataEncoding)
 	return &InternalDB{
 		stringIndexedFields: stringIndexedFields,
 		prefixIndexedFields: prefixIndexedFields,
 		cnter:               utils.NewCounter(time.Now().UnixNano(), 0),
 		ms:                  ms,
-		db:                  ltcache.NewTransCache(tcCfg),
+		db:                  iCh,
 		isDataDB:            isDataDB,
 	}
 }
@@ -80,8 +87,12 @@ func (iDB *InternalDB) SetPrefixIndexedFields(prefixIndexedFields []string) {
 	iDB.indexedFieldsMutex.Unlock()
 }
 
-// Close only to implement Storage interface
-func (iDB *InternalDB) Close() {}
+// Close stops writing in the dump if this is enabled
+func (iDB *InternalDB) Close() {
+	if iDB.db.dump != nil {
+		iDB.db.dump.close()
+	}
+}
 
 // Flush clears the cache
 func (iDB *InternalDB) Flush(string) error {
@@ -146,17 +157,15 @@ func (iDB *InternalDB) SetVersions(vrs Versions, overwrite bool) (err error) {
 	}
 	x, ok := iDB.db.Get(utils.CacheVersions, utils.VersionName)
 	if !ok || x == nil {
-		iDB.db.Set(utils.CacheVersions, utils.VersionName, vrs, nil,
+		return iDB.db.Set(utils.CacheVersions, utils.VersionName, vrs, nil,
 			true, utils.NonTransactional)
-		return
 	}
 	provVrs := x.(Versions)
 	for key, val := range vrs {
 		provVrs[key] = val
 	}
-	iDB.db.Set(utils.CacheVersions, utils.VersionName, provVrs, nil,
+	return iDB.db.Set(utils.CacheVersions, utils.VersionName, provVrs, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveVersions(vrs Versions) (err error) {
@@ -170,9 +179,8 @@ func (iDB *InternalDB) RemoveVersions(vrs Versions) (err error) {
 		for key := range vrs {
 			delete(internalVersions, key)
 		}
-		iDB.db.Set(utils.CacheVersions, utils.VersionName, internalVersions, nil,
+		return iDB.db.Set(utils.CacheVersions, utils.VersionName, internalVersions, nil,
 			true, utils.NonTransactional)
-		return
 	}
 	iDB.db.Remove(utils.CacheVersions, utils.VersionName,
 		true, utils.NonTransactional)
@@ -222,9 +230,8 @@ func (iDB *InternalDB) GetRatingPlanDrv(id string) (rp *RatingPlan, err error) {
 }
 
 func (iDB *InternalDB) SetRatingPlanDrv(rp *RatingPlan) (err error) {
-	iDB.db.Set(utils.CacheRatingPlans, rp.Id, rp, nil,
+	return iDB.db.Set(utils.CacheRatingPlans, rp.Id, rp, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveRatingPlanDrv(id string) (err error) {
@@ -242,9 +249,8 @@ func (iDB *InternalDB) GetRatingProfileDrv(id string) (rp *RatingProfile, err er
 }
 
 func (iDB *InternalDB) SetRatingProfileDrv(rp *RatingProfile) (err error) {
-	iDB.db.Set(utils.CacheRatingProfiles, rp.Id, rp, nil,
+	return iDB.db.Set(utils.CacheRatingProfiles, rp.Id, rp, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveRatingProfileDrv(id string) (err error) {
@@ -261,9 +267,8 @@ func (iDB *InternalDB) GetDestinationDrv(key, _ string) (dest *Destination, err
 }
 
 func (iDB *InternalDB) SetDestinationDrv(dest *Destination, transactionID string) (err error) {
-	iDB.db.Set(utils.CacheDestinations, dest.Id, dest, nil,
+	return iDB.db.Set(utils.CacheDestinations, dest.Id, dest, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveDestinationDrv(destID string, transactionID string) (err error) {
@@ -284,8 +289,10 @@ func (iDB *InternalDB) RemoveReverseDestinationDrv(dstID, prfx, transactionID st
 	mpRevDst := utils.NewStringSet(revDst)
 	mpRevDst.Remove(dstID)
 	if mpRevDst.Size() != 0 {
-		iDB.db.Set(utils.CacheReverseDestinations, prfx, mpRevDst.AsSlice(), nil,
-			cacheCommit(transactionID), transactionID)
+		if err = iDB.db.Set(utils.CacheReverseDestinations, prfx, mpRevDst.AsSlice(), nil,
+			cacheCommit(transactionID), transactionID); err != nil {
+			return
+		}
 	} else {
 		iDB.db.Remove(utils.CacheReverseDestinations, prfx,
 			cacheCommit(transactionID), transactionID)
@@ -304,8 +311,10 @@ func (iDB *InternalDB) SetReverseDestinationDrv(destID string, prefixes []string
 		mpRevDst := utils.NewStringSet(revDst)
 		mpRevDst.Add(destID)
 		// for ReverseDestination we will use Groups
-		iDB.db.Set(utils.CacheReverseDestinations, p, mpRevDst.AsSlice(), nil,
-			true, utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheReverseDestinations, p, mpRevDst.AsSlice(), nil,
+			true, utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -327,9 +336,8 @@ func (iDB *InternalDB) GetActionsDrv(id string) (acts Actions, err error) {
 }
 
 func (iDB *InternalDB) SetActionsDrv(id string, acts Actions) (err error) {
-	iDB.db.Set(utils.CacheActions, id, acts, nil,
+	return iDB.db.Set(utils.CacheActions, id, acts, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveActionsDrv(id string) (err error) {
@@ -346,9 +354,8 @@ func (iDB *InternalDB) GetSharedGroupDrv(id string) (sh *SharedGroup, err error)
 }
 
 func (iDB *InternalDB) SetSharedGroupDrv(sh *SharedGroup) (err error) {
-	iDB.db.Set(utils.CacheSharedGroups, sh.Id, sh, nil,
+	return iDB.db.Set(utils.CacheSharedGroups, sh.Id, sh, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveSharedGroupDrv(id string) (err error) {
@@ -365,9 +372,8 @@ func (iDB *InternalDB) GetActionTriggersDrv(id string) (at ActionTriggers, err e
 }
 
 func (iDB *InternalDB) SetActionTriggersDrv(id string, at ActionTriggers) (err error) {
-	iDB.db.Set(utils.CacheActionTriggers, id, at, nil,
+	return iDB.db.Set(utils.CacheActionTriggers, id, at, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveActionTriggersDrv(id string) (err error) {
@@ -384,9 +390,8 @@ func (iDB *InternalDB) GetActionPlanDrv(key string) (ats *ActionPlan, err error)
 }
 
 func (iDB *InternalDB) SetActionPlanDrv(key string, ats *ActionPlan) (err error) {
-	iDB.db.Set(utils.CacheActionPlans, key, ats, nil,
+	return iDB.db.Set(utils.CacheActionPlans, key, ats, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveActionPlanDrv(key string) (err error) {
@@ -420,9 +425,8 @@ func (iDB *InternalDB) GetAccountActionPlansDrv(acntID string) (apIDs []string,
 }
 
 func (iDB *InternalDB) SetAccountActionPlansDrv(acntID string, apIDs []string) (err error) {
-	iDB.db.Set(utils.CacheAccountActionPlans, acntID, apIDs, nil,
+	return iDB.db.Set(utils.CacheAccountActionPlans, acntID, apIDs, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemAccountActionPlansDrv(acntID string) (err error) {
@@ -451,6 +455,53 @@ func (iDB *InternalDB) PopTask() (t *Task, err error) {
 	return
 }
 
+func (iDB *InternalDB) GetLeaseDrv(id string) (lse *Lease, err error) {
+	iDB.mu.RLock()
+	defer iDB.mu.RUnlock()
+	if x, has := iDB.leases[id]; has {
+		lse = new(Lease)
+		*lse = *x
+		return
+	}
+	return nil, utils.ErrNotFound
+}
+
+// AcquireLeaseDrv stores the lease unless another owner holds an active one
+func (iDB *InternalDB) AcquireLeaseDrv(lse *Lease) (acquired bool, err error) {
+	iDB.mu.Lock()
+	defer iDB.mu.Unlock()
+	if x, has := iDB.leases[lse.ID]; has &&
+		x.OwnerID != lse.OwnerID && x.IsActive(lse.RenewTime) {
+		return
+	}
+	if iDB.leases == nil {
+		iDB.leases = make(map[string]*Lease)
+	}
+	iDB.leases[lse.ID] = new(Lease)
+	*iDB.leases[lse.ID] = *lse
+	return true, nil
+}
+
+func (iDB *InternalDB) GetActionTimingLastRunDrv(id string) (lastRun time.Time, err error) {
+	iDB.mu.RLock()
+	defer iDB.mu.RUnlock()
+	var has bool
+	if lastRun, has = iDB.atLastRuns[id]; !has {
+		err = utils.ErrNotFound
+	}
+	return
+}
+
+func (iDB *InternalDB) SetActionTimingLastRunDrv(id string, lastRun time.Time) (err error) {
+	iDB.mu.Lock()
+	defer iDB.mu.Unlock()
+	if iDB.atLastRuns == nil {
+		iDB.atLastRuns = make(map[string]time.Time)
+	}
+	iDB.atLastRuns[id] = lastRun
+	return
+}
+
 func (iDB *InternalDB) GetAccountDrv(id string) (acc *Account, err error) {
 	if x, ok := iDB.db.Get(utils.CacheAccounts, id); ok && x != nil {
 		return x.(*Account).Clone(), nil
@@ -472,9 +523,8 @@ func (iDB *InternalDB) SetAccountDrv(acc *Account) (err error) {
 		}
 	}
 	acc.UpdateTime = time.Now()
-	iDB.db.Set(utils.CacheAccounts, acc.ID, acc, nil,
+	return iDB.db.Set(utils.CacheAccounts, acc.ID, acc, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveAccountDrv(id string) (err error) {
@@ -491,9 +541,8 @@ func (iDB *InternalDB) GetResourceProfileDrv(tenant, id string) (rp *ResourcePro
 }
 
 func (iDB *InternalDB) SetResourceProfileDrv(rp *ResourceProfile) (err error) {
-	iDB.db.Set(utils.CacheResourceProfiles, rp.TenantID(), rp, nil,
+	return iDB.db.Set(utils.CacheResourceProfiles, rp.TenantID(), rp, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveResourceProfileDrv(tenant, id string) (err error) {
@@ -510,9 +559,8 @@ func (iDB *InternalDB) GetResourceDrv(tenant, id string) (r *Resource, err error
 }
 
 func (iDB *InternalDB) SetResourceDrv(r *Resource) (err error) {
-	iDB.db.Set(utils.CacheResources, r.TenantID(), r, nil,
+	return iDB.db.Set(utils.CacheResources, r.TenantID(), r, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveResourceDrv(tenant, id string) (err error) {
@@ -530,9 +578,8 @@ func (iDB *InternalDB) GetTimingDrv(id string) (tmg *utils.TPTiming, err error)
 }
 
 func (iDB *InternalDB) SetTimingDrv(timing *utils.TPTiming) (err error) {
-	iDB.db.Set(utils.CacheTimings, timing.ID, timing, nil,
+	return iDB.db.Set(utils.CacheTimings, timing.ID, timing, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveTimingDrv(id string) (err error) {
@@ -558,9 +605,8 @@ func (iDB *InternalDB) GetStatQueueProfileDrv(tenant string, id string) (sq *Sta
 
 }
 func (iDB *InternalDB) SetStatQueueProfileDrv(sq *StatQueueProfile) (err error) {
-	iDB.db.Set(utils.CacheStatQueueProfiles, sq.TenantID(), sq, nil,
+	return iDB.db.Set(utils.CacheStatQueueProfiles, sq.TenantID(), sq, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemStatQueueProfileDrv(tenant, id string) (err error) {
@@ -583,9 +629,8 @@ func (iDB *InternalDB) SetStatQueueDrv(ssq *StoredStatQueue, sq *StatQueue) (err
 			return
 		}
 	}
-	iDB.db.Set(utils.CacheStatQueues, utils.ConcatenatedKey(sq.Tenant, sq.ID), sq, nil,
+	return iDB.db.Set(utils.CacheStatQueues, utils.ConcatenatedKey(sq.Tenant, sq.ID), sq, nil,
 		true, utils.NonTransactional)
-	return
 }
 func (iDB *InternalDB) RemStatQueueDrv(tenant, id string) (err error) {
 	iDB.db.Remove(utils.CacheStatQueues, utils.ConcatenatedKey(tenant, id),
@@ -593,8 +638,7 @@ func (iDB *InternalDB) RemStatQueueDrv(tenant, id string) (err error) {
 	return
 }
 func (iDB *InternalDB) SetTrendProfileDrv(srp *TrendProfile) (err error) {
-	iDB.db.Set(utils.CacheTrendProfiles, srp.TenantID(), srp, nil, true, utils.NonTransactional)
-	return nil
+	return iDB.db.Set(utils.CacheTrendProfiles, srp.TenantID(), srp, nil, true, utils.NonTransactional)
 }
 
 func (iDB *InternalDB) RemTrendProfileDrv(tenant, id string) (err error) {
@@ -619,9 +663,8 @@ func (iDB *InternalDB) GetTrendDrv(tenant, id string) (th *Trend, err error) {
 }
 
 func (iDB *InternalDB) SetTrendDrv(tr *Trend) (err error) {
-	iDB.db.Set(utils.CacheTrends, tr.TenantID(), tr, nil,
+	return iDB.db.Set(utils.CacheTrends, tr.TenantID(), tr, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveTrendDrv(tenant, id string) (err error) {
@@ -630,9 +673,27 @@ func (iDB *InternalDB) RemoveTrendDrv(tenant, id string) (err error) {
 	return
 }
 
+func (iDB *InternalDB) GetHolidayCalendarDrv(tenant, id string) (hc *HolidayCalendar, err error) {
+	x, ok := iDB.db.Get(utils.CacheHolidayCalendars, utils.ConcatenatedKey(tenant, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*HolidayCalendar), nil
+}
+
+func (iDB *InternalDB) SetHolidayCalendarDrv(hc *HolidayCalendar) (err error) {
+	return iDB.db.Set(utils.CacheHolidayCalendars, hc.TenantID(), hc, nil,
+		true, utils.NonTransactional)
+}
+
+func (iDB *InternalDB) RemoveHolidayCalendarDrv(tenant, id string) (err error) {
+	iDB.db.Remove(utils.CacheHolidayCalendars, utils.ConcatenatedKey(tenant, id),
+		true, utils.NonTransactional)
+	return
+}
+
 func (iDB *InternalDB) SetRankingProfileDrv(sgp *RankingProfile) (err error) {
-	iDB.db.Set(utils.CacheRankingProfiles, sgp.TenantID(), sgp, nil, true, utils.NonTransactional)
-	return nil
+	return iDB.db.Set(utils.CacheRankingProfiles, sgp.TenantID(), sgp, nil, true, utils.NonTransactional)
 }
 
 func (iDB *InternalDB) RemRankingProfileDrv(tenant, id string) (err error) {
@@ -657,9 +718,8 @@ func (iDB *InternalDB) GetRankingDrv(tenant, id string) (rn *Ranking, err error)
 }
 
 func (iDB *InternalDB) SetRankingDrv(rn *Ranking) (err error) {
-	iDB.db.Set(utils.CacheRankings, rn.TenantID(), rn, nil,
+	return iDB.db.Set(utils.CacheRankings, rn.TenantID(), rn, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveRankingDrv(tenant, id string) (err error) {
@@ -677,9 +737,8 @@ func (iDB *InternalDB) GetThresholdProfileDrv(tenant, id string) (tp *ThresholdP
 }
 
 func (iDB *InternalDB) SetThresholdProfileDrv(tp *ThresholdProfile) (err error) {
-	iDB.db.Set(utils.CacheThresholdProfiles, tp.TenantID(), tp, nil,
+	return iDB.db.Set(utils.CacheThresholdProfiles, tp.TenantID(), tp, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemThresholdProfileDrv(tenant, id string) (err error) {
@@ -697,9 +756,8 @@ func (iDB *InternalDB) GetThresholdDrv(tenant, id string) (th *Threshold, err er
 }
 
 func (iDB *InternalDB) SetThresholdDrv(th *Threshold) (err error) {
-	iDB.db.Set(utils.CacheThresholds, th.TenantID(), th, nil,
+	return iDB.db.Set(utils.CacheThresholds, th.TenantID(), th, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveThresholdDrv(tenant, id string) (err error) {
@@ -721,9 +779,8 @@ func (iDB *InternalDB) SetFilterDrv(fltr *Filter) (err error) {
 	if err = fltr.Compile(); err != nil {
 		return
 	}
-	iDB.db.Set(utils.CacheFilters, fltr.TenantID(), fltr, nil,
+	return iDB.db.Set(utils.CacheFilters, fltr.TenantID(), fltr, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveFilterDrv(tenant, id string) (err error) {
@@ -744,9 +801,8 @@ func (iDB *InternalDB) SetRouteProfileDrv(spp *RouteProfile) (err error) {
 	if err = spp.Compile(); err != nil {
 		return
 	}
-	iDB.db.Set(utils.CacheRouteProfiles, spp.TenantID(), spp, nil,
+	return iDB.db.Set(utils.CacheRouteProfiles, spp.TenantID(), spp, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveRouteProfileDrv(tenant, id string) (err error) {
@@ -767,9 +823,8 @@ func (iDB *InternalDB) SetAttributeProfileDrv(attr *AttributeProfile) (err error
 	if err = attr.Compile(); err != nil {
 		return
 	}
-	iDB.db.Set(utils.CacheAttributeProfiles, attr.TenantID(), attr, nil,
+	return iDB.db.Set(utils.CacheAttributeProfiles, attr.TenantID(), attr, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveAttributeProfileDrv(tenant, id string) (err error) {
@@ -787,9 +842,8 @@ func (iDB *InternalDB) GetChargerProfileDrv(tenant, id string) (ch *ChargerProfi
 }
 
 func (iDB *InternalDB) SetChargerProfileDrv(chr *ChargerProfile) (err error) {
-	iDB.db.Set(utils.CacheChargerProfiles, chr.TenantID(), chr, nil,
+	return iDB.db.Set(utils.CacheChargerProfiles, chr.TenantID(), chr, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveChargerProfileDrv(tenant, id string) (err error) {
@@ -807,9 +861,8 @@ func (iDB *InternalDB) GetDispatcherProfileDrv(tenant, id string) (dpp *Dispatch
 }
 
 func (iDB *InternalDB) SetDispatcherProfileDrv(dpp *DispatcherProfile) (err error) {
-	iDB.db.Set(utils.CacheDispatcherProfiles, dpp.TenantID(), dpp, nil,
+	return iDB.db.Set(utils.CacheDispatcherProfiles, dpp.TenantID(), dpp, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveDispatcherProfileDrv(tenant, id string) (err error) {
@@ -831,9 +884,8 @@ func (iDB *InternalDB) GetItemLoadIDsDrv(itemIDPrefix string) (loadIDs map[strin
 }
 
 func (iDB *InternalDB) SetLoadIDsDrv(loadIDs map[string]int64) (err error) {
-	iDB.db.Set(utils.CacheLoadIDs, utils.LoadIDs, loadIDs, nil,
+	return iDB.db.Set(utils.CacheLoadIDs, utils.LoadIDs, loadIDs, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) GetDispatcherHostDrv(tenant, id string) (dpp *DispatcherHost, err error) {
@@ -845,9 +897,8 @@ func (iDB *InternalDB) GetDispatcherHostDrv(tenant, id string) (dpp *DispatcherH
 }
 
 func (iDB *InternalDB) SetDispatcherHostDrv(dpp *DispatcherHost) (err error) {
-	iDB.db.Set(utils.CacheDispatcherHosts, dpp.TenantID(), dpp, nil,
+	return iDB.db.Set(utils.CacheDispatcherHosts, dpp.TenantID(), dpp, nil,
 		true, utils.NonTransactional)
-	return
 }
 
 func (iDB *InternalDB) RemoveDispatcherHostDrv(tenant, id string) (err error) {
@@ -900,8 +951,10 @@ func (iDB *InternalDB) SetIndexesDrv(idxItmType, tntCtx string,
 			iDB.db.Remove(idxItmType, dbKey,
 				true, utils.NonTransactional)
 			key := strings.TrimSuffix(strings.TrimPrefix(dbKey, "tmp_"), utils.ConcatenatedKeySep+transactionID)
-			iDB.db.Set(idxItmType, key, x, []string{tntCtx},
-				true, utils.NonTransactional)
+			if err = iDB.db.Set(idxItmType, key, x, []string{tntCtx},
+				true, utils.NonTransactional); err != nil {
+				return
+			}
 		}
 		return
 	}
@@ -915,8 +968,10 @@ func (iDB *InternalDB) SetIndexesDrv(idxItmType, tntCtx string,
 				true, utils.NonTransactional)
 			continue
 		}
-		iDB.db.Set(idxItmType, dbKey, indx, []string{tntCtx},
-			true, utils.NonTransactional)
+		if err = iDB.db.Set(idxItmType, dbKey, indx, []string{tntCtx},
+			true, utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -932,10 +987,12 @@ func (iDB *InternalDB) RemoveIndexesDrv(idxItmType, tntCtx, idxKey string) (err
 
 // Will backup active sessions in DataDB
 func (iDB *InternalDB) SetBackupSessionsDrv(nodeID string,
-	tnt string, storedSessions []*StoredSession) error {
+	tnt string, storedSessions []*StoredSession) (err error) {
 	for _, sess := range storedSessions {
-		iDB.db.Set(utils.CacheSessionsBackup, sess.CGRID, sess,
-			[]string{utils.ConcatenatedKey(tnt, nodeID)}, true, utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheSessionsBackup, sess.CGRID, sess,
+			[]string{utils.ConcatenatedKey(tnt, nodeID)}, true, utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return nil
 }
@@ -0,0 +1,667 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/ltcache"
+)
+
+const (
+	dumpLogFile      = "internal_db.log"      // the append-only log with the changes since the last snapshot
+	dumpSnapshotFile = "internal_db.snapshot" // the compacted state
+	dumpTmpSuffix    = ".tmp"
+
+	dumpOpSet         = "*set"
+	dumpOpRemove      = "*remove"
+	dumpOpRemoveGroup = "*remove_group"
+	dumpOpClear       = "*clear"
+)
+
+// dumpValues returns the new value used to decode the items of each partition
+var dumpValues = map[string]func() any{
+	utils.CacheVersions:            func() any { return new(Versions) },
+	utils.CacheRatingPlans:         func() any { return new(*RatingPlan) },
+	utils.CacheRatingProfiles:      func() any { return new(*RatingProfile) },
+	utils.CacheDestinations:        func() any { return new(*Destination) },
+	utils.CacheReverseDestinations: func() any { return new([]string) },
+	utils.CacheActions:             func() any { return new(Actions) },
+	utils.CacheSharedGroups:        func() any { return new(*SharedGroup) },
+	utils.CacheActionTriggers:      func() any { return new(ActionTriggers) },
+	utils.CacheActionPlans:         func() any { return new(*ActionPlan) },
+	utils.CacheAccountActionPlans:  func() any { return new([]string) },
+	utils.CacheAccounts:            func() any { return new(*Account) },
+	utils.CacheResourceProfiles:    func() any { return new(*ResourceProfile) },
+	utils.CacheResources:           func() any { return new(*Resource) },
+	utils.CacheTimings:             func() any { return new(*utils.TPTiming) },
+	utils.CacheHolidayCalendars:    func() any { return new(*HolidayCalendar) },
+	utils.CacheStatQueueProfiles:   func() any { return new(*StatQueueProfile) },
+	utils.CacheTrendProfiles:       func() any { return new(*TrendProfile) },
+	utils.CacheTrends:              func() any { return new(*Trend) },
+	utils.CacheRankingProfiles:     func() any { return new(*RankingProfile) },
+	utils.CacheRankings:            func() any { return new(*Ranking) },
+	utils.CacheThresholdProfiles:   func() any { return new(*ThresholdProfile) },
+	utils.CacheThresholds:          func() any { return new(*Threshold) },
+	utils.CacheFilters:             func() any { return new(*Filter) },
+	utils.CacheRouteProfiles:       func() any { return new(*RouteProfile) },
+	utils.CacheAttributeProfiles:   func() any { return new(*AttributeProfile) },
+	utils.CacheChargerProfiles:     func() any { return new(*ChargerProfile) },
+	utils.CacheDispatcherProfiles:  func() any { return new(*DispatcherProfile) },
+	utils.CacheDispatcherHosts:     func() any { return new(*DispatcherHost) },
+	utils.CacheLoadIDs:             func() any { return new(map[string]int64) },
+	utils.CacheSessionsBackup:      func() any { return new(*StoredSession) },
+
+	utils.CacheTBLTPTimings:          func() any { return new(*utils.ApierTPTiming) },
+	utils.CacheTBLTPDestinations:     func() any { return new(*utils.TPDestination) },
+	utils.CacheTBLTPRates:            func() any { return new(*utils.TPRateRALs) },
+	utils.CacheTBLTPDestinationRates: func() any { return new(*utils.TPDestinationRate) },
+	utils.CacheTBLTPRatingPlans:      func() any { return new(*utils.TPRatingPlan) },
+	utils.CacheTBLTPRatingProfiles:   func() any { return new(*utils.TPRatingProfile) },
+	utils.CacheTBLTPSharedGroups:     func() any { return new(*utils.TPSharedGroups) },
+	utils.CacheTBLTPActions:          func() any { return new(*utils.TPActions) },
+	utils.CacheTBLTPActionPlans:      func() any { return new(*utils.TPActionPlan) },
+	utils.CacheTBLTPActionTriggers:   func() any { return new(*utils.TPActionTriggers) },
+	utils.CacheTBLTPAccountActions:   func() any { return new(*utils.TPAccountActions) },
+	utils.CacheTBLTPResources:        func() any { return new(*utils.TPResourceProfile) },
+	utils.CacheTBLTPStats:            func() any { return new(*utils.TPStatProfile) },
+	utils.CacheTBLTPRankings:         func() any { return new(*utils.TPRankingProfile) },
+	utils.CacheTBLTPTrends:           func() any { return new(*utils.TPTrendsProfile) },
+	utils.CacheTBLTPThresholds:       func() any { return new(*utils.TPThresholdProfile) },
+	utils.CacheTBLTPFilters:          func() any { return new(*utils.TPFilterProfile) },
+	utils.CacheTBLTPRoutes:           func() any { return new(*utils.TPRouteProfile) },
+	utils.CacheTBLTPAttributes:       func() any { return new(*utils.TPAttributeProfile) },
+	utils.CacheTBLTPChargers:         func() any { return new(*utils.TPChargerProfile) },
+	utils.CacheTBLTPDispatchers:      func() any { return new(*utils.TPDispatcherProfile) },
+	utils.CacheTBLTPDispatcherHosts:  func() any { return new(*utils.TPDispatcherHost) },
+	utils.CacheTBLTPHolidayCalendars: func() any { return new(*utils.TPHolidayCalendar) },
+	utils.CacheCDRsTBL:               func() any { return new(*CDR) },
+	utils.CacheSessionCostsTBL:       func() any { return new(*SMCost) },
+}
+
+// dumpRecord is one change of the InternalDB as written in the dump files
+type dumpRecord struct {
+	Op       string
+	CacheID  string
+	ItemID   string // the group ID in case of *remove_group
+	GroupIDs []string
+	Value    []byte
+}
+
+// internalCache is the TransCache of the InternalDB which also writes
+// the committed changes in the dump when this is enabled
+type internalCache struct {
+	*ltcache.TransCache
+	dump *internalDump
+}
+
+// Set will write the item also in the dump, the item not being stored if it cannot be written
+func (iCh *internalCache) Set(chID, itmID string, value any,
+	grpIDs []string, commit bool, transID string) (err error) {
+	if iCh.dump == nil || !commit { // the InternalDB does not commit the transactions
+		iCh.TransCache.Set(chID, itmID, value, grpIDs, commit, transID)
+		return
+	}
+	var val []byte
+	if val, err = iCh.dump.marshal(value); err != nil {
+		return fmt.Errorf("failed to marshal item <%s> from <%s> for dump: %w", itmID, chID, err)
+	}
+	iCh.dump.Lock()
+	iCh.TransCache.Set(chID, itmID, value, grpIDs, commit, transID)
+	iCh.dump.write(&dumpRecord{Op: dumpOpSet, CacheID: chID, ItemID: itmID,
+		GroupIDs: grpIDs, Value: val})
+	iCh.dump.Unlock()
+	return
+}
+
+// Remove will write the removal also in the dump
+func (iCh *internalCache) Remove(chID, itmID string, commit bool, transID string) {
+	if iCh.dump == nil || !commit {
+		iCh.TransCache.Remove(chID, itmID, commit, transID)
+		return
+	}
+	iCh.dump.Lock()
+	iCh.TransCache.Remove(chID, itmID, commit, transID)
+	iCh.dump.write(&dumpRecord{Op: dumpOpRemove, CacheID: chID, ItemID: itmID})
+	iCh.dump.Unlock()
+}
+
+// RemoveGroup will write the removal also in the dump
+func (iCh *internalCache) RemoveGroup(chID, grpID string, commit bool, transID string) {
+	if iCh.dump == nil || !commit {
+		iCh.TransCache.RemoveGroup(chID, grpID, commit, transID)
+		return
+	}
+	iCh.dump.Lock()
+	iCh.TransCache.RemoveGroup(chID, grpID, commit, transID)
+	iCh.dump.write(&dumpRecord{Op: dumpOpRemoveGroup, CacheID: chID, ItemID: grpID})
+	iCh.dump.Unlock()
+}
+
+// evicted queues the removal of an item evicted by the TransCache (ttl or limit)
+// it is called with the partition locked so the record is written later by the dump
+func (iCh *internalCache) evicted(chID, itmID string) {
+	if iCh.dump == nil {
+		return
+	}
+	iCh.dump.evMux.Lock()
+	iCh.dump.evictions = append(iCh.dump.evictions,
+		&dumpRecord{Op: dumpOpRemove, CacheID: chID, ItemID: itmID})
+	iCh.dump.evMux.Unlock()
+}
+
+// Clear will write the clear also in the dump
+func (iCh *internalCache) Clear(chIDs []string) {
+	if iCh.dump == nil {
+		iCh.TransCache.Clear(chIDs)
+		return
+	}
+	iCh.dump.Lock()
+	iCh.TransCache.Clear(chIDs)
+	if chIDs == nil { // all partitions
+		iCh.dump.write(&dumpRecord{Op: dumpOpClear})
+	}
+	for _, chID := range chIDs {
+		iCh.dump.write(&dumpRecord{Op: dumpOpClear, CacheID: chID})
+	}
+	iCh.dump.Unlock()
+}
+
+// apply will execute the record on the TransCache without writing it in the dump
+func (iCh *internalCache) apply(ms Marshaler, rec *dumpRecord) (err error) {
+	switch rec.Op {
+	case dumpOpSet:
+		var val any
+		if val, err = unmarshalDumpValue(ms, rec.CacheID, rec.Value); err != nil {
+			return
+		}
+		iCh.TransCache.Set(rec.CacheID, rec.ItemID, val, rec.GroupIDs,
+			true, utils.NonTransactional)
+	case dumpOpRemove:
+		iCh.TransCache.Remove(rec.CacheID, rec.ItemID,
+			true, utils.NonTransactional)
+	case dumpOpRemoveGroup:
+		iCh.TransCache.RemoveGroup(rec.CacheID, rec.ItemID,
+			true, utils.NonTransactional)
+	case dumpOpClear:
+		var chIDs []string
+		if rec.CacheID != utils.EmptyString {
+			chIDs = []string{rec.CacheID}
+		}
+		iCh.TransCache.Clear(chIDs)
+	default:
+		return fmt.Errorf("unsupported dump operation <%s>", rec.Op)
+	}
+	return
+}
+
+// unmarshalDumpValue decodes the value of an item based on the partition it belongs to
+func unmarshalDumpValue(ms Marshaler, chID string, data []byte) (val any, err error) {
+	if chID == utils.CacheStatQueues {
+		var ssq *StoredStatQueue
+		if err = ms.Unmarshal(data, &ssq); err != nil {
+			return
+		}
+		return ssq.AsStatQueue(ms)
+	}
+	newVal, has := dumpValues[chID]
+	if !has {
+		if _, isIdx := utils.CacheIndexesToPrefix[chID]; !isIdx {
+			return nil, fmt.Errorf("unsupported dump partition <%s>", chID)
+		}
+		newVal = func() any { return new(utils.StringSet) }
+	}
+	ptr := newVal()
+	if err = ms.Unmarshal(data, ptr); err != nil {
+		return
+	}
+	return reflect.ValueOf(ptr).Elem().Interface(), nil // the value as stored in the TransCache
+}
+
+// internalDump writes the changes of an InternalDB into an append-only log
+// which is compacted into a snapshot periodically or on demand
+type internalDump struct {
+	sync.Mutex            // locks the TransCache together with the log so they keep the same order
+	snapMux    sync.Mutex // only one snapshot at a time
+	path       string     // the directory with the dump files
+	ms         Marshaler
+	fsyncIntvl time.Duration // 0 to sync after each change
+	logFile    *os.File
+	logWr      *bufio.Writer
+	stopChan   chan struct{}
+	closeOnce  sync.Once
+	wg         sync.WaitGroup // the background syncing and snapshots
+	evMux      sync.Mutex     // protects evictions
+	evictions  []*dumpRecord  // the removals of the evicted items not yet written
+}
+
+// newInternalDump opens the log of the dump for appending the changes
+func newInternalDump(path string, ms Marshaler, fsyncIntvl, snapshotIntvl time.Duration) (d *internalDump, err error) {
+	d = &internalDump{
+		path:       path,
+		ms:         ms,
+		fsyncIntvl: fsyncIntvl,
+		stopChan:   make(chan struct{}),
+	}
+	if err = d.openLog(); err != nil {
+		return nil, err
+	}
+	if fsyncIntvl > 0 {
+		d.wg.Add(1)
+		go d.syncLoop()
+	}
+	if snapshotIntvl > 0 {
+		d.wg.Add(1)
+		go d.snapshotLoop(snapshotIntvl)
+	}
+	return
+}
+
+func (d *internalDump) openLog() (err error) {
+	if d.logFile, err = os.OpenFile(filepath.Join(d.path, dumpLogFile),
+		os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644); err != nil {
+		return
+	}
+	d.logWr = bufio.NewWriter(d.logFile)
+	return
+}
+
+// marshal encodes the value of an item
+func (d *internalDump) marshal(value any) ([]byte, error) {
+	if sq, isSQ := value.(*StatQueue); isSQ {
+		// not using NewStoredStatQueue since the queue in memory should not be compressed
+		ssq := &StoredStatQueue{
+			Tenant:    sq.Tenant,
+			ID:        sq.ID,
+			SQItems:   sq.SQItems,
+			SQMetrics: make(map[string][]byte, len(sq.SQMetrics)),
+		}
+		for metricID, metric := range sq.SQMetrics {
+			marshaled, err := metric.Marshal(d.ms)
+			if err != nil {
+				return nil, err
+			}
+			ssq.SQMetrics[metricID] = marshaled
+		}
+		value = ssq
+	}
+	return d.ms.Marshal(value)
+}
+
+// write appends the record to the log, called under lock
+func (d *internalDump) write(rec *dumpRecord) {
+	if d.logWr == nil { // closed
+		return
+	}
+	d.writeEvictions() // the evictions happened before this change
+	err := writeDumpRecord(d.logWr, d.ms, rec)
+	if err == nil && d.fsyncIntvl <= 0 {
+		err = d.sync()
+	}
+	if err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed to write <%s> for item <%s> from <%s> in dump: %s",
+			utils.MetaInternal, rec.Op, rec.ItemID, rec.CacheID, err))
+	}
+}
+
+// writeEvictions appends the queued removals of the evicted items to the log, called under lock
+func (d *internalDump) writeEvictions() {
+	d.evMux.Lock()
+	evictions := d.evictions
+	d.evictions = nil
+	d.evMux.Unlock()
+	for _, rec := range evictions {
+		if err := writeDumpRecord(d.logWr, d.ms, rec); err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> failed to write <%s> for item <%s> from <%s> in dump: %s",
+				utils.MetaInternal, rec.Op, rec.ItemID, rec.CacheID, err))
+		}
+	}
+}
+
+// sync flushes the buffered records and commits the log to disk, called under lock
+func (d *internalDump) sync() (err error) {
+	if d.logWr == nil {
+		return
+	}
+	d.writeEvictions()
+	if err = d.logWr.Flush(); err != nil {
+		return
+	}
+	return d.logFile.Sync()
+}
+
+func (d *internalDump) syncLoop() {
+	defer d.wg.Done()
+	tkr := time.NewTicker(d.fsyncIntvl)
+	defer tkr.Stop()
+	for {
+		select {
+		case <-d.stopChan:
+			return
+		case <-tkr.C:
+			d.Lock()
+			err := d.sync()
+			d.Unlock()
+			if err != nil {
+				utils.Logger.Warning(fmt.Sprintf("<%s> failed to sync the dump log: %s",
+					utils.MetaInternal, err))
+			}
+		}
+	}
+}
+
+func (d *internalDump) snapshotLoop(snapshotIntvl time.Duration) {
+	defer d.wg.Done()
+	tkr := time.NewTicker(snapshotIntvl)
+	defer tkr.Stop()
+	for {
+		select {
+		case <-d.stopChan:
+			return
+		case <-tkr.C:
+			if err := d.snapshot(); err != nil {
+				utils.Logger.Warning(fmt.Sprintf("<%s> failed to take the dump snapshot: %s",
+					utils.MetaInternal, err))
+			}
+		}
+	}
+}
+
+// rotate moves the current log aside so it can be compacted while the new changes go in a new log
+func (d *internalDump) rotate() (err error) {
+	d.Lock()
+	defer d.Unlock()
+	if d.logWr == nil { // closed
+		return utils.ErrDumpNotEnabled
+	}
+	if err = d.sync(); err != nil {
+		return
+	}
+	if err = d.logFile.Close(); err != nil {
+		return
+	}
+	err = os.Rename(filepath.Join(d.path, dumpLogFile),
+		filepath.Join(d.path, dumpLogFile+utils.NestingSep+fmt.Sprintf("%020d", time.Now().UnixNano())))
+	if errOpen := d.openLog(); errOpen != nil {
+		d.logWr = nil // no more writes until restart
+		return errOpen
+	}
+	return
+}
+
+// snapshot compacts the previous snapshot and the logs into a new snapshot
+func (d *internalDump) snapshot() (err error) {
+	d.snapMux.Lock()
+	defer d.snapMux.Unlock()
+	if err = d.rotate(); err != nil {
+		return
+	}
+	var logs []string
+	if logs, err = rotatedDumpLogs(d.path); err != nil {
+		return
+	}
+	items := make(map[string]map[string]*dumpRecord) // compacted items indexed on partition and ID
+	if err = readDumpFile(filepath.Join(d.path, dumpSnapshotFile), d.ms,
+		func(rec *dumpRecord) error { compactDumpRecord(items, rec); return nil }); err != nil {
+		return
+	}
+	for _, logPath := range logs {
+		if err = readDumpFile(logPath, d.ms,
+			func(rec *dumpRecord) error { compactDumpRecord(items, rec); return nil }); err != nil {
+			return
+		}
+	}
+	tmpPath := filepath.Join(d.path, dumpSnapshotFile+dumpTmpSuffix)
+	if err = writeDumpSnapshot(tmpPath, d.ms, items); err != nil {
+		return
+	}
+	if err = os.Rename(tmpPath, filepath.Join(d.path, dumpSnapshotFile)); err != nil {
+		return
+	}
+	for _, logPath := range logs { // already part of the snapshot
+		if err = os.Remove(logPath); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// close stops the background routines and syncs the log
+func (d *internalDump) close() {
+	d.closeOnce.Do(func() {
+		close(d.stopChan)
+		d.wg.Wait()
+		d.Lock()
+		defer d.Unlock()
+		if d.logWr == nil {
+			return
+		}
+		if err := d.sync(); err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> failed to sync the dump log: %s",
+				utils.MetaInternal, err))
+		}
+		d.logFile.Close()
+		d.logWr = nil
+	})
+}
+
+// compactDumpRecord applies the record on the items, keeping only the last *set of each
+func compactDumpRecord(items map[string]map[string]*dumpRecord, rec *dumpRecord) {
+	switch rec.Op {
+	case dumpOpSet:
+		if _, has := items[rec.CacheID]; !has {
+			items[rec.CacheID] = make(map[string]*dumpRecord)
+		}
+		items[rec.CacheID][rec.ItemID] = rec
+	case dumpOpRemove:
+		delete(items[rec.CacheID], rec.ItemID)
+	case dumpOpRemoveGroup:
+		for itmID, itm := range items[rec.CacheID] {
+			for _, grpID := range itm.GroupIDs {
+				if grpID == rec.ItemID {
+					delete(items[rec.CacheID], itmID)
+					break
+				}
+			}
+		}
+	case dumpOpClear:
+		if rec.CacheID == utils.EmptyString {
+			clear(items)
+			return
+		}
+		delete(items, rec.CacheID)
+	}
+}
+
+// writeDumpSnapshot writes the compacted items into a new file
+func writeDumpSnapshot(fPath string, ms Marshaler, items map[string]map[string]*dumpRecord) (err error) {
+	var f *os.File
+	if f, err = os.Create(fPath); err != nil {
+		return
+	}
+	defer f.Close()
+	wr := bufio.NewWriter(f)
+	chIDs := make([]string, 0, len(items))
+	for chID := range items {
+		chIDs = append(chIDs, chID)
+	}
+	sort.Strings(chIDs)
+	for _, chID := range chIDs {
+		for _, rec := range items[chID] {
+			if err = writeDumpRecord(wr, ms, rec); err != nil {
+				return
+			}
+		}
+	}
+	if err = wr.Flush(); err != nil {
+		return
+	}
+	return f.Sync()
+}
+
+// writeDumpRecord writes the record prefixed by its length
+func writeDumpRecord(wr io.Writer, ms Marshaler, rec *dumpRecord) (err error) {
+	var b []byte
+	if b, err = ms.Marshal(rec); err != nil {
+		return
+	}
+	lenBuf := make([]byte, 4)
+	binary.BigEndian.PutUint32(lenBuf, uint32(len(b)))
+	if _, err = wr.Write(lenBuf); err != nil {
+		return
+	}
+	_, err = wr.Write(b)
+	return
+}
+
+// errDumpTruncated is returned when the last record of the file was not fully written
+var errDumpTruncated = errors.New("truncated record")
+
+// readDumpFile calls f for each record in the file, a missing file is not considered an error
+// the valid records are processed even if the last record was truncated
+func readDumpFile(fPath string, ms Marshaler, f func(*dumpRecord) error) (err error) {
+	_, err = readDumpRecords(fPath, ms, f)
+	if err == errDumpTruncated {
+		utils.Logger.Warning(fmt.Sprintf("<%s> ignoring the truncated record at the end of dump file <%s>",
+			utils.MetaInternal, fPath))
+		err = nil
+	}
+	return
+}
+
+// readDumpRecords returns the size of the valid records in the file
+func readDumpRecords(fPath string, ms Marshaler, f func(*dumpRecord) error) (size int64, err error) {
+	var fl *os.File
+	if fl, err = os.Open(fPath); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return
+	}
+	defer fl.Close()
+	rdr := bufio.NewReader(fl)
+	lenBuf := make([]byte, 4)
+	for {
+		if _, err = io.ReadFull(rdr, lenBuf); err != nil {
+			if err == io.EOF {
+				err = nil
+			} else if err == io.ErrUnexpectedEOF {
+				err = errDumpTruncated
+			}
+			return
+		}
+		b := make([]byte, binary.BigEndian.Uint32(lenBuf))
+		if _, err = io.ReadFull(rdr, b); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				err = errDumpTruncated
+			}
+			return
+		}
+		rec := new(dumpRecord)
+		if err = ms.Unmarshal(b, rec); err != nil {
+			return
+		}
+		if err = f(rec); err != nil {
+			return
+		}
+		size += int64(len(lenBuf) + len(b))
+	}
+}
+
+// rotatedDumpLogs returns the logs waiting to be compacted in the order they were written
+func rotatedDumpLogs(path string) (logs []string, err error) {
+	if logs, err = filepath.Glob(filepath.Join(path, dumpLogFile+utils.NestingSep+"*")); err != nil {
+		return
+	}
+	sort.Strings(logs)
+	return
+}
+
+// replayDump loads the dump files from path into the TransCache
+func replayDump(iCh *internalCache, path string, ms Marshaler, truncate bool) (err error) {
+	apply := func(rec *dumpRecord) error { return iCh.apply(ms, rec) }
+	if err = readDumpFile(filepath.Join(path, dumpSnapshotFile), ms, apply); err != nil {
+		return
+	}
+	var logs []string
+	if logs, err = rotatedDumpLogs(path); err != nil {
+		return
+	}
+	for _, logPath := range logs {
+		if err = readDumpFile(logPath, ms, apply); err != nil {
+			return
+		}
+	}
+	logPath := filepath.Join(path, dumpLogFile)
+	var size int64
+	if size, err = readDumpRecords(logPath, ms, apply); err != errDumpTruncated {
+		return
+	}
+	utils.Logger.Warning(fmt.Sprintf("<%s> ignoring the truncated record at the end of dump file <%s>",
+		utils.MetaInternal, logPath))
+	if !truncate {
+		return nil
+	}
+	// remove the incomplete record so the new changes can be appended
+	return os.Truncate(logPath, size)
+}
+
+// OpenDump loads the items from the dump files found in path and starts
+// writing all the changes of the InternalDB in the dump
+func (iDB *InternalDB) OpenDump(path string, fsyncIntvl, snapshotIntvl time.Duration) (err error) {
+	if err = os.MkdirAll(path, 0755); err != nil {
+		return
+	}
+	if err = replayDump(iDB.db, path, iDB.ms, true); err != nil {
+		return fmt.Errorf("failed to load the dump from <%s>: %w", path, err)
+	}
+	iDB.db.dump, err = newInternalDump(path, iDB.ms, fsyncIntvl, snapshotIntvl)
+	return
+}
+
+// LoadDump only loads the items from the dump files found in path
+// without writing further changes in the dump (used by cgr-migrator)
+func (iDB *InternalDB) LoadDump(path string) (err error) {
+	if _, err = os.Stat(path); err != nil {
+		return
+	}
+	if err = replayDump(iDB.db, path, iDB.ms, false); err != nil {
+		return fmt.Errorf("failed to load the dump from <%s>: %w", path, err)
+	}
+	return
+}
+
+// Snapshot compacts the dump log into a new snapshot
+func (iDB *InternalDB) Snapshot() (err error) {
+	if iDB.db.dump == nil {
+		return utils.ErrDumpNotEnabled
+	}
+	return iDB.db.dump.snapshot()
+}
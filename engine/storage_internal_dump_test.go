@@ -0,0 +1,270 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestInternalDBDumpRestore(t *testing.T) {
+	dumpPath := t.TempDir()
+	cfg := config.NewDefaultCGRConfig()
+	iDB := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB.OpenDump(dumpPath, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	acc := &Account{
+		ID: "cgrates.org:1001",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{ID: "MONETARY", Value: 10}},
+		},
+	}
+	if err := iDB.SetAccountDrv(acc); err != nil {
+		t.Fatal(err)
+	}
+	if err := iDB.SetAccountDrv(&Account{ID: "cgrates.org:1002"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := iDB.RemoveAccountDrv("cgrates.org:1002"); err != nil {
+		t.Fatal(err)
+	}
+	sq := &StatQueue{
+		Tenant:    "cgrates.org",
+		ID:        "SQ_1",
+		SQItems:   []SQItem{{EventID: "ev1"}},
+		SQMetrics: map[string]StatMetric{},
+	}
+	if err := iDB.SetStatQueueDrv(nil, sq); err != nil {
+		t.Fatal(err)
+	}
+	idx := map[string]utils.StringSet{
+		"*string:*req.Account:1001": utils.NewStringSet([]string{"ATTR_1"}),
+	}
+	if err := iDB.SetIndexesDrv(utils.CacheAttributeFilterIndexes, "cgrates.org:*any", idx,
+		true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err := iDB.SetIndexesDrv(utils.CacheAttributeFilterIndexes, "cgrates.org:*sessions", idx,
+		true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err := iDB.RemoveIndexesDrv(utils.CacheAttributeFilterIndexes, "cgrates.org:*sessions",
+		utils.EmptyString); err != nil {
+		t.Fatal(err)
+	}
+	iDB.Close()
+
+	iDB = NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB.OpenDump(dumpPath, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer iDB.Close()
+	if rcv, err := iDB.GetAccountDrv(acc.ID); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(acc.BalanceMap, rcv.BalanceMap) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(acc.BalanceMap), utils.ToJSON(rcv.BalanceMap))
+	}
+	if _, err := iDB.GetAccountDrv("cgrates.org:1002"); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if rcv, err := iDB.GetStatQueueDrv("cgrates.org", "SQ_1"); err != nil {
+		t.Fatal(err)
+	} else if len(rcv.SQItems) != 1 || rcv.SQItems[0].EventID != "ev1" {
+		t.Errorf("Unexpected StatQueue: %s", utils.ToJSON(rcv))
+	}
+	if rcv, err := iDB.GetIndexesDrv(utils.CacheAttributeFilterIndexes, "cgrates.org:*any",
+		utils.EmptyString); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(idx, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(idx), utils.ToJSON(rcv))
+	}
+	if _, err := iDB.GetIndexesDrv(utils.CacheAttributeFilterIndexes, "cgrates.org:*sessions",
+		utils.EmptyString); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestInternalDBDumpSnapshot(t *testing.T) {
+	dumpPath := t.TempDir()
+	cfg := config.NewDefaultCGRConfig()
+	iDB := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB.Snapshot(); err != utils.ErrDumpNotEnabled {
+		t.Errorf("Expected %v, received %v", utils.ErrDumpNotEnabled, err)
+	}
+	if err := iDB.OpenDump(dumpPath, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"cgrates.org:1001", "cgrates.org:1002", "cgrates.org:1001"} {
+		if err := iDB.SetAccountDrv(&Account{ID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := iDB.Snapshot(); err != nil {
+		t.Fatal(err)
+	}
+	if err := iDB.RemoveAccountDrv("cgrates.org:1002"); err != nil {
+		t.Fatal(err)
+	}
+	iDB.Close()
+	if err := iDB.Snapshot(); err != utils.ErrDumpNotEnabled {
+		t.Errorf("Expected %v, received %v", utils.ErrDumpNotEnabled, err)
+	}
+	if _, err := os.Stat(path.Join(dumpPath, dumpSnapshotFile)); err != nil {
+		t.Error(err)
+	}
+	if logs, err := rotatedDumpLogs(dumpPath); err != nil {
+		t.Fatal(err)
+	} else if len(logs) != 0 {
+		t.Errorf("Expected the rotated logs to be removed, received: %+v", logs)
+	}
+	var snapRecs int
+	if err := readDumpFile(path.Join(dumpPath, dumpSnapshotFile), iDB.ms, func(*dumpRecord) error {
+		snapRecs++
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	} else if snapRecs != 2 {
+		t.Errorf("Expected 2 records in snapshot, received: %d", snapRecs)
+	}
+
+	iDB = NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB.LoadDump(dumpPath); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := iDB.GetAccountDrv("cgrates.org:1001"); err != nil {
+		t.Error(err)
+	}
+	if _, err := iDB.GetAccountDrv("cgrates.org:1002"); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestInternalDBDumpTruncatedLog(t *testing.T) {
+	dumpPath := t.TempDir()
+	cfg := config.NewDefaultCGRConfig()
+	iDB := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB.OpenDump(dumpPath, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := iDB.SetAccountDrv(&Account{ID: "cgrates.org:1001"}); err != nil {
+		t.Fatal(err)
+	}
+	iDB.Close()
+	logPath := path.Join(dumpPath, dumpLogFile)
+	info, err := os.Stat(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// simulate a crash in the middle of writing a record
+	f, err := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.Write([]byte{0, 0, 0, 100, 1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	iDB = NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB.OpenDump(dumpPath, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := iDB.GetAccountDrv("cgrates.org:1001"); err != nil {
+		t.Error(err)
+	}
+	if newInfo, err := os.Stat(logPath); err != nil {
+		t.Fatal(err)
+	} else if newInfo.Size() != info.Size() {
+		t.Errorf("Expected the log truncated to %d, received: %d", info.Size(), newInfo.Size())
+	}
+	if err := iDB.SetAccountDrv(&Account{ID: "cgrates.org:1002"}); err != nil {
+		t.Fatal(err)
+	}
+	iDB.Close()
+
+	iDB = NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB.LoadDump(dumpPath); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := iDB.GetAccountDrv("cgrates.org:1002"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInternalDBDumpMarshalError(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	iDB := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB.OpenDump(t.TempDir(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer iDB.Close()
+	iDB.db.dump.ms = new(JSONMarshaler) // cannot encode channels
+	if err := iDB.db.Set(utils.CacheAccounts, "cgrates.org:1001", make(chan struct{}),
+		nil, true, utils.NonTransactional); err == nil {
+		t.Error("Expected error")
+	}
+	if _, has := iDB.db.Get(utils.CacheAccounts, "cgrates.org:1001"); has {
+		t.Error("Expected the item not to be stored")
+	}
+}
+
+func TestInternalDBDumpEvictions(t *testing.T) {
+	dumpPath := t.TempDir()
+	iDB := NewInternalDB(nil, nil, true, map[string]*config.ItemOpt{
+		utils.CacheAccounts:    {Limit: 1},
+		utils.CacheActionPlans: {Limit: -1, TTL: 10 * time.Millisecond},
+	})
+	if err := iDB.OpenDump(dumpPath, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := iDB.SetActionPlanDrv("AP_1", &ActionPlan{Id: "AP_1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := iDB.SetAccountDrv(&Account{ID: "cgrates.org:1001"}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(50 * time.Millisecond)                                           // the action plan expires
+	if err := iDB.SetAccountDrv(&Account{ID: "cgrates.org:1002"}); err != nil { // evicts 1001
+		t.Fatal(err)
+	}
+	iDB.Close()
+
+	cfg := config.NewDefaultCGRConfig()
+	iDB = NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB.OpenDump(dumpPath, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer iDB.Close()
+	if _, err := iDB.GetActionPlanDrv("AP_1"); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if _, err := iDB.GetAccountDrv("cgrates.org:1001"); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if _, err := iDB.GetAccountDrv("cgrates.org:1002"); err != nil {
+		t.Error(err)
+	}
+}
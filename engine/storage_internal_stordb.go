@@ -447,6 +447,28 @@ func (iDB *InternalDB) GetTPRankings(tpid string, tenant string, id string) (ran
 	return
 }
 
+func (iDB *InternalDB) GetTPHolidayCalendars(tpid, tenant, id string) (hcs []*utils.TPHolidayCalendar, err error) {
+	key := tpid
+	if tenant != utils.EmptyString {
+		key += utils.ConcatenatedKeySep + tenant
+	}
+	if id != utils.EmptyString {
+		key += utils.ConcatenatedKeySep + id
+	}
+	ids := iDB.db.GetItemIDs(utils.CacheTBLTPHolidayCalendars, key)
+	for _, id := range ids {
+		x, ok := iDB.db.Get(utils.CacheTBLTPHolidayCalendars, id)
+		if !ok || x == nil {
+			return nil, utils.ErrNotFound
+		}
+		hcs = append(hcs, x.(*utils.TPHolidayCalendar))
+	}
+	if len(hcs) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	return
+}
+
 func (iDB *InternalDB) GetTPThresholds(tpid, tenant, id string) (ths []*utils.TPThresholdProfile, err error) {
 	key := tpid
 	if tenant != utils.EmptyString {
@@ -644,8 +666,10 @@ func (iDB *InternalDB) SetTPTimings(timings []*utils.ApierTPTiming) (err error)
 		return nil
 	}
 	for _, timing := range timings {
-		iDB.db.Set(utils.CacheTBLTPTimings, utils.ConcatenatedKey(timing.TPid, timing.ID), timing, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPTimings, utils.ConcatenatedKey(timing.TPid, timing.ID), timing, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -654,8 +678,10 @@ func (iDB *InternalDB) SetTPDestinations(dests []*utils.TPDestination) (err erro
 		return nil
 	}
 	for _, destination := range dests {
-		iDB.db.Set(utils.CacheTBLTPDestinations, utils.ConcatenatedKey(destination.TPid, destination.ID), destination, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPDestinations, utils.ConcatenatedKey(destination.TPid, destination.ID), destination, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -665,8 +691,10 @@ func (iDB *InternalDB) SetTPRates(rates []*utils.TPRateRALs) (err error) {
 		return nil
 	}
 	for _, rate := range rates {
-		iDB.db.Set(utils.CacheTBLTPRates, utils.ConcatenatedKey(rate.TPid, rate.ID), rate, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPRates, utils.ConcatenatedKey(rate.TPid, rate.ID), rate, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -676,8 +704,10 @@ func (iDB *InternalDB) SetTPDestinationRates(dRates []*utils.TPDestinationRate)
 		return nil
 	}
 	for _, dRate := range dRates {
-		iDB.db.Set(utils.CacheTBLTPDestinationRates, utils.ConcatenatedKey(dRate.TPid, dRate.ID), dRate, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPDestinationRates, utils.ConcatenatedKey(dRate.TPid, dRate.ID), dRate, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -687,8 +717,10 @@ func (iDB *InternalDB) SetTPRatingPlans(ratingPlans []*utils.TPRatingPlan) (err
 		return nil
 	}
 	for _, rPlan := range ratingPlans {
-		iDB.db.Set(utils.CacheTBLTPRatingPlans, utils.ConcatenatedKey(rPlan.TPid, rPlan.ID), rPlan, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPRatingPlans, utils.ConcatenatedKey(rPlan.TPid, rPlan.ID), rPlan, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -698,9 +730,11 @@ func (iDB *InternalDB) SetTPRatingProfiles(ratingProfiles []*utils.TPRatingProfi
 		return nil
 	}
 	for _, rProfile := range ratingProfiles {
-		iDB.db.Set(utils.CacheTBLTPRatingProfiles, utils.ConcatenatedKey(rProfile.TPid,
+		if err = iDB.db.Set(utils.CacheTBLTPRatingProfiles, utils.ConcatenatedKey(rProfile.TPid,
 			rProfile.LoadId, rProfile.Tenant, rProfile.Category, rProfile.Subject), rProfile, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -710,8 +744,10 @@ func (iDB *InternalDB) SetTPSharedGroups(groups []*utils.TPSharedGroups) (err er
 		return nil
 	}
 	for _, group := range groups {
-		iDB.db.Set(utils.CacheTBLTPSharedGroups, utils.ConcatenatedKey(group.TPid, group.ID), group, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPSharedGroups, utils.ConcatenatedKey(group.TPid, group.ID), group, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -721,8 +757,10 @@ func (iDB *InternalDB) SetTPActions(acts []*utils.TPActions) (err error) {
 		return nil
 	}
 	for _, action := range acts {
-		iDB.db.Set(utils.CacheTBLTPActions, utils.ConcatenatedKey(action.TPid, action.ID), action, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPActions, utils.ConcatenatedKey(action.TPid, action.ID), action, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -732,8 +770,10 @@ func (iDB *InternalDB) SetTPActionPlans(aPlans []*utils.TPActionPlan) (err error
 		return nil
 	}
 	for _, aPlan := range aPlans {
-		iDB.db.Set(utils.CacheTBLTPActionPlans, utils.ConcatenatedKey(aPlan.TPid, aPlan.ID), aPlan, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPActionPlans, utils.ConcatenatedKey(aPlan.TPid, aPlan.ID), aPlan, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -743,8 +783,10 @@ func (iDB *InternalDB) SetTPActionTriggers(aTriggers []*utils.TPActionTriggers)
 		return nil
 	}
 	for _, aTrigger := range aTriggers {
-		iDB.db.Set(utils.CacheTBLTPActionTriggers, utils.ConcatenatedKey(aTrigger.TPid, aTrigger.ID), aTrigger, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPActionTriggers, utils.ConcatenatedKey(aTrigger.TPid, aTrigger.ID), aTrigger, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -754,9 +796,11 @@ func (iDB *InternalDB) SetTPAccountActions(accActions []*utils.TPAccountActions)
 		return nil
 	}
 	for _, accAction := range accActions {
-		iDB.db.Set(utils.CacheTBLTPAccountActions, utils.ConcatenatedKey(accAction.TPid,
+		if err = iDB.db.Set(utils.CacheTBLTPAccountActions, utils.ConcatenatedKey(accAction.TPid,
 			accAction.LoadId, accAction.Tenant, accAction.Account), accAction, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -766,8 +810,10 @@ func (iDB *InternalDB) SetTPResources(resources []*utils.TPResourceProfile) (err
 		return nil
 	}
 	for _, resource := range resources {
-		iDB.db.Set(utils.CacheTBLTPResources, utils.ConcatenatedKey(resource.TPid, resource.Tenant, resource.ID), resource, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPResources, utils.ConcatenatedKey(resource.TPid, resource.Tenant, resource.ID), resource, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -776,8 +822,10 @@ func (iDB *InternalDB) SetTPStats(stats []*utils.TPStatProfile) (err error) {
 		return nil
 	}
 	for _, stat := range stats {
-		iDB.db.Set(utils.CacheTBLTPStats, utils.ConcatenatedKey(stat.TPid, stat.Tenant, stat.ID), stat, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPStats, utils.ConcatenatedKey(stat.TPid, stat.Tenant, stat.ID), stat, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -786,7 +834,20 @@ func (iDB *InternalDB) SetTPRankings(rankings []*utils.TPRankingProfile) (err er
 		return nil
 	}
 	for _, ranking := range rankings {
-		iDB.db.Set(utils.CacheTBLTPRankings, utils.ConcatenatedKey(ranking.TPid, ranking.Tenant, ranking.ID), ranking, nil, cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPRankings, utils.ConcatenatedKey(ranking.TPid, ranking.Tenant, ranking.ID), ranking, nil, cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
+	}
+	return
+}
+func (iDB *InternalDB) SetTPHolidayCalendars(hcs []*utils.TPHolidayCalendar) (err error) {
+	if len(hcs) == 0 {
+		return nil
+	}
+	for _, hc := range hcs {
+		if err = iDB.db.Set(utils.CacheTBLTPHolidayCalendars, utils.ConcatenatedKey(hc.TPid, hc.Tenant, hc.ID), hc, nil, cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -795,7 +856,9 @@ func (iDB *InternalDB) SetTPTrends(trends []*utils.TPTrendsProfile) (err error)
 		return nil
 	}
 	for _, trend := range trends {
-		iDB.db.Set(utils.CacheTBLTPTrends, utils.ConcatenatedKey(trend.TPid, trend.Tenant, trend.ID), trend, nil, cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPTrends, utils.ConcatenatedKey(trend.TPid, trend.Tenant, trend.ID), trend, nil, cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -805,8 +868,10 @@ func (iDB *InternalDB) SetTPThresholds(thresholds []*utils.TPThresholdProfile) (
 	}
 
 	for _, threshold := range thresholds {
-		iDB.db.Set(utils.CacheTBLTPThresholds, utils.ConcatenatedKey(threshold.TPid, threshold.Tenant, threshold.ID), threshold, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPThresholds, utils.ConcatenatedKey(threshold.TPid, threshold.Tenant, threshold.ID), threshold, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -816,8 +881,10 @@ func (iDB *InternalDB) SetTPFilters(filters []*utils.TPFilterProfile) (err error
 	}
 
 	for _, filter := range filters {
-		iDB.db.Set(utils.CacheTBLTPFilters, utils.ConcatenatedKey(filter.TPid, filter.Tenant, filter.ID), filter, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPFilters, utils.ConcatenatedKey(filter.TPid, filter.Tenant, filter.ID), filter, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -827,8 +894,10 @@ func (iDB *InternalDB) SetTPRoutes(routes []*utils.TPRouteProfile) (err error) {
 		return nil
 	}
 	for _, route := range routes {
-		iDB.db.Set(utils.CacheTBLTPRoutes, utils.ConcatenatedKey(route.TPid, route.Tenant, route.ID), route, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPRoutes, utils.ConcatenatedKey(route.TPid, route.Tenant, route.ID), route, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -839,8 +908,10 @@ func (iDB *InternalDB) SetTPAttributes(attributes []*utils.TPAttributeProfile) (
 	}
 
 	for _, attribute := range attributes {
-		iDB.db.Set(utils.CacheTBLTPAttributes, utils.ConcatenatedKey(attribute.TPid, attribute.Tenant, attribute.ID), attribute, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPAttributes, utils.ConcatenatedKey(attribute.TPid, attribute.Tenant, attribute.ID), attribute, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -850,8 +921,10 @@ func (iDB *InternalDB) SetTPChargers(cpps []*utils.TPChargerProfile) (err error)
 	}
 
 	for _, cpp := range cpps {
-		iDB.db.Set(utils.CacheTBLTPChargers, utils.ConcatenatedKey(cpp.TPid, cpp.Tenant, cpp.ID), cpp, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPChargers, utils.ConcatenatedKey(cpp.TPid, cpp.Tenant, cpp.ID), cpp, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -861,8 +934,10 @@ func (iDB *InternalDB) SetTPDispatcherProfiles(dpps []*utils.TPDispatcherProfile
 	}
 
 	for _, dpp := range dpps {
-		iDB.db.Set(utils.CacheTBLTPDispatchers, utils.ConcatenatedKey(dpp.TPid, dpp.Tenant, dpp.ID), dpp, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPDispatchers, utils.ConcatenatedKey(dpp.TPid, dpp.Tenant, dpp.ID), dpp, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -871,8 +946,10 @@ func (iDB *InternalDB) SetTPDispatcherHosts(dpps []*utils.TPDispatcherHost) (err
 		return nil
 	}
 	for _, dpp := range dpps {
-		iDB.db.Set(utils.CacheTBLTPDispatcherHosts, utils.ConcatenatedKey(dpp.TPid, dpp.Tenant, dpp.ID), dpp, nil,
-			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		if err = iDB.db.Set(utils.CacheTBLTPDispatcherHosts, utils.ConcatenatedKey(dpp.TPid, dpp.Tenant, dpp.ID), dpp, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -921,10 +998,8 @@ func (iDB *InternalDB) SetCDR(cdr *CDR, allowUpdate bool) (err error) {
 	}
 	iDB.indexedFieldsMutex.RUnlock()
 
-	iDB.db.Set(utils.CacheCDRsTBL, cdrKey, cdr, idxs.AsSlice(),
+	return iDB.db.Set(utils.CacheCDRsTBL, cdrKey, cdr, idxs.AsSlice(),
 		cacheCommit(utils.NonTransactional), utils.NonTransactional)
-
-	return
 }
 
 func (iDB *InternalDB) RemoveSMCost(smc *SMCost) (err error) {
@@ -1527,7 +1602,6 @@ func (iDB *InternalDB) SetSMCost(smCost *SMCost) (err error) {
 	idxs.Add(utils.ConcatenatedKey(utils.OriginHost, smCost.OriginHost))
 	idxs.Add(utils.ConcatenatedKey(utils.OriginID, smCost.OriginID))
 	idxs.Add(utils.ConcatenatedKey(utils.CostSource, smCost.CostSource))
-	iDB.db.Set(utils.CacheSessionCostsTBL, utils.ConcatenatedKey(smCost.CGRID, smCost.RunID, smCost.OriginHost, smCost.OriginID), smCost, idxs.AsSlice(),
+	return iDB.db.Set(utils.CacheSessionCostsTBL, utils.ConcatenatedKey(smCost.CGRID, smCost.RunID, smCost.OriginHost, smCost.OriginID), smCost, idxs.AsSlice(),
 		cacheCommit(utils.NonTransactional), utils.NonTransactional)
-	return err
 }
@@ -52,6 +52,8 @@ const (
 	ColApl  = "action_plans"
 	ColAAp  = "account_action_plans"
 	ColTsk  = "tasks"
+	ColLse  = "leases"
+	ColAlr  = "action_timing_runs"
 	ColAtr  = "action_triggers"
 	ColRpl  = "rating_plans"
 	ColRpf  = "rating_profiles"
@@ -62,6 +64,7 @@ const (
 	ColRsP  = "resource_profiles"
 	ColIndx = "indexes"
 	ColTmg  = "timings"
+	ColHol  = "holiday_calendars"
 	ColRes  = "resources"
 	ColSqs  = "statqueues"
 	ColTrp  = "trend_profiles"
@@ -304,7 +307,8 @@ func (ms *MongoStorage) ensureIndexesForCol(col string) error { // exported for
 	switch col {
 	case ColAct, ColApl, ColAAp, ColAtr, ColRpl, ColDst, ColRds, ColLht, ColIndx:
 		err = ms.enusureIndex(col, true, "key")
-	case ColRsP, ColRes, ColSqs, ColRgp, ColTrp, ColRnk, ColSqp, ColTps, ColThs, ColTrd, ColRts, ColAttr, ColFlt, ColCpp, ColDpp, ColDph:
+	case ColRsP, ColRes, ColSqs, ColRgp, ColTrp, ColRnk, ColSqp, ColTps, ColThs, ColTrd, ColRts, ColAttr, ColFlt, ColCpp, ColDpp, ColDph,
+		ColHol:
 		err = ms.enusureIndex(col, true, "tenant", "id")
 	case ColRpf, ColShg, ColAcc:
 		err = ms.enusureIndex(col, true, "id")
@@ -317,6 +321,8 @@ func (ms *MongoStorage) ensureIndexesForCol(col string) error { // exported for
 		utils.TBLTPDispatcherHosts, utils.TBLTPChargers,
 		utils.TBLTPRoutes, utils.TBLTPThresholds:
 		err = ms.enusureIndex(col, true, "tpid", "id")
+	case utils.TBLTPHolidayCalendars:
+		err = ms.enusureIndex(col, true, "tpid", "tenant", "id")
 	case utils.TBLTPRatingProfiles:
 		err = ms.enusureIndex(col, true, "tpid", "tenant",
 			"category", "subject", "loadid")
@@ -350,14 +356,14 @@ func (ms *MongoStorage) EnsureIndexes(cols ...string) error {
 			cols = []string{
 				ColAct, ColApl, ColAAp, ColAtr, ColRpl, ColDst, ColRds, ColLht, ColIndx,
 				ColRsP, ColRes, ColSqs, ColSqp, ColTps, ColThs, ColRts, ColAttr, ColFlt, ColCpp,
-				ColDpp, ColRpf, ColShg, ColAcc, ColRgp, ColTrp, ColTrd, ColRnk,
+				ColDpp, ColRpf, ColShg, ColAcc, ColRgp, ColTrp, ColTrd, ColRnk, ColHol,
 			}
 		} else {
 			cols = []string{
 				utils.TBLTPTimings, utils.TBLTPDestinations, utils.TBLTPDestinationRates,
 				utils.TBLTPRatingPlans, utils.TBLTPSharedGroups, utils.TBLTPActions, utils.TBLTPActionPlans,
 				utils.TBLTPActionTriggers, utils.TBLTPRankings, utils.TBLTPStats, utils.TBLTPResources, utils.TBLTPRatingProfiles,
-				utils.CDRsTBL, utils.SessionCostsTBL,
+				utils.TBLTPHolidayCalendars, utils.CDRsTBL, utils.SessionCostsTBL,
 			}
 		}
 	}
@@ -429,6 +435,8 @@ func (ms *MongoStorage) RemoveKeysForPrefix(prefix string) error {
 		colName = ColVer
 	case utils.TimingsPrefix:
 		colName = ColTmg
+	case utils.HolidayCalendarPrefix:
+		colName = ColHol
 	case utils.ResourcesPrefix:
 		colName = ColRes
 	case utils.ResourceProfilesPrefix:
@@ -620,6 +628,8 @@ func (ms *MongoStorage) GetKeysForPrefix(prefix string) (keys []string, err erro
 			keys, qryErr = ms.getAllKeysMatchingField(sctx, ColAAp, utils.AccountActionPlansPrefix, subject, "key")
 		case utils.TimingsPrefix:
 			keys, qryErr = ms.getAllKeysMatchingField(sctx, ColTmg, utils.TimingsPrefix, subject, "id")
+		case utils.HolidayCalendarPrefix:
+			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColHol, utils.HolidayCalendarPrefix, subject, tntID)
 		case utils.TrendPrefix:
 			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColTrd, utils.TrendPrefix, subject, tntID)
 		case utils.RankingPrefix:
@@ -714,6 +724,8 @@ func (ms *MongoStorage) HasDataDrv(category, subject, tenant string) (has bool,
 			count, err = ms.getCol(ColDpp).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
 		case utils.DispatcherHostPrefix:
 			count, err = ms.getCol(ColDph).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
+		case utils.HolidayCalendarPrefix:
+			count, err = ms.getCol(ColHol).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
 		default:
 			err = fmt.Errorf("unsupported category in HasData: %s", category)
 		}
@@ -1365,6 +1377,65 @@ func (ms *MongoStorage) PopTask() (*Task, error) {
 	return v.Task, err
 }
 
+func (ms *MongoStorage) GetLeaseDrv(id string) (*Lease, error) {
+	lse := new(Lease)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColLse).FindOne(sctx, bson.M{"_id": id})
+		decodeErr := sr.Decode(lse)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return lse, err
+}
+
+// AcquireLeaseDrv upserts the lease unless another owner holds an active one,
+// in which case the upsert fails on the duplicated _id
+func (ms *MongoStorage) AcquireLeaseDrv(lse *Lease) (acquired bool, err error) {
+	err = ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColLse).UpdateOne(sctx,
+			bson.M{"_id": lse.ID, "$or": bson.A{
+				bson.M{"ownerid": lse.OwnerID},
+				bson.M{"expirytime": bson.M{"$lte": lse.RenewTime}},
+			}},
+			bson.M{"$set": lse},
+			options.Update().SetUpsert(true),
+		)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		acquired = err == nil
+		return err
+	})
+	return
+}
+
+func (ms *MongoStorage) GetActionTimingLastRunDrv(id string) (lastRun time.Time, err error) {
+	var v struct {
+		LastRun time.Time
+	}
+	err = ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColAlr).FindOne(sctx, bson.M{"_id": id})
+		decodeErr := sr.Decode(&v)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return v.LastRun, err
+}
+
+func (ms *MongoStorage) SetActionTimingLastRunDrv(id string, lastRun time.Time) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColAlr).UpdateOne(sctx, bson.M{"_id": id},
+			bson.M{"$set": bson.M{"lastrun": lastRun}},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
 func (ms *MongoStorage) GetResourceProfileDrv(tenant, id string) (*ResourceProfile, error) {
 	rsProfile := new(ResourceProfile)
 	err := ms.query(func(sctx mongo.SessionContext) error {
@@ -1544,6 +1615,39 @@ func (ms *MongoStorage) RemStatQueueDrv(tenant, id string) error {
 	})
 }
 
+func (ms *MongoStorage) GetHolidayCalendarDrv(tenant, id string) (*HolidayCalendar, error) {
+	hc := new(HolidayCalendar)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColHol).FindOne(sctx, bson.M{"tenant": tenant, "id": id})
+		decodeErr := sr.Decode(hc)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return hc, err
+}
+
+func (ms *MongoStorage) SetHolidayCalendarDrv(hc *HolidayCalendar) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColHol).UpdateOne(sctx, bson.M{"tenant": hc.Tenant, "id": hc.ID},
+			bson.M{"$set": hc},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) RemoveHolidayCalendarDrv(tenant, id string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColHol).DeleteOne(sctx, bson.M{"tenant": tenant, "id": id})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
 func (ms *MongoStorage) GetRankingProfileDrv(tenant, id string) (*RankingProfile, error) {
 	rgProfile := new(RankingProfile)
 	err := ms.query(func(sctx mongo.SessionContext) error {
@@ -2129,6 +2233,7 @@ type mongoStoredSession struct {
 	SRuns         []*StoredSRun
 	OptsStart     MapEvent
 	UpdatedAt     time.Time
+	Services      map[string]*StoredSession
 }
 
 // Will backup active sessions in DataDB
@@ -2155,6 +2260,7 @@ func (ms *MongoStorage) SetBackupSessionsDrv(nodeID, tnt string, storedSessions
 					SRuns:         sess.SRuns,
 					OptsStart:     sess.OptsStart,
 					UpdatedAt:     sess.UpdatedAt,
+					Services:      sess.Services,
 				}}
 				model := mongo.NewUpdateOneModel().SetUpdate(doc).SetUpsert(true).SetFilter(bson.M{"nodeid": nodeID, "cgrid": sess.CGRID})
 				models = append(models, model)
@@ -2203,6 +2309,7 @@ func (ms *MongoStorage) GetSessionsBackupDrv(nodeID, tnt string) ([]*StoredSessi
 				SRuns:         result.SRuns,
 				OptsStart:     result.OptsStart,
 				UpdatedAt:     result.UpdatedAt,
+				Services:      result.Services,
 			}
 			storeSessions = append(storeSessions, oneStSession)
 		}
@@ -504,6 +504,35 @@ func (ms *MongoStorage) GetTPRankings(tpid string, tenant string, id string) ([]
 	return results, err
 }
 
+func (ms *MongoStorage) GetTPHolidayCalendars(tpid, tenant, id string) ([]*utils.TPHolidayCalendar, error) {
+	filter := bson.M{"tpid": tpid}
+	if id != "" {
+		filter["id"] = id
+	}
+	if tenant != "" {
+		filter["tenant"] = tenant
+	}
+	var results []*utils.TPHolidayCalendar
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		cur, err := ms.getCol(utils.TBLTPHolidayCalendars).Find(sctx, filter)
+		if err != nil {
+			return err
+		}
+		for cur.Next(sctx) {
+			var el utils.TPHolidayCalendar
+			if err := cur.Decode(&el); err != nil {
+				return err
+			}
+			results = append(results, &el)
+		}
+		if len(results) == 0 {
+			return utils.ErrNotFound
+		}
+		return cur.Close(sctx)
+	})
+	return results, err
+}
+
 func (ms *MongoStorage) GetTPActions(tpid, id string) ([]*utils.TPActions, error) {
 	filter := bson.M{"tpid": tpid}
 	if id != "" {
@@ -1313,6 +1342,22 @@ func (ms *MongoStorage) SetTPRankings(tpRankings []*utils.TPRankingProfile) (err
 	})
 }
 
+func (ms *MongoStorage) SetTPHolidayCalendars(tpHCs []*utils.TPHolidayCalendar) (err error) {
+	if len(tpHCs) == 0 {
+		return
+	}
+	return ms.query(func(sctx mongo.SessionContext) (err error) {
+		for _, tp := range tpHCs {
+			_, err := ms.getCol(utils.TBLTPHolidayCalendars).UpdateOne(sctx, bson.M{"tpid": tp.TPid, "tenant": tp.Tenant, "id": tp.ID},
+				bson.M{"$set": tp}, options.Update().SetUpsert(true))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (ms *MongoStorage) GetTPThresholds(tpid, tenant, id string) ([]*utils.TPThresholdProfile, error) {
 	filter := bson.M{"tpid": tpid}
 	if id != "" {
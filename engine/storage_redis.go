@@ -67,6 +67,16 @@ const (
 	redis_RENAME   = "RENAME"
 	redis_HMSET    = "HMSET"
 	redis_HSET     = "HSET"
+	redis_EVAL     = "EVAL"
+
+	// redisAcquireLease sets the lease unless another owner holds an active one
+	// the times are passed as unix milliseconds
+	redisAcquireLease = `local owner = redis.call('HGET', KEYS[1], 'OwnerID')
+if owner and owner ~= ARGV[1] and tonumber(redis.call('HGET', KEYS[1], 'ExpiryTime')) > tonumber(ARGV[2]) then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'OwnerID', ARGV[1], 'RenewTime', ARGV[2], 'ExpiryTime', ARGV[3])
+return 1`
 
 	redisLoadError = "Redis is loading the dataset in memory"
 	RedisLimit     = 524287 // https://github.com/StackExchange/StackExchange.Redis/issues/201#issuecomment-98639005
@@ -758,6 +768,60 @@ func (rs *RedisStorage) PopTask() (t *Task, err error) {
 	return
 }
 
+func (rs *RedisStorage) GetLeaseDrv(id string) (lse *Lease, err error) {
+	var values map[string]string
+	if err = rs.Cmd(&values, redis_HGETALL, utils.LeasePrefix+id); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	lse = &Lease{
+		ID:      id,
+		OwnerID: values["OwnerID"],
+	}
+	var renewMs, expiryMs int64
+	if renewMs, err = strconv.ParseInt(values["RenewTime"], 10, 64); err != nil {
+		return
+	}
+	if expiryMs, err = strconv.ParseInt(values["ExpiryTime"], 10, 64); err != nil {
+		return
+	}
+	lse.RenewTime = time.UnixMilli(renewMs)
+	lse.ExpiryTime = time.UnixMilli(expiryMs)
+	return
+}
+
+func (rs *RedisStorage) AcquireLeaseDrv(lse *Lease) (acquired bool, err error) {
+	var reply int
+	if err = rs.Cmd(&reply, redis_EVAL, redisAcquireLease, "1", utils.LeasePrefix+lse.ID,
+		lse.OwnerID, strconv.FormatInt(lse.RenewTime.UnixMilli(), 10),
+		strconv.FormatInt(lse.ExpiryTime.UnixMilli(), 10)); err != nil {
+		return
+	}
+	return reply == 1, nil
+}
+
+func (rs *RedisStorage) GetActionTimingLastRunDrv(id string) (lastRun time.Time, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.ActionTimingLastRunPrefix+id); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	var lastRunMs int64
+	if lastRunMs, err = strconv.ParseInt(string(values), 10, 64); err != nil {
+		return
+	}
+	return time.UnixMilli(lastRunMs), nil
+}
+
+func (rs *RedisStorage) SetActionTimingLastRunDrv(id string, lastRun time.Time) error {
+	return rs.Cmd(nil, redis_SET, utils.ActionTimingLastRunPrefix+id,
+		strconv.FormatInt(lastRun.UnixMilli(), 10))
+}
+
 func (rs *RedisStorage) GetResourceProfileDrv(tenant, id string) (rsp *ResourceProfile, err error) {
 	var values []byte
 	if err = rs.Cmd(&values, redis_GET, utils.ResourceProfilesPrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
@@ -987,6 +1051,30 @@ func (rs *RedisStorage) RemoveTrendDrv(tenant, id string) (err error) {
 	return rs.Cmd(nil, redis_DEL, utils.TrendPrefix+utils.ConcatenatedKey(tenant, id))
 }
 
+func (rs *RedisStorage) GetHolidayCalendarDrv(tenant, id string) (hc *HolidayCalendar, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.HolidayCalendarPrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &hc)
+	return
+}
+
+func (rs *RedisStorage) SetHolidayCalendarDrv(hc *HolidayCalendar) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(hc); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.HolidayCalendarPrefix+hc.TenantID(), string(result))
+}
+
+func (rs *RedisStorage) RemoveHolidayCalendarDrv(tenant, id string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.HolidayCalendarPrefix+utils.ConcatenatedKey(tenant, id))
+}
+
 func (rs *RedisStorage) SetRankingProfileDrv(sg *RankingProfile) (err error) {
 	var result []byte
 	if result, err = rs.ms.Marshal(sg); err != nil {
@@ -105,7 +105,7 @@ func (sqls *SQLStorage) IsDBEmpty() (resp bool, err error) {
 		utils.TBLTPAccountActions, utils.TBLTPResources, utils.TBLTPStats, utils.TBLTPThresholds,
 		utils.TBLTPFilters, utils.SessionCostsTBL, utils.CDRsTBL, utils.TBLTPActionPlans,
 		utils.TBLVersions, utils.TBLTPRoutes, utils.TBLTPAttributes, utils.TBLTPChargers,
-		utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts,
+		utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts, utils.TBLTPHolidayCalendars,
 	}
 	for _, tbl := range tbls {
 		if sqls.db.Migrator().HasTable(tbl) {
@@ -124,7 +124,7 @@ func (sqls *SQLStorage) GetTpIds(colName string) ([]string, error) {
 	qryStr := fmt.Sprintf(" (SELECT tpid FROM %s)", colName)
 	if colName == "" {
 		qryStr = fmt.Sprintf(
-			"(SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s)",
+			"(SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s)",
 			utils.TBLTPTimings,
 			utils.TBLTPDestinations,
 			utils.TBLTPRates,
@@ -146,6 +146,7 @@ func (sqls *SQLStorage) GetTpIds(colName string) ([]string, error) {
 			utils.TBLTPChargers,
 			utils.TBLTPDispatchers,
 			utils.TBLTPDispatcherHosts,
+			utils.TBLTPHolidayCalendars,
 		)
 	}
 	rows, err = sqls.Db.Query(qryStr)
@@ -242,7 +243,7 @@ func (sqls *SQLStorage) RemTpData(table, tpid string, args map[string]string) er
 			utils.TBLTPSharedGroups, utils.TBLTPActions, utils.TBLTPActionTriggers, utils.TBLTPRankings,
 			utils.TBLTPAccountActions, utils.TBLTPResources, utils.TBLTPStats, utils.TBLTPThresholds,
 			utils.TBLTPFilters, utils.TBLTPActionPlans, utils.TBLTPRoutes, utils.TBLTPAttributes,
-			utils.TBLTPChargers, utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts} {
+			utils.TBLTPChargers, utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts, utils.TBLTPHolidayCalendars} {
 			if err := tx.Table(tblName).Where("tpid = ?", tpid).Delete(nil).Error; err != nil {
 				tx.Rollback()
 				return err
@@ -577,6 +578,27 @@ func (sqls *SQLStorage) SetTPStats(sts []*utils.TPStatProfile) error {
 	return nil
 }
 
+func (sqls *SQLStorage) SetTPHolidayCalendars(hcs []*utils.TPHolidayCalendar) error {
+	if len(hcs) == 0 {
+		return nil
+	}
+	tx := sqls.db.Begin()
+	for _, hc := range hcs {
+		if err := tx.Where(&HolidayCalendarMdl{Tpid: hc.TPid, Tenant: hc.Tenant, ID: hc.ID}).Delete(HolidayCalendarMdl{}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		for _, mdl := range APItoModelTPHolidayCalendar(hc) {
+			if err := tx.Create(&mdl).Error; err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	}
+	tx.Commit()
+	return nil
+}
+
 func (sqls *SQLStorage) SetTPRankings(rgs []*utils.TPRankingProfile) error {
 	if len(rgs) == 0 {
 		return nil
@@ -1499,6 +1521,25 @@ func (sqls *SQLStorage) GetTPRankings(tpid string, tenant string, id string) ([]
 	return asgs, nil
 }
 
+func (sqls *SQLStorage) GetTPHolidayCalendars(tpid, tenant, id string) ([]*utils.TPHolidayCalendar, error) {
+	var hcs HolidayCalendarMdls
+	q := sqls.db.Where("tpid = ?", tpid)
+	if len(id) != 0 {
+		q = q.Where("id = ?", id)
+	}
+	if len(tenant) != 0 {
+		q = q.Where("tenant = ?", tenant)
+	}
+	if err := q.Find(&hcs).Error; err != nil {
+		return nil, err
+	}
+	ahcs := hcs.AsTPHolidayCalendars()
+	if len(ahcs) == 0 {
+		return ahcs, utils.ErrNotFound
+	}
+	return ahcs, nil
+}
+
 func (sqls *SQLStorage) GetTPThresholds(tpid, tenant, id string) ([]*utils.TPThresholdProfile, error) {
 	var ths ThresholdMdls
 	q := sqls.db.Where("tpid = ?", tpid)
@@ -55,7 +55,12 @@ func NewDataDBConn(dbType, host, port, name, user,
 	case utils.MetaMongo:
 		d, err = NewMongoStorage(opts.MongoConnScheme, host, port, name, user, pass, marshaler, utils.DataDB, nil, opts.MongoQueryTimeout)
 	case utils.MetaInternal:
-		d = NewInternalDB(nil, nil, true, itmsCfg)
+		iDB := NewInternalDB(nil, nil, true, itmsCfg)
+		if opts.InternalDBDumpPath != utils.EmptyString {
+			err = iDB.OpenDump(opts.InternalDBDumpPath,
+				opts.InternalDBFsyncInterval, opts.InternalDBSnapshotInterval)
+		}
+		d = iDB
 	default:
 		err = fmt.Errorf("unsupported db_type <%s>", dbType)
 	}
@@ -77,7 +82,12 @@ func NewStorDBConn(dbType, host, port, name, user, pass, marshaler string,
 		db, err = NewMySQLStorage(host, port, name, user, pass, opts.SQLMaxOpenConns, opts.SQLMaxIdleConns,
 			opts.SQLConnMaxLifetime, opts.MySQLLocation, opts.MySQLDSNParams)
 	case utils.MetaInternal:
-		db = NewInternalDB(stringIndexedFields, prefixIndexedFields, false, itmsCfg)
+		iDB := NewInternalDB(stringIndexedFields, prefixIndexedFields, false, itmsCfg)
+		if opts.InternalDBDumpPath != utils.EmptyString {
+			err = iDB.OpenDump(opts.InternalDBDumpPath,
+				opts.InternalDBFsyncInterval, opts.InternalDBSnapshotInterval)
+		}
+		db = iDB
 	default:
 		err = fmt.Errorf("unknown db '%s' valid options are [%s, %s, %s, %s]",
 			dbType, utils.MetaMySQL, utils.MetaMongo, utils.MetaPostgres, utils.MetaInternal)
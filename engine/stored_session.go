@@ -45,5 +45,6 @@ type StoredSession struct {
 	Chargeable    bool          // used in case of pausing debit
 	SRuns         []*StoredSRun // forked based on ChargerS
 	OptsStart     MapEvent
-	UpdatedAt     time.Time // time when session was changed
+	UpdatedAt     time.Time                 // time when session was changed
+	Services      map[string]*StoredSession // per-service sub-sessions
 }
@@ -409,6 +409,16 @@ func (incs Increments) GetTotalCost() float64 {
 	return utils.Round(cost, globalRoundingDecimals, utils.MetaRoundingMiddle)
 }
 
+// getDecimalTotalCost returns the exact cost of the increments
+func (incs Increments) getDecimalTotalCost() *utils.Decimal {
+	cost := utils.NewDecimal(0, 0)
+	for _, increment := range incs {
+		cost = utils.SumDecimal(cost,
+			decimalCost(utils.NewDecimalFromFloat64(increment.Cost), increment.GetCompressFactor()))
+	}
+	return utils.RoundDecimal(cost, globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
+
 func (incs Increments) Length() (length int) {
 	for _, incr := range incs {
 		length += incr.GetCompressFactor()
@@ -457,6 +467,14 @@ func (ts *TimeSpan) CalculateCost() float64 {
 	return ts.Increments.GetTotalCost() * float64(ts.GetCompressFactor())
 }
 
+// calculateDecimalCost returns the exact cost of the timespan, the decimal version of CalculateCost
+func (ts *TimeSpan) calculateDecimalCost() *utils.Decimal {
+	if ts.Increments.Length() == 0 {
+		return utils.NewDecimalFromFloat64(ts.CalculateCost())
+	}
+	return decimalCost(ts.Increments.getDecimalTotalCost(), ts.GetCompressFactor())
+}
+
 func (ts *TimeSpan) setRatingInfo(rp *RatingInfo) {
 	ts.ratingInfo = rp
 	ts.MatchedSubject = rp.MatchedSubject
@@ -415,6 +415,20 @@ func (tpExp *TPExporter) Run() error {
 		}
 	}
 
+	storDataHolidayCalendars, err := tpExp.storDb.GetTPHolidayCalendars(tpExp.tpID, "", "")
+	if err != nil && err.Error() != utils.ErrNotFound.Error() {
+		utils.Logger.Warning(fmt.Sprintf("<%s> error: %s, when getting %s from stordb for export", utils.ApierS, err, utils.TpHolidayCalendars))
+		withError = true
+	}
+	if len(storDataHolidayCalendars) != 0 {
+		toExportMap[utils.HolidayCalendarsCsv] = make([]any, 0, len(storDataHolidayCalendars))
+		for _, sd := range storDataHolidayCalendars {
+			for _, sdModel := range APItoModelTPHolidayCalendar(sd) {
+				toExportMap[utils.HolidayCalendarsCsv] = append(toExportMap[utils.HolidayCalendarsCsv], sdModel)
+			}
+		}
+	}
+
 	if len(toExportMap) == 0 { // if we don't have anything to export we return not found error
 		return utils.ErrNotFound
 	}
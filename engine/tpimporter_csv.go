@@ -62,6 +62,7 @@ var fileHandlers = map[string]func(*TPCSVImporter, string) error{
 	utils.ChargersCsv:           (*TPCSVImporter).importChargerProfiles,
 	utils.DispatcherProfilesCsv: (*TPCSVImporter).importDispatcherProfiles,
 	utils.DispatcherHostsCsv:    (*TPCSVImporter).importDispatcherHosts,
+	utils.HolidayCalendarsCsv:   (*TPCSVImporter).importHolidayCalendars,
 }
 
 func (tpImp *TPCSVImporter) Run() error {
@@ -385,3 +386,14 @@ func (tpImp *TPCSVImporter) importDispatcherHosts(fn string) error {
 	}
 	return tpImp.StorDb.SetTPDispatcherHosts(dpps)
 }
+
+func (tpImp *TPCSVImporter) importHolidayCalendars(fn string) error {
+	if tpImp.Verbose {
+		log.Printf("Processing file: <%s> ", fn)
+	}
+	hcs, err := tpImp.csvr.GetTPHolidayCalendars(tpImp.TPid, "", "")
+	if err != nil {
+		return err
+	}
+	return tpImp.StorDb.SetTPHolidayCalendars(hcs)
+}
@@ -42,6 +42,7 @@ type TpReader struct {
 	accountActions     map[string]*Account
 	destinations       map[string]*Destination
 	timings            map[string]*utils.TPTiming
+	holidayCalendars   map[utils.TenantID]*utils.TPHolidayCalendar
 	rates              map[string]*utils.TPRateRALs
 	destinationRates   map[string]*utils.TPDestinationRate
 	ratingPlans        map[string]*RatingPlan
@@ -91,6 +92,7 @@ func (tpr *TpReader) Init() {
 	tpr.destinations = make(map[string]*Destination)
 	tpr.destinationRates = make(map[string]*utils.TPDestinationRate)
 	tpr.timings = make(map[string]*utils.TPTiming)
+	tpr.holidayCalendars = make(map[utils.TenantID]*utils.TPHolidayCalendar)
 	tpr.ratingPlans = make(map[string]*RatingPlan)
 	tpr.ratingProfiles = make(map[string]*RatingProfile)
 	tpr.sharedGroups = make(map[string]*SharedGroup)
@@ -159,6 +161,23 @@ func (tpr *TpReader) LoadTimings() (err error) {
 	return err
 }
 
+func (tpr *TpReader) LoadHolidayCalendarsFiltered(tag string) (err error) {
+	tps, err := tpr.lr.GetTPHolidayCalendars(tpr.tpid, "", tag)
+	if err != nil {
+		return err
+	}
+	mapHCs := make(map[utils.TenantID]*utils.TPHolidayCalendar)
+	for _, hc := range tps {
+		mapHCs[utils.TenantID{Tenant: hc.Tenant, ID: hc.ID}] = hc
+	}
+	tpr.holidayCalendars = mapHCs
+	return nil
+}
+
+func (tpr *TpReader) LoadHolidayCalendars() error {
+	return tpr.LoadHolidayCalendarsFiltered("")
+}
+
 func (tpr *TpReader) LoadRates() (err error) {
 	tps, err := tpr.lr.GetTPRates(tpr.tpid, "")
 	if err != nil {
@@ -525,13 +544,14 @@ func (tpr *TpReader) LoadActions() (err error) {
 						}
 					}
 					acts[idx].Balance.Timings = append(acts[idx].Balance.Timings, &RITiming{
-						ID:        timingID,
-						Years:     timing.Years,
-						Months:    timing.Months,
-						MonthDays: timing.MonthDays,
-						WeekDays:  timing.WeekDays,
-						StartTime: timing.StartTime,
-						EndTime:   timing.EndTime,
+						ID:                 timingID,
+						Years:              timing.Years,
+						Months:             timing.Months,
+						MonthDays:          timing.MonthDays,
+						WeekDays:           timing.WeekDays,
+						StartTime:          timing.StartTime,
+						EndTime:            timing.EndTime,
+						HolidayCalendarIDs: timing.HolidayCalendarIDs,
 					})
 				}
 			}
@@ -574,12 +594,13 @@ func (tpr *TpReader) LoadActionPlans() (err error) {
 				Weight: at.Weight,
 				Timing: &RateInterval{
 					Timing: &RITiming{
-						ID:        at.TimingId,
-						Years:     t.Years,
-						Months:    t.Months,
-						MonthDays: t.MonthDays,
-						WeekDays:  t.WeekDays,
-						StartTime: t.StartTime,
+						ID:                 at.TimingId,
+						Years:              t.Years,
+						Months:             t.Months,
+						MonthDays:          t.MonthDays,
+						WeekDays:           t.WeekDays,
+						StartTime:          t.StartTime,
+						HolidayCalendarIDs: t.HolidayCalendarIDs,
 					},
 				},
 				ActionsID: at.ActionsId,
@@ -751,11 +772,12 @@ func (tpr *TpReader) LoadAccountActionsFiltered(qriedAA *utils.TPAccountActions)
 					Weight: at.Weight,
 					Timing: &RateInterval{
 						Timing: &RITiming{
-							ID:        at.TimingId,
-							Months:    t.Months,
-							MonthDays: t.MonthDays,
-							WeekDays:  t.WeekDays,
-							StartTime: t.StartTime,
+							ID:                 at.TimingId,
+							Months:             t.Months,
+							MonthDays:          t.MonthDays,
+							WeekDays:           t.WeekDays,
+							StartTime:          t.StartTime,
+							HolidayCalendarIDs: t.HolidayCalendarIDs,
 						},
 					},
 					ActionsID: at.ActionsId,
@@ -990,13 +1012,14 @@ func (tpr *TpReader) LoadAccountActionsFiltered(qriedAA *utils.TPAccountActions)
 						for _, timingID := range timingIds {
 							if timing, found := tpr.timings[timingID]; found {
 								acts[idx].Balance.Timings = append(acts[idx].Balance.Timings, &RITiming{
-									ID:        timingID,
-									Years:     timing.Years,
-									Months:    timing.Months,
-									MonthDays: timing.MonthDays,
-									WeekDays:  timing.WeekDays,
-									StartTime: timing.StartTime,
-									EndTime:   timing.EndTime,
+									ID:                 timingID,
+									Years:              timing.Years,
+									Months:             timing.Months,
+									MonthDays:          timing.MonthDays,
+									WeekDays:           timing.WeekDays,
+									StartTime:          timing.StartTime,
+									EndTime:            timing.EndTime,
+									HolidayCalendarIDs: timing.HolidayCalendarIDs,
 								})
 							} else {
 								return fmt.Errorf("could not find timing: %q", timingID)
@@ -1318,6 +1341,9 @@ func (tpr *TpReader) LoadAll() (err error) {
 	if err = tpr.LoadTimings(); err != nil && err.Error() != utils.NotFoundCaps {
 		return
 	}
+	if err = tpr.LoadHolidayCalendars(); err != nil && err.Error() != utils.NotFoundCaps {
+		return
+	}
 	if err = tpr.LoadRates(); err != nil && err.Error() != utils.NotFoundCaps {
 		return
 	}
@@ -1769,6 +1795,24 @@ func (tpr *TpReader) WriteToDatabase(verbose, disableReverse bool) (err error) {
 	if len(tpr.timings) != 0 {
 		loadIDs[utils.CacheTimings] = loadID
 	}
+	if verbose {
+		log.Print("HolidayCalendars:")
+	}
+	for _, tpHC := range tpr.holidayCalendars {
+		var hc *HolidayCalendar
+		if hc, err = APItoHolidayCalendar(tpHC); err != nil {
+			return
+		}
+		if err = tpr.dm.SetHolidayCalendar(hc); err != nil {
+			return
+		}
+		if verbose {
+			log.Print("\t", hc.TenantID())
+		}
+	}
+	if len(tpr.holidayCalendars) != 0 {
+		loadIDs[utils.CacheHolidayCalendars] = loadID
+	}
 	if !disableReverse {
 		if len(tpr.acntActionPlans) > 0 {
 			if verbose {
@@ -1851,6 +1895,8 @@ func (tpr *TpReader) ShowStatistics() {
 	log.Print("DispatcherProfiles: ", len(tpr.dispatcherProfiles))
 	// Dispatcher Hosts
 	log.Print("DispatcherHosts: ", len(tpr.dispatcherHosts))
+	// Holiday calendars
+	log.Print("HolidayCalendars: ", len(tpr.holidayCalendars))
 }
 
 // GetLoadedIds returns the identities loaded for a specific category, useful for cache reloads
@@ -1928,6 +1974,14 @@ func (tpr *TpReader) GetLoadedIds(categ string) ([]string, error) {
 			i++
 		}
 		return keys, nil
+	case utils.HolidayCalendarPrefix:
+		keys := make([]string, len(tpr.holidayCalendars))
+		i := 0
+		for k := range tpr.holidayCalendars {
+			keys[i] = k.TenantID()
+			i++
+		}
+		return keys, nil
 	case utils.ResourceProfilesPrefix:
 		keys := make([]string, len(tpr.resProfiles))
 		i := 0
@@ -2224,6 +2278,17 @@ func (tpr *TpReader) RemoveFromDatabase(verbose, disableReverse bool) (err error
 			log.Print("\t", t.ID)
 		}
 	}
+	if verbose {
+		log.Print("HolidayCalendars:")
+	}
+	for _, tpHC := range tpr.holidayCalendars {
+		if err = tpr.dm.RemoveHolidayCalendar(tpHC.Tenant, tpHC.ID); err != nil {
+			return
+		}
+		if verbose {
+			log.Print("\t", utils.ConcatenatedKey(tpHC.Tenant, tpHC.ID))
+		}
+	}
 	if !disableReverse {
 		if len(tpr.destinations) > 0 {
 			if verbose {
@@ -2316,6 +2381,9 @@ func (tpr *TpReader) RemoveFromDatabase(verbose, disableReverse bool) (err error
 	if len(tpr.timings) != 0 {
 		loadIDs[utils.CacheTimings] = loadID
 	}
+	if len(tpr.holidayCalendars) != 0 {
+		loadIDs[utils.CacheHolidayCalendars] = loadID
+	}
 	return tpr.dm.SetLoadIDs(loadIDs)
 }
 
@@ -2331,6 +2399,7 @@ func (tpr *TpReader) ReloadCache(caching string, verbose bool, opts map[string]a
 	dstIds, _ := tpr.GetLoadedIds(utils.DestinationPrefix)
 	revDstIDs, _ := tpr.GetLoadedIds(utils.ReverseDestinationPrefix)
 	tmgIds, _ := tpr.GetLoadedIds(utils.TimingsPrefix)
+	hcIDs, _ := tpr.GetLoadedIds(utils.HolidayCalendarPrefix)
 	rplIds, _ := tpr.GetLoadedIds(utils.RatingPlanPrefix)
 	rpfIds, _ := tpr.GetLoadedIds(utils.RatingProfilePrefix)
 	actIds, _ := tpr.GetLoadedIds(utils.ActionPrefix)
@@ -2354,6 +2423,7 @@ func (tpr *TpReader) ReloadCache(caching string, verbose bool, opts map[string]a
 		utils.CacheDestinations:        dstIds,
 		utils.CacheReverseDestinations: revDstIDs,
 		utils.CacheTimings:             tmgIds,
+		utils.CacheHolidayCalendars:    hcIDs,
 		utils.CacheRatingPlans:         rplIds,
 		utils.CacheRatingProfiles:      rpfIds,
 		utils.CacheActions:             actIds,
@@ -933,6 +933,7 @@ func TestTPReaderReloadCache(t *testing.T) {
 		ActionTriggerIDs:      []string{"ActionTriggersID"},
 		DestinationIDs:        []string{"DestinationsID"},
 		TimingIDs:             []string{"TimingsID"},
+		HolidayCalendarIDs:    []string{"cgrates.org:holidayCalendarsID"},
 		RatingPlanIDs:         []string{"RatingPlansID"},
 		RatingProfileIDs:      []string{"RatingProfilesID"},
 		SharedGroupIDs:        []string{"SharedGroupsID"},
@@ -987,6 +988,9 @@ func TestTPReaderReloadCache(t *testing.T) {
 		timings: map[string]*utils.TPTiming{
 			"TimingsID": {},
 		},
+		holidayCalendars: map[utils.TenantID]*utils.TPHolidayCalendar{
+			{Tenant: "cgrates.org", ID: "holidayCalendarsID"}: {},
+		},
 		ratingPlans: map[string]*RatingPlan{
 			"RatingPlansID": {},
 		},
@@ -1055,7 +1059,7 @@ func TestTPReaderLoadDestinationsFiltered(t *testing.T) {
 		TPid: "tpID",
 		ID:   "prefixes",
 	}, []string{"groupId"}, true, "tId")
-	db.db = tscache
+	db.db = &internalCache{TransCache: tscache}
 
 	tpr, err := NewTpReader(db, db, "itemId", "local", nil, nil, true)
 	if err != nil {
@@ -1208,7 +1212,7 @@ func TestTpReaderLoadAccountActions(t *testing.T) {
 		ActionPlanId: "actionplans",
 	}, []string{"groupId"}, true, "tId")
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "local", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -1407,7 +1411,7 @@ func TestTpReaderLoadTimingsErr(t *testing.T) {
 		ID:   duplicateId,
 	}, []string{"groupId"}, true, "tId")
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "local", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -1440,7 +1444,7 @@ func TestLoadDestinationRatesErr(t *testing.T) {
 		ID:   duplicateId,
 	}, []string{"groupId"}, true, "tId")
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "local", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -1523,7 +1527,7 @@ func TestLoadRatingProfilesFiltered(t *testing.T) {
 		},
 	)
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "local", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -1580,7 +1584,7 @@ func TestTpReaderLoadActionTriggers(t *testing.T) {
 		},
 	)
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "UTC", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -1665,7 +1669,7 @@ func TestTpReaderSetDestination(t *testing.T) {
 		},
 	)
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "UTC", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -138,18 +138,28 @@ func (vers Versions) Compare(curent Versions, storType string, isDataDB bool) st
 		message = dataDBVers
 	}
 	for subsis, reason := range message {
-		if vers[subsis] != curent[subsis] {
+		if vers[subsis] != curent[subsis] &&
+			!(subsis == utils.Accounts && vers[subsis] == 4 && curent[subsis] == 3) { // decimal_balances disabled after being used
 			return reason
 		}
 	}
 	return ""
 }
 
+// accountsVersion returns the needed Accounts version,
+// v4 adding the decimal values used with rals decimal_balances
+func accountsVersion() int64 {
+	if decimalBalances {
+		return 4
+	}
+	return 3
+}
+
 // CurrentDataDBVersions returns the needed DataDB versions
 func CurrentDataDBVersions() Versions {
 	return Versions{
 		utils.StatS:               4,
-		utils.Accounts:            3,
+		utils.Accounts:            accountsVersion(),
 		utils.Actions:             2,
 		utils.ActionTriggers:      2,
 		utils.ActionPlans:         3,
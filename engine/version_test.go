@@ -334,3 +334,25 @@ func TestVersionCurrentDBVersionsInternal(t *testing.T) {
 		t.Errorf("Internal: Versions mismatch")
 	}
 }
+
+func TestVersionAccountsDecimalBalances(t *testing.T) {
+	if vrs := CurrentDataDBVersions()[utils.Accounts]; vrs != 3 {
+		t.Errorf("expected accounts version 3, received: %d", vrs)
+	}
+	// v4 accounts are still usable with decimal_balances disabled
+	if msg := (Versions{utils.Accounts: 4}).Compare(Versions{utils.Accounts: 3},
+		utils.MetaRedis, true); msg != utils.EmptyString {
+		t.Errorf("expected no migration, received: %s", msg)
+	}
+	SetDecimalBalances(true)
+	defer SetDecimalBalances(false)
+	if vrs := CurrentDataDBVersions()[utils.Accounts]; vrs != 4 {
+		t.Errorf("expected accounts version 4, received: %d", vrs)
+	}
+	vrs := CurrentDataDBVersions()
+	vrs[utils.Accounts] = 3
+	if msg := vrs.Compare(CurrentDataDBVersions(),
+		utils.MetaRedis, true); msg != "cgr-migrator -exec=*accounts" {
+		t.Errorf("expected accounts migration, received: %s", msg)
+	}
+}
@@ -0,0 +1,301 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ers
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cgrates/cgrates/agents"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/linkedin/goavro/v2"
+)
+
+func NewAvroFileER(cfg *config.CGRConfig, cfgIdx int,
+	rdrEvents, partialEvents chan *erEvent, rdrErr chan error,
+	fltrS *engine.FilterS, rdrExit chan struct{}) (er EventReader, err error) {
+	srcPath := cfg.ERsCfg().Readers[cfgIdx].SourcePath
+	if strings.HasSuffix(srcPath, utils.Slash) {
+		srcPath = srcPath[:len(srcPath)-1]
+	}
+	avroEr := &AvroFileER{
+		cgrCfg:        cfg,
+		cfgIdx:        cfgIdx,
+		fltrS:         fltrS,
+		sourceDir:     srcPath,
+		rdrEvents:     rdrEvents,
+		partialEvents: partialEvents,
+		rdrError:      rdrErr,
+		rdrExit:       rdrExit,
+		conReqs:       make(chan struct{}, cfg.ERsCfg().Readers[cfgIdx].ConcurrentReqs),
+	}
+	return avroEr, nil
+}
+
+// AvroFileER implements EventReader interface for .avro files
+type AvroFileER struct {
+	cgrCfg        *config.CGRConfig
+	cfgIdx        int // index of config instance within ERsCfg.Readers
+	fltrS         *engine.FilterS
+	sourceDir     string        // path to the directory monitored by the reader for new events
+	rdrEvents     chan *erEvent // channel to dispatch the events created to
+	partialEvents chan *erEvent // channel to dispatch the partial events created to
+	rdrError      chan error
+	rdrExit       chan struct{}
+	conReqs       chan struct{} // limit number of opened files
+}
+
+func (rdr *AvroFileER) Config() *config.EventReaderCfg {
+	return rdr.cgrCfg.ERsCfg().Readers[rdr.cfgIdx]
+}
+
+func (rdr *AvroFileER) serveDefault() {
+	if rdr.Config().StartDelay > 0 {
+		select {
+		case <-time.After(rdr.Config().StartDelay):
+		case <-rdr.rdrExit:
+			utils.Logger.Info(
+				fmt.Sprintf("<%s> stop monitoring path <%s>",
+					utils.ERs, rdr.sourceDir))
+			return
+		}
+	}
+	tm := time.NewTimer(0)
+	for {
+		// Not automated, process and sleep approach
+		select {
+		case <-rdr.rdrExit:
+			tm.Stop()
+			utils.Logger.Info(
+				fmt.Sprintf("<%s> stop monitoring path <%s>",
+					utils.ERs, rdr.sourceDir))
+			return
+		case <-tm.C:
+		}
+		processReaderDir(rdr.sourceDir, utils.AvroSuffix, rdr.processFile)
+		tm.Reset(rdr.Config().RunDelay)
+	}
+}
+
+func (rdr *AvroFileER) Serve() (err error) {
+	switch rdr.Config().RunDelay {
+	case time.Duration(0): // 0 disables the automatic read, maybe done per API
+		return
+	case time.Duration(-1):
+		go func() {
+			time.Sleep(rdr.Config().StartDelay)
+
+			// Ensure that files already existing in the source path are processed
+			// before the reader starts listening for filesystem change events.
+			processReaderDir(rdr.sourceDir, utils.AvroSuffix, rdr.processFile)
+
+			if err := utils.WatchDir(rdr.sourceDir, rdr.processFile,
+				utils.ERs, rdr.rdrExit); err != nil {
+				rdr.rdrError <- err
+			}
+		}()
+	default:
+		go rdr.serveDefault()
+	}
+	return
+}
+
+// processFile is called for each file in a directory and dispatches erEvents from it
+func (rdr *AvroFileER) processFile(fName string) (err error) {
+	if cap(rdr.conReqs) != 0 { // 0 goes for no limit
+		rdr.conReqs <- struct{}{} // Queue here for maxOpenFiles
+		defer func() { <-rdr.conReqs }()
+	}
+	absPath := path.Join(rdr.sourceDir, fName)
+	utils.Logger.Info(
+		fmt.Sprintf("<%s> parsing <%s>", utils.ERs, absPath))
+	var file *os.File
+	if file, err = os.Open(absPath); err != nil {
+		return
+	}
+	defer file.Close()
+	var ocfReader *goavro.OCFReader
+	if ocfReader, err = goavro.NewOCFReader(file); err != nil {
+		return
+	}
+	var schema any
+	if err = json.Unmarshal([]byte(ocfReader.Codec().Schema()), &schema); err != nil {
+		return
+	}
+
+	rowNr := 0 // This counts the rows in the file, not really number of CDRs
+	evsPosted := 0
+	timeStart := time.Now()
+	reqVars := &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{utils.MetaFileName: utils.NewLeafNode(fName), utils.MetaReaderID: utils.NewLeafNode(rdr.cgrCfg.ERsCfg().Readers[rdr.cfgIdx].ID)}}
+	for ocfReader.Scan() {
+		var record any
+		if record, err = ocfReader.Read(); err != nil {
+			return
+		}
+		rowNr++ // increment the rowNr after checking if it's not the end of file
+		reqVars.Map[utils.MetaFileLineNumber] = utils.NewLeafNode(rowNr)
+		recordMp, canCast := avroNativeValue(record, schema).(map[string]any)
+		if !canCast {
+			return fmt.Errorf("unsupported avro record <%T> in file <%s>", record, absPath)
+		}
+		agReq := agents.NewAgentRequest(
+			utils.MapStorage(recordMp), reqVars,
+			nil, nil, nil, rdr.Config().Tenant,
+			rdr.cgrCfg.GeneralCfg().DefaultTenant,
+			utils.FirstNonEmpty(rdr.Config().Timezone,
+				rdr.cgrCfg.GeneralCfg().DefaultTimezone),
+			rdr.fltrS, nil) // create an AgentRequest
+		if pass, err := rdr.fltrS.Pass(agReq.Tenant, rdr.Config().Filters,
+			agReq); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> reading file: <%s> row <%d>, ignoring due to filter error: <%s>",
+					utils.ERs, absPath, rowNr, err.Error()))
+			return err
+		} else if !pass {
+			continue
+		}
+		if err = agReq.SetFields(rdr.Config().Fields); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> reading file: <%s> row <%d>, ignoring due to error: <%s>",
+					utils.ERs, absPath, rowNr, err.Error()))
+			return
+		}
+		cgrEv := utils.NMAsCGREvent(agReq.CGRRequest, agReq.Tenant, utils.NestingSep, agReq.Opts)
+		rdrEv := rdr.rdrEvents
+		if _, isPartial := cgrEv.APIOpts[utils.PartialOpt]; isPartial {
+			rdrEv = rdr.partialEvents
+		}
+		rdrEv <- &erEvent{
+			cgrEvent: cgrEv,
+			rdrCfg:   rdr.Config(),
+		}
+		evsPosted++
+	}
+	if err = ocfReader.Err(); err != nil {
+		return
+	}
+	if rdr.Config().ProcessedPath != "" {
+		// Finished with file, move it to processed folder
+		outPath := path.Join(rdr.Config().ProcessedPath, fName)
+		if err = os.Rename(absPath, outPath); err != nil {
+			return
+		}
+	}
+
+	utils.Logger.Info(
+		fmt.Sprintf("%s finished processing file <%s>. Total records processed: %d, events posted: %d, run duration: %s",
+			utils.ERs, absPath, rowNr, evsPosted, time.Since(timeStart)))
+	return
+}
+
+// avroNativeValue unwraps the union values decoded by goavro based on the schema
+// so the fields can be referenced directly by their name
+func avroNativeValue(val, schema any) any {
+	switch sch := schema.(type) {
+	case []any: // union, decoded as map[typeName]value
+		unionMp, isMap := val.(map[string]any)
+		if !isMap || len(unionMp) != 1 {
+			return val
+		}
+		for typName, v := range unionMp {
+			for _, branch := range sch {
+				if avroTypeName(branch) == typName {
+					return avroNativeValue(v, branch)
+				}
+			}
+			return v
+		}
+	case map[string]any:
+		switch sch["type"] {
+		case "record":
+			recMp, isMap := val.(map[string]any)
+			if !isMap {
+				return val
+			}
+			flds, _ := sch["fields"].([]any)
+			for _, fld := range flds {
+				fldMp, _ := fld.(map[string]any)
+				name, _ := fldMp["name"].(string)
+				if v, has := recMp[name]; has {
+					if v = avroNativeValue(v, fldMp["type"]); v == nil { // null values are considered missing
+						delete(recMp, name)
+						continue
+					}
+					recMp[name] = v
+				}
+			}
+			return recMp
+		case "array":
+			if vals, isSlice := val.([]any); isSlice {
+				for i, v := range vals {
+					vals[i] = avroNativeValue(v, sch["items"])
+				}
+			}
+			return val
+		case "map":
+			if valsMp, isMap := val.(map[string]any); isMap {
+				for k, v := range valsMp {
+					valsMp[k] = avroNativeValue(v, sch["values"])
+				}
+			}
+			return val
+		default:
+			return avroNativeValue(val, sch["type"])
+		}
+	}
+	switch v := val.(type) {
+	case []byte:
+		return string(v)
+	case int32:
+		return int64(v)
+	case float32:
+		return float64(v)
+	}
+	return val
+}
+
+// avroTypeName returns the name used by goavro as key for the union branch
+func avroTypeName(schema any) string {
+	switch sch := schema.(type) {
+	case string:
+		return sch
+	case map[string]any:
+		typ, _ := sch["type"].(string)
+		switch typ {
+		case "record", "enum", "fixed":
+			name, _ := sch["name"].(string)
+			if ns, _ := sch["namespace"].(string); ns != utils.EmptyString &&
+				!strings.Contains(name, utils.NestingSep) {
+				name = ns + utils.NestingSep + name
+			}
+			return name
+		}
+		if lt, has := sch["logicalType"].(string); has {
+			return typ + utils.NestingSep + lt
+		}
+		return typ
+	}
+	return utils.EmptyString
+}
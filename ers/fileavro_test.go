@@ -0,0 +1,95 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+package ers
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/linkedin/goavro/v2"
+)
+
+func TestFileAvroProcessFile(t *testing.T) {
+	cfg := newFileColumnarReaderCfg(t, utils.MetaFileAvro)
+	rdrCfg := cfg.ERsCfg().Readers[0]
+	fName := "cdrs" + utils.AvroSuffix
+	f, err := os.Create(path.Join(rdrCfg.SourcePath, fName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ocfw, err := goavro.NewOCFWriter(goavro.OCFConfig{
+		W: f,
+		Schema: `{"type":"record","name":"CDR","namespace":"carrier","fields":[
+			{"name":"OriginID","type":"string"},
+			{"name":"Subscriber","type":["null",{"type":"record","name":"Subscriber","fields":[{"name":"Account","type":"string"}]}]},
+			{"name":"AnswerTime","type":{"type":"long","logicalType":"timestamp-millis"}},
+			{"name":"Cost","type":["null","double"]},
+			{"name":"Partial","type":"boolean"}]}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ansTime := time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+	var recs []any
+	for _, id := range []string{"sess1", "sess2", "sess3"} {
+		recs = append(recs, map[string]any{
+			"OriginID":   id,
+			"Subscriber": goavro.Union("carrier.Subscriber", map[string]any{"Account": "1001"}),
+			"AnswerTime": ansTime,
+			"Cost":       goavro.Union("double", 1.5),
+			"Partial":    id == "sess3",
+		})
+	}
+	recs[1].(map[string]any)["Cost"] = nil // filtered out
+	if err := ocfw.Append(recs); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	rdrEvs := make(chan *erEvent, 3)
+	partialEvs := make(chan *erEvent, 3)
+	rdr, err := NewAvroFileER(cfg, 0, rdrEvs, partialEvs, nil,
+		engine.NewFilterS(cfg, nil, nil), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rdr.(*AvroFileER).processFile(fName); err != nil {
+		t.Fatal(err)
+	}
+	checkColumnarReaderEvents(t, rdrEvs, partialEvs)
+	if _, err := os.Stat(path.Join(rdrCfg.ProcessedPath, fName)); err != nil {
+		t.Errorf("Expected the file to be moved to processed path, received: %v", err)
+	}
+}
+
+func TestFileAvroTypeName(t *testing.T) {
+	for exp, schema := range map[string]any{
+		"string":                map[string]any{"type": "string"},
+		"long":                  "long",
+		"long.timestamp-micros": map[string]any{"type": "long", "logicalType": "timestamp-micros"},
+		"carrier.Subscriber":    map[string]any{"type": "record", "name": "Subscriber", "namespace": "carrier"},
+		"array":                 map[string]any{"type": "array", "items": "string"},
+	} {
+		if rcv := avroTypeName(schema); rcv != exp {
+			t.Errorf("Expected %q, received %q", exp, rcv)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ers
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cgrates/cgrates/agents"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/parquet-go/parquet-go"
+)
+
+func NewParquetFileER(cfg *config.CGRConfig, cfgIdx int,
+	rdrEvents, partialEvents chan *erEvent, rdrErr chan error,
+	fltrS *engine.FilterS, rdrExit chan struct{}) (er EventReader, err error) {
+	srcPath := cfg.ERsCfg().Readers[cfgIdx].SourcePath
+	if strings.HasSuffix(srcPath, utils.Slash) {
+		srcPath = srcPath[:len(srcPath)-1]
+	}
+	pqEr := &ParquetFileER{
+		cgrCfg:        cfg,
+		cfgIdx:        cfgIdx,
+		fltrS:         fltrS,
+		sourceDir:     srcPath,
+		rdrEvents:     rdrEvents,
+		partialEvents: partialEvents,
+		rdrError:      rdrErr,
+		rdrExit:       rdrExit,
+		conReqs:       make(chan struct{}, cfg.ERsCfg().Readers[cfgIdx].ConcurrentReqs),
+	}
+	return pqEr, nil
+}
+
+// ParquetFileER implements EventReader interface for .parquet files
+type ParquetFileER struct {
+	cgrCfg        *config.CGRConfig
+	cfgIdx        int // index of config instance within ERsCfg.Readers
+	fltrS         *engine.FilterS
+	sourceDir     string        // path to the directory monitored by the reader for new events
+	rdrEvents     chan *erEvent // channel to dispatch the events created to
+	partialEvents chan *erEvent // channel to dispatch the partial events created to
+	rdrError      chan error
+	rdrExit       chan struct{}
+	conReqs       chan struct{} // limit number of opened files
+}
+
+func (rdr *ParquetFileER) Config() *config.EventReaderCfg {
+	return rdr.cgrCfg.ERsCfg().Readers[rdr.cfgIdx]
+}
+
+func (rdr *ParquetFileER) serveDefault() {
+	if rdr.Config().StartDelay > 0 {
+		select {
+		case <-time.After(rdr.Config().StartDelay):
+		case <-rdr.rdrExit:
+			utils.Logger.Info(
+				fmt.Sprintf("<%s> stop monitoring path <%s>",
+					utils.ERs, rdr.sourceDir))
+			return
+		}
+	}
+	tm := time.NewTimer(0)
+	for {
+		// Not automated, process and sleep approach
+		select {
+		case <-rdr.rdrExit:
+			tm.Stop()
+			utils.Logger.Info(
+				fmt.Sprintf("<%s> stop monitoring path <%s>",
+					utils.ERs, rdr.sourceDir))
+			return
+		case <-tm.C:
+		}
+		processReaderDir(rdr.sourceDir, utils.ParquetSuffix, rdr.processFile)
+		tm.Reset(rdr.Config().RunDelay)
+	}
+}
+
+func (rdr *ParquetFileER) Serve() (err error) {
+	switch rdr.Config().RunDelay {
+	case time.Duration(0): // 0 disables the automatic read, maybe done per API
+		return
+	case time.Duration(-1):
+		go func() {
+			time.Sleep(rdr.Config().StartDelay)
+
+			// Ensure that files already existing in the source path are processed
+			// before the reader starts listening for filesystem change events.
+			processReaderDir(rdr.sourceDir, utils.ParquetSuffix, rdr.processFile)
+
+			if err := utils.WatchDir(rdr.sourceDir, rdr.processFile,
+				utils.ERs, rdr.rdrExit); err != nil {
+				rdr.rdrError <- err
+			}
+		}()
+	default:
+		go rdr.serveDefault()
+	}
+	return
+}
+
+// processFile is called for each file in a directory and dispatches erEvents from it
+func (rdr *ParquetFileER) processFile(fName string) (err error) {
+	if cap(rdr.conReqs) != 0 { // 0 goes for no limit
+		rdr.conReqs <- struct{}{} // Queue here for maxOpenFiles
+		defer func() { <-rdr.conReqs }()
+	}
+	absPath := path.Join(rdr.sourceDir, fName)
+	utils.Logger.Info(
+		fmt.Sprintf("<%s> parsing <%s>", utils.ERs, absPath))
+	var file *os.File
+	if file, err = os.Open(absPath); err != nil {
+		return
+	}
+	defer file.Close()
+	var fi os.FileInfo
+	if fi, err = file.Stat(); err != nil {
+		return
+	}
+	var pqFile *parquet.File
+	if pqFile, err = parquet.OpenFile(file, fi.Size()); err != nil {
+		return
+	}
+	cols := newParquetColumns(pqFile.Schema())
+	pqReader := parquet.NewReader(pqFile)
+	defer pqReader.Close()
+
+	rowNr := 0 // This counts the rows in the file, not really number of CDRs
+	evsPosted := 0
+	timeStart := time.Now()
+	reqVars := &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{utils.MetaFileName: utils.NewLeafNode(fName), utils.MetaReaderID: utils.NewLeafNode(rdr.cgrCfg.ERsCfg().Readers[rdr.cfgIdx].ID)}}
+	rows := make([]parquet.Row, 1)
+	for {
+		var n int
+		if n, err = pqReader.ReadRows(rows); n == 0 {
+			if err == io.EOF {
+				err = nil //If it reaches the end of the file, return nil
+				break
+			}
+			if err != nil {
+				return
+			}
+			continue
+		}
+		rowNr++ // increment the rowNr after checking if it's not the end of file
+		reqVars.Map[utils.MetaFileLineNumber] = utils.NewLeafNode(rowNr)
+		agReq := agents.NewAgentRequest(
+			parquetRowAsMapStorage(rows[0], cols), reqVars,
+			nil, nil, nil, rdr.Config().Tenant,
+			rdr.cgrCfg.GeneralCfg().DefaultTenant,
+			utils.FirstNonEmpty(rdr.Config().Timezone,
+				rdr.cgrCfg.GeneralCfg().DefaultTimezone),
+			rdr.fltrS, nil) // create an AgentRequest
+		if pass, err := rdr.fltrS.Pass(agReq.Tenant, rdr.Config().Filters,
+			agReq); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> reading file: <%s> row <%d>, ignoring due to filter error: <%s>",
+					utils.ERs, absPath, rowNr, err.Error()))
+			return err
+		} else if !pass {
+			continue
+		}
+		if err = agReq.SetFields(rdr.Config().Fields); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> reading file: <%s> row <%d>, ignoring due to error: <%s>",
+					utils.ERs, absPath, rowNr, err.Error()))
+			return
+		}
+		cgrEv := utils.NMAsCGREvent(agReq.CGRRequest, agReq.Tenant, utils.NestingSep, agReq.Opts)
+		rdrEv := rdr.rdrEvents
+		if _, isPartial := cgrEv.APIOpts[utils.PartialOpt]; isPartial {
+			rdrEv = rdr.partialEvents
+		}
+		rdrEv <- &erEvent{
+			cgrEvent: cgrEv,
+			rdrCfg:   rdr.Config(),
+		}
+		evsPosted++
+	}
+	if rdr.Config().ProcessedPath != "" {
+		// Finished with file, move it to processed folder
+		outPath := path.Join(rdr.Config().ProcessedPath, fName)
+		if err = os.Rename(absPath, outPath); err != nil {
+			return
+		}
+	}
+
+	utils.Logger.Info(
+		fmt.Sprintf("%s finished processing file <%s>. Total records processed: %d, events posted: %d, run duration: %s",
+			utils.ERs, absPath, rowNr, evsPosted, time.Since(timeStart)))
+	return
+}
+
+// newParquetColumns returns the leaf columns of the schema indexed by the column index
+func newParquetColumns(schema *parquet.Schema) (cols []parquet.LeafColumn) {
+	paths := schema.Columns()
+	cols = make([]parquet.LeafColumn, len(paths))
+	for _, colPath := range paths {
+		leaf, _ := schema.Lookup(colPath...)
+		leaf.Path = colPath // the lookup only populates the leaf name
+		cols[leaf.ColumnIndex] = leaf
+	}
+	return
+}
+
+// parquetRowAsMapStorage converts the parquet row into a MapStorage
+// the nested columns are populated as nested maps while the repeated ones as slices
+func parquetRowAsMapStorage(row parquet.Row, cols []parquet.LeafColumn) (mp utils.MapStorage) {
+	mp = make(utils.MapStorage)
+	row.Range(func(colIdx int, vals []parquet.Value) bool {
+		col := cols[colIdx]
+		var val any
+		if col.MaxRepetitionLevel == 0 {
+			val = parquetNativeValue(vals[0], col.Node)
+		} else {
+			repVals := make([]any, 0, len(vals))
+			for _, v := range vals {
+				if !v.IsNull() {
+					repVals = append(repVals, parquetNativeValue(v, col.Node))
+				}
+			}
+			val = repVals
+		}
+		if val == nil {
+			return true
+		}
+		dst := map[string]any(mp)
+		for _, fld := range col.Path[:len(col.Path)-1] {
+			nested, has := dst[fld].(map[string]any)
+			if !has {
+				nested = make(map[string]any)
+				dst[fld] = nested
+			}
+			dst = nested
+		}
+		dst[col.Path[len(col.Path)-1]] = val
+		return true
+	})
+	return
+}
+
+// parquetNativeValue converts the parquet value considering the logical type of the column
+func parquetNativeValue(v parquet.Value, node parquet.Node) any {
+	if v.IsNull() {
+		return nil
+	}
+	if lt := node.Type().LogicalType(); lt != nil {
+		switch {
+		case lt.Timestamp != nil:
+			switch {
+			case lt.Timestamp.Unit.Millis != nil:
+				return time.UnixMilli(v.Int64()).UTC()
+			case lt.Timestamp.Unit.Micros != nil:
+				return time.UnixMicro(v.Int64()).UTC()
+			default:
+				return time.Unix(0, v.Int64()).UTC()
+			}
+		case lt.Date != nil:
+			return time.Unix(int64(v.Int32())*86400, 0).UTC()
+		}
+	}
+	switch v.Kind() {
+	case parquet.Boolean:
+		return v.Boolean()
+	case parquet.Int32:
+		return int64(v.Int32())
+	case parquet.Int64:
+		return v.Int64()
+	case parquet.Float:
+		return float64(v.Float())
+	case parquet.Double:
+		return v.Double()
+	default:
+		return string(v.ByteArray())
+	}
+}
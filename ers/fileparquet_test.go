@@ -0,0 +1,143 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+package ers
+
+import (
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/parquet-go/parquet-go"
+)
+
+// newFileColumnarReaderCfg returns the reader config shared by the parquet and avro tests
+func newFileColumnarReaderCfg(t *testing.T, rdrType string) *config.CGRConfig {
+	cfg := config.NewDefaultCGRConfig()
+	rdrCfg := cfg.ERsCfg().Readers[0]
+	rdrCfg.ID = "CARRIER_CDRS"
+	rdrCfg.Type = rdrType
+	rdrCfg.SourcePath = t.TempDir()
+	rdrCfg.ProcessedPath = t.TempDir()
+	rdrCfg.ConcurrentReqs = 1
+	rdrCfg.Filters = []string{"*gt:~*req.Cost:0"}
+	rdrCfg.Fields = []*config.FCTemplate{
+		{Tag: utils.OriginID, Path: utils.MetaCgreq + utils.NestingSep + utils.OriginID, Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.OriginID", utils.InfieldSep), Mandatory: true},
+		{Tag: utils.AccountField, Path: utils.MetaCgreq + utils.NestingSep + utils.AccountField, Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.Subscriber.Account", utils.InfieldSep)},
+		{Tag: utils.AnswerTime, Path: utils.MetaCgreq + utils.NestingSep + utils.AnswerTime, Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.AnswerTime", utils.InfieldSep)},
+		{Tag: utils.Cost, Path: utils.MetaCgreq + utils.NestingSep + utils.Cost, Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.Cost", utils.InfieldSep)},
+		{Tag: utils.PartialOpt, Path: utils.MetaOpts + utils.NestingSep + utils.PartialOpt, Type: utils.MetaConstant,
+			Filters: []string{"*string:~*req.Partial:true"},
+			Value:   config.NewRSRParsersMustCompile(utils.TrueStr, utils.InfieldSep)},
+	}
+	for _, fld := range rdrCfg.Fields {
+		fld.ComputePath()
+	}
+	return cfg
+}
+
+type parquetTestRecord struct {
+	OriginID   string `parquet:"OriginID"`
+	Subscriber struct {
+		Account string `parquet:"Account"`
+	} `parquet:"Subscriber"`
+	AnswerTime time.Time `parquet:"AnswerTime,timestamp(millisecond)"`
+	Cost       float64   `parquet:"Cost"`
+	Partial    bool      `parquet:"Partial"`
+}
+
+// checkColumnarReaderEvents checks the events dispatched out of the test records
+func checkColumnarReaderEvents(t *testing.T, rdrEvs, partialEvs chan *erEvent) {
+	if len(rdrEvs) != 1 || len(partialEvs) != 1 {
+		t.Fatalf("Expected 1 event and 1 partial event, received: %d and %d", len(rdrEvs), len(partialEvs))
+	}
+	rcv := (<-rdrEvs).cgrEvent
+	exp := map[string]any{
+		utils.OriginID:     "sess1",
+		utils.AccountField: "1001",
+		utils.AnswerTime:   "2024-07-01T10:00:00Z",
+		utils.Cost:         "1.5",
+	}
+	if !reflect.DeepEqual(exp, rcv.Event) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rcv.Event))
+	}
+	if rcv = (<-partialEvs).cgrEvent; rcv.Event[utils.OriginID] != "sess3" ||
+		rcv.APIOpts[utils.PartialOpt] != utils.TrueStr {
+		t.Errorf("Unexpected partial event: %s", utils.ToJSON(rcv))
+	}
+}
+
+func TestFileParquetProcessFile(t *testing.T) {
+	cfg := newFileColumnarReaderCfg(t, utils.MetaFileParquet)
+	rdrCfg := cfg.ERsCfg().Readers[0]
+	ansTime := time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+	recs := make([]parquetTestRecord, 3)
+	for i, id := range []string{"sess1", "sess2", "sess3"} {
+		recs[i].OriginID = id
+		recs[i].Subscriber.Account = "1001"
+		recs[i].AnswerTime = ansTime
+		recs[i].Cost = 1.5
+	}
+	recs[1].Cost = 0 // filtered out
+	recs[2].Partial = true
+	fName := "cdrs" + utils.ParquetSuffix
+	if err := parquet.WriteFile(path.Join(rdrCfg.SourcePath, fName), recs); err != nil {
+		t.Fatal(err)
+	}
+	rdrEvs := make(chan *erEvent, 3)
+	partialEvs := make(chan *erEvent, 3)
+	rdr, err := NewParquetFileER(cfg, 0, rdrEvs, partialEvs, nil,
+		engine.NewFilterS(cfg, nil, nil), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rdr.(*ParquetFileER).processFile(fName); err != nil {
+		t.Fatal(err)
+	}
+	checkColumnarReaderEvents(t, rdrEvs, partialEvs)
+	if _, err := os.Stat(path.Join(rdrCfg.ProcessedPath, fName)); err != nil {
+		t.Errorf("Expected the file to be moved to processed path, received: %v", err)
+	}
+}
+
+func TestFileParquetProcessFileError(t *testing.T) {
+	cfg := newFileColumnarReaderCfg(t, utils.MetaFileParquet)
+	rdrCfg := cfg.ERsCfg().Readers[0]
+	fName := "invalid" + utils.ParquetSuffix
+	if err := os.WriteFile(path.Join(rdrCfg.SourcePath, fName), []byte("not parquet"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	rdr, err := NewParquetFileER(cfg, 0, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rdr.(*ParquetFileER).processFile(fName); err == nil {
+		t.Error("Expected error for invalid parquet file")
+	}
+	if _, err := os.Stat(path.Join(rdrCfg.SourcePath, fName)); err != nil {
+		t.Errorf("Expected the file to remain in source path, received: %v", err)
+	}
+}
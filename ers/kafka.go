@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/cgrates/cgrates/agents"
@@ -34,6 +35,7 @@ import (
 	"github.com/cgrates/cgrates/utils"
 
 	kafka "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
 )
 
 // NewKafkaER return a new kafka event reader
@@ -74,6 +76,7 @@ type KafkaER struct {
 	tls           bool   // if true it will attempt to authenticate the server it connects to
 	caPath        string // path to CA pem file
 	skipTLSVerify bool   // if true it skips certificate validation
+	saslMechanism sasl.Mechanism
 
 	rdrEvents     chan *erEvent // channel to dispatch the events created to
 	partialEvents chan *erEvent // channel to dispatch the partial events created to
@@ -96,6 +99,13 @@ func (rdr *KafkaER) Serve() (err error) {
 		MaxWait: rdr.maxWait,
 	}
 
+	if rdr.tls || rdr.saslMechanism != nil {
+		readerCfg.Dialer = &kafka.Dialer{
+			Timeout:       10 * time.Second,
+			DualStack:     true,
+			SASLMechanism: rdr.saslMechanism,
+		}
+	}
 	if rdr.tls {
 		var rootCAs *x509.CertPool
 		if rootCAs, err = x509.SystemCertPool(); err != nil {
@@ -113,13 +123,9 @@ func (rdr *KafkaER) Serve() (err error) {
 				return
 			}
 		}
-		readerCfg.Dialer = &kafka.Dialer{
-			Timeout:   10 * time.Second,
-			DualStack: true,
-			TLS: &tls.Config{
-				RootCAs:            rootCAs,
-				InsecureSkipVerify: rdr.skipTLSVerify,
-			},
+		readerCfg.Dialer.TLS = &tls.Config{
+			RootCAs:            rootCAs,
+			InsecureSkipVerify: rdr.skipTLSVerify,
 		}
 	}
 
@@ -164,7 +170,7 @@ func (rdr *KafkaER) readLoop(r *kafka.Reader) {
 			return
 		}
 		go func(msg kafka.Message) {
-			if err := rdr.processMessage(msg.Value); err != nil {
+			if err := rdr.processMessage(msg); err != nil {
 				utils.Logger.Warning(
 					fmt.Sprintf("<%s> processing message %s error: %s",
 						utils.ERs, string(msg.Key), err.Error()))
@@ -176,9 +182,9 @@ func (rdr *KafkaER) readLoop(r *kafka.Reader) {
 	}
 }
 
-func (rdr *KafkaER) processMessage(msg []byte) (err error) {
+func (rdr *KafkaER) processMessage(msg kafka.Message) (err error) {
 	var decodedMessage map[string]any
-	if err = json.Unmarshal(msg, &decodedMessage); err != nil {
+	if err = json.Unmarshal(msg.Value, &decodedMessage); err != nil {
 		return
 	}
 
@@ -190,7 +196,7 @@ func (rdr *KafkaER) processMessage(msg []byte) (err error) {
 		rdr.cgrCfg.GeneralCfg().DefaultTenant,
 		utils.FirstNonEmpty(rdr.Config().Timezone,
 			rdr.cgrCfg.GeneralCfg().DefaultTimezone),
-		rdr.fltrS, nil) // create an AgentRequest
+		rdr.fltrS, map[string]utils.DataProvider{utils.MetaKafka: kafkaMessageDP(msg)}) // create an AgentRequest
 	var pass bool
 	if pass, err = rdr.fltrS.Pass(agReq.Tenant, rdr.Config().Filters,
 		agReq); err != nil || !pass {
@@ -211,6 +217,20 @@ func (rdr *KafkaER) processMessage(msg []byte) (err error) {
 	return
 }
 
+// kafkaMessageDP exposes the key, partition, offset and headers of the message
+func kafkaMessageDP(msg kafka.Message) utils.MapStorage {
+	hdrs := make(utils.MapStorage, len(msg.Headers))
+	for _, hdr := range msg.Headers {
+		hdrs[hdr.Key] = string(hdr.Value)
+	}
+	return utils.MapStorage{
+		utils.KafkaKey:       string(msg.Key),
+		utils.KafkaPartition: strconv.Itoa(msg.Partition),
+		utils.KafkaOffset:    strconv.FormatInt(msg.Offset, 10),
+		utils.KafkaHeadersDP: hdrs,
+	}
+}
+
 func (rdr *KafkaER) setOpts(opts *config.EventReaderOpts) (err error) {
 	rdr.topic = utils.KafkaDefaultTopic
 	rdr.groupID = utils.KafkaDefaultGroupID
@@ -234,6 +254,20 @@ func (rdr *KafkaER) setOpts(opts *config.EventReaderOpts) (err error) {
 		if kfkOpts.SkipTLSVerify != nil && *kfkOpts.SkipTLSVerify {
 			rdr.skipTLSVerify = true
 		}
+		var saslMechanism, saslUsername, saslPassword string
+		if kfkOpts.SASLMechanism != nil {
+			saslMechanism = *kfkOpts.SASLMechanism
+		}
+		if kfkOpts.SASLUsername != nil {
+			saslUsername = *kfkOpts.SASLUsername
+		}
+		if kfkOpts.SASLPassword != nil {
+			saslPassword = *kfkOpts.SASLPassword
+		}
+		if rdr.saslMechanism, err = utils.NewKafkaSASLMechanism(saslMechanism,
+			saslUsername, saslPassword); err != nil {
+			return
+		}
 	}
 	return
 }
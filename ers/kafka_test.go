@@ -26,6 +26,7 @@ import (
 	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
+	kafka "github.com/segmentio/kafka-go"
 )
 
 func TestKafkasetOpts(t *testing.T) {
@@ -179,7 +180,7 @@ func TestKafkaERProcessMessage(t *testing.T) {
 	rdr.Config().Fields[0].ComputePath()
 
 	msg := []byte(`{"test":"input"}`)
-	if err := rdr.processMessage(msg); err != nil {
+	if err := rdr.processMessage(kafka.Message{Value: msg}); err != nil {
 		t.Error(err)
 	}
 	select {
@@ -214,7 +215,7 @@ func TestKafkaERProcessMessageError1(t *testing.T) {
 	}
 	msg := []byte(`{"test":"input"}`)
 	errExpect := "unsupported type: <>"
-	if err := rdr.processMessage(msg); err == nil || err.Error() != errExpect {
+	if err := rdr.processMessage(kafka.Message{Value: msg}); err == nil || err.Error() != errExpect {
 		t.Errorf("Expected %v but received %v", errExpect, err)
 	}
 }
@@ -240,7 +241,7 @@ func TestKafkaERProcessMessageError2(t *testing.T) {
 	rdr.Config().Filters = []string{"Filter1"}
 	msg := []byte(`{"test":"input"}`)
 	errExpect := "NOT_FOUND:Filter1"
-	if err := rdr.processMessage(msg); err == nil || err.Error() != errExpect {
+	if err := rdr.processMessage(kafka.Message{Value: msg}); err == nil || err.Error() != errExpect {
 		t.Errorf("Expected %v but received %v", errExpect, err)
 	}
 }
@@ -262,7 +263,82 @@ func TestKafkaERProcessMessageError3(t *testing.T) {
 	}
 	msg := []byte(`{"invalid":"input"`)
 	errExpect := "unexpected end of JSON input"
-	if err := rdr.processMessage(msg); err == nil || err.Error() != errExpect {
+	if err := rdr.processMessage(kafka.Message{Value: msg}); err == nil || err.Error() != errExpect {
 		t.Errorf("Expected %v but received %v", errExpect, err)
 	}
 }
+
+func TestKafkaERProcessMessageKafkaDP(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	rdr := &KafkaER{
+		cgrCfg:    cfg,
+		cfgIdx:    0,
+		fltrS:     new(engine.FilterS),
+		rdrEvents: make(chan *erEvent, 1),
+		rdrExit:   make(chan struct{}, 1),
+		rdrErr:    make(chan error, 1),
+		cap:       make(chan struct{}, 1),
+	}
+	rdr.Config().Fields = []*config.FCTemplate{
+		{
+			Tag:   "Account",
+			Type:  utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*kafka.Key", utils.InfieldSep),
+			Path:  "*cgreq.Account",
+		},
+		{
+			Tag:   "Partition",
+			Type:  utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*kafka.Partition", utils.InfieldSep),
+			Path:  "*cgreq.Partition",
+		},
+		{
+			Tag:   "Offset",
+			Type:  utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*kafka.Offset", utils.InfieldSep),
+			Path:  "*cgreq.Offset",
+		},
+		{
+			Tag:   "Origin",
+			Type:  utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*kafka.Headers.Origin", utils.InfieldSep),
+			Path:  "*opts.Origin",
+		},
+	}
+	for _, fld := range rdr.Config().Fields {
+		fld.ComputePath()
+	}
+	msg := kafka.Message{
+		Key:       []byte("1001"),
+		Value:     []byte(`{"test":"input"}`),
+		Partition: 2,
+		Offset:    10,
+		Headers: []kafka.Header{
+			{Key: "Origin", Value: []byte("node1")},
+		},
+	}
+	if err := rdr.processMessage(msg); err != nil {
+		t.Fatal(err)
+	}
+	expEvent := &utils.CGREvent{
+		Tenant: "cgrates.org",
+		Event: map[string]any{
+			utils.AccountField: "1001",
+			"Partition":        "2",
+			"Offset":           "10",
+		},
+		APIOpts: map[string]any{
+			"Origin": "node1",
+		},
+	}
+	select {
+	case data := <-rdr.rdrEvents:
+		expEvent.ID = data.cgrEvent.ID
+		expEvent.Time = data.cgrEvent.Time
+		if !reflect.DeepEqual(data.cgrEvent, expEvent) {
+			t.Errorf("Expected %v but received %v", utils.ToJSON(expEvent), utils.ToJSON(data.cgrEvent))
+		}
+	case <-time.After(50 * time.Millisecond):
+		t.Error("Time limit exceeded")
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cgrates/cgrates/agents"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// NewMQTTER return a new MQTT event reader
+func NewMQTTER(cfg *config.CGRConfig, cfgIdx int,
+	rdrEvents, partialEvents chan *erEvent, rdrErr chan error,
+	fltrS *engine.FilterS, rdrExit chan struct{}) (EventReader, error) {
+	rdr := &MQTTER{
+		cgrCfg:        cfg,
+		cfgIdx:        cfgIdx,
+		fltrS:         fltrS,
+		rdrEvents:     rdrEvents,
+		partialEvents: partialEvents,
+		rdrExit:       rdrExit,
+		rdrErr:        rdrErr,
+	}
+	if concReq := rdr.Config().ConcurrentReqs; concReq != -1 {
+		rdr.cap = make(chan struct{}, concReq)
+	}
+	if err := rdr.processOpts(); err != nil {
+		return nil, err
+	}
+	return rdr, nil
+}
+
+// MQTTER implements EventReader interface for MQTT messages
+type MQTTER struct {
+	cgrCfg *config.CGRConfig
+	cfgIdx int // index of config instance within ERsCfg.Readers
+	fltrS  *engine.FilterS
+
+	rdrEvents     chan *erEvent // channel to dispatch the events created to
+	partialEvents chan *erEvent // channel to dispatch the partial events created to
+	rdrExit       chan struct{}
+	rdrErr        chan error
+	cap           chan struct{}
+
+	topic      string // topic filter, including the shared subscription prefix
+	qos        byte
+	clientOpts *mqtt.ClientOptions
+}
+
+// Config returns the curent configuration
+func (rdr *MQTTER) Config() *config.EventReaderCfg {
+	return rdr.cgrCfg.ERsCfg().Readers[rdr.cfgIdx]
+}
+
+// Serve will connect to the broker and subscribe to the topic, processing the incoming
+// messages until the rdrExit channel will be closed.
+func (rdr *MQTTER) Serve() error {
+	// subscribe on each (re)connect since the subscriptions are not kept by the client
+	rdr.clientOpts.SetOnConnectHandler(func(c mqtt.Client) {
+		if err := utils.WaitMQTTToken(c.Subscribe(rdr.topic, rdr.qos, rdr.handleMessage),
+			rdr.clientOpts.ConnectTimeout); err != nil {
+			rdr.rdrErr <- err
+		}
+	})
+	client := mqtt.NewClient(rdr.clientOpts)
+	go func() {
+		time.Sleep(rdr.Config().StartDelay)
+		if err := utils.WaitMQTTToken(client.Connect(), rdr.clientOpts.ConnectTimeout); err != nil {
+			rdr.rdrErr <- err
+		}
+	}()
+	go func() {
+		// Wait for exit signal.
+		<-rdr.rdrExit
+		utils.Logger.Info(
+			fmt.Sprintf("<%s> stop monitoring mqtt path <%s>",
+				utils.ERs, rdr.Config().SourcePath))
+		client.Disconnect(250)
+	}()
+	return nil
+}
+
+func (rdr *MQTTER) handleMessage(_ mqtt.Client, msg mqtt.Message) {
+	// If the rdr.cap channel buffer is empty, block until a resource is available.
+	if rdr.Config().ConcurrentReqs != -1 {
+		rdr.cap <- struct{}{}
+	}
+	go func() {
+		if err := rdr.processMessage(msg); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> processing message %s error: %s",
+					utils.ERs, string(msg.Payload()), err.Error()))
+		}
+		if rdr.Config().ConcurrentReqs != -1 {
+			<-rdr.cap
+		}
+	}()
+}
+
+func (rdr *MQTTER) processMessage(msg mqtt.Message) (err error) {
+	var decodedMessage map[string]any
+	if err = json.Unmarshal(msg.Payload(), &decodedMessage); err != nil {
+		return
+	}
+
+	reqVars := &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{utils.MetaReaderID: utils.NewLeafNode(rdr.cgrCfg.ERsCfg().Readers[rdr.cfgIdx].ID)}}
+
+	agReq := agents.NewAgentRequest(
+		utils.MapStorage(decodedMessage), reqVars,
+		nil, nil, nil, rdr.Config().Tenant,
+		rdr.cgrCfg.GeneralCfg().DefaultTenant,
+		utils.FirstNonEmpty(rdr.Config().Timezone,
+			rdr.cgrCfg.GeneralCfg().DefaultTimezone),
+		rdr.fltrS, map[string]utils.DataProvider{utils.MetaMQTT: mqttMessageDP(msg)}) // create an AgentRequest
+	var pass bool
+	if pass, err = rdr.fltrS.Pass(agReq.Tenant, rdr.Config().Filters,
+		agReq); err != nil || !pass {
+		return
+	}
+	if err = agReq.SetFields(rdr.Config().Fields); err != nil {
+		return
+	}
+	cgrEv := utils.NMAsCGREvent(agReq.CGRRequest, agReq.Tenant, utils.NestingSep, agReq.Opts)
+	rdrEv := rdr.rdrEvents
+	if _, isPartial := cgrEv.APIOpts[utils.PartialOpt]; isPartial {
+		rdrEv = rdr.partialEvents
+	}
+	rdrEv <- &erEvent{
+		cgrEvent: cgrEv,
+		rdrCfg:   rdr.Config(),
+	}
+	return
+}
+
+// mqttMessageDP exposes the topic the message was published on together with its QoS and retained flag
+func mqttMessageDP(msg mqtt.Message) utils.MapStorage {
+	return utils.MapStorage{
+		utils.MQTTMsgTopic:    msg.Topic(),
+		utils.MQTTMsgQoS:      strconv.Itoa(int(msg.Qos())),
+		utils.MQTTMsgRetained: strconv.FormatBool(msg.Retained()),
+	}
+}
+
+func (rdr *MQTTER) processOpts() (err error) {
+	connOpts := &utils.MQTTConnOpts{
+		BrokerURL:      rdr.Config().SourcePath,
+		ClientID:       utils.CGRateSLwr + rdr.cgrCfg.GeneralCfg().NodeID + rdr.Config().ID,
+		ConnectTimeout: rdr.cgrCfg.GeneralCfg().ConnectTimeout,
+	}
+	rdr.topic = utils.DefaultQueueID
+	if opts := rdr.Config().Opts.MQTT; opts != nil {
+		if opts.Topic != nil {
+			rdr.topic = *opts.Topic
+		}
+		if opts.QoS != nil {
+			rdr.qos = byte(*opts.QoS)
+		}
+		if opts.SharedGroup != nil && *opts.SharedGroup != utils.EmptyString {
+			rdr.topic = utils.MQTTSharedPrefix + *opts.SharedGroup + utils.Slash + rdr.topic
+		}
+		if opts.ClientID != nil {
+			connOpts.ClientID = *opts.ClientID
+		}
+		if opts.Username != nil {
+			connOpts.Username = *opts.Username
+		}
+		if opts.Password != nil {
+			connOpts.Password = *opts.Password
+		}
+		if opts.CertificateAuthority != nil {
+			connOpts.CertificateAuthority = *opts.CertificateAuthority
+		}
+		if opts.ClientCertificate != nil {
+			connOpts.ClientCertificate = *opts.ClientCertificate
+		}
+		if opts.ClientKey != nil {
+			connOpts.ClientKey = *opts.ClientKey
+		}
+		if opts.SkipTLSVerify != nil {
+			connOpts.SkipTLSVerify = *opts.SkipTLSVerify
+		}
+	}
+	rdr.clientOpts, err = connOpts.ClientOptions()
+	return
+}
@@ -0,0 +1,304 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ers
+
+import (
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/ees"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/eclipse/paho.mqtt.golang/packets"
+)
+
+// testMQTTBroker is a minimal MQTT 3.1.1 broker supporting QoS 0 and 1,
+// topic wildcards and shared subscriptions
+type testMQTTBroker struct {
+	ln   net.Listener
+	mu   sync.Mutex
+	subs []*testMQTTSub
+}
+
+type testMQTTSub struct {
+	conn   net.Conn
+	wMux   *sync.Mutex
+	group  string
+	filter string
+	qos    byte
+}
+
+func newTestMQTTBroker(t *testing.T) *testMQTTBroker {
+	ln, err := net.Listen(utils.TCP, "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &testMQTTBroker{ln: ln}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go b.handleConn(conn)
+		}
+	}()
+	t.Cleanup(func() { ln.Close() })
+	return b
+}
+
+func (b *testMQTTBroker) URL() string { return "tcp://" + b.ln.Addr().String() }
+
+func (b *testMQTTBroker) handleConn(conn net.Conn) {
+	defer conn.Close()
+	wMux := new(sync.Mutex)
+	write := func(p packets.ControlPacket) {
+		wMux.Lock()
+		p.Write(conn)
+		wMux.Unlock()
+	}
+	for {
+		cp, err := packets.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		switch p := cp.(type) {
+		case *packets.ConnectPacket:
+			write(packets.NewControlPacket(packets.Connack))
+		case *packets.SubscribePacket:
+			b.mu.Lock()
+			for i, filter := range p.Topics {
+				sub := &testMQTTSub{conn: conn, wMux: wMux, filter: filter, qos: p.Qoss[i]}
+				if strings.HasPrefix(filter, utils.MQTTSharedPrefix) {
+					grpFltr := strings.SplitN(strings.TrimPrefix(filter, utils.MQTTSharedPrefix), utils.Slash, 2)
+					sub.group, sub.filter = grpFltr[0], grpFltr[1]
+				}
+				b.subs = append(b.subs, sub)
+			}
+			b.mu.Unlock()
+			suback := packets.NewControlPacket(packets.Suback).(*packets.SubackPacket)
+			suback.MessageID = p.MessageID
+			suback.ReturnCodes = p.Qoss
+			write(suback)
+		case *packets.PublishPacket:
+			if p.Qos == 1 {
+				puback := packets.NewControlPacket(packets.Puback).(*packets.PubackPacket)
+				puback.MessageID = p.MessageID
+				write(puback)
+			}
+			b.route(p)
+		case *packets.PingreqPacket:
+			write(packets.NewControlPacket(packets.Pingresp))
+		case *packets.DisconnectPacket:
+			return
+		}
+	}
+}
+
+// route delivers the message to the matching subscribers, once per shared group
+func (b *testMQTTBroker) route(p *packets.PublishPacket) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delivered := make(map[string]bool)
+	for _, sub := range b.subs {
+		if !testMQTTTopicMatch(sub.filter, p.TopicName) {
+			continue
+		}
+		if sub.group != utils.EmptyString {
+			if delivered[sub.group] {
+				continue
+			}
+			delivered[sub.group] = true
+		}
+		pub := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+		pub.TopicName = p.TopicName
+		pub.Payload = p.Payload
+		pub.Qos = min(sub.qos, p.Qos)
+		if pub.Qos != 0 {
+			pub.MessageID = 1
+		}
+		sub.wMux.Lock()
+		pub.Write(sub.conn)
+		sub.wMux.Unlock()
+	}
+}
+
+func testMQTTTopicMatch(filter, topic string) bool {
+	fltrLvls := strings.Split(filter, utils.Slash)
+	topicLvls := strings.Split(topic, utils.Slash)
+	for i, lvl := range fltrLvls {
+		if lvl == "#" {
+			return true
+		}
+		if i >= len(topicLvls) ||
+			(lvl != "+" && lvl != topicLvls[i]) {
+			return false
+		}
+	}
+	return len(fltrLvls) == len(topicLvls)
+}
+
+func TestMQTTERProcessOpts(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.GeneralCfg().NodeID = "node1"
+	rdrCfg := cfg.ERsCfg().Readers[0]
+	rdrCfg.ID = "mqtt"
+	rdrCfg.SourcePath = "tcp://127.0.0.1:1883"
+	rdrCfg.Opts = &config.EventReaderOpts{
+		MQTT: &config.MQTTROpts{
+			Topic:       utils.StringPointer("usage/+/reports"),
+			QoS:         utils.IntPointer(1),
+			SharedGroup: utils.StringPointer("cgr"),
+		},
+	}
+	rdr := &MQTTER{
+		cgrCfg: cfg,
+	}
+	if err := rdr.processOpts(); err != nil {
+		t.Fatal(err)
+	}
+	if exp := "$share/cgr/usage/+/reports"; rdr.topic != exp {
+		t.Errorf("expected %q, received %q", exp, rdr.topic)
+	}
+	if rdr.qos != 1 {
+		t.Errorf("expected qos 1, received %d", rdr.qos)
+	}
+	if exp := "cgratesnode1mqtt"; rdr.clientOpts.ClientID != exp {
+		t.Errorf("expected %q, received %q", exp, rdr.clientOpts.ClientID)
+	}
+
+	rdrCfg.Opts.MQTT.ClientCertificate = utils.StringPointer("/tmp/cert.pem")
+	if err := rdr.processOpts(); err == nil || err.Error() != "has certificate but no key" {
+		t.Errorf("expected certificate error, received %v", err)
+	}
+}
+
+func TestMQTTERServe(t *testing.T) {
+	broker := newTestMQTTBroker(t)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.ERsCfg().Readers[0] = &config.EventReaderCfg{
+		ID:             "mqtt",
+		Type:           utils.MetaMQTTjsonMap,
+		ConcurrentReqs: 1,
+		SourcePath:     broker.URL(),
+		Tenant:         config.NewRSRParsersMustCompile("cgrates.org", utils.InfieldSep),
+		Opts: &config.EventReaderOpts{
+			MQTT: &config.MQTTROpts{
+				Topic:       utils.StringPointer("usage/+/reports"),
+				QoS:         utils.IntPointer(1),
+				SharedGroup: utils.StringPointer("cgr"),
+			},
+		},
+		Fields: []*config.FCTemplate{
+			{
+				Tag:   "CGRID",
+				Type:  utils.MetaVariable,
+				Value: config.NewRSRParsersMustCompile("~*req.CGRID", utils.InfieldSep),
+				Path:  "*cgreq.CGRID",
+			},
+			{
+				Tag:   "Topic",
+				Type:  utils.MetaVariable,
+				Value: config.NewRSRParsersMustCompile("~*mqtt.Topic", utils.InfieldSep),
+				Path:  "*cgreq.Topic",
+			},
+		},
+	}
+	for _, fld := range cfg.ERsCfg().Readers[0].Fields {
+		fld.ComputePath()
+	}
+	rdrEvents := make(chan *erEvent, 1)
+	rdrErr := make(chan error, 1)
+	rdrExit := make(chan struct{})
+	rdr, err := NewMQTTER(cfg, 0, rdrEvents, make(chan *erEvent, 1),
+		rdrErr, new(engine.FilterS), rdrExit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = rdr.Serve(); err != nil {
+		t.Fatal(err)
+	}
+	defer close(rdrExit)
+	// wait for the subscription before publishing
+	if !waitForCondition(func() bool {
+		broker.mu.Lock()
+		defer broker.mu.Unlock()
+		return len(broker.subs) != 0
+	}) {
+		t.Fatal("reader did not subscribe")
+	}
+
+	eeCfg := config.NewEventExporterCfg("mqtt_ee", utils.MetaMQTTjsonMap, broker.URL(),
+		utils.MetaNone, 1, &config.EventExporterOpts{
+			MQTT: &config.MQTTOpts{
+				Topic: utils.StringPointer("usage/dev1/reports"),
+				QoS:   utils.IntPointer(1),
+			},
+		})
+	ee, err := ees.NewMQTTEE(eeCfg, "node1", time.Second, time.Second, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ee.ExportEvent([]byte(`{"CGRID":"cgrid1"}`), utils.EmptyString); err != utils.ErrDisconnected {
+		t.Errorf("expected %v, received %v", utils.ErrDisconnected, err)
+	}
+	if err = ee.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer ee.Close()
+	if err = ee.ExportEvent([]byte(`{"CGRID":"cgrid1"}`), utils.EmptyString); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err = <-rdrErr:
+		t.Fatal(err)
+	case ev := <-rdrEvents:
+		exp := &utils.CGREvent{
+			Tenant: "cgrates.org",
+			ID:     ev.cgrEvent.ID,
+			Time:   ev.cgrEvent.Time,
+			Event: map[string]any{
+				utils.CGRID: "cgrid1",
+				"Topic":     "usage/dev1/reports",
+			},
+			APIOpts: map[string]any{},
+		}
+		if !reflect.DeepEqual(ev.cgrEvent, exp) {
+			t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(ev.cgrEvent))
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for the event")
+	}
+}
+
+func waitForCondition(cond func() bool) bool {
+	for i := 0; i < 100; i++ {
+		if cond() {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
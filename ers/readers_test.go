@@ -203,6 +203,46 @@ func TestNewJSONReader(t *testing.T) {
 	}
 }
 
+func TestNewParquetReader(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	fltr := &engine.FilterS{}
+	cfg.ERsCfg().Readers[0].Type = utils.MetaFileParquet
+	expected, err := NewParquetFileER(cfg, 0, nil, nil, nil, fltr, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	rcv, err := NewEventReader(cfg, 0, nil, nil, nil, fltr, nil, nil)
+	if err != nil {
+		t.Error(err)
+	} else {
+		rcv.(*ParquetFileER).conReqs = nil
+		expected.(*ParquetFileER).conReqs = nil
+		if !reflect.DeepEqual(expected, rcv) {
+			t.Errorf("Expecting %v but received %v", expected, rcv)
+		}
+	}
+}
+
+func TestNewAvroReader(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	fltr := &engine.FilterS{}
+	cfg.ERsCfg().Readers[0].Type = utils.MetaFileAvro
+	expected, err := NewAvroFileER(cfg, 0, nil, nil, nil, fltr, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	rcv, err := NewEventReader(cfg, 0, nil, nil, nil, fltr, nil, nil)
+	if err != nil {
+		t.Error(err)
+	} else {
+		rcv.(*AvroFileER).conReqs = nil
+		expected.(*AvroFileER).conReqs = nil
+		if !reflect.DeepEqual(expected, rcv) {
+			t.Errorf("Expecting %v but received %v", expected, rcv)
+		}
+	}
+}
+
 func TestNewAMQPReader(t *testing.T) {
 	cfg := config.NewDefaultCGRConfig()
 	fltr := &engine.FilterS{}
@@ -25,7 +25,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/cgrates/cgrates/agents"
@@ -89,19 +88,10 @@ func (rdr *S3ER) Config() *config.EventReaderCfg {
 
 // Serve will start the gorutines needed to watch the s3 topic
 func (rdr *S3ER) Serve() (err error) {
-	var sess *session.Session
-	cfg := aws.Config{Endpoint: aws.String(rdr.Config().SourcePath)}
-	if len(rdr.awsRegion) != 0 {
-		cfg.Region = aws.String(rdr.awsRegion)
-	}
-	if len(rdr.awsID) != 0 &&
-		len(rdr.awsKey) != 0 {
-		cfg.Credentials = credentials.NewStaticCredentials(rdr.awsID, rdr.awsKey, rdr.awsToken)
-	}
-	if sess, err = session.NewSessionWithOptions(session.Options{Config: cfg}); err != nil {
+	if rdr.session, err = engine.NewAWSSession(rdr.Config().SourcePath,
+		rdr.awsRegion, rdr.awsID, rdr.awsKey, rdr.awsToken); err != nil {
 		return
 	}
-	rdr.session = sess
 
 	if rdr.Config().RunDelay == time.Duration(0) { // 0 disables the automatic read, maybe done per API
 		return
@@ -25,7 +25,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/cgrates/cgrates/agents"
@@ -251,18 +250,7 @@ func (rdr *SQSER) readMsg(scv sqsClient, msg *sqs.Message) (err error) {
 }
 
 func (rdr *SQSER) newSession() (err error) {
-	cfg := aws.Config{Endpoint: aws.String(rdr.Config().SourcePath)}
-	if len(rdr.awsRegion) != 0 {
-		cfg.Region = aws.String(rdr.awsRegion)
-	}
-	if len(rdr.awsID) != 0 &&
-		len(rdr.awsKey) != 0 {
-		cfg.Credentials = credentials.NewStaticCredentials(rdr.awsID, rdr.awsKey, rdr.awsToken)
-	}
-	rdr.session, err = session.NewSessionWithOptions(
-		session.Options{
-			Config: cfg,
-		},
-	)
+	rdr.session, err = engine.NewAWSSession(rdr.Config().SourcePath,
+		rdr.awsRegion, rdr.awsID, rdr.awsKey, rdr.awsToken)
 	return
 }
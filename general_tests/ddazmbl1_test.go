@@ -70,7 +70,7 @@ TOPUP10_AT,TOPUP10_AC1,ASAP,10`
 			destinationRates, ratingPlans, ratingProfiles,
 			sharedGroups, actions, actionPlans, actionTriggers, accountActions,
 			resLimits, stats, trends, rankings, thresholds, filters, suppliers,
-			attrProfiles, chargerProfiles, ``, ""), "", "", nil, nil, false)
+			attrProfiles, chargerProfiles, ``, "", ""), "", "", nil, nil, false)
 	if err != nil {
 		t.Error(err)
 	}
@@ -0,0 +1,950 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: cgrates.proto
+
+package grpcapi
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CGREvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant  string           `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Id      string           `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Event   *structpb.Struct `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	ApiOpts *structpb.Struct `protobuf:"bytes,4,opt,name=api_opts,json=apiOpts,proto3" json:"api_opts,omitempty"`
+	Flags   []string         `protobuf:"bytes,5,rep,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *CGREvent) Reset() {
+	*x = CGREvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cgrates_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CGREvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CGREvent) ProtoMessage() {}
+
+func (x *CGREvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cgrates_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CGREvent.ProtoReflect.Descriptor instead.
+func (*CGREvent) Descriptor() ([]byte, []int) {
+	return file_cgrates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CGREvent) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *CGREvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CGREvent) GetEvent() *structpb.Struct {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CGREvent) GetApiOpts() *structpb.Struct {
+	if x != nil {
+		return x.ApiOpts
+	}
+	return nil
+}
+
+func (x *CGREvent) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type TenantID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant  string           `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Id      string           `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ApiOpts *structpb.Struct `protobuf:"bytes,3,opt,name=api_opts,json=apiOpts,proto3" json:"api_opts,omitempty"`
+}
+
+func (x *TenantID) Reset() {
+	*x = TenantID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cgrates_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantID) ProtoMessage() {}
+
+func (x *TenantID) ProtoReflect() protoreflect.Message {
+	mi := &file_cgrates_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantID.ProtoReflect.Descriptor instead.
+func (*TenantID) Descriptor() ([]byte, []int) {
+	return file_cgrates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TenantID) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *TenantID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TenantID) GetApiOpts() *structpb.Struct {
+	if x != nil {
+		return x.ApiOpts
+	}
+	return nil
+}
+
+type AccountArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant          string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Account         string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	ReloadScheduler bool   `protobuf:"varint,3,opt,name=reload_scheduler,json=reloadScheduler,proto3" json:"reload_scheduler,omitempty"`
+}
+
+func (x *AccountArgs) Reset() {
+	*x = AccountArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cgrates_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountArgs) ProtoMessage() {}
+
+func (x *AccountArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_cgrates_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountArgs.ProtoReflect.Descriptor instead.
+func (*AccountArgs) Descriptor() ([]byte, []int) {
+	return file_cgrates_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountArgs) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *AccountArgs) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AccountArgs) GetReloadScheduler() bool {
+	if x != nil {
+		return x.ReloadScheduler
+	}
+	return false
+}
+
+type SetAccountArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant           string          `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Account          string          `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	ActionPlanId     string          `protobuf:"bytes,3,opt,name=action_plan_id,json=actionPlanId,proto3" json:"action_plan_id,omitempty"`
+	ActionTriggersId string          `protobuf:"bytes,4,opt,name=action_triggers_id,json=actionTriggersId,proto3" json:"action_triggers_id,omitempty"`
+	ExtraOptions     map[string]bool `protobuf:"bytes,5,rep,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ReloadScheduler  bool            `protobuf:"varint,6,opt,name=reload_scheduler,json=reloadScheduler,proto3" json:"reload_scheduler,omitempty"`
+}
+
+func (x *SetAccountArgs) Reset() {
+	*x = SetAccountArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cgrates_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAccountArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountArgs) ProtoMessage() {}
+
+func (x *SetAccountArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_cgrates_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountArgs.ProtoReflect.Descriptor instead.
+func (*SetAccountArgs) Descriptor() ([]byte, []int) {
+	return file_cgrates_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetAccountArgs) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *SetAccountArgs) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *SetAccountArgs) GetActionPlanId() string {
+	if x != nil {
+		return x.ActionPlanId
+	}
+	return ""
+}
+
+func (x *SetAccountArgs) GetActionTriggersId() string {
+	if x != nil {
+		return x.ActionTriggersId
+	}
+	return ""
+}
+
+func (x *SetAccountArgs) GetExtraOptions() map[string]bool {
+	if x != nil {
+		return x.ExtraOptions
+	}
+	return nil
+}
+
+func (x *SetAccountArgs) GetReloadScheduler() bool {
+	if x != nil {
+		return x.ReloadScheduler
+	}
+	return false
+}
+
+type GetAccountsArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant     string          `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	AccountIds []string        `protobuf:"bytes,2,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	Offset     int64           `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit      int64           `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Filter     map[string]bool `protobuf:"bytes,5,rep,name=filter,proto3" json:"filter,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetAccountsArgs) Reset() {
+	*x = GetAccountsArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cgrates_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountsArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsArgs) ProtoMessage() {}
+
+func (x *GetAccountsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_cgrates_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsArgs.ProtoReflect.Descriptor instead.
+func (*GetAccountsArgs) Descriptor() ([]byte, []int) {
+	return file_cgrates_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAccountsArgs) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetAccountsArgs) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *GetAccountsArgs) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetAccountsArgs) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAccountsArgs) GetFilter() map[string]bool {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type BalanceArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant          string           `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Account         string           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	BalanceType     string           `protobuf:"bytes,3,opt,name=balance_type,json=balanceType,proto3" json:"balance_type,omitempty"`
+	Value           float64          `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	Balance         *structpb.Struct `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	ActionExtraData *structpb.Struct `protobuf:"bytes,6,opt,name=action_extra_data,json=actionExtraData,proto3" json:"action_extra_data,omitempty"`
+	Overwrite       bool             `protobuf:"varint,7,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	Cdrlog          bool             `protobuf:"varint,8,opt,name=cdrlog,proto3" json:"cdrlog,omitempty"`
+}
+
+func (x *BalanceArgs) Reset() {
+	*x = BalanceArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cgrates_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceArgs) ProtoMessage() {}
+
+func (x *BalanceArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_cgrates_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceArgs.ProtoReflect.Descriptor instead.
+func (*BalanceArgs) Descriptor() ([]byte, []int) {
+	return file_cgrates_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BalanceArgs) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *BalanceArgs) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *BalanceArgs) GetBalanceType() string {
+	if x != nil {
+		return x.BalanceType
+	}
+	return ""
+}
+
+func (x *BalanceArgs) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *BalanceArgs) GetBalance() *structpb.Struct {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *BalanceArgs) GetActionExtraData() *structpb.Struct {
+	if x != nil {
+		return x.ActionExtraData
+	}
+	return nil
+}
+
+func (x *BalanceArgs) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+func (x *BalanceArgs) GetCdrlog() bool {
+	if x != nil {
+		return x.Cdrlog
+	}
+	return false
+}
+
+type Reply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reply *structpb.Value `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Error string          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *Reply) Reset() {
+	*x = Reply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cgrates_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reply) ProtoMessage() {}
+
+func (x *Reply) ProtoReflect() protoreflect.Message {
+	mi := &file_cgrates_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reply.ProtoReflect.Descriptor instead.
+func (*Reply) Descriptor() ([]byte, []int) {
+	return file_cgrates_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Reply) GetReply() *structpb.Value {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *Reply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_cgrates_proto protoreflect.FileDescriptor
+
+var file_cgrates_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x01, 0x0a, 0x08, 0x43, 0x47,
+	0x52, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a,
+	0x08, 0x61, 0x70, 0x69, 0x5f, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x61, 0x70, 0x69, 0x4f, 0x70, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x66, 0x0a, 0x08, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x61,
+	0x70, 0x69, 0x5f, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x61, 0x70, 0x69, 0x4f, 0x70, 0x74, 0x73, 0x22,
+	0x6a, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x22, 0xd5, 0x02, 0x0a, 0x0e,
+	0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x73, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x1a, 0x3f, 0x0a, 0x11, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xf4, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3f,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a,
+	0x39, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6, 0x02, 0x0a, 0x0b, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0f, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x64, 0x72, 0x6c, 0x6f, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x64, 0x72,
+	0x6c, 0x6f, 0x67, 0x22, 0x4b, 0x0a, 0x05, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x05,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x32, 0xa5, 0x03, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x76, 0x31, 0x12,
+	0x39, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x14, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x47, 0x52, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x0f, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e,
+	0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e,
+	0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3b, 0x0a, 0x10, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72,
+	0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a,
+	0x0a, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x43, 0x44, 0x52, 0x12, 0x14, 0x2e, 0x63, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x37, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0x7f, 0x0a, 0x06, 0x43, 0x44, 0x52, 0x73,
+	0x56, 0x31, 0x12, 0x37, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x0d, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x63,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x28, 0x01, 0x30, 0x01, 0x32, 0x88, 0x01, 0x0a, 0x0c, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x76, 0x31, 0x12, 0x3f, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x63, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x32, 0x88, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x76, 0x31, 0x12, 0x3f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x63,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x63, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x11, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x63, 0x67, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a,
+	0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32,
+	0x84, 0x02, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x74, 0x53, 0x76, 0x31, 0x12, 0x37, 0x0a, 0x0c, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x63, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x40, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e,
+	0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x40, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12,
+	0x14, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x63, 0x67, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x47, 0x52, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a,
+	0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x28, 0x01, 0x30, 0x01, 0x32, 0xf3, 0x02, 0x0a, 0x08, 0x41, 0x50, 0x49, 0x65, 0x72,
+	0x53, 0x76, 0x31, 0x12, 0x38, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x17, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72,
+	0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a,
+	0x0a, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x63, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x0d, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x72, 0x67, 0x73, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41,
+	0x72, 0x67, 0x73, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x11, 0x2e,
+	0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x62, 0x69, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x17, 0x2e, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x11, 0x2e, 0x63, 0x67, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x24, 0x5a, 0x22,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x2f, 0x63, 0x67, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x61,
+	0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cgrates_proto_rawDescOnce sync.Once
+	file_cgrates_proto_rawDescData = file_cgrates_proto_rawDesc
+)
+
+func file_cgrates_proto_rawDescGZIP() []byte {
+	file_cgrates_proto_rawDescOnce.Do(func() {
+		file_cgrates_proto_rawDescData = protoimpl.X.CompressGZIP(file_cgrates_proto_rawDescData)
+	})
+	return file_cgrates_proto_rawDescData
+}
+
+var file_cgrates_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cgrates_proto_goTypes = []any{
+	(*CGREvent)(nil),        // 0: cgrates.v1.CGREvent
+	(*TenantID)(nil),        // 1: cgrates.v1.TenantID
+	(*AccountArgs)(nil),     // 2: cgrates.v1.AccountArgs
+	(*SetAccountArgs)(nil),  // 3: cgrates.v1.SetAccountArgs
+	(*GetAccountsArgs)(nil), // 4: cgrates.v1.GetAccountsArgs
+	(*BalanceArgs)(nil),     // 5: cgrates.v1.BalanceArgs
+	(*Reply)(nil),           // 6: cgrates.v1.Reply
+	nil,                     // 7: cgrates.v1.SetAccountArgs.ExtraOptionsEntry
+	nil,                     // 8: cgrates.v1.GetAccountsArgs.FilterEntry
+	(*structpb.Struct)(nil), // 9: google.protobuf.Struct
+	(*structpb.Value)(nil),  // 10: google.protobuf.Value
+}
+var file_cgrates_proto_depIdxs = []int32{
+	9,  // 0: cgrates.v1.CGREvent.event:type_name -> google.protobuf.Struct
+	9,  // 1: cgrates.v1.CGREvent.api_opts:type_name -> google.protobuf.Struct
+	9,  // 2: cgrates.v1.TenantID.api_opts:type_name -> google.protobuf.Struct
+	7,  // 3: cgrates.v1.SetAccountArgs.extra_options:type_name -> cgrates.v1.SetAccountArgs.ExtraOptionsEntry
+	8,  // 4: cgrates.v1.GetAccountsArgs.filter:type_name -> cgrates.v1.GetAccountsArgs.FilterEntry
+	9,  // 5: cgrates.v1.BalanceArgs.balance:type_name -> google.protobuf.Struct
+	9,  // 6: cgrates.v1.BalanceArgs.action_extra_data:type_name -> google.protobuf.Struct
+	10, // 7: cgrates.v1.Reply.reply:type_name -> google.protobuf.Value
+	0,  // 8: cgrates.v1.SessionSv1.AuthorizeEvent:input_type -> cgrates.v1.CGREvent
+	0,  // 9: cgrates.v1.SessionSv1.InitiateSession:input_type -> cgrates.v1.CGREvent
+	0,  // 10: cgrates.v1.SessionSv1.UpdateSession:input_type -> cgrates.v1.CGREvent
+	0,  // 11: cgrates.v1.SessionSv1.TerminateSession:input_type -> cgrates.v1.CGREvent
+	0,  // 12: cgrates.v1.SessionSv1.ProcessCDR:input_type -> cgrates.v1.CGREvent
+	0,  // 13: cgrates.v1.SessionSv1.ProcessMessage:input_type -> cgrates.v1.CGREvent
+	0,  // 14: cgrates.v1.SessionSv1.ProcessEvent:input_type -> cgrates.v1.CGREvent
+	0,  // 15: cgrates.v1.CDRsV1.ProcessEvent:input_type -> cgrates.v1.CGREvent
+	0,  // 16: cgrates.v1.CDRsV1.ProcessEvents:input_type -> cgrates.v1.CGREvent
+	0,  // 17: cgrates.v1.AttributeSv1.GetAttributeForEvent:input_type -> cgrates.v1.CGREvent
+	0,  // 18: cgrates.v1.AttributeSv1.ProcessEvent:input_type -> cgrates.v1.CGREvent
+	0,  // 19: cgrates.v1.ResourceSv1.GetResourcesForEvent:input_type -> cgrates.v1.CGREvent
+	0,  // 20: cgrates.v1.ResourceSv1.AuthorizeResources:input_type -> cgrates.v1.CGREvent
+	0,  // 21: cgrates.v1.ResourceSv1.AllocateResources:input_type -> cgrates.v1.CGREvent
+	0,  // 22: cgrates.v1.ResourceSv1.ReleaseResources:input_type -> cgrates.v1.CGREvent
+	0,  // 23: cgrates.v1.StatSv1.ProcessEvent:input_type -> cgrates.v1.CGREvent
+	0,  // 24: cgrates.v1.StatSv1.GetStatQueuesForEvent:input_type -> cgrates.v1.CGREvent
+	1,  // 25: cgrates.v1.StatSv1.GetQueueStringMetrics:input_type -> cgrates.v1.TenantID
+	0,  // 26: cgrates.v1.StatSv1.ProcessEvents:input_type -> cgrates.v1.CGREvent
+	2,  // 27: cgrates.v1.APIerSv1.GetAccount:input_type -> cgrates.v1.AccountArgs
+	3,  // 28: cgrates.v1.APIerSv1.SetAccount:input_type -> cgrates.v1.SetAccountArgs
+	2,  // 29: cgrates.v1.APIerSv1.RemoveAccount:input_type -> cgrates.v1.AccountArgs
+	4,  // 30: cgrates.v1.APIerSv1.GetAccounts:input_type -> cgrates.v1.GetAccountsArgs
+	5,  // 31: cgrates.v1.APIerSv1.AddBalance:input_type -> cgrates.v1.BalanceArgs
+	5,  // 32: cgrates.v1.APIerSv1.DebitBalance:input_type -> cgrates.v1.BalanceArgs
+	6,  // 33: cgrates.v1.SessionSv1.AuthorizeEvent:output_type -> cgrates.v1.Reply
+	6,  // 34: cgrates.v1.SessionSv1.InitiateSession:output_type -> cgrates.v1.Reply
+	6,  // 35: cgrates.v1.SessionSv1.UpdateSession:output_type -> cgrates.v1.Reply
+	6,  // 36: cgrates.v1.SessionSv1.TerminateSession:output_type -> cgrates.v1.Reply
+	6,  // 37: cgrates.v1.SessionSv1.ProcessCDR:output_type -> cgrates.v1.Reply
+	6,  // 38: cgrates.v1.SessionSv1.ProcessMessage:output_type -> cgrates.v1.Reply
+	6,  // 39: cgrates.v1.SessionSv1.ProcessEvent:output_type -> cgrates.v1.Reply
+	6,  // 40: cgrates.v1.CDRsV1.ProcessEvent:output_type -> cgrates.v1.Reply
+	6,  // 41: cgrates.v1.CDRsV1.ProcessEvents:output_type -> cgrates.v1.Reply
+	6,  // 42: cgrates.v1.AttributeSv1.GetAttributeForEvent:output_type -> cgrates.v1.Reply
+	6,  // 43: cgrates.v1.AttributeSv1.ProcessEvent:output_type -> cgrates.v1.Reply
+	6,  // 44: cgrates.v1.ResourceSv1.GetResourcesForEvent:output_type -> cgrates.v1.Reply
+	6,  // 45: cgrates.v1.ResourceSv1.AuthorizeResources:output_type -> cgrates.v1.Reply
+	6,  // 46: cgrates.v1.ResourceSv1.AllocateResources:output_type -> cgrates.v1.Reply
+	6,  // 47: cgrates.v1.ResourceSv1.ReleaseResources:output_type -> cgrates.v1.Reply
+	6,  // 48: cgrates.v1.StatSv1.ProcessEvent:output_type -> cgrates.v1.Reply
+	6,  // 49: cgrates.v1.StatSv1.GetStatQueuesForEvent:output_type -> cgrates.v1.Reply
+	6,  // 50: cgrates.v1.StatSv1.GetQueueStringMetrics:output_type -> cgrates.v1.Reply
+	6,  // 51: cgrates.v1.StatSv1.ProcessEvents:output_type -> cgrates.v1.Reply
+	6,  // 52: cgrates.v1.APIerSv1.GetAccount:output_type -> cgrates.v1.Reply
+	6,  // 53: cgrates.v1.APIerSv1.SetAccount:output_type -> cgrates.v1.Reply
+	6,  // 54: cgrates.v1.APIerSv1.RemoveAccount:output_type -> cgrates.v1.Reply
+	6,  // 55: cgrates.v1.APIerSv1.GetAccounts:output_type -> cgrates.v1.Reply
+	6,  // 56: cgrates.v1.APIerSv1.AddBalance:output_type -> cgrates.v1.Reply
+	6,  // 57: cgrates.v1.APIerSv1.DebitBalance:output_type -> cgrates.v1.Reply
+	33, // [33:58] is the sub-list for method output_type
+	8,  // [8:33] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_cgrates_proto_init() }
+func file_cgrates_proto_init() {
+	if File_cgrates_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cgrates_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CGREvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cgrates_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TenantID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cgrates_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cgrates_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*SetAccountArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cgrates_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountsArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cgrates_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*BalanceArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cgrates_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Reply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cgrates_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   6,
+		},
+		GoTypes:           file_cgrates_proto_goTypes,
+		DependencyIndexes: file_cgrates_proto_depIdxs,
+		MessageInfos:      file_cgrates_proto_msgTypes,
+	}.Build()
+	File_cgrates_proto = out.File
+	file_cgrates_proto_rawDesc = nil
+	file_cgrates_proto_goTypes = nil
+	file_cgrates_proto_depIdxs = nil
+}
@@ -0,0 +1,1328 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cgrates.proto
+
+package grpcapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SessionSv1_AuthorizeEvent_FullMethodName   = "/cgrates.v1.SessionSv1/AuthorizeEvent"
+	SessionSv1_InitiateSession_FullMethodName  = "/cgrates.v1.SessionSv1/InitiateSession"
+	SessionSv1_UpdateSession_FullMethodName    = "/cgrates.v1.SessionSv1/UpdateSession"
+	SessionSv1_TerminateSession_FullMethodName = "/cgrates.v1.SessionSv1/TerminateSession"
+	SessionSv1_ProcessCDR_FullMethodName       = "/cgrates.v1.SessionSv1/ProcessCDR"
+	SessionSv1_ProcessMessage_FullMethodName   = "/cgrates.v1.SessionSv1/ProcessMessage"
+	SessionSv1_ProcessEvent_FullMethodName     = "/cgrates.v1.SessionSv1/ProcessEvent"
+)
+
+// SessionSv1Client is the client API for SessionSv1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SessionSv1Client interface {
+	AuthorizeEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	InitiateSession(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	UpdateSession(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	TerminateSession(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	ProcessCDR(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	ProcessMessage(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	ProcessEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+}
+
+type sessionSv1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSessionSv1Client(cc grpc.ClientConnInterface) SessionSv1Client {
+	return &sessionSv1Client{cc}
+}
+
+func (c *sessionSv1Client) AuthorizeEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, SessionSv1_AuthorizeEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionSv1Client) InitiateSession(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, SessionSv1_InitiateSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionSv1Client) UpdateSession(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, SessionSv1_UpdateSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionSv1Client) TerminateSession(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, SessionSv1_TerminateSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionSv1Client) ProcessCDR(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, SessionSv1_ProcessCDR_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionSv1Client) ProcessMessage(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, SessionSv1_ProcessMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionSv1Client) ProcessEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, SessionSv1_ProcessEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SessionSv1Server is the server API for SessionSv1 service.
+// All implementations must embed UnimplementedSessionSv1Server
+// for forward compatibility
+type SessionSv1Server interface {
+	AuthorizeEvent(context.Context, *CGREvent) (*Reply, error)
+	InitiateSession(context.Context, *CGREvent) (*Reply, error)
+	UpdateSession(context.Context, *CGREvent) (*Reply, error)
+	TerminateSession(context.Context, *CGREvent) (*Reply, error)
+	ProcessCDR(context.Context, *CGREvent) (*Reply, error)
+	ProcessMessage(context.Context, *CGREvent) (*Reply, error)
+	ProcessEvent(context.Context, *CGREvent) (*Reply, error)
+	mustEmbedUnimplementedSessionSv1Server()
+}
+
+// UnimplementedSessionSv1Server must be embedded to have forward compatible implementations.
+type UnimplementedSessionSv1Server struct {
+}
+
+func (UnimplementedSessionSv1Server) AuthorizeEvent(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeEvent not implemented")
+}
+func (UnimplementedSessionSv1Server) InitiateSession(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateSession not implemented")
+}
+func (UnimplementedSessionSv1Server) UpdateSession(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSession not implemented")
+}
+func (UnimplementedSessionSv1Server) TerminateSession(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateSession not implemented")
+}
+func (UnimplementedSessionSv1Server) ProcessCDR(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessCDR not implemented")
+}
+func (UnimplementedSessionSv1Server) ProcessMessage(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessMessage not implemented")
+}
+func (UnimplementedSessionSv1Server) ProcessEvent(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessEvent not implemented")
+}
+func (UnimplementedSessionSv1Server) mustEmbedUnimplementedSessionSv1Server() {}
+
+// UnsafeSessionSv1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SessionSv1Server will
+// result in compilation errors.
+type UnsafeSessionSv1Server interface {
+	mustEmbedUnimplementedSessionSv1Server()
+}
+
+func RegisterSessionSv1Server(s grpc.ServiceRegistrar, srv SessionSv1Server) {
+	s.RegisterService(&SessionSv1_ServiceDesc, srv)
+}
+
+func _SessionSv1_AuthorizeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionSv1Server).AuthorizeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionSv1_AuthorizeEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionSv1Server).AuthorizeEvent(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionSv1_InitiateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionSv1Server).InitiateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionSv1_InitiateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionSv1Server).InitiateSession(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionSv1_UpdateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionSv1Server).UpdateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionSv1_UpdateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionSv1Server).UpdateSession(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionSv1_TerminateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionSv1Server).TerminateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionSv1_TerminateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionSv1Server).TerminateSession(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionSv1_ProcessCDR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionSv1Server).ProcessCDR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionSv1_ProcessCDR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionSv1Server).ProcessCDR(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionSv1_ProcessMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionSv1Server).ProcessMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionSv1_ProcessMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionSv1Server).ProcessMessage(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionSv1_ProcessEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionSv1Server).ProcessEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionSv1_ProcessEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionSv1Server).ProcessEvent(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SessionSv1_ServiceDesc is the grpc.ServiceDesc for SessionSv1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SessionSv1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cgrates.v1.SessionSv1",
+	HandlerType: (*SessionSv1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AuthorizeEvent",
+			Handler:    _SessionSv1_AuthorizeEvent_Handler,
+		},
+		{
+			MethodName: "InitiateSession",
+			Handler:    _SessionSv1_InitiateSession_Handler,
+		},
+		{
+			MethodName: "UpdateSession",
+			Handler:    _SessionSv1_UpdateSession_Handler,
+		},
+		{
+			MethodName: "TerminateSession",
+			Handler:    _SessionSv1_TerminateSession_Handler,
+		},
+		{
+			MethodName: "ProcessCDR",
+			Handler:    _SessionSv1_ProcessCDR_Handler,
+		},
+		{
+			MethodName: "ProcessMessage",
+			Handler:    _SessionSv1_ProcessMessage_Handler,
+		},
+		{
+			MethodName: "ProcessEvent",
+			Handler:    _SessionSv1_ProcessEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cgrates.proto",
+}
+
+const (
+	CDRsV1_ProcessEvent_FullMethodName  = "/cgrates.v1.CDRsV1/ProcessEvent"
+	CDRsV1_ProcessEvents_FullMethodName = "/cgrates.v1.CDRsV1/ProcessEvents"
+)
+
+// CDRsV1Client is the client API for CDRsV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CDRsV1Client interface {
+	ProcessEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	ProcessEvents(ctx context.Context, opts ...grpc.CallOption) (CDRsV1_ProcessEventsClient, error)
+}
+
+type cDRsV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCDRsV1Client(cc grpc.ClientConnInterface) CDRsV1Client {
+	return &cDRsV1Client{cc}
+}
+
+func (c *cDRsV1Client) ProcessEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, CDRsV1_ProcessEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDRsV1Client) ProcessEvents(ctx context.Context, opts ...grpc.CallOption) (CDRsV1_ProcessEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CDRsV1_ServiceDesc.Streams[0], CDRsV1_ProcessEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cDRsV1ProcessEventsClient{stream}
+	return x, nil
+}
+
+type CDRsV1_ProcessEventsClient interface {
+	Send(*CGREvent) error
+	Recv() (*Reply, error)
+	grpc.ClientStream
+}
+
+type cDRsV1ProcessEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cDRsV1ProcessEventsClient) Send(m *CGREvent) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cDRsV1ProcessEventsClient) Recv() (*Reply, error) {
+	m := new(Reply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CDRsV1Server is the server API for CDRsV1 service.
+// All implementations must embed UnimplementedCDRsV1Server
+// for forward compatibility
+type CDRsV1Server interface {
+	ProcessEvent(context.Context, *CGREvent) (*Reply, error)
+	ProcessEvents(CDRsV1_ProcessEventsServer) error
+	mustEmbedUnimplementedCDRsV1Server()
+}
+
+// UnimplementedCDRsV1Server must be embedded to have forward compatible implementations.
+type UnimplementedCDRsV1Server struct {
+}
+
+func (UnimplementedCDRsV1Server) ProcessEvent(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessEvent not implemented")
+}
+func (UnimplementedCDRsV1Server) ProcessEvents(CDRsV1_ProcessEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProcessEvents not implemented")
+}
+func (UnimplementedCDRsV1Server) mustEmbedUnimplementedCDRsV1Server() {}
+
+// UnsafeCDRsV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CDRsV1Server will
+// result in compilation errors.
+type UnsafeCDRsV1Server interface {
+	mustEmbedUnimplementedCDRsV1Server()
+}
+
+func RegisterCDRsV1Server(s grpc.ServiceRegistrar, srv CDRsV1Server) {
+	s.RegisterService(&CDRsV1_ServiceDesc, srv)
+}
+
+func _CDRsV1_ProcessEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDRsV1Server).ProcessEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDRsV1_ProcessEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDRsV1Server).ProcessEvent(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDRsV1_ProcessEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CDRsV1Server).ProcessEvents(&cDRsV1ProcessEventsServer{stream})
+}
+
+type CDRsV1_ProcessEventsServer interface {
+	Send(*Reply) error
+	Recv() (*CGREvent, error)
+	grpc.ServerStream
+}
+
+type cDRsV1ProcessEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cDRsV1ProcessEventsServer) Send(m *Reply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cDRsV1ProcessEventsServer) Recv() (*CGREvent, error) {
+	m := new(CGREvent)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CDRsV1_ServiceDesc is the grpc.ServiceDesc for CDRsV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CDRsV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cgrates.v1.CDRsV1",
+	HandlerType: (*CDRsV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProcessEvent",
+			Handler:    _CDRsV1_ProcessEvent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ProcessEvents",
+			Handler:       _CDRsV1_ProcessEvents_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "cgrates.proto",
+}
+
+const (
+	AttributeSv1_GetAttributeForEvent_FullMethodName = "/cgrates.v1.AttributeSv1/GetAttributeForEvent"
+	AttributeSv1_ProcessEvent_FullMethodName         = "/cgrates.v1.AttributeSv1/ProcessEvent"
+)
+
+// AttributeSv1Client is the client API for AttributeSv1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttributeSv1Client interface {
+	GetAttributeForEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	ProcessEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+}
+
+type attributeSv1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttributeSv1Client(cc grpc.ClientConnInterface) AttributeSv1Client {
+	return &attributeSv1Client{cc}
+}
+
+func (c *attributeSv1Client) GetAttributeForEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, AttributeSv1_GetAttributeForEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeSv1Client) ProcessEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, AttributeSv1_ProcessEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttributeSv1Server is the server API for AttributeSv1 service.
+// All implementations must embed UnimplementedAttributeSv1Server
+// for forward compatibility
+type AttributeSv1Server interface {
+	GetAttributeForEvent(context.Context, *CGREvent) (*Reply, error)
+	ProcessEvent(context.Context, *CGREvent) (*Reply, error)
+	mustEmbedUnimplementedAttributeSv1Server()
+}
+
+// UnimplementedAttributeSv1Server must be embedded to have forward compatible implementations.
+type UnimplementedAttributeSv1Server struct {
+}
+
+func (UnimplementedAttributeSv1Server) GetAttributeForEvent(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttributeForEvent not implemented")
+}
+func (UnimplementedAttributeSv1Server) ProcessEvent(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessEvent not implemented")
+}
+func (UnimplementedAttributeSv1Server) mustEmbedUnimplementedAttributeSv1Server() {}
+
+// UnsafeAttributeSv1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttributeSv1Server will
+// result in compilation errors.
+type UnsafeAttributeSv1Server interface {
+	mustEmbedUnimplementedAttributeSv1Server()
+}
+
+func RegisterAttributeSv1Server(s grpc.ServiceRegistrar, srv AttributeSv1Server) {
+	s.RegisterService(&AttributeSv1_ServiceDesc, srv)
+}
+
+func _AttributeSv1_GetAttributeForEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeSv1Server).GetAttributeForEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttributeSv1_GetAttributeForEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeSv1Server).GetAttributeForEvent(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttributeSv1_ProcessEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeSv1Server).ProcessEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttributeSv1_ProcessEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeSv1Server).ProcessEvent(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttributeSv1_ServiceDesc is the grpc.ServiceDesc for AttributeSv1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttributeSv1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cgrates.v1.AttributeSv1",
+	HandlerType: (*AttributeSv1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttributeForEvent",
+			Handler:    _AttributeSv1_GetAttributeForEvent_Handler,
+		},
+		{
+			MethodName: "ProcessEvent",
+			Handler:    _AttributeSv1_ProcessEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cgrates.proto",
+}
+
+const (
+	ResourceSv1_GetResourcesForEvent_FullMethodName = "/cgrates.v1.ResourceSv1/GetResourcesForEvent"
+	ResourceSv1_AuthorizeResources_FullMethodName   = "/cgrates.v1.ResourceSv1/AuthorizeResources"
+	ResourceSv1_AllocateResources_FullMethodName    = "/cgrates.v1.ResourceSv1/AllocateResources"
+	ResourceSv1_ReleaseResources_FullMethodName     = "/cgrates.v1.ResourceSv1/ReleaseResources"
+)
+
+// ResourceSv1Client is the client API for ResourceSv1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ResourceSv1Client interface {
+	GetResourcesForEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	AuthorizeResources(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	AllocateResources(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	ReleaseResources(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+}
+
+type resourceSv1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewResourceSv1Client(cc grpc.ClientConnInterface) ResourceSv1Client {
+	return &resourceSv1Client{cc}
+}
+
+func (c *resourceSv1Client) GetResourcesForEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, ResourceSv1_GetResourcesForEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceSv1Client) AuthorizeResources(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, ResourceSv1_AuthorizeResources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceSv1Client) AllocateResources(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, ResourceSv1_AllocateResources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceSv1Client) ReleaseResources(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, ResourceSv1_ReleaseResources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ResourceSv1Server is the server API for ResourceSv1 service.
+// All implementations must embed UnimplementedResourceSv1Server
+// for forward compatibility
+type ResourceSv1Server interface {
+	GetResourcesForEvent(context.Context, *CGREvent) (*Reply, error)
+	AuthorizeResources(context.Context, *CGREvent) (*Reply, error)
+	AllocateResources(context.Context, *CGREvent) (*Reply, error)
+	ReleaseResources(context.Context, *CGREvent) (*Reply, error)
+	mustEmbedUnimplementedResourceSv1Server()
+}
+
+// UnimplementedResourceSv1Server must be embedded to have forward compatible implementations.
+type UnimplementedResourceSv1Server struct {
+}
+
+func (UnimplementedResourceSv1Server) GetResourcesForEvent(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourcesForEvent not implemented")
+}
+func (UnimplementedResourceSv1Server) AuthorizeResources(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeResources not implemented")
+}
+func (UnimplementedResourceSv1Server) AllocateResources(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateResources not implemented")
+}
+func (UnimplementedResourceSv1Server) ReleaseResources(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseResources not implemented")
+}
+func (UnimplementedResourceSv1Server) mustEmbedUnimplementedResourceSv1Server() {}
+
+// UnsafeResourceSv1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResourceSv1Server will
+// result in compilation errors.
+type UnsafeResourceSv1Server interface {
+	mustEmbedUnimplementedResourceSv1Server()
+}
+
+func RegisterResourceSv1Server(s grpc.ServiceRegistrar, srv ResourceSv1Server) {
+	s.RegisterService(&ResourceSv1_ServiceDesc, srv)
+}
+
+func _ResourceSv1_GetResourcesForEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceSv1Server).GetResourcesForEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceSv1_GetResourcesForEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceSv1Server).GetResourcesForEvent(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceSv1_AuthorizeResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceSv1Server).AuthorizeResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceSv1_AuthorizeResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceSv1Server).AuthorizeResources(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceSv1_AllocateResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceSv1Server).AllocateResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceSv1_AllocateResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceSv1Server).AllocateResources(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceSv1_ReleaseResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceSv1Server).ReleaseResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceSv1_ReleaseResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceSv1Server).ReleaseResources(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ResourceSv1_ServiceDesc is the grpc.ServiceDesc for ResourceSv1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ResourceSv1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cgrates.v1.ResourceSv1",
+	HandlerType: (*ResourceSv1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetResourcesForEvent",
+			Handler:    _ResourceSv1_GetResourcesForEvent_Handler,
+		},
+		{
+			MethodName: "AuthorizeResources",
+			Handler:    _ResourceSv1_AuthorizeResources_Handler,
+		},
+		{
+			MethodName: "AllocateResources",
+			Handler:    _ResourceSv1_AllocateResources_Handler,
+		},
+		{
+			MethodName: "ReleaseResources",
+			Handler:    _ResourceSv1_ReleaseResources_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cgrates.proto",
+}
+
+const (
+	StatSv1_ProcessEvent_FullMethodName          = "/cgrates.v1.StatSv1/ProcessEvent"
+	StatSv1_GetStatQueuesForEvent_FullMethodName = "/cgrates.v1.StatSv1/GetStatQueuesForEvent"
+	StatSv1_GetQueueStringMetrics_FullMethodName = "/cgrates.v1.StatSv1/GetQueueStringMetrics"
+	StatSv1_ProcessEvents_FullMethodName         = "/cgrates.v1.StatSv1/ProcessEvents"
+)
+
+// StatSv1Client is the client API for StatSv1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StatSv1Client interface {
+	ProcessEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	GetStatQueuesForEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error)
+	GetQueueStringMetrics(ctx context.Context, in *TenantID, opts ...grpc.CallOption) (*Reply, error)
+	ProcessEvents(ctx context.Context, opts ...grpc.CallOption) (StatSv1_ProcessEventsClient, error)
+}
+
+type statSv1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStatSv1Client(cc grpc.ClientConnInterface) StatSv1Client {
+	return &statSv1Client{cc}
+}
+
+func (c *statSv1Client) ProcessEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, StatSv1_ProcessEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statSv1Client) GetStatQueuesForEvent(ctx context.Context, in *CGREvent, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, StatSv1_GetStatQueuesForEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statSv1Client) GetQueueStringMetrics(ctx context.Context, in *TenantID, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, StatSv1_GetQueueStringMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statSv1Client) ProcessEvents(ctx context.Context, opts ...grpc.CallOption) (StatSv1_ProcessEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StatSv1_ServiceDesc.Streams[0], StatSv1_ProcessEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &statSv1ProcessEventsClient{stream}
+	return x, nil
+}
+
+type StatSv1_ProcessEventsClient interface {
+	Send(*CGREvent) error
+	Recv() (*Reply, error)
+	grpc.ClientStream
+}
+
+type statSv1ProcessEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *statSv1ProcessEventsClient) Send(m *CGREvent) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *statSv1ProcessEventsClient) Recv() (*Reply, error) {
+	m := new(Reply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StatSv1Server is the server API for StatSv1 service.
+// All implementations must embed UnimplementedStatSv1Server
+// for forward compatibility
+type StatSv1Server interface {
+	ProcessEvent(context.Context, *CGREvent) (*Reply, error)
+	GetStatQueuesForEvent(context.Context, *CGREvent) (*Reply, error)
+	GetQueueStringMetrics(context.Context, *TenantID) (*Reply, error)
+	ProcessEvents(StatSv1_ProcessEventsServer) error
+	mustEmbedUnimplementedStatSv1Server()
+}
+
+// UnimplementedStatSv1Server must be embedded to have forward compatible implementations.
+type UnimplementedStatSv1Server struct {
+}
+
+func (UnimplementedStatSv1Server) ProcessEvent(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessEvent not implemented")
+}
+func (UnimplementedStatSv1Server) GetStatQueuesForEvent(context.Context, *CGREvent) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatQueuesForEvent not implemented")
+}
+func (UnimplementedStatSv1Server) GetQueueStringMetrics(context.Context, *TenantID) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueStringMetrics not implemented")
+}
+func (UnimplementedStatSv1Server) ProcessEvents(StatSv1_ProcessEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProcessEvents not implemented")
+}
+func (UnimplementedStatSv1Server) mustEmbedUnimplementedStatSv1Server() {}
+
+// UnsafeStatSv1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StatSv1Server will
+// result in compilation errors.
+type UnsafeStatSv1Server interface {
+	mustEmbedUnimplementedStatSv1Server()
+}
+
+func RegisterStatSv1Server(s grpc.ServiceRegistrar, srv StatSv1Server) {
+	s.RegisterService(&StatSv1_ServiceDesc, srv)
+}
+
+func _StatSv1_ProcessEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatSv1Server).ProcessEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatSv1_ProcessEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatSv1Server).ProcessEvent(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatSv1_GetStatQueuesForEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CGREvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatSv1Server).GetStatQueuesForEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatSv1_GetStatQueuesForEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatSv1Server).GetStatQueuesForEvent(ctx, req.(*CGREvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatSv1_GetQueueStringMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatSv1Server).GetQueueStringMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatSv1_GetQueueStringMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatSv1Server).GetQueueStringMetrics(ctx, req.(*TenantID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatSv1_ProcessEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StatSv1Server).ProcessEvents(&statSv1ProcessEventsServer{stream})
+}
+
+type StatSv1_ProcessEventsServer interface {
+	Send(*Reply) error
+	Recv() (*CGREvent, error)
+	grpc.ServerStream
+}
+
+type statSv1ProcessEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *statSv1ProcessEventsServer) Send(m *Reply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *statSv1ProcessEventsServer) Recv() (*CGREvent, error) {
+	m := new(CGREvent)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StatSv1_ServiceDesc is the grpc.ServiceDesc for StatSv1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StatSv1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cgrates.v1.StatSv1",
+	HandlerType: (*StatSv1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProcessEvent",
+			Handler:    _StatSv1_ProcessEvent_Handler,
+		},
+		{
+			MethodName: "GetStatQueuesForEvent",
+			Handler:    _StatSv1_GetStatQueuesForEvent_Handler,
+		},
+		{
+			MethodName: "GetQueueStringMetrics",
+			Handler:    _StatSv1_GetQueueStringMetrics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ProcessEvents",
+			Handler:       _StatSv1_ProcessEvents_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "cgrates.proto",
+}
+
+const (
+	APIerSv1_GetAccount_FullMethodName    = "/cgrates.v1.APIerSv1/GetAccount"
+	APIerSv1_SetAccount_FullMethodName    = "/cgrates.v1.APIerSv1/SetAccount"
+	APIerSv1_RemoveAccount_FullMethodName = "/cgrates.v1.APIerSv1/RemoveAccount"
+	APIerSv1_GetAccounts_FullMethodName   = "/cgrates.v1.APIerSv1/GetAccounts"
+	APIerSv1_AddBalance_FullMethodName    = "/cgrates.v1.APIerSv1/AddBalance"
+	APIerSv1_DebitBalance_FullMethodName  = "/cgrates.v1.APIerSv1/DebitBalance"
+)
+
+// APIerSv1Client is the client API for APIerSv1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type APIerSv1Client interface {
+	GetAccount(ctx context.Context, in *AccountArgs, opts ...grpc.CallOption) (*Reply, error)
+	SetAccount(ctx context.Context, in *SetAccountArgs, opts ...grpc.CallOption) (*Reply, error)
+	RemoveAccount(ctx context.Context, in *AccountArgs, opts ...grpc.CallOption) (*Reply, error)
+	GetAccounts(ctx context.Context, in *GetAccountsArgs, opts ...grpc.CallOption) (*Reply, error)
+	AddBalance(ctx context.Context, in *BalanceArgs, opts ...grpc.CallOption) (*Reply, error)
+	DebitBalance(ctx context.Context, in *BalanceArgs, opts ...grpc.CallOption) (*Reply, error)
+}
+
+type aPIerSv1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIerSv1Client(cc grpc.ClientConnInterface) APIerSv1Client {
+	return &aPIerSv1Client{cc}
+}
+
+func (c *aPIerSv1Client) GetAccount(ctx context.Context, in *AccountArgs, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, APIerSv1_GetAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIerSv1Client) SetAccount(ctx context.Context, in *SetAccountArgs, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, APIerSv1_SetAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIerSv1Client) RemoveAccount(ctx context.Context, in *AccountArgs, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, APIerSv1_RemoveAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIerSv1Client) GetAccounts(ctx context.Context, in *GetAccountsArgs, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, APIerSv1_GetAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIerSv1Client) AddBalance(ctx context.Context, in *BalanceArgs, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, APIerSv1_AddBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIerSv1Client) DebitBalance(ctx context.Context, in *BalanceArgs, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, APIerSv1_DebitBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIerSv1Server is the server API for APIerSv1 service.
+// All implementations must embed UnimplementedAPIerSv1Server
+// for forward compatibility
+type APIerSv1Server interface {
+	GetAccount(context.Context, *AccountArgs) (*Reply, error)
+	SetAccount(context.Context, *SetAccountArgs) (*Reply, error)
+	RemoveAccount(context.Context, *AccountArgs) (*Reply, error)
+	GetAccounts(context.Context, *GetAccountsArgs) (*Reply, error)
+	AddBalance(context.Context, *BalanceArgs) (*Reply, error)
+	DebitBalance(context.Context, *BalanceArgs) (*Reply, error)
+	mustEmbedUnimplementedAPIerSv1Server()
+}
+
+// UnimplementedAPIerSv1Server must be embedded to have forward compatible implementations.
+type UnimplementedAPIerSv1Server struct {
+}
+
+func (UnimplementedAPIerSv1Server) GetAccount(context.Context, *AccountArgs) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedAPIerSv1Server) SetAccount(context.Context, *SetAccountArgs) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccount not implemented")
+}
+func (UnimplementedAPIerSv1Server) RemoveAccount(context.Context, *AccountArgs) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccount not implemented")
+}
+func (UnimplementedAPIerSv1Server) GetAccounts(context.Context, *GetAccountsArgs) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+}
+func (UnimplementedAPIerSv1Server) AddBalance(context.Context, *BalanceArgs) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBalance not implemented")
+}
+func (UnimplementedAPIerSv1Server) DebitBalance(context.Context, *BalanceArgs) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebitBalance not implemented")
+}
+func (UnimplementedAPIerSv1Server) mustEmbedUnimplementedAPIerSv1Server() {}
+
+// UnsafeAPIerSv1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APIerSv1Server will
+// result in compilation errors.
+type UnsafeAPIerSv1Server interface {
+	mustEmbedUnimplementedAPIerSv1Server()
+}
+
+func RegisterAPIerSv1Server(s grpc.ServiceRegistrar, srv APIerSv1Server) {
+	s.RegisterService(&APIerSv1_ServiceDesc, srv)
+}
+
+func _APIerSv1_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIerSv1Server).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIerSv1_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIerSv1Server).GetAccount(ctx, req.(*AccountArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIerSv1_SetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIerSv1Server).SetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIerSv1_SetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIerSv1Server).SetAccount(ctx, req.(*SetAccountArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIerSv1_RemoveAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIerSv1Server).RemoveAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIerSv1_RemoveAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIerSv1Server).RemoveAccount(ctx, req.(*AccountArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIerSv1_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIerSv1Server).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIerSv1_GetAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIerSv1Server).GetAccounts(ctx, req.(*GetAccountsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIerSv1_AddBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIerSv1Server).AddBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIerSv1_AddBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIerSv1Server).AddBalance(ctx, req.(*BalanceArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIerSv1_DebitBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIerSv1Server).DebitBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIerSv1_DebitBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIerSv1Server).DebitBalance(ctx, req.(*BalanceArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// APIerSv1_ServiceDesc is the grpc.ServiceDesc for APIerSv1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var APIerSv1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cgrates.v1.APIerSv1",
+	HandlerType: (*APIerSv1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccount",
+			Handler:    _APIerSv1_GetAccount_Handler,
+		},
+		{
+			MethodName: "SetAccount",
+			Handler:    _APIerSv1_SetAccount_Handler,
+		},
+		{
+			MethodName: "RemoveAccount",
+			Handler:    _APIerSv1_RemoveAccount_Handler,
+		},
+		{
+			MethodName: "GetAccounts",
+			Handler:    _APIerSv1_GetAccounts_Handler,
+		},
+		{
+			MethodName: "AddBalance",
+			Handler:    _APIerSv1_AddBalance_Handler,
+		},
+		{
+			MethodName: "DebitBalance",
+			Handler:    _APIerSv1_DebitBalance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cgrates.proto",
+}
@@ -0,0 +1,373 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative cgrates.proto
+
+package grpcapi
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+
+	v1 "github.com/cgrates/cgrates/apier/v1"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/sessions"
+	"github.com/cgrates/cgrates/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// APICaller executes the API calls within the engine, the same way as for the JSON-RPC requests
+type APICaller interface {
+	CallJSON(rmtAddr net.Addr, serviceMethod string, args, reply any) error
+}
+
+// Register registers all the gRPC services, served on top of the engine APIs
+func Register(srv grpc.ServiceRegistrar, caller APICaller) {
+	RegisterSessionSv1Server(srv, &sessionSv1Server{caller: caller})
+	RegisterCDRsV1Server(srv, &cdrsV1Server{caller: caller})
+	RegisterAttributeSv1Server(srv, &attributeSv1Server{caller: caller})
+	RegisterResourceSv1Server(srv, &resourceSv1Server{caller: caller})
+	RegisterStatSv1Server(srv, &statSv1Server{caller: caller})
+	RegisterAPIerSv1Server(srv, &apierSv1Server{caller: caller})
+}
+
+// AsCGREvent converts the message into the utils.CGREvent used by the APIs
+func (ev *CGREvent) AsCGREvent() *utils.CGREvent {
+	return &utils.CGREvent{
+		Tenant:  ev.GetTenant(),
+		ID:      ev.GetId(),
+		Event:   ev.GetEvent().AsMap(),
+		APIOpts: ev.GetApiOpts().AsMap(),
+	}
+}
+
+// call executes the API and converts the reply into gRPC one
+func call(ctx context.Context, caller APICaller, serviceMethod string, args any) (rply *Reply, err error) {
+	var rmtAddr net.Addr
+	if p, has := peer.FromContext(ctx); has {
+		rmtAddr = p.Addr
+	}
+	var rplyData any
+	if err = caller.CallJSON(rmtAddr, serviceMethod, args, &rplyData); err != nil {
+		return nil, apiErrorStatus(err)
+	}
+	rply = new(Reply)
+	if rply.Reply, err = structpb.NewValue(rplyData); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return
+}
+
+// apiErrorStatus converts the API errors into gRPC status
+func apiErrorStatus(err error) error {
+	switch errStr := err.Error(); {
+	case errStr == utils.ErrNotFound.Error():
+		return status.Error(codes.NotFound, errStr)
+	case strings.HasPrefix(errStr, utils.MandatoryIEMissingCaps):
+		return status.Error(codes.InvalidArgument, errStr)
+	case errStr == utils.ErrUnauthorizedApi.Error():
+		return status.Error(codes.PermissionDenied, errStr)
+	default:
+		return status.Error(codes.Unknown, errStr)
+	}
+}
+
+// processStream calls the API for each event received, sending back the replies in the same order
+func processStream(stream grpc.ServerStream, caller APICaller, process func(context.Context, *CGREvent) (*Reply, error)) error {
+	for {
+		ev := new(CGREvent)
+		if err := stream.RecvMsg(ev); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		rply, err := process(stream.Context(), ev)
+		if err != nil {
+			rply = &Reply{Error: status.Convert(err).Message()}
+		}
+		if err = stream.SendMsg(rply); err != nil {
+			return err
+		}
+	}
+}
+
+type sessionSv1Server struct {
+	UnimplementedSessionSv1Server
+	caller APICaller
+}
+
+func (sS *sessionSv1Server) AuthorizeEvent(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	cgrEv := ev.AsCGREvent()
+	flags := utils.FlagsWithParamsFromSlice(ev.GetFlags())
+	cgrArgs, err := utils.GetRoutePaginatorFromOpts(cgrEv.APIOpts)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return call(ctx, sS.caller, utils.SessionSv1AuthorizeEvent,
+		sessions.NewV1AuthorizeArgs(
+			flags.GetBool(utils.MetaAttributes),
+			flags.ParamsSlice(utils.MetaAttributes, utils.MetaIDs),
+			flags.GetBool(utils.MetaThresholds),
+			flags.ParamsSlice(utils.MetaThresholds, utils.MetaIDs),
+			flags.GetBool(utils.MetaStats),
+			flags.ParamsSlice(utils.MetaStats, utils.MetaIDs),
+			flags.GetBool(utils.MetaResources),
+			flags.Has(utils.MetaAccounts),
+			flags.GetBool(utils.MetaRoutes),
+			flags.Has(utils.MetaRoutesIgnoreErrors),
+			flags.Has(utils.MetaRoutesEventCost),
+			cgrEv, cgrArgs,
+			flags.Has(utils.MetaFD),
+			flags.ParamValue(utils.MetaRoutesMaxCost),
+		))
+}
+
+func (sS *sessionSv1Server) InitiateSession(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	flags := utils.FlagsWithParamsFromSlice(ev.GetFlags())
+	return call(ctx, sS.caller, utils.SessionSv1InitiateSession,
+		sessions.NewV1InitSessionArgs(
+			flags.GetBool(utils.MetaAttributes),
+			flags.ParamsSlice(utils.MetaAttributes, utils.MetaIDs),
+			flags.GetBool(utils.MetaThresholds),
+			flags.ParamsSlice(utils.MetaThresholds, utils.MetaIDs),
+			flags.GetBool(utils.MetaStats),
+			flags.ParamsSlice(utils.MetaStats, utils.MetaIDs),
+			flags.GetBool(utils.MetaResources),
+			flags.Has(utils.MetaAccounts),
+			ev.AsCGREvent(), flags.Has(utils.MetaFD)))
+}
+
+func (sS *sessionSv1Server) UpdateSession(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	flags := utils.FlagsWithParamsFromSlice(ev.GetFlags())
+	return call(ctx, sS.caller, utils.SessionSv1UpdateSession,
+		sessions.NewV1UpdateSessionArgs(
+			flags.GetBool(utils.MetaAttributes),
+			flags.ParamsSlice(utils.MetaAttributes, utils.MetaIDs),
+			flags.Has(utils.MetaAccounts),
+			ev.AsCGREvent(), flags.Has(utils.MetaFD)))
+}
+
+func (sS *sessionSv1Server) TerminateSession(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	flags := utils.FlagsWithParamsFromSlice(ev.GetFlags())
+	return call(ctx, sS.caller, utils.SessionSv1TerminateSession,
+		sessions.NewV1TerminateSessionArgs(
+			flags.Has(utils.MetaAccounts),
+			flags.GetBool(utils.MetaResources),
+			flags.GetBool(utils.MetaThresholds),
+			flags.ParamsSlice(utils.MetaThresholds, utils.MetaIDs),
+			flags.GetBool(utils.MetaStats),
+			flags.ParamsSlice(utils.MetaStats, utils.MetaIDs),
+			ev.AsCGREvent(), flags.Has(utils.MetaFD)))
+}
+
+func (sS *sessionSv1Server) ProcessCDR(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	return call(ctx, sS.caller, utils.SessionSv1ProcessCDR, ev.AsCGREvent())
+}
+
+func (sS *sessionSv1Server) ProcessMessage(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	cgrEv := ev.AsCGREvent()
+	flags := utils.FlagsWithParamsFromSlice(ev.GetFlags())
+	cgrArgs, err := utils.GetRoutePaginatorFromOpts(cgrEv.APIOpts)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return call(ctx, sS.caller, utils.SessionSv1ProcessMessage,
+		sessions.NewV1ProcessMessageArgs(
+			flags.GetBool(utils.MetaAttributes),
+			flags.ParamsSlice(utils.MetaAttributes, utils.MetaIDs),
+			flags.GetBool(utils.MetaThresholds),
+			flags.ParamsSlice(utils.MetaThresholds, utils.MetaIDs),
+			flags.GetBool(utils.MetaStats),
+			flags.ParamsSlice(utils.MetaStats, utils.MetaIDs),
+			flags.GetBool(utils.MetaResources),
+			flags.Has(utils.MetaAccounts),
+			flags.GetBool(utils.MetaRoutes),
+			flags.Has(utils.MetaRoutesIgnoreErrors),
+			flags.Has(utils.MetaRoutesEventCost),
+			cgrEv, cgrArgs,
+			flags.Has(utils.MetaFD),
+			flags.ParamValue(utils.MetaRoutesMaxCost),
+		))
+}
+
+func (sS *sessionSv1Server) ProcessEvent(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	cgrEv := ev.AsCGREvent()
+	cgrArgs, err := utils.GetRoutePaginatorFromOpts(cgrEv.APIOpts)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return call(ctx, sS.caller, utils.SessionSv1ProcessEvent,
+		&sessions.V1ProcessEventArgs{
+			Flags:     ev.GetFlags(),
+			Paginator: cgrArgs,
+			CGREvent:  cgrEv,
+		})
+}
+
+type cdrsV1Server struct {
+	UnimplementedCDRsV1Server
+	caller APICaller
+}
+
+func (cdrS *cdrsV1Server) ProcessEvent(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	return call(ctx, cdrS.caller, utils.CDRsV1ProcessEvent,
+		&engine.ArgV1ProcessEvent{
+			Flags:    ev.GetFlags(),
+			CGREvent: *ev.AsCGREvent(),
+		})
+}
+
+func (cdrS *cdrsV1Server) ProcessEvents(stream CDRsV1_ProcessEventsServer) error {
+	return processStream(stream, cdrS.caller, cdrS.ProcessEvent)
+}
+
+type attributeSv1Server struct {
+	UnimplementedAttributeSv1Server
+	caller APICaller
+}
+
+func (attrS *attributeSv1Server) GetAttributeForEvent(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	return call(ctx, attrS.caller, utils.AttributeSv1GetAttributeForEvent, ev.AsCGREvent())
+}
+
+func (attrS *attributeSv1Server) ProcessEvent(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	return call(ctx, attrS.caller, utils.AttributeSv1ProcessEvent, ev.AsCGREvent())
+}
+
+type resourceSv1Server struct {
+	UnimplementedResourceSv1Server
+	caller APICaller
+}
+
+func (rS *resourceSv1Server) GetResourcesForEvent(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	return call(ctx, rS.caller, utils.ResourceSv1GetResourcesForEvent, ev.AsCGREvent())
+}
+
+func (rS *resourceSv1Server) AuthorizeResources(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	return call(ctx, rS.caller, utils.ResourceSv1AuthorizeResources, ev.AsCGREvent())
+}
+
+func (rS *resourceSv1Server) AllocateResources(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	return call(ctx, rS.caller, utils.ResourceSv1AllocateResources, ev.AsCGREvent())
+}
+
+func (rS *resourceSv1Server) ReleaseResources(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	return call(ctx, rS.caller, utils.ResourceSv1ReleaseResources, ev.AsCGREvent())
+}
+
+type statSv1Server struct {
+	UnimplementedStatSv1Server
+	caller APICaller
+}
+
+func (stS *statSv1Server) ProcessEvent(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	return call(ctx, stS.caller, utils.StatSv1ProcessEvent, ev.AsCGREvent())
+}
+
+func (stS *statSv1Server) GetStatQueuesForEvent(ctx context.Context, ev *CGREvent) (*Reply, error) {
+	return call(ctx, stS.caller, utils.StatSv1GetStatQueuesForEvent, ev.AsCGREvent())
+}
+
+func (stS *statSv1Server) GetQueueStringMetrics(ctx context.Context, args *TenantID) (*Reply, error) {
+	return call(ctx, stS.caller, utils.StatSv1GetQueueStringMetrics,
+		&utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{Tenant: args.GetTenant(), ID: args.GetId()},
+			APIOpts:  args.GetApiOpts().AsMap(),
+		})
+}
+
+func (stS *statSv1Server) ProcessEvents(stream StatSv1_ProcessEventsServer) error {
+	return processStream(stream, stS.caller, stS.ProcessEvent)
+}
+
+type apierSv1Server struct {
+	UnimplementedAPIerSv1Server
+	caller APICaller
+}
+
+func (apierS *apierSv1Server) GetAccount(ctx context.Context, args *AccountArgs) (*Reply, error) {
+	return call(ctx, apierS.caller, utils.APIerSv1GetAccount,
+		&utils.AttrGetAccount{
+			Tenant:  args.GetTenant(),
+			Account: args.GetAccount(),
+		})
+}
+
+func (apierS *apierSv1Server) SetAccount(ctx context.Context, args *SetAccountArgs) (*Reply, error) {
+	return call(ctx, apierS.caller, utils.APIerSv1SetAccount,
+		&utils.AttrSetAccount{
+			Tenant:           args.GetTenant(),
+			Account:          args.GetAccount(),
+			ActionPlanID:     args.GetActionPlanId(),
+			ActionTriggersID: args.GetActionTriggersId(),
+			ExtraOptions:     args.GetExtraOptions(),
+			ReloadScheduler:  args.GetReloadScheduler(),
+		})
+}
+
+func (apierS *apierSv1Server) RemoveAccount(ctx context.Context, args *AccountArgs) (*Reply, error) {
+	return call(ctx, apierS.caller, utils.APIerSv1RemoveAccount,
+		&utils.AttrRemoveAccount{
+			Tenant:          args.GetTenant(),
+			Account:         args.GetAccount(),
+			ReloadScheduler: args.GetReloadScheduler(),
+		})
+}
+
+func (apierS *apierSv1Server) GetAccounts(ctx context.Context, args *GetAccountsArgs) (*Reply, error) {
+	return call(ctx, apierS.caller, utils.APIerSv1GetAccounts,
+		&utils.AttrGetAccounts{
+			Tenant:     args.GetTenant(),
+			AccountIDs: args.GetAccountIds(),
+			Offset:     int(args.GetOffset()),
+			Limit:      int(args.GetLimit()),
+			Filter:     args.GetFilter(),
+		})
+}
+
+func (apierS *apierSv1Server) AddBalance(ctx context.Context, args *BalanceArgs) (*Reply, error) {
+	return call(ctx, apierS.caller, utils.APIerSv1AddBalance, args.asAttrAddBalance())
+}
+
+func (apierS *apierSv1Server) DebitBalance(ctx context.Context, args *BalanceArgs) (*Reply, error) {
+	return call(ctx, apierS.caller, utils.APIerSv1DebitBalance, args.asAttrAddBalance())
+}
+
+func (args *BalanceArgs) asAttrAddBalance() (attr *v1.AttrAddBalance) {
+	attr = &v1.AttrAddBalance{
+		Tenant:      args.GetTenant(),
+		Account:     args.GetAccount(),
+		BalanceType: args.GetBalanceType(),
+		Value:       args.GetValue(),
+		Balance:     args.GetBalance().AsMap(),
+		Overwrite:   args.GetOverwrite(),
+		Cdrlog:      args.GetCdrlog(),
+	}
+	if args.GetActionExtraData() != nil {
+		extraData := args.GetActionExtraData().AsMap()
+		attr.ActionExtraData = &extraData
+	}
+	return
+}
@@ -0,0 +1,201 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package grpcapi
+
+import (
+	"context"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+
+	v1 "github.com/cgrates/cgrates/apier/v1"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/sessions"
+	"github.com/cgrates/cgrates/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type callerMock struct {
+	calls []string
+	args  []any
+	reply any
+	err   error
+}
+
+func (cM *callerMock) CallJSON(_ net.Addr, serviceMethod string, args, reply any) error {
+	cM.calls = append(cM.calls, serviceMethod)
+	cM.args = append(cM.args, args)
+	if cM.err != nil {
+		return cM.err
+	}
+	*reply.(*any) = cM.reply
+	return nil
+}
+
+func newTestConn(t *testing.T, caller APICaller) *grpc.ClientConn {
+	l := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	Register(srv, caller)
+	go srv.Serve(l)
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestSessionSv1AuthorizeEvent(t *testing.T) {
+	caller := &callerMock{reply: map[string]any{utils.CapMaxUsage: 3600000000000.}}
+	clnt := NewSessionSv1Client(newTestConn(t, caller))
+	ev, err := structpb.NewStruct(map[string]any{
+		utils.AccountField: "1001",
+		utils.Usage:        "1h",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rply, err := clnt.AuthorizeEvent(context.Background(), &CGREvent{
+		Tenant: "cgrates.org",
+		Id:     "TestAuth",
+		Event:  ev,
+		Flags:  []string{utils.MetaAccounts, "*attributes:*ids:ATTR_1001"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := []string{utils.SessionSv1AuthorizeEvent}; !reflect.DeepEqual(exp, caller.calls) {
+		t.Errorf("Expected %+v, received %+v", exp, caller.calls)
+	}
+	expArgs := &sessions.V1AuthorizeArgs{
+		GetAttributes: true,
+		AttributeIDs:  []string{"ATTR_1001"},
+		GetMaxUsage:   true,
+		CGREvent: &utils.CGREvent{
+			Tenant: "cgrates.org",
+			ID:     "TestAuth",
+			Event: map[string]any{
+				utils.AccountField: "1001",
+				utils.Usage:        "1h",
+			},
+			APIOpts: map[string]any{},
+		},
+	}
+	if !reflect.DeepEqual(expArgs, caller.args[0]) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(expArgs), utils.ToJSON(caller.args[0]))
+	}
+	if rcv := rply.GetReply().AsInterface(); !reflect.DeepEqual(caller.reply, rcv) {
+		t.Errorf("Expected %+v, received %+v", caller.reply, rcv)
+	}
+}
+
+func TestAttributeSv1ProcessEventErrors(t *testing.T) {
+	caller := &callerMock{err: utils.ErrNotFound}
+	clnt := NewAttributeSv1Client(newTestConn(t, caller))
+	if _, err := clnt.ProcessEvent(context.Background(), &CGREvent{Tenant: "cgrates.org"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected %v, received %v", codes.NotFound, err)
+	}
+	caller.err = utils.NewErrMandatoryIeMissing(utils.Event)
+	if _, err := clnt.ProcessEvent(context.Background(), &CGREvent{Tenant: "cgrates.org"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected %v, received %v", codes.InvalidArgument, err)
+	}
+}
+
+func TestCDRsV1ProcessEvents(t *testing.T) {
+	caller := &callerMock{reply: utils.OK}
+	clnt := NewCDRsV1Client(newTestConn(t, caller))
+	stream, err := clnt.ProcessEvents(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"CDR1", "CDR2"} {
+		if err = stream.Send(&CGREvent{
+			Tenant: "cgrates.org",
+			Id:     id,
+			Flags:  []string{utils.MetaRALs},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		var rply *Reply
+		if rply, err = stream.Recv(); err != nil {
+			t.Fatal(err)
+		} else if rply.GetReply().GetStringValue() != utils.OK || rply.GetError() != utils.EmptyString {
+			t.Errorf("Unexpected reply: %+v", rply)
+		}
+	}
+	if err = stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stream.Recv(); err != io.EOF {
+		t.Errorf("Expected %v, received %v", io.EOF, err)
+	}
+	expArgs := &engine.ArgV1ProcessEvent{
+		Flags: []string{utils.MetaRALs},
+		CGREvent: utils.CGREvent{
+			Tenant:  "cgrates.org",
+			ID:      "CDR2",
+			Event:   map[string]any{},
+			APIOpts: map[string]any{},
+		},
+	}
+	if len(caller.args) != 2 {
+		t.Fatalf("Expected 2 calls, received: %+v", caller.calls)
+	} else if !reflect.DeepEqual(expArgs, caller.args[1]) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(expArgs), utils.ToJSON(caller.args[1]))
+	}
+}
+
+func TestAPIerSv1AddBalance(t *testing.T) {
+	caller := &callerMock{reply: utils.OK}
+	clnt := NewAPIerSv1Client(newTestConn(t, caller))
+	blnc, err := structpb.NewStruct(map[string]any{utils.ID: "MONETARY_1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.AddBalance(context.Background(), &BalanceArgs{
+		Tenant:      "cgrates.org",
+		Account:     "1001",
+		BalanceType: utils.MetaMonetary,
+		Value:       10,
+		Balance:     blnc,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	expArgs := &v1.AttrAddBalance{
+		Tenant:      "cgrates.org",
+		Account:     "1001",
+		BalanceType: utils.MetaMonetary,
+		Value:       10,
+		Balance:     map[string]any{utils.ID: "MONETARY_1"},
+	}
+	if exp := []string{utils.APIerSv1AddBalance}; !reflect.DeepEqual(exp, caller.calls) {
+		t.Errorf("Expected %+v, received %+v", exp, caller.calls)
+	} else if !reflect.DeepEqual(expArgs, caller.args[0]) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(expArgs), utils.ToJSON(caller.args[0]))
+	}
+}
@@ -61,6 +61,7 @@ func NewLoader(dm *engine.DataManager, cfg *config.LoaderSCfg,
 		filterS:       filterS,
 		connMgr:       connMgr,
 		cacheConns:    cacheConns,
+		remote:        newRemoteSource(cfg),
 	}
 	for _, ldrData := range cfg.Data {
 		ldr.dataTpls[ldrData.Type] = ldrData.Fields
@@ -105,6 +106,7 @@ type Loader struct {
 	filterS       *engine.FilterS
 	connMgr       *engine.ConnManager
 	cacheConns    []string
+	remote        remoteSource // nil when reading from the local tpInDir
 }
 
 func (ldr *Loader) ListenAndServe(stopChan chan struct{}) (err error) {
@@ -118,6 +120,7 @@ func (ldr *Loader) ProcessFolder(caching, loadOption string, stopOnError bool) (
 		return
 	}
 	defer ldr.unlockFolder()
+	var processed []string // the files loaded, the only ones moved out of a remote source
 	for ldrType := range ldr.rdrs {
 		if err = ldr.processFiles(ldrType, caching, loadOption); err != nil {
 			if stopOnError {
@@ -127,8 +130,11 @@ func (ldr *Loader) ProcessFolder(caching, loadOption string, stopOnError bool) (
 				utils.LoaderS, ldr.ldrID, ldrType, err.Error()))
 			continue
 		}
+		for fName := range ldr.rdrs[ldrType] {
+			processed = append(processed, fName)
+		}
 	}
-	return ldr.moveFiles()
+	return ldr.moveFiles(processed)
 }
 
 // lockFolder will attempt to lock the folder by creating the lock file
@@ -174,10 +180,18 @@ func (ldr *Loader) unreferenceFile(loaderType, fileName string) (err error) {
 	return openedCSVFile.rdr.Close()
 }
 
-func (ldr *Loader) moveFiles() (err error) {
+func (ldr *Loader) moveFiles(processed []string) (err error) {
 	if ldr.tpOutDir == utils.EmptyString {
 		return
 	}
+	if ldr.remote != nil {
+		for _, fName := range processed {
+			if err = ldr.remote.Move(fName); err != nil {
+				return
+			}
+		}
+		return
+	}
 	filesInDir, _ := os.ReadDir(ldr.tpInDir)
 	for _, file := range filesInDir {
 		fName := file.Name()
@@ -195,8 +209,8 @@ func (ldr *Loader) moveFiles() (err error) {
 
 func (ldr *Loader) processFiles(loaderType, caching, loadOption string) (err error) {
 	for fName := range ldr.rdrs[loaderType] {
-		var rdr *os.File
-		if rdr, err = os.Open(path.Join(ldr.tpInDir, fName)); err != nil {
+		var rdr io.ReadCloser
+		if rdr, err = ldr.openFile(fName); err != nil {
 			return err
 		}
 		csvReader := csv.NewReader(rdr)
@@ -216,6 +230,14 @@ func (ldr *Loader) processFiles(loaderType, caching, loadOption string) (err err
 	return
 }
 
+// openFile opens the file from the local folder or from the remote source
+func (ldr *Loader) openFile(fName string) (io.ReadCloser, error) {
+	if ldr.remote != nil {
+		return ldr.remote.Open(fName)
+	}
+	return os.Open(path.Join(ldr.tpInDir, fName))
+}
+
 // processContent will process the contect and will store it into database
 func (ldr *Loader) processContent(loaderType, caching string) (err error) {
 	// start processing lines
@@ -464,6 +486,34 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 				cacheArgs[utils.CacheRankingFilterIndexes] = ids
 			}
 		}
+	case utils.MetaHolidayCalendars:
+		for _, lDataSet := range lds {
+			hcModels := make(engine.HolidayCalendarMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				hcModels[i] = new(engine.HolidayCalendarMdl)
+				if err = utils.UpdateStructWithIfaceMap(hcModels[i], ld); err != nil {
+					return
+				}
+			}
+			for _, tpHc := range hcModels.AsTPHolidayCalendars() {
+				hc, err := engine.APItoHolidayCalendar(tpHc)
+				if err != nil {
+					return err
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: HolidayCalendar: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(hc)))
+					continue
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, hc.TenantID())
+				if err := ldr.dm.SetHolidayCalendar(hc); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheHolidayCalendars] = ids
+			}
+		}
 	case utils.MetaThresholds:
 		cacheIDs = []string{utils.CacheThresholdFilterIndexes}
 		for _, lDataSet := range lds {
@@ -791,6 +841,23 @@ func (ldr *Loader) removeLoadedData(loaderType string, lds map[string][]LoaderDa
 				cacheArgs[utils.CacheRankingProfiles] = ids
 			}
 		}
+	case utils.MetaHolidayCalendars:
+		for tntID := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: HolidayCalendarID: %s",
+						utils.LoaderS, ldr.ldrID, tntID))
+			} else {
+				tntIDStruct := utils.NewTenantID(tntID)
+				// get IDs so we can reload in cache
+				ids = append(ids, tntID)
+				if err := ldr.dm.RemoveHolidayCalendar(tntIDStruct.Tenant,
+					tntIDStruct.ID); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheHolidayCalendars] = ids
+			}
+		}
 	case utils.MetaThresholds:
 		cacheIDs = []string{utils.CacheThresholdFilterIndexes}
 		for tntID := range lds {
@@ -898,6 +965,9 @@ func (ldr *Loader) serve(stopChan chan struct{}) (err error) {
 	case time.Duration(0): // 0 disables the automatic read, maybe done per API
 		return
 	case time.Duration(-1):
+		if ldr.remote != nil {
+			return fmt.Errorf("cannot watch for changes the remote source <%s>", ldr.tpInDir)
+		}
 		return utils.WatchDir(ldr.tpInDir, ldr.processFile,
 			utils.LoaderS+"-"+ldr.ldrID, stopChan)
 	default:
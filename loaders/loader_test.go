@@ -1366,6 +1366,98 @@ func TestLoaderProcessDispatcheHosts(t *testing.T) {
 	}
 }
 
+func TestLoaderProcessHolidayCalendars(t *testing.T) {
+	ldr := &Loader{
+		ldrID:         "TestLoaderProcessContent",
+		bufLoaderData: make(map[string][]LoaderData),
+		dm:            engine.NewDataManager(data, config.CgrConfig().CacheCfg(), nil),
+		timezone:      "UTC",
+	}
+	ldr.dataTpls = map[string][]*config.FCTemplate{
+		utils.MetaHolidayCalendars: {
+			{
+				Tag:       "Tenant",
+				Path:      "Tenant",
+				Type:      utils.MetaComposed,
+				Value:     config.NewRSRParsersMustCompile("~*req.0", utils.InfieldSep),
+				Mandatory: true,
+			},
+			{
+				Tag:       "ID",
+				Path:      "ID",
+				Type:      utils.MetaComposed,
+				Value:     config.NewRSRParsersMustCompile("~*req.1", utils.InfieldSep),
+				Mandatory: true,
+			},
+			{
+				Tag:       "Date",
+				Path:      "Date",
+				Type:      utils.MetaComposed,
+				Value:     config.NewRSRParsersMustCompile("~*req.2", utils.InfieldSep),
+				Mandatory: true,
+			},
+			{
+				Tag:   "Name",
+				Path:  "Name",
+				Type:  utils.MetaComposed,
+				Value: config.NewRSRParsersMustCompile("~*req.3", utils.InfieldSep),
+			},
+		},
+	}
+	hcCSVContent := `
+#Tenant[0],ID[1],Date[2],Name[3]
+cgrates.org,HC_DE,01-01,New Year
+cgrates.org,HC_DE,*easter+1,Easter Monday
+cgrates.org,HC_DE,12-25,Christmas Day
+`
+	rdr := io.NopCloser(strings.NewReader(hcCSVContent))
+	csvRdr := csv.NewReader(rdr)
+	csvRdr.Comment = '#'
+	ldr.rdrs = map[string]map[string]*openedCSVFile{
+		utils.MetaHolidayCalendars: {
+			utils.HolidayCalendarsCsv: &openedCSVFile{
+				fileName: utils.HolidayCalendarsCsv,
+				rdr:      rdr,
+				csvRdr:   csvRdr,
+			},
+		},
+	}
+	if err := ldr.processContent(utils.MetaHolidayCalendars, utils.EmptyString); err != nil {
+		t.Error(err)
+	}
+	if len(ldr.bufLoaderData) != 0 {
+		t.Errorf("wrong buffer content: %+v", ldr.bufLoaderData)
+	}
+	eHC := &engine.HolidayCalendar{
+		Tenant: "cgrates.org",
+		ID:     "HC_DE",
+		Holidays: []*engine.Holiday{
+			{Date: "01-01", Name: "New Year"},
+			{Date: "*easter+1", Name: "Easter Monday"},
+			{Date: "12-25", Name: "Christmas Day"},
+		},
+	}
+	if err := eHC.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err := ldr.dm.GetHolidayCalendar("cgrates.org", "HC_DE",
+		true, false, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(eHC, rcv) {
+		t.Errorf("expecting: %+v, received: %+v", utils.ToJSON(eHC), utils.ToJSON(rcv))
+	}
+
+	if err := ldr.removeLoadedData(utils.MetaHolidayCalendars, map[string][]LoaderData{
+		"cgrates.org:HC_DE": {{utils.Tenant: "cgrates.org", utils.ID: "HC_DE"}},
+	}, utils.EmptyString); err != nil {
+		t.Error(err)
+	}
+	if _, err := ldr.dm.GetHolidayCalendar("cgrates.org", "HC_DE",
+		false, false, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
 func TestLoaderRemoveContentSingleFile(t *testing.T) {
 	ldr := &Loader{
 		ldrID:         "TestLoaderProcessContent",
@@ -3787,7 +3879,7 @@ func TestLoaderMoveFiles(t *testing.T) {
 		lockFilepath: "lockfile",
 	}
 
-	if err := loader.moveFiles(); err != nil {
+	if err := loader.moveFiles(nil); err != nil {
 		t.Errorf("moveFiles returned an error: %v", err)
 	}
 
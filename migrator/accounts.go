@@ -119,6 +119,36 @@ func (m *Migrator) migrateV2Accounts() (v3Acnt *engine.Account, err error) {
 	return
 }
 
+// migrateV3Accounts populates the decimal values of the *monetary balances for all the accounts in DataDB
+// since the v3 structure is compatible with the current one
+func (m *Migrator) migrateV3Accounts() (err error) {
+	var ids []string
+	if ids, err = m.dmIN.DataManager().DataDB().GetKeysForPrefix(utils.AccountPrefix); err != nil {
+		return
+	}
+	for _, id := range ids {
+		idg := strings.TrimPrefix(id, utils.AccountPrefix)
+		var acc *engine.Account
+		if acc, err = m.dmIN.DataManager().GetAccount(idg); err != nil {
+			return
+		}
+		if acc == nil || m.dryRun {
+			continue
+		}
+		acc.InitDecimalBalances()
+		if err = m.dmOut.DataManager().SetAccount(acc); err != nil {
+			return
+		}
+		if !m.sameDataDB {
+			if err = m.dmIN.DataManager().RemoveAccount(idg); err != nil {
+				return
+			}
+		}
+		m.stats[utils.Accounts]++
+	}
+	return
+}
+
 func (m *Migrator) migrateAccounts() (err error) {
 	var vrs engine.Versions
 	current := engine.CurrentDataDBVersions()
@@ -135,15 +165,15 @@ func (m *Migrator) migrateAccounts() (err error) {
 			switch version {
 			default:
 				return fmt.Errorf("Unsupported version %v", version)
-			case current[utils.Accounts]:
+			case current[utils.Accounts], 4: // v4 is usable as it is with decimal_balances disabled
 				migrated = false
+				version = current[utils.Accounts]
 				if m.sameDataDB {
 					break
 				}
 				if err = m.migrateCurrentAccounts(); err != nil {
 					return
 				}
-				version = 3
 			case 1: //migrate v1 to v3
 				if v3Acnt, err = m.migrateV1Accounts(); err != nil && err != utils.ErrNoMoreData {
 					return err
@@ -158,6 +188,16 @@ func (m *Migrator) migrateAccounts() (err error) {
 					break
 				}
 				version = 3
+			case 3: //migrate v3 to v4
+				if v3Acnt == nil { // nothing converted in memory, migrate the ones from DataDB
+					if err = m.migrateV3Accounts(); err != nil {
+						return
+					}
+					err = utils.ErrNoMoreData
+					break
+				}
+				v3Acnt.InitDecimalBalances()
+				version = 4
 			}
 			if version == current[utils.Accounts] || err == utils.ErrNoMoreData {
 				break
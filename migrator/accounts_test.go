@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 )
@@ -153,3 +154,73 @@ func TestV2toV3Account(t *testing.T) {
 		}
 	}
 }
+
+func TestMigrateV3Accounts(t *testing.T) {
+	engine.SetDecimalBalances(true)
+	defer engine.SetDecimalBalances(false)
+	cfg := config.NewDefaultCGRConfig()
+	dm := engine.NewDataManager(engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items),
+		cfg.CacheCfg(), nil)
+	iDBMig := newInternalMigrator(dm)
+	m, err := NewMigrator(iDBMig, iDBMig, nil, nil, false, true, true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = dm.DataDB().SetVersions(engine.Versions{utils.Accounts: 3}, false); err != nil {
+		t.Fatal(err)
+	}
+	if err = dm.SetAccount(&engine.Account{
+		ID: "cgrates.org:1001",
+		BalanceMap: map[string]engine.Balances{
+			utils.MetaMonetary: {{ID: "MONETARY", Value: 12.345}},
+			utils.MetaSMS:      {{ID: "SMS", Value: 10}},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.migrateAccounts(); err != nil {
+		t.Fatal(err)
+	}
+	if acc, err := dm.GetAccount("cgrates.org:1001"); err != nil {
+		t.Error(err)
+	} else if d := acc.BalanceMap[utils.MetaMonetary][0].DecimalValue; d == nil || d.String() != "12.345" {
+		t.Errorf("unexpected decimal value: %v", d)
+	} else if d := acc.BalanceMap[utils.MetaSMS][0].DecimalValue; d != nil {
+		t.Errorf("expected no decimal value for *sms balances, received: %v", d)
+	}
+	if m.stats[utils.Accounts] != 1 {
+		t.Errorf("expected 1 migrated account, received: %d", m.stats[utils.Accounts])
+	}
+	if vrs, err := dm.DataDB().GetVersions(utils.Accounts); err != nil {
+		t.Error(err)
+	} else if vrs[utils.Accounts] != 4 {
+		t.Errorf("unexpected version: %d", vrs[utils.Accounts])
+	}
+}
+
+func TestMigrateAccountsDecimalBalancesDisabled(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	dm := engine.NewDataManager(engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items),
+		cfg.CacheCfg(), nil)
+	iDBMig := newInternalMigrator(dm)
+	m, err := NewMigrator(iDBMig, iDBMig, nil, nil, false, true, true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, vrs := range []int64{3, 4} { // v4 left by a previous run with decimal_balances
+		if err = dm.DataDB().SetVersions(engine.Versions{utils.Accounts: vrs}, false); err != nil {
+			t.Fatal(err)
+		}
+		if err = m.migrateAccounts(); err != nil {
+			t.Fatal(err)
+		}
+		if m.stats[utils.Accounts] != 0 {
+			t.Errorf("expected no migrated accounts, received: %d", m.stats[utils.Accounts])
+		}
+		if rcv, err := dm.DataDB().GetVersions(utils.Accounts); err != nil {
+			t.Error(err)
+		} else if rcv[utils.Accounts] != vrs {
+			t.Errorf("expected version %d, received: %d", vrs, rcv[utils.Accounts])
+		}
+	}
+}
@@ -332,7 +332,9 @@ func (iDBMig *internalMigrator) remSupplier(tenant, id string) (err error) {
 	return utils.ErrNotImplemented
 }
 
-func (iDBMig *internalMigrator) close() {}
+func (iDBMig *internalMigrator) close() {
+	iDBMig.iDB.Close()
+}
 
 func (iDBMig *internalMigrator) getV1ChargerProfile() (v1chrPrf *engine.ChargerProfile, err error) {
 	return nil, utils.ErrNotImplemented
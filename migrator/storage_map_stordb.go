@@ -35,7 +35,9 @@ type internalStorDBMigrator struct {
 	iDB    *engine.InternalDB
 }
 
-func (iDBMig *internalStorDBMigrator) close() {}
+func (iDBMig *internalStorDBMigrator) close() {
+	iDBMig.iDB.Close()
+}
 
 func (iDBMig *internalStorDBMigrator) StorDB() engine.StorDB {
 	return *iDBMig.storDB
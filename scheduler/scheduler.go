@@ -42,6 +42,8 @@ type Scheduler struct {
 	actStatsInterval                time.Duration                 // How long time to keep the stats in memory
 	aSMux, aFMux                    sync.RWMutex                  // protect schedStats
 	actSuccessStats, actFailedStats map[string]map[time.Time]bool // keep here stats regarding executed actions, map[actionType]map[execTime]bool
+	leaderSince                     time.Time                     // when the node became leader, zero if it is not the leader
+	stopLease                       chan struct{}                 // stops renewing the lease on shutdown
 }
 
 func NewScheduler(dm *engine.DataManager, cfg *config.CGRConfig,
@@ -89,6 +91,13 @@ func (s *Scheduler) Loop() {
 	s.Lock()
 	s.started = true
 	s.Unlock()
+	if s.cfg.SchedulerCfg().LeaderElection {
+		s.Lock()
+		s.stopLease = make(chan struct{})
+		s.Unlock()
+		s.renewLease()
+		go s.leaseLoop(s.stopLease)
+	}
 	for {
 		if !s.isRunning() { // shutdown requested
 			break
@@ -103,7 +112,12 @@ func (s *Scheduler) Loop() {
 		now := time.Now()
 		start := a0.GetNextStartTime(now)
 		if start.Equal(now) || start.Before(now) {
-			go a0.Execute(s.fltrS, utils.SchedulerS)
+			if s.isActive(start) {
+				go func(at *engine.ActionTiming, start time.Time) {
+					s.setLastRun(at, start)
+					at.Execute(s.fltrS, utils.SchedulerS)
+				}(a0, start)
+			}
 			// if after execute the next start time is in the past then
 			// do not add it to the queue
 			a0.ResetStartTimeCache()
@@ -215,17 +229,7 @@ func (s *Scheduler) loadActionPlans() {
 			}
 			at.SetAccountIDs(actionPlan.AccountIDs) // copy the accounts
 			at.SetActionPlanID(actionPlan.Id)
-			for _, task := range at.Tasks() {
-				if pass, err := s.fltrS.Pass(s.cfg.GeneralCfg().DefaultTenant,
-					s.cfg.SchedulerCfg().Filters, task); err != nil {
-					utils.Logger.Warning(
-						fmt.Sprintf("<%s> error: <%s> querying filters for path: <%+v>, not executing action <%s> on account <%s>",
-							utils.SchedulerS, err.Error(), s.cfg.SchedulerCfg().Filters, task.ActionsID, task.AccountID))
-					at.RemoveAccountID(task.AccountID)
-				} else if !pass {
-					at.RemoveAccountID(task.AccountID)
-				}
-			}
+			s.filterAccounts(at)
 			s.queue = append(s.queue, at)
 		}
 	}
@@ -233,6 +237,21 @@ func (s *Scheduler) loadActionPlans() {
 	utils.Logger.Info(fmt.Sprintf("<Scheduler> queued %d action plans", len(s.queue)))
 }
 
+// filterAccounts removes from the action timing the accounts not matching the scheduler filters
+func (s *Scheduler) filterAccounts(at *engine.ActionTiming) {
+	for _, task := range at.Tasks() {
+		if pass, err := s.fltrS.Pass(s.cfg.GeneralCfg().DefaultTenant,
+			s.cfg.SchedulerCfg().Filters, task); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> error: <%s> querying filters for path: <%+v>, not executing action <%s> on account <%s>",
+					utils.SchedulerS, err.Error(), s.cfg.SchedulerCfg().Filters, task.ActionsID, task.AccountID))
+			at.RemoveAccountID(task.AccountID)
+		} else if !pass {
+			at.RemoveAccountID(task.AccountID)
+		}
+	}
+}
+
 func (s *Scheduler) restart() {
 	if s.isRunning() {
 		s.restartLoop <- struct{}{}
@@ -317,9 +336,188 @@ func (s *Scheduler) GetScheduledActions(fltr ArgsGetScheduledActions) (schedActi
 func (s *Scheduler) Shutdown() {
 	s.Lock()
 	s.started = false // disable loop on next run
+	if s.stopLease != nil {
+		close(s.stopLease)
+		s.stopLease = nil
+	}
 	s.Unlock()
 	s.restartLoop <- struct{}{} // cancel waiting tasks
 	if s.timer != nil {
 		s.timer.Stop()
 	}
 }
+
+// isActive returns true if this node should execute the actions due at start time
+// with leader election only the actions due after the node became leader are executed
+// since the previous ones are handled by the catch-up policy
+func (s *Scheduler) isActive(start time.Time) bool {
+	if !s.cfg.SchedulerCfg().LeaderElection {
+		return true
+	}
+	return !s.leaderSince.IsZero() && start.After(s.leaderSince)
+}
+
+// leaseLoop renews the lease each third of its TTL until stopped
+func (s *Scheduler) leaseLoop(stopLease chan struct{}) {
+	tkr := time.NewTicker(s.cfg.SchedulerCfg().LeaseTTL / 3)
+	defer tkr.Stop()
+	for {
+		select {
+		case <-stopLease:
+			s.releaseLease()
+			return
+		case <-tkr.C:
+			s.renewLease()
+		}
+	}
+}
+
+// renewLease attempts to acquire or renew the leadership through DataDB
+// on acquiring the leadership the executions missed since the last renewal are caught up
+func (s *Scheduler) renewLease() {
+	nodeID := s.cfg.GeneralCfg().NodeID
+	s.RLock()
+	wasLeader := !s.leaderSince.IsZero()
+	s.RUnlock()
+	var prevLease *engine.Lease
+	if !wasLeader {
+		var err error
+		if prevLease, err = s.dm.DataDB().GetLeaseDrv(utils.SchedulerS); err != nil &&
+			err != utils.ErrNotFound {
+			utils.Logger.Warning(fmt.Sprintf("<%s> failed querying the lease, error: <%s>",
+				utils.SchedulerS, err.Error()))
+			return
+		}
+	}
+	now := time.Now()
+	acquired, err := s.dm.DataDB().AcquireLeaseDrv(&engine.Lease{
+		ID:         utils.SchedulerS,
+		OwnerID:    nodeID,
+		RenewTime:  now,
+		ExpiryTime: now.Add(s.cfg.SchedulerCfg().LeaseTTL),
+	})
+	if err != nil { // step down since another node may take over once the lease expires
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed renewing the lease, error: <%s>",
+			utils.SchedulerS, err.Error()))
+		acquired = false
+	}
+	if acquired == wasLeader {
+		return
+	}
+	s.Lock()
+	s.leaderSince = time.Time{}
+	if acquired {
+		s.leaderSince = now
+	}
+	s.Unlock()
+	if !acquired {
+		utils.Logger.Warning(fmt.Sprintf("<%s> node <%s> lost the leadership", utils.SchedulerS, nodeID))
+		return
+	}
+	utils.Logger.Info(fmt.Sprintf("<%s> node <%s> acquired the leadership", utils.SchedulerS, nodeID))
+	if prevLease != nil && !prevLease.RenewTime.IsZero() {
+		s.catchUp(prevLease.RenewTime, now)
+	}
+}
+
+// releaseLease expires the lease so another node can take over without waiting for the TTL
+func (s *Scheduler) releaseLease() {
+	s.Lock()
+	wasLeader := !s.leaderSince.IsZero()
+	s.leaderSince = time.Time{}
+	s.Unlock()
+	if !wasLeader {
+		return
+	}
+	now := time.Now()
+	if _, err := s.dm.DataDB().AcquireLeaseDrv(&engine.Lease{
+		ID:         utils.SchedulerS,
+		OwnerID:    s.cfg.GeneralCfg().NodeID,
+		RenewTime:  now,
+		ExpiryTime: now,
+	}); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed releasing the lease, error: <%s>",
+			utils.SchedulerS, err.Error()))
+	}
+}
+
+// setLastRun persists the time of the last execution of the action timing
+// so the next leader knows where to catch up from
+func (s *Scheduler) setLastRun(at *engine.ActionTiming, lastRun time.Time) {
+	if !s.cfg.SchedulerCfg().LeaderElection {
+		return
+	}
+	if err := s.dm.DataDB().SetActionTimingLastRunDrv(
+		utils.ConcatenatedKey(at.GetActionPlanID(), at.Uuid), lastRun); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed storing the last run of actions <%s> from action plan <%s>, error: <%s>",
+			utils.SchedulerS, at.ActionsID, at.GetActionPlanID(), err.Error()))
+	}
+}
+
+// catchUp executes the actions missed until to, based on the catch-up policy
+// each action timing is caught up from its last execution, falling back on from
+// for the ones never executed by a leader
+func (s *Scheduler) catchUp(from, to time.Time) {
+	policy := s.cfg.SchedulerCfg().CatchUpPolicy
+	if policy != utils.MetaRunOnce && policy != utils.MetaRunAll {
+		return
+	}
+	actionPlans, err := s.dm.GetAllActionPlans()
+	if err != nil && err != utils.ErrNotFound {
+		utils.Logger.Warning(fmt.Sprintf("<%s> cannot get action plans for catch-up: %v",
+			utils.SchedulerS, err))
+		return
+	}
+	for _, actionPlan := range actionPlans {
+		if actionPlan == nil {
+			continue
+		}
+		for _, at := range actionPlan.ActionTimings {
+			if at.Timing == nil || at.IsASAP() {
+				continue
+			}
+			lastRun, err := s.dm.DataDB().GetActionTimingLastRunDrv(
+				utils.ConcatenatedKey(actionPlan.Id, at.Uuid))
+			if err == utils.ErrNotFound {
+				lastRun = from
+			} else if err != nil {
+				utils.Logger.Warning(fmt.Sprintf("<%s> cannot get the last run of actions <%s> from action plan <%s> for catch-up: %v",
+					utils.SchedulerS, at.ActionsID, actionPlan.Id, err))
+				continue
+			}
+			runs, lastStart := missedRuns(at.Clone(), lastRun, to)
+			if runs == 0 {
+				continue
+			}
+			if policy == utils.MetaRunOnce {
+				runs = 1
+			}
+			at = at.Clone() // do not share the accounts with the queued action timing
+			at.SetAccountIDs(actionPlan.AccountIDs.Clone())
+			at.SetActionPlanID(actionPlan.Id)
+			s.filterAccounts(at)
+			s.setLastRun(at, lastStart)
+			utils.Logger.Info(fmt.Sprintf("<%s> catching up %d execution(s) of actions <%s> from action plan <%s>",
+				utils.SchedulerS, runs, at.ActionsID, actionPlan.Id))
+			go func(at *engine.ActionTiming, runs int) {
+				for i := 0; i < runs; i++ {
+					at.Execute(s.fltrS, utils.SchedulerS)
+				}
+			}(at, runs)
+		}
+	}
+}
+
+// missedRuns returns the number of times the action timing was due within (from, to]
+// together with the last time it was due
+func missedRuns(at *engine.ActionTiming, from, to time.Time) (runs int, last time.Time) {
+	for {
+		at.ResetStartTimeCache()
+		start := at.GetNextStartTime(from)
+		if start.IsZero() || !start.After(from) || start.After(to) {
+			return
+		}
+		runs++
+		from, last = start, start
+	}
+}
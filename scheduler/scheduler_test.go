@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 )
@@ -46,3 +47,147 @@ func TestSchedulerUpdateActStats(t *testing.T) {
 		t.Errorf("Wrong stats: %+v", sched.actSuccessStats)
 	}
 }
+
+func TestSchedulerMissedRuns(t *testing.T) {
+	at := &engine.ActionTiming{
+		Timing: &engine.RateInterval{
+			Timing: &engine.RITiming{
+				Months:    utils.Months{},
+				MonthDays: utils.MonthDays{1},
+				StartTime: "00:00:00",
+			},
+		},
+	}
+	from := time.Date(2024, 1, 15, 10, 0, 0, 0, time.Local)
+	if runs, last := missedRuns(at, from, time.Date(2024, 4, 2, 0, 0, 0, 0, time.Local)); runs != 3 {
+		t.Errorf("Expected 3 missed runs, received: %d", runs)
+	} else if exp := time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local); !last.Equal(exp) {
+		t.Errorf("Expected last run %v, received: %v", exp, last)
+	}
+	if runs, _ := missedRuns(at, from, time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local)); runs != 3 {
+		t.Errorf("Expected 3 missed runs, received: %d", runs)
+	}
+	if runs, _ := missedRuns(at, from, time.Date(2024, 1, 31, 0, 0, 0, 0, time.Local)); runs != 0 {
+		t.Errorf("Expected no missed runs, received: %d", runs)
+	}
+}
+
+func TestSchedulerLeaderElection(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.SchedulerCfg().LeaderElection = true
+	cfg.SchedulerCfg().LeaseTTL = time.Minute
+	cfg.SchedulerCfg().CatchUpPolicy = utils.MetaRunOnce
+	config.SetCgrConfig(cfg)
+	defer config.SetCgrConfig(config.NewDefaultCGRConfig())
+	data := engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
+	engine.SetDataStorage(dm)
+	defer engine.SetDataStorage(nil)
+	fltrS := engine.NewFilterS(cfg, nil, dm)
+
+	if err := dm.SetActions("TOPUP_10", engine.Actions{{
+		Id:         "TOPUP_10",
+		ActionType: utils.MetaTopUp,
+		Balance: &engine.BalanceFilter{
+			Type:  utils.StringPointer(utils.MetaMonetary),
+			Value: &utils.ValueFormula{Static: 10},
+		},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	atUUID := utils.GenUUID()
+	if err := dm.SetActionPlan("MONTHLY", &engine.ActionPlan{
+		Id:         "MONTHLY",
+		AccountIDs: utils.StringMap{"cgrates.org:1001": true},
+		ActionTimings: []*engine.ActionTiming{{
+			Uuid:      atUUID,
+			ActionsID: "TOPUP_10",
+			Timing: &engine.RateInterval{
+				Timing: &engine.RITiming{
+					Months:    utils.Months{},
+					MonthDays: utils.MonthDays{1},
+					StartTime: "00:00:00",
+				},
+			},
+		}},
+	}, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	// the previous leader was last seen three months ago
+	if _, err := data.AcquireLeaseDrv(&engine.Lease{
+		ID:         utils.SchedulerS,
+		OwnerID:    "node0",
+		RenewTime:  time.Now().AddDate(0, -3, 0),
+		ExpiryTime: time.Now().AddDate(0, -3, 0).Add(time.Minute),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg1 := cfg.Clone()
+	cfg1.GeneralCfg().NodeID = "node1"
+	sched1 := &Scheduler{dm: dm, cfg: cfg1, fltrS: fltrS}
+	cfg2 := cfg.Clone()
+	cfg2.GeneralCfg().NodeID = "node2"
+	sched2 := &Scheduler{dm: dm, cfg: cfg2, fltrS: fltrS}
+
+	sched1.renewLease()
+	if sched1.leaderSince.IsZero() {
+		t.Fatal("Expected node1 to acquire the leadership")
+	}
+	sched2.renewLease()
+	if !sched2.leaderSince.IsZero() {
+		t.Error("Expected node2 to not acquire the leadership")
+	}
+	if start := sched1.leaderSince.Add(time.Second); !sched1.isActive(start) || sched2.isActive(start) {
+		t.Error("Expected only node1 to execute the actions")
+	}
+	if sched1.isActive(sched1.leaderSince) {
+		t.Error("Expected the actions due before the leadership to be handled by catch-up")
+	}
+
+	// *run_once catches up the missed monthly topups with a single execution
+	var acc *engine.Account
+	for range 50 {
+		time.Sleep(10 * time.Millisecond)
+		if acc, _ = dm.GetAccount("cgrates.org:1001"); acc != nil {
+			break
+		}
+	}
+	if acc == nil {
+		t.Fatal("Expected the catch-up to create the account")
+	} else if val := acc.GetBalanceWithID(utils.MetaMonetary, acc.BalanceMap[utils.MetaMonetary][0].ID).GetValue(); val != 10 {
+		t.Errorf("Expected balance 10, received: %v", val)
+	}
+	if _, err := data.GetActionTimingLastRunDrv(utils.ConcatenatedKey("MONTHLY", atUUID)); err != nil {
+		t.Errorf("Expected the catch-up to store the last run, received: %v", err)
+	}
+
+	sched1.releaseLease()
+	if !sched1.leaderSince.IsZero() {
+		t.Error("Expected node1 to step down")
+	}
+	// a stale renewal time must not execute again the actions already caught up
+	if _, err := data.AcquireLeaseDrv(&engine.Lease{
+		ID:         utils.SchedulerS,
+		OwnerID:    "node1",
+		RenewTime:  time.Now().AddDate(0, -3, 0),
+		ExpiryTime: time.Now().AddDate(0, -3, 0).Add(time.Minute),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	sched2.renewLease()
+	if sched2.leaderSince.IsZero() {
+		t.Error("Expected node2 to take over the leadership")
+	}
+	if lse, err := data.GetLeaseDrv(utils.SchedulerS); err != nil {
+		t.Error(err)
+	} else if lse.OwnerID != "node2" || !lse.IsActive(time.Now()) {
+		t.Errorf("Unexpected lease: %+v", lse)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if acc, err := dm.GetAccount("cgrates.org:1001"); err != nil {
+		t.Error(err)
+	} else if val := acc.GetBalanceWithID(utils.MetaMonetary, acc.BalanceMap[utils.MetaMonetary][0].ID).GetValue(); val != 10 {
+		t.Errorf("Expected balance 10, received: %v", val)
+	}
+}
@@ -20,6 +20,7 @@ package services
 
 import (
 	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/cgrates/cgrates/agents"
@@ -55,8 +56,7 @@ type DiameterAgent struct {
 	connMgr *engine.ConnManager
 	caps    *engine.Caps
 
-	lnet  string
-	laddr string
+	listeners []config.DiameterListener
 
 	srvDep map[string]*sync.WaitGroup
 }
@@ -83,8 +83,7 @@ func (da *DiameterAgent) start(filterS *engine.FilterS, caps *engine.Caps) error
 			utils.DiameterAgent, err))
 		return err
 	}
-	da.lnet = da.cfg.DiameterAgentCfg().ListenNet
-	da.laddr = da.cfg.DiameterAgentCfg().Listen
+	da.listeners = slices.Clone(da.cfg.DiameterAgentCfg().Listeners)
 	da.stopChan = make(chan struct{})
 	go func(d *agents.DiameterAgent) {
 		lnsErr := d.ListenAndServe(da.stopChan)
@@ -101,8 +100,7 @@ func (da *DiameterAgent) start(filterS *engine.FilterS, caps *engine.Caps) error
 func (da *DiameterAgent) Reload() (err error) {
 	da.Lock()
 	defer da.Unlock()
-	if da.lnet == da.cfg.DiameterAgentCfg().ListenNet &&
-		da.laddr == da.cfg.DiameterAgentCfg().Listen {
+	if slices.Equal(da.listeners, da.cfg.DiameterAgentCfg().Listeners) {
 		return
 	}
 	close(da.stopChan)
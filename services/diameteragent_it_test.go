@@ -156,7 +156,7 @@ func TestDiameterAgentReload3(t *testing.T) {
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
 	srv := NewDiameterAgent(cfg, filterSChan, shdChan, nil, caps, srvDep)
 
-	cfg.DiameterAgentCfg().ListenNet = "bad"
+	cfg.DiameterAgentCfg().Listeners[0].Network = "bad"
 	cfg.DiameterAgentCfg().DictionariesPath = ""
 
 	da := srv.(*DiameterAgent)
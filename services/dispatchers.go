@@ -87,6 +87,7 @@ func (dspS *DispatcherService) Start() error {
 	defer dspS.Unlock()
 
 	dspS.dspS = dispatchers.NewDispatcherService(datadb, dspS.cfg, fltrS, dspS.connMgr)
+	dspS.dspS.StartLoop()
 
 	dspS.server.RpcUnregisterName(utils.AttributeSv1)
 
@@ -104,7 +105,10 @@ func (dspS *DispatcherService) Start() error {
 
 // Reload handles the change of config
 func (dspS *DispatcherService) Reload() (err error) {
-	return // for the momment nothing to reload
+	dspS.Lock()
+	dspS.dspS.Reload()
+	dspS.Unlock()
+	return
 }
 
 // Shutdown stops the service
@@ -59,7 +59,7 @@ func TestDispatcherSCoverage(t *testing.T) {
 		anz:         anz,
 		srvDep:      srvDep,
 	}
-	srv2.dspS = &dispatchers.DispatcherService{}
+	srv2.dspS = dispatchers.NewDispatcherService(nil, cfg, nil, nil)
 	if !srv2.IsRunning() {
 		t.Errorf("Expected service to be running")
 	}
@@ -87,7 +87,12 @@ func TestDispatcherSCoverage(t *testing.T) {
 }
 
 func TestDispatcherServiceReload(t *testing.T) {
-	dspService := &DispatcherService{}
+	cfg := config.NewDefaultCGRConfig()
+	dspService := &DispatcherService{
+		cfg:  cfg,
+		dspS: dispatchers.NewDispatcherService(nil, cfg, nil, nil),
+	}
+	dspService.dspS.StartLoop()
 	err := dspService.Reload()
 	if err != nil {
 		t.Errorf("Reload() returned an error: %v", err)
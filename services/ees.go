@@ -84,7 +84,10 @@ func (es *EventExporterService) Reload() error {
 	es.mu.Lock()
 	defer es.mu.Unlock()
 	es.eeS.ClearExporterCache()
-	return es.eeS.SetupExporterCache()
+	if err := es.eeS.SetupExporterCache(); err != nil {
+		return err
+	}
+	return es.eeS.SetupRetryQueues()
 }
 
 // Shutdown stops the service
@@ -93,6 +96,7 @@ func (es *EventExporterService) Shutdown() error {
 	defer es.mu.Unlock()
 	utils.Logger.Info(fmt.Sprintf("<%s> shutdown <%s>", utils.CoreS, utils.EEs))
 	es.eeS.ClearExporterCache()
+	es.eeS.StopRetryQueues()
 	es.eeS = nil
 	<-es.intConnChan
 	return nil
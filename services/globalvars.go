@@ -47,6 +47,7 @@ type GlobalVarS struct {
 // Start should handle the sercive start
 func (gv *GlobalVarS) Start() (err error) {
 	engine.SetRoundingDecimals(gv.cfg.GeneralCfg().RoundingDecimals)
+	engine.SetDecimalBalances(gv.cfg.RalsCfg().DecimalBalances)
 	ees.SetFailedPostCacheTTL(gv.cfg.GeneralCfg().FailedPostsTTL)
 	engine.SetHTTPPstrTransport(gv.cfg.HTTPCfg().ClientOpts)
 	return nil
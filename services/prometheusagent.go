@@ -0,0 +1,100 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package services
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cgrates/cgrates/agents"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/servmanager"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// NewPrometheusAgent returns the Prometheus Agent
+func NewPrometheusAgent(cfg *config.CGRConfig, connMgr *engine.ConnManager,
+	srvDep map[string]*sync.WaitGroup) servmanager.Service {
+	return &PrometheusAgent{
+		cfg:     cfg,
+		connMgr: connMgr,
+		srvDep:  srvDep,
+	}
+}
+
+// PrometheusAgent implements Service interface
+type PrometheusAgent struct {
+	sync.RWMutex
+	cfg     *config.CGRConfig
+	connMgr *engine.ConnManager
+	pa      *agents.PrometheusAgent
+	srvDep  map[string]*sync.WaitGroup
+}
+
+// Start should handle the service start
+func (pa *PrometheusAgent) Start() (err error) {
+	pa.Lock()
+	defer pa.Unlock()
+	if pa.pa != nil {
+		return utils.ErrServiceAlreadyRunning
+	}
+	prmAgnt := agents.NewPrometheusAgent(pa.cfg, pa.connMgr)
+	// the collector is served by the default handler registered on http prometheus_url
+	if err = prometheus.Register(prmAgnt); err != nil {
+		return
+	}
+	pa.pa = prmAgnt
+	utils.Logger.Info(fmt.Sprintf("<%s> successfully started.", utils.PrometheusAgent))
+	return
+}
+
+// Reload handles the change of config
+func (pa *PrometheusAgent) Reload() (err error) {
+	return // config is read on each scrape
+}
+
+// Shutdown stops the service
+func (pa *PrometheusAgent) Shutdown() (err error) {
+	pa.Lock()
+	if pa.pa != nil {
+		prometheus.Unregister(pa.pa)
+		pa.pa = nil
+	}
+	pa.Unlock()
+	return
+}
+
+// IsRunning returns if the service is running
+func (pa *PrometheusAgent) IsRunning() bool {
+	pa.RLock()
+	defer pa.RUnlock()
+	return pa.pa != nil
+}
+
+// ServiceName returns the service name
+func (pa *PrometheusAgent) ServiceName() string {
+	return utils.PrometheusAgent
+}
+
+// ShouldRun returns if the service should be running
+func (pa *PrometheusAgent) ShouldRun() bool {
+	return pa.cfg.PrometheusAgentCfg().Enabled
+}
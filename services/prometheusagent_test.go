@@ -0,0 +1,67 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package services
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestPrometheusAgentStartShutdown(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
+	srv := NewPrometheusAgent(cfg, engine.NewConnManager(cfg, nil), srvDep)
+	if srv.ShouldRun() {
+		t.Errorf("Expected service to be disabled by default")
+	}
+	if srv.ServiceName() != utils.PrometheusAgent {
+		t.Errorf("Expected %q, received %q", utils.PrometheusAgent, srv.ServiceName())
+	}
+	if err := srv.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if !srv.IsRunning() {
+		t.Errorf("Expected service to be running")
+	}
+	if err := srv.Start(); err != utils.ErrServiceAlreadyRunning {
+		t.Errorf("Expected %v, received %v", utils.ErrServiceAlreadyRunning, err)
+	}
+	if err := srv.Reload(); err != nil {
+		t.Error(err)
+	}
+	if err := srv.Shutdown(); err != nil {
+		t.Error(err)
+	}
+	if srv.IsRunning() {
+		t.Errorf("Expected service to be down")
+	}
+	// the collector must be unregistered so it can be started again
+	if err := srv.Start(); err != nil {
+		t.Error(err)
+	}
+	srv.Shutdown()
+	// shutting down a stopped service should not touch the registry
+	if err := srv.Shutdown(); err != nil {
+		t.Error(err)
+	}
+}
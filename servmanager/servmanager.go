@@ -226,6 +226,8 @@ func (srvMngr *ServiceManager) handleReload() {
 			go srvMngr.reloadService(utils.CoreS)
 		case <-srvMngr.GetConfig().GetReloadChan(config.JanusAgentJson):
 			go srvMngr.reloadService(utils.JanusAgent)
+		case <-srvMngr.GetConfig().GetReloadChan(config.PrometheusAgentJson):
+			go srvMngr.reloadService(utils.PrometheusAgent)
 		}
 		// handle RPC server
 	}
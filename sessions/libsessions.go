@@ -20,6 +20,7 @@ package sessions
 
 import (
 	"errors"
+	"fmt"
 	"slices"
 	"strings"
 	"time"
@@ -63,6 +64,84 @@ func GetSetCGRID(ev engine.MapEvent) (cgrID string) {
 	return
 }
 
+// popServiceEvents extracts the per-service events out of ev, removing their fields from it
+// services are received either as a map under the Services field (ie: via API)
+// or as Services.<ServiceID>.<FieldName> fields (ie: populated by agent templates)
+func popServiceEvents(ev engine.MapEvent) (svcEvs map[string]engine.MapEvent, err error) {
+	getSvcEv := func(svcID string) engine.MapEvent {
+		if svcEvs == nil {
+			svcEvs = make(map[string]engine.MapEvent)
+		}
+		if _, has := svcEvs[svcID]; !has {
+			svcEvs[svcID] = make(engine.MapEvent)
+		}
+		return svcEvs[svcID]
+	}
+	for fldName, fldVal := range ev {
+		if fldName == utils.Services {
+			delete(ev, fldName)
+			svcs, canCast := fldVal.(map[string]any)
+			if !canCast {
+				return nil, fmt.Errorf("unsupported %s value: <%v>", utils.Services, fldVal)
+			}
+			for svcID, svcIface := range svcs {
+				svcFlds, canCast := svcIface.(map[string]any)
+				if !canCast {
+					return nil, fmt.Errorf("unsupported value: <%v> for service: <%s>", svcIface, svcID)
+				}
+				svcEv := getSvcEv(svcID)
+				for k, v := range svcFlds {
+					svcEv[k] = v
+				}
+			}
+			continue
+		}
+		if !strings.HasPrefix(fldName, utils.Services+utils.NestingSep) {
+			continue
+		}
+		delete(ev, fldName)
+		svcID, svcFld, found := strings.Cut(fldName[len(utils.Services)+1:], utils.NestingSep)
+		if !found || svcID == utils.EmptyString || svcFld == utils.EmptyString {
+			return nil, fmt.Errorf("invalid service field: <%s>", fldName)
+		}
+		getSvcEv(svcID)[svcFld] = fldVal
+	}
+	return
+}
+
+// ServiceUsage is the reply for one of the services charged within a session
+type ServiceUsage struct {
+	MaxUsage  time.Duration // units granted for the service
+	FinalUnit bool          `json:",omitempty"` // no further units will be granted for the service
+	Error     string        `json:",omitempty"`
+}
+
+// servicesMaxUsage returns the highest usage granted out of the services
+func servicesMaxUsage(svcsUsage map[string]*ServiceUsage) (maxUsage time.Duration) {
+	for _, svcUsage := range svcsUsage {
+		if svcUsage.MaxUsage > maxUsage {
+			maxUsage = svcUsage.MaxUsage
+		}
+	}
+	return
+}
+
+// servicesAsDataNode converts the services usage into a DataNode for the CGRReply
+func servicesAsDataNode(svcsUsage map[string]*ServiceUsage) (nd *utils.DataNode) {
+	nd = &utils.DataNode{Type: utils.NMMapType, Map: make(map[string]*utils.DataNode, len(svcsUsage))}
+	for svcID, svcUsage := range svcsUsage {
+		svcNd := &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{
+			utils.CapMaxUsage:  utils.NewLeafNode(svcUsage.MaxUsage),
+			utils.CapFinalUnit: utils.NewLeafNode(svcUsage.FinalUnit),
+		}}
+		if svcUsage.Error != utils.EmptyString {
+			svcNd.Map[utils.Error] = utils.NewLeafNode(svcUsage.Error)
+		}
+		nd.Map[svcID] = svcNd
+	}
+	return
+}
+
 func getFlagIDs(flag string) []string {
 	flagWithIDs := strings.Split(flag, utils.InInFieldSep)
 	if len(flagWithIDs) <= 1 {
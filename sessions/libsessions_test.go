@@ -79,6 +79,47 @@ func TestGetFlagIDs(t *testing.T) {
 	}
 }
 
+func TestPopServiceEvents(t *testing.T) {
+	ev := engine.MapEvent{
+		utils.OriginID: "sess1",
+		utils.Services: map[string]any{
+			"1": map[string]any{
+				utils.Category: "video",
+			},
+		},
+		"Services.1.Usage":    "10s",
+		"Services.2.LastUsed": "5s",
+	}
+	eSvcEvs := map[string]engine.MapEvent{
+		"1": {
+			utils.Category: "video",
+			utils.Usage:    "10s",
+		},
+		"2": {
+			utils.LastUsed: "5s",
+		},
+	}
+	if svcEvs, err := popServiceEvents(ev); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(eSvcEvs, svcEvs) {
+		t.Errorf("Expected %s , received: %s", utils.ToJSON(eSvcEvs), utils.ToJSON(svcEvs))
+	}
+	if eEv := (engine.MapEvent{utils.OriginID: "sess1"}); !reflect.DeepEqual(eEv, ev) {
+		t.Errorf("Expected %s , received: %s", utils.ToJSON(eEv), utils.ToJSON(ev))
+	}
+	if svcEvs, err := popServiceEvents(ev); err != nil || svcEvs != nil {
+		t.Errorf("Expected no services, received: %s, err: %v", utils.ToJSON(svcEvs), err)
+	}
+	expErr := "invalid service field: <Services.1>"
+	if _, err := popServiceEvents(engine.MapEvent{"Services.1": "10s"}); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %s , received: %v", expErr, err)
+	}
+	expErr = "unsupported Services value: <1>"
+	if _, err := popServiceEvents(engine.MapEvent{utils.Services: "1"}); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %s , received: %v", expErr, err)
+	}
+}
+
 func TestNewProcessedIdentity(t *testing.T) {
 	if _, err := NewProcessedIdentity(""); err == nil ||
 		err.Error() != "missing parts of the message header" {
@@ -19,7 +19,9 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package sessions
 
 import (
+	"maps"
 	"runtime"
+	"slices"
 	"sync"
 	"time"
 
@@ -79,7 +81,8 @@ type Session struct {
 	Chargeable    bool            // used in case of pausing debit
 	SRuns         []*SRun         // forked based on ChargerS
 	OptsStart     engine.MapEvent
-	UpdatedAt     time.Time // time when session was changed
+	UpdatedAt     time.Time           // time when session was changed
+	Services      map[string]*Session // per-service sub-sessions (ie: Diameter Rating-Groups), charged instead of SRuns
 
 	debitStop   chan struct{}
 	sTerminator *sTerminator // automatic timeout for the session
@@ -129,6 +132,12 @@ func (s *Session) Clone() (cln *Session) {
 			cln.SRuns[i] = sR.Clone()
 		}
 	}
+	if s.Services != nil {
+		cln.Services = make(map[string]*Session, len(s.Services))
+		for svcID, svc := range s.Services {
+			cln.Services[svcID] = svc.Clone()
+		}
+	}
 	s.RUnlock()
 	return
 }
@@ -148,6 +157,13 @@ func (s *Session) asStoredSession() *engine.StoredSession {
 		}
 	}
 
+	var storedSvcs map[string]*engine.StoredSession
+	if s.Services != nil {
+		storedSvcs = make(map[string]*engine.StoredSession, len(s.Services))
+		for svcID, svc := range s.Services {
+			storedSvcs[svcID] = svc.asStoredSession()
+		}
+	}
 	return &engine.StoredSession{
 		CGRID:         s.CGRID,
 		Tenant:        s.Tenant,
@@ -159,6 +175,7 @@ func (s *Session) asStoredSession() *engine.StoredSession {
 		SRuns:         storedSRuns,
 		OptsStart:     s.OptsStart,
 		UpdatedAt:     s.UpdatedAt,
+		Services:      storedSvcs,
 	}
 }
 
@@ -177,6 +194,13 @@ func newSessionFromStoredSession(s *engine.StoredSession) *Session {
 		}
 	}
 
+	var storedSvcs map[string]*Session
+	if s.Services != nil {
+		storedSvcs = make(map[string]*Session, len(s.Services))
+		for svcID, svc := range s.Services {
+			storedSvcs[svcID] = newSessionFromStoredSession(svc)
+		}
+	}
 	return &Session{
 		CGRID:         s.CGRID,
 		Tenant:        s.Tenant,
@@ -188,6 +212,7 @@ func newSessionFromStoredSession(s *engine.StoredSession) *Session {
 		SRuns:         storedSRuns,
 		OptsStart:     s.OptsStart,
 		UpdatedAt:     s.UpdatedAt,
+		Services:      storedSvcs,
 	}
 }
 
@@ -279,9 +304,32 @@ func (s *Session) totalUsage() (tDur time.Duration) {
 	return
 }
 
+// setPerService marks the session as charged per service instead of its SRuns
+func (s *Session) setPerService() {
+	s.Lock()
+	if s.Services == nil {
+		s.Services = make(map[string]*Session)
+	}
+	s.Unlock()
+}
+
+// isPerService returns true if the session is charged per service instead of its SRuns
+func (s *Session) isPerService() (perService bool) {
+	s.RLock()
+	perService = s.Services != nil
+	s.RUnlock()
+	return
+}
+
 // AsCGREvents is a  method to return the Session as CGREvents
 // AsCGREvents is not thread safe since it is supposed to run by the time Session is closed
 func (s *Session) asCGREvents() (cgrEvs []*utils.CGREvent) {
+	if len(s.Services) != 0 { // charging was done per service, one CDR for each service run
+		for _, svcID := range slices.Sorted(maps.Keys(s.Services)) {
+			cgrEvs = append(cgrEvs, s.Services[svcID].asCGREvents()...)
+		}
+		return
+	}
 	cgrEvs = make([]*utils.CGREvent, len(s.SRuns)) // so we can gather all cdr info while under lock
 	for i, sr := range s.SRuns {
 		cgrEvs[i] = &utils.CGREvent{
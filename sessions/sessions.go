@@ -21,6 +21,7 @@ package sessions
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"math/rand"
 	"runtime"
 	"slices"
@@ -1692,6 +1693,155 @@ func (sS *SessionS) updateSession(s *Session, updtEv, opts engine.MapEvent, isMs
 	return
 }
 
+// newService creates the sub-session charging one of the services within s
+// not thread-safe, needs to be called under the lock of s
+func (sS *SessionS) newService(s *Session, svcID string, svcEv engine.MapEvent,
+	forceDuration bool) (svc *Session, err error) {
+	ev := s.EventStart.Clone()
+	for k, v := range svcEv {
+		if k != utils.Usage && utils.ProtectedSFlds.Has(k) {
+			continue
+		}
+		ev[k] = v
+	}
+	ev[utils.ServiceID] = svcID
+	ev[utils.CGRID] = utils.Sha1(s.CGRID, svcID) // one CDR per service
+	if svc, err = sS.newSession(&utils.CGREvent{
+		Tenant:  s.Tenant,
+		ID:      utils.UUIDSha1Prefix(),
+		Event:   ev,
+		APIOpts: s.OptsStart.Clone(),
+	}, s.ResourceID, s.ClientConnID, 0, forceDuration, true); err != nil {
+		return
+	}
+	if s.Services == nil {
+		s.Services = make(map[string]*Session)
+	}
+	s.Services[svcID] = svc
+	return
+}
+
+// updateServices will reset terminator and perform the debits for each of the services received
+func (sS *SessionS) updateServices(s *Session, updtEv engine.MapEvent, svcEvs map[string]engine.MapEvent,
+	opts engine.MapEvent, forceDuration bool) (svcsUsage map[string]*ServiceUsage) {
+	defer sS.replicateSessions(s.CGRID, false, sS.cgrCfg.SessionSCfg().ReplicationConns)
+	s.Lock()
+	defer s.Unlock()
+	for k, v := range updtEv {
+		if utils.ProtectedSFlds.Has(k) {
+			continue
+		}
+		s.EventStart[k] = v
+	}
+	s.updateSRuns(updtEv, sS.cgrCfg.SessionSCfg().AlterableFields)
+	sS.setSTerminator(s, opts)
+	s.Chargeable = opts.GetBoolOrDefault(utils.OptsChargeable, true)
+	s.UpdatedAt = time.Now()
+	svcsUsage = make(map[string]*ServiceUsage, len(svcEvs))
+	for svcID, svcEv := range svcEvs {
+		svcsUsage[svcID] = sS.updateService(s, svcID, svcEv, opts, forceDuration)
+	}
+	return
+}
+
+// updateService debits the usage requested for one service, creating its sub-session on first request
+// errors are returned as part of the ServiceUsage so the other services can still be granted
+// not thread-safe, needs to be called under the lock of s
+func (sS *SessionS) updateService(s *Session, svcID string, svcEv, opts engine.MapEvent,
+	forceDuration bool) (svcUsage *ServiceUsage) {
+	svcUsage = new(ServiceUsage)
+	svc, has := s.Services[svcID]
+	if !has {
+		var err error
+		if svc, err = sS.newService(s, svcID, svcEv, forceDuration); err != nil {
+			svcUsage.FinalUnit = true
+			svcUsage.Error = err.Error()
+			return
+		}
+	} else {
+		for k, v := range svcEv {
+			if utils.ProtectedSFlds.Has(k) {
+				continue
+			}
+			svc.EventStart[k] = v
+		}
+		svc.updateSRuns(svcEv, sS.cgrCfg.SessionSCfg().AlterableFields)
+	}
+	updtEv := svcEv.Clone()
+	reqUsage, err := updtEv.GetDuration(utils.Usage)
+	if err != nil {
+		if err != utils.ErrNotFound {
+			svcUsage.FinalUnit = true
+			svcUsage.Error = err.Error()
+			return
+		}
+		reqUsage = sS.cgrCfg.SessionSCfg().GetDefaultUsage(svc.EventStart.GetStringIgnoreErrors(utils.ToR))
+		updtEv[utils.Usage] = reqUsage
+	}
+	var sRunsUsage map[string]time.Duration
+	if sRunsUsage, err = sS.updateSession(svc, updtEv, opts, true); err != nil {
+		svcUsage.FinalUnit = true
+		svcUsage.Error = utils.NewErrRALs(err).Error()
+		return
+	}
+	var maxUsageSet bool // so we know if we have set the 0 on purpose
+	for _, rplyMaxUsage := range sRunsUsage {
+		if !maxUsageSet || rplyMaxUsage < svcUsage.MaxUsage {
+			svcUsage.MaxUsage = rplyMaxUsage
+			maxUsageSet = true
+		}
+	}
+	svcUsage.FinalUnit = svcUsage.MaxUsage < reqUsage
+	return
+}
+
+// terminateServices applies the final usage reported for each service before the session is ended
+// services reported for the first time are charged with their usage
+// not thread-safe, needs to be called under the lock of s
+func (sS *SessionS) terminateServices(s *Session, svcEvs map[string]engine.MapEvent,
+	opts engine.MapEvent, forceDuration bool) {
+	for svcID, svcEv := range svcEvs {
+		svc, has := s.Services[svcID]
+		if !has {
+			updtEv := svcEv.Clone()
+			if !updtEv.HasField(utils.Usage) && updtEv.HasField(utils.LastUsed) {
+				updtEv[utils.Usage] = updtEv[utils.LastUsed]
+				delete(updtEv, utils.LastUsed)
+			}
+			var err error
+			if svc, err = sS.newService(s, svcID, updtEv, forceDuration); err == nil &&
+				updtEv.HasField(utils.Usage) {
+				_, err = sS.updateSession(svc, updtEv, opts, true)
+			}
+			if err != nil {
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> failed charging service: <%s> of session: <%s>, error: <%s>",
+						utils.SessionS, svcID, s.CGRID, err.Error()))
+			}
+			continue
+		}
+		for k, v := range svcEv {
+			if utils.ProtectedSFlds.Has(k) {
+				continue
+			}
+			svc.EventStart[k] = v
+		}
+		svc.updateSRuns(svcEv, sS.cgrCfg.SessionSCfg().AlterableFields)
+		tUsage := svcEv.GetDurationPtrIgnoreErrors(utils.Usage)
+		lastUsed := svcEv.GetDurationPtrIgnoreErrors(utils.LastUsed)
+		for _, sr := range svc.SRuns {
+			if tUsage != nil {
+				sr.TotalUsage = *tUsage
+			} else if lastUsed != nil &&
+				sr.LastUsage != *lastUsed {
+				sr.TotalUsage -= sr.LastUsage
+				sr.TotalUsage += *lastUsed
+				sr.LastUsage = *lastUsed
+			}
+		}
+	}
+}
+
 // terminateSession will end a session from outside
 // calls endSession thread safe
 func (sS *SessionS) terminateSession(s *Session, tUsage, lastUsage *time.Duration,
@@ -1713,6 +1863,13 @@ func (sS *SessionS) endSession(s *Session, tUsage, lastUsage *time.Duration,
 		s.stopSTerminator()
 		s.stopDebitLoops()
 	}
+	for _, svcID := range slices.Sorted(maps.Keys(s.Services)) { // services keep their own usage
+		if errSvc := sS.endSession(s.Services[svcID], nil, nil, aTime, true); errSvc != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> failed ending service: <%s> of session: <%s>, error: <%s>",
+					utils.SessionS, svcID, s.CGRID, errSvc.Error()))
+		}
+	}
 	for sRunIdx, sr := range s.SRuns {
 		sUsage := sr.TotalUsage
 		if tUsage != nil {
@@ -2319,6 +2476,7 @@ type V1InitSessionReply struct {
 	MaxUsage           *time.Duration                 `json:",omitempty"`
 	ThresholdIDs       *[]string                      `json:",omitempty"`
 	StatQueueIDs       *[]string                      `json:",omitempty"`
+	Services           map[string]*ServiceUsage       `json:",omitempty"`
 
 	needsMaxUsage bool // for gob encoding only
 }
@@ -2353,6 +2511,9 @@ func (v1Rply *V1InitSessionReply) AsNavigableMap() map[string]*utils.DataNode {
 	} else if v1Rply.needsMaxUsage {
 		cgrReply[utils.CapMaxUsage] = utils.NewLeafNode(0)
 	}
+	if v1Rply.Services != nil {
+		cgrReply[utils.Services] = servicesAsDataNode(v1Rply.Services)
+	}
 
 	if v1Rply.ThresholdIDs != nil {
 		thIDs := &utils.DataNode{Type: utils.NMSliceType, Slice: make([]*utils.DataNode, len(*v1Rply.ThresholdIDs))}
@@ -2449,16 +2610,34 @@ func (sS *SessionS) BiRPCv1InitiateSession(ctx *context.Context,
 				return utils.NewErrRALs(err)
 			}
 		}
+		var svcEvs map[string]engine.MapEvent
+		if svcEvs, err = popServiceEvents(args.CGREvent.Event); err != nil {
+			return utils.NewErrRALs(err)
+		}
+		if len(svcEvs) != 0 {
+			dbtItvl = 0 // services are debited on request only
+		}
 		s, err := sS.initSession(args.CGREvent, sS.biJClntID(ctx.Client), originID, dbtItvl,
 			false, args.ForceDuration)
 		if err != nil {
 			return err
 		}
+		if len(svcEvs) != 0 {
+			s.setPerService()
+		}
 		s.RLock() // avoid concurrency with activeDebit
 		isPrepaid := s.debitStop != nil
 		s.RUnlock()
 		if isPrepaid { //active debit
 			rply.MaxUsage = utils.DurationPointer(sS.cgrCfg.SessionSCfg().GetDefaultUsage(utils.IfaceAsString(args.CGREvent.Event[utils.ToR])))
+		} else if len(svcEvs) != 0 {
+			rply.Services = sS.updateServices(s, nil, svcEvs, args.APIOpts, args.ForceDuration)
+			if sS.cgrCfg.SessionSCfg().BackupInterval > 0 {
+				sS.bkpSessionIDsMux.Lock()
+				sS.bkpSessionIDs.Add(s.CGRID)
+				sS.bkpSessionIDsMux.Unlock()
+			}
+			rply.MaxUsage = utils.DurationPointer(servicesMaxUsage(rply.Services))
 		} else {
 			var sRunsUsage map[string]time.Duration
 			if sRunsUsage, err = sS.updateSession(s, nil, args.APIOpts, false); err != nil {
@@ -2579,6 +2758,7 @@ func (V1UpdateSessionArgs) RPCClone() {}
 type V1UpdateSessionReply struct {
 	Attributes *engine.AttrSProcessEventReply `json:",omitempty"`
 	MaxUsage   *time.Duration                 `json:",omitempty"`
+	Services   map[string]*ServiceUsage       `json:",omitempty"`
 
 	needsMaxUsage bool // for gob encoding only
 }
@@ -2610,6 +2790,9 @@ func (v1Rply *V1UpdateSessionReply) AsNavigableMap() map[string]*utils.DataNode
 	} else if v1Rply.needsMaxUsage {
 		cgrReply[utils.CapMaxUsage] = utils.NewLeafNode(0)
 	}
+	if v1Rply.Services != nil {
+		cgrReply[utils.Services] = servicesAsDataNode(v1Rply.Services)
+	}
 	return cgrReply
 }
 
@@ -2671,6 +2854,13 @@ func (sS *SessionS) BiRPCv1UpdateSession(ctx *context.Context,
 				return utils.NewErrRALs(err)
 			}
 		}
+		var svcEvs map[string]engine.MapEvent
+		if svcEvs, err = popServiceEvents(ev); err != nil {
+			return utils.NewErrRALs(err)
+		}
+		if len(svcEvs) != 0 {
+			dbtItvl = 0 // services are debited on request only
+		}
 		cgrID := GetSetCGRID(ev)
 		s := sS.getRelocateSession(cgrID,
 			ev.GetStringIgnoreErrors(utils.InitialOriginID),
@@ -2681,6 +2871,23 @@ func (sS *SessionS) BiRPCv1UpdateSession(ctx *context.Context,
 				dbtItvl, false, args.ForceDuration); err != nil {
 				return err
 			}
+			if len(svcEvs) != 0 {
+				s.setPerService()
+			}
+		}
+		perService := s.isPerService()
+		if len(svcEvs) != 0 && !perService { // the session itself is already charged
+			return utils.ErrMixedServices
+		}
+		if perService { // only the services are charged, even if none is received
+			rply.Services = sS.updateServices(s, ev, svcEvs, args.APIOpts, args.ForceDuration)
+			if sS.cgrCfg.SessionSCfg().BackupInterval > 0 {
+				sS.bkpSessionIDsMux.Lock()
+				sS.bkpSessionIDs.Add(s.CGRID)
+				sS.bkpSessionIDsMux.Unlock()
+			}
+			rply.MaxUsage = utils.DurationPointer(servicesMaxUsage(rply.Services))
+			return
 		}
 		var sRunsUsage map[string]time.Duration
 		if sRunsUsage, err = sS.updateSession(s, ev, args.APIOpts, false); err != nil {
@@ -2812,6 +3019,10 @@ func (sS *SessionS) BiRPCv1TerminateSession(ctx *context.Context,
 				return utils.NewErrRALs(err)
 			}
 		}
+		var svcEvs map[string]engine.MapEvent
+		if svcEvs, err = popServiceEvents(ev); err != nil {
+			return utils.NewErrRALs(err)
+		}
 		var s *Session
 		fib := utils.FibDuration(time.Millisecond, 0)
 		var isMsg bool // one time charging, do not perform indexing and sTerminator
@@ -2831,16 +3042,25 @@ func (sS *SessionS) BiRPCv1TerminateSession(ctx *context.Context,
 				dbtItvl, isMsg, args.ForceDuration); err != nil {
 				return utils.NewErrRALs(err)
 			}
+			if len(svcEvs) != 0 { // charged per service
+				break
+			}
 			if _, err = sS.updateSession(s, ev, opts, isMsg); err != nil {
 				return err
 			}
 			break
 		}
+		if len(svcEvs) != 0 && !isMsg && !s.isPerService() { // the session itself is already charged
+			return utils.ErrMixedServices
+		}
 		if !isMsg {
 			s.UpdateSRuns(ev, sS.cgrCfg.SessionSCfg().AlterableFields)
 		}
 		s.Lock()
 		s.Chargeable = opts.GetBoolOrDefault(utils.OptsChargeable, true)
+		if len(svcEvs) != 0 {
+			sS.terminateServices(s, svcEvs, opts, args.ForceDuration)
+		}
 		s.Unlock()
 		if err = sS.terminateSession(s,
 			ev.GetDurationPtrIgnoreErrors(utils.Usage),
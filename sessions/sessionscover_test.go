@@ -4574,3 +4574,268 @@ func TestSyncSessionsSync(t *testing.T) {
 	//There are no sessions to be removed
 	sessions.terminateSyncSessions([]string{"no_sesssion"})
 }
+
+func TestBiRPCv1SessionServices(t *testing.T) {
+	log.SetOutput(io.Discard)
+	tmp := engine.Cache
+	defer func() { engine.Cache = tmp }()
+	clnt := &testMockClients{
+		calls: map[string]func(args any, reply any) error{
+			utils.ChargerSv1ProcessEvent: func(args any, reply any) error {
+				cgrEv := args.(*utils.CGREvent).Clone()
+				cgrEv.Event[utils.RunID] = utils.MetaDefault
+				*reply.(*[]*engine.ChrgSProcessEventReply) = []*engine.ChrgSProcessEventReply{
+					{ChargerSProfile: "DEFAULT", CGREvent: cgrEv},
+				}
+				return nil
+			},
+			utils.ResponderMaxDebit: func(args any, reply any) error {
+				cd := args.(*engine.CallDescriptorWithAPIOpts).CallDescriptor
+				tEnd := cd.TimeEnd
+				if cd.Category == "video" && cd.DurationIndex > 5*time.Second { // video quota is 5s
+					tEnd = tEnd.Add(5*time.Second - cd.DurationIndex)
+				}
+				*(reply.(*engine.CallCost)) = engine.CallCost{
+					Category: cd.Category,
+					Timespans: []*engine.TimeSpan{{
+						TimeStart: cd.TimeStart,
+						TimeEnd:   tEnd,
+					}},
+				}
+				return nil
+			},
+			utils.ResponderRefundIncrements: func(args any, reply any) error { return nil },
+			utils.ResponderRefundRounding:   func(args any, reply any) error { return nil },
+		},
+	}
+	chrgrsChan := make(chan birpc.ClientConnector, 1)
+	chrgrsChan <- clnt
+	ralsChan := make(chan birpc.ClientConnector, 1)
+	ralsChan <- clnt
+	cfg := config.NewDefaultCGRConfig()
+	cfg.SessionSCfg().ChargerSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers)}
+	cfg.SessionSCfg().RALsConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs)}
+	data := engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	connMgr := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers): chrgrsChan,
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs):     ralsChan,
+	})
+	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
+	engine.Cache = engine.NewCacheS(cfg, dm, nil)
+	sS := NewSessionS(cfg, dm, connMgr)
+
+	initArgs := NewV1InitSessionArgs(false, nil, false, nil, false, nil, false, true,
+		&utils.CGREvent{
+			Tenant: "cgrates.org",
+			ID:     "TestSessionServicesInit",
+			Event: map[string]any{
+				utils.ToR:          utils.MetaData,
+				utils.OriginID:     "TestSessionServices",
+				utils.RequestType:  utils.MetaPrepaid,
+				utils.AccountField: "1001",
+				utils.Destination:  "data",
+				utils.AnswerTime:   time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+				utils.Services: map[string]any{
+					"1": map[string]any{
+						utils.Category: "video",
+						utils.Usage:    "4s",
+					},
+				},
+				"Services.2.Usage": "4s",
+			},
+		}, false)
+	var initRply V1InitSessionReply
+	if err := sS.BiRPCv1InitiateSession(context.Background(), initArgs, &initRply); err != nil {
+		t.Fatal(err)
+	}
+	expSvcs := map[string]*ServiceUsage{
+		"1": {MaxUsage: 4 * time.Second},
+		"2": {MaxUsage: 4 * time.Second},
+	}
+	if !reflect.DeepEqual(expSvcs, initRply.Services) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(expSvcs), utils.ToJSON(initRply.Services))
+	} else if *initRply.MaxUsage != 4*time.Second {
+		t.Errorf("expected MaxUsage 4s, received %v", *initRply.MaxUsage)
+	}
+	cgrID := utils.Sha1("TestSessionServices", "")
+	ss := sS.getSessions(cgrID, false)
+	if len(ss) != 1 {
+		t.Fatalf("expected one active session, received %d", len(ss))
+	} else if len(ss[0].Services) != 2 {
+		t.Fatalf("expected two services, received %s", utils.ToJSON(ss[0].Services))
+	} else if ss[0].Services["1"].CGRID != utils.Sha1(cgrID, "1") ||
+		ss[0].Services["1"].EventStart[utils.ServiceID] != "1" ||
+		ss[0].Services["1"].SRuns[0].CD.Category != "video" {
+		t.Errorf("unexpected service: %s", utils.ToJSON(ss[0].Services["1"]))
+	}
+
+	updtArgs := NewV1UpdateSessionArgs(false, nil, true,
+		&utils.CGREvent{
+			Tenant: "cgrates.org",
+			ID:     "TestSessionServicesUpdate",
+			Event: map[string]any{
+				utils.OriginID:        "TestSessionServices",
+				"Services.1.Usage":    "4s",
+				"Services.1.LastUsed": "4s",
+				"Services.2.Usage":    "4s",
+				"Services.2.LastUsed": "3s",
+			},
+		}, false)
+	var updtRply V1UpdateSessionReply
+	if err := sS.BiRPCv1UpdateSession(context.Background(), updtArgs, &updtRply); err != nil {
+		t.Fatal(err)
+	}
+	expSvcs = map[string]*ServiceUsage{
+		"1": {MaxUsage: time.Second, FinalUnit: true},
+		"2": {MaxUsage: 4 * time.Second},
+	}
+	if !reflect.DeepEqual(expSvcs, updtRply.Services) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(expSvcs), utils.ToJSON(updtRply.Services))
+	}
+	if nm := updtRply.AsNavigableMap(); nm[utils.Services] == nil ||
+		nm[utils.Services].Map["1"].Map[utils.CapFinalUnit].Value.Data != true {
+		t.Errorf("unexpected navigable map: %s", utils.ToJSON(nm))
+	}
+
+	termArgs := NewV1TerminateSessionArgs(true, false, false, nil, false, nil,
+		&utils.CGREvent{
+			Tenant: "cgrates.org",
+			ID:     "TestSessionServicesTerminate",
+			Event: map[string]any{
+				utils.OriginID:        "TestSessionServices",
+				"Services.1.LastUsed": "1s",
+				"Services.2.LastUsed": "2s",
+				"Services.3.LastUsed": "2s",
+			},
+		}, false)
+	var termRply string
+	if err := sS.BiRPCv1TerminateSession(context.Background(), termArgs, &termRply); err != nil {
+		t.Fatal(err)
+	}
+	if len(sS.getSessions(cgrID, false)) != 0 {
+		t.Error("session should not be active anymore")
+	}
+	sIface, has := engine.Cache.Get(utils.CacheClosedSessions, cgrID)
+	if !has {
+		t.Fatal("session not cached as closed")
+	}
+	s := sIface.(*Session)
+	for svcID, expUsage := range map[string]time.Duration{
+		"1": 5 * time.Second,
+		"2": 5 * time.Second,
+		"3": 2 * time.Second,
+	} {
+		if svc, has := s.Services[svcID]; !has {
+			t.Errorf("missing service %s", svcID)
+		} else if svc.SRuns[0].Event[utils.Usage] != expUsage {
+			t.Errorf("expected usage %v for service %s, received %v", expUsage, svcID, svc.SRuns[0].Event[utils.Usage])
+		}
+	}
+	if cgrEvs := s.asCGREvents(); len(cgrEvs) != 3 {
+		t.Errorf("expected one CDR event per service, received %s", utils.ToJSON(cgrEvs))
+	}
+}
+
+func TestBiRPCv1SessionServicesMixed(t *testing.T) {
+	log.SetOutput(io.Discard)
+	tmp := engine.Cache
+	defer func() { engine.Cache = tmp }()
+	var debits int
+	clnt := &testMockClients{
+		calls: map[string]func(args any, reply any) error{
+			utils.ChargerSv1ProcessEvent: func(args any, reply any) error {
+				cgrEv := args.(*utils.CGREvent).Clone()
+				cgrEv.Event[utils.RunID] = utils.MetaDefault
+				*reply.(*[]*engine.ChrgSProcessEventReply) = []*engine.ChrgSProcessEventReply{
+					{ChargerSProfile: "DEFAULT", CGREvent: cgrEv},
+				}
+				return nil
+			},
+			utils.ResponderMaxDebit: func(args any, reply any) error {
+				debits++
+				cd := args.(*engine.CallDescriptorWithAPIOpts).CallDescriptor
+				*(reply.(*engine.CallCost)) = engine.CallCost{
+					Category: cd.Category,
+					Timespans: []*engine.TimeSpan{{
+						TimeStart: cd.TimeStart,
+						TimeEnd:   cd.TimeEnd,
+					}},
+				}
+				return nil
+			},
+			utils.ResponderRefundIncrements: func(args any, reply any) error { return nil },
+			utils.ResponderRefundRounding:   func(args any, reply any) error { return nil },
+		},
+	}
+	chrgrsChan := make(chan birpc.ClientConnector, 1)
+	chrgrsChan <- clnt
+	ralsChan := make(chan birpc.ClientConnector, 1)
+	ralsChan <- clnt
+	cfg := config.NewDefaultCGRConfig()
+	cfg.SessionSCfg().ChargerSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers)}
+	cfg.SessionSCfg().RALsConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs)}
+	data := engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	connMgr := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers): chrgrsChan,
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs):     ralsChan,
+	})
+	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
+	engine.Cache = engine.NewCacheS(cfg, dm, nil)
+	sS := NewSessionS(cfg, dm, connMgr)
+
+	// the session started without services is charged as a whole
+	initArgs := NewV1InitSessionArgs(false, nil, false, nil, false, nil, false, true,
+		&utils.CGREvent{
+			Tenant: "cgrates.org",
+			ID:     "TestSessionServicesMixedInit",
+			Event: map[string]any{
+				utils.ToR:          utils.MetaData,
+				utils.OriginID:     "TestSessionServicesMixed",
+				utils.RequestType:  utils.MetaPostpaid,
+				utils.AccountField: "1001",
+				utils.Destination:  "data",
+				utils.AnswerTime:   time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+				utils.Usage:        "4s",
+			},
+		}, false)
+	var initRply V1InitSessionReply
+	if err := sS.BiRPCv1InitiateSession(context.Background(), initArgs, &initRply); err != nil {
+		t.Fatal(err)
+	}
+	debitsBefore := debits
+	updtArgs := NewV1UpdateSessionArgs(false, nil, true,
+		&utils.CGREvent{
+			Tenant: "cgrates.org",
+			ID:     "TestSessionServicesMixedUpdate",
+			Event: map[string]any{
+				utils.OriginID:     "TestSessionServicesMixed",
+				"Services.1.Usage": "4s",
+			},
+		}, false)
+	var updtRply V1UpdateSessionReply
+	if err := sS.BiRPCv1UpdateSession(context.Background(), updtArgs, &updtRply); err != utils.ErrMixedServices {
+		t.Errorf("expected error %v, received %v", utils.ErrMixedServices, err)
+	}
+	termArgs := NewV1TerminateSessionArgs(true, false, false, nil, false, nil,
+		&utils.CGREvent{
+			Tenant: "cgrates.org",
+			ID:     "TestSessionServicesMixedTerminate",
+			Event: map[string]any{
+				utils.OriginID:        "TestSessionServicesMixed",
+				"Services.1.LastUsed": "4s",
+			},
+		}, false)
+	var termRply string
+	if err := sS.BiRPCv1TerminateSession(context.Background(), termArgs, &termRply); err != utils.ErrMixedServices {
+		t.Errorf("expected error %v, received %v", utils.ErrMixedServices, err)
+	}
+	if debits != debitsBefore {
+		t.Errorf("expected no services to be debited, received %d debits", debits-debitsBefore)
+	}
+	cgrID := utils.Sha1("TestSessionServicesMixed", "")
+	if ss := sS.getSessions(cgrID, false); len(ss) != 1 {
+		t.Fatalf("expected the session to be still active, received %d", len(ss))
+	} else if ss[0].Services != nil {
+		t.Errorf("expected no services, received %s", utils.ToJSON(ss[0].Services))
+	}
+}
@@ -183,13 +183,14 @@ type ApierTPTiming struct {
 }
 
 type TPTiming struct {
-	ID        string
-	Years     Years
-	Months    Months
-	MonthDays MonthDays
-	WeekDays  WeekDays
-	StartTime string
-	EndTime   string
+	ID                 string
+	Years              Years
+	Months             Months
+	MonthDays          MonthDays
+	WeekDays           WeekDays
+	StartTime          string
+	EndTime            string
+	HolidayCalendarIDs []string // the days are restricted to the holidays of these calendars
 }
 
 // Returns wheter the Timing is active at the specified time
@@ -268,6 +269,7 @@ func NewTiming(ID, years, mounths, mounthdays, weekdays, time string) (rt *TPTim
 	rt.Years.Parse(years, InfieldSep)
 	rt.Months.Parse(mounths, InfieldSep)
 	rt.MonthDays.Parse(mounthdays, InfieldSep)
+	rt.HolidayCalendarIDs = ParseHolidayCalendarIDs(mounthdays, InfieldSep)
 	rt.WeekDays.Parse(weekdays, InfieldSep)
 	times := strings.Split(time, InfieldSep)
 	rt.StartTime = times[0]
@@ -277,6 +279,17 @@ func NewTiming(ID, years, mounths, mounthdays, weekdays, time string) (rt *TPTim
 	return
 }
 
+// ParseHolidayCalendarIDs returns the holiday calendars referenced
+// as *holidays:<[tenant:]ID> between the month days
+func ParseHolidayCalendarIDs(monthDays, sep string) (ids []string) {
+	for _, md := range strings.Split(monthDays, sep) {
+		if calID, has := strings.CutPrefix(md, MetaHolidays+InInFieldSep); has && calID != EmptyString {
+			ids = append(ids, calID)
+		}
+	}
+	return
+}
+
 type TPRatingPlan struct {
 	TPid               string                 // Tariff plan id
 	ID                 string                 // RatingPlan profile id
@@ -1053,6 +1066,20 @@ type TPRankingProfile struct {
 	ThresholdIDs      []string
 }
 
+// TPHolidayCalendar is used in APIs to manage remotely offline HolidayCalendar
+type TPHolidayCalendar struct {
+	TPid     string
+	Tenant   string
+	ID       string
+	Holidays []*TPHoliday
+}
+
+// TPHoliday is one day of the TPHolidayCalendar
+type TPHoliday struct {
+	Date string // <YYYY-MM-DD|MM-DD|*easter[+-days]>
+	Name string
+}
+
 // MetricWithSettings adds specific settings to the Metric
 type MetricWithSettings struct {
 	MetricID         string
@@ -1397,6 +1424,7 @@ func NewAttrReloadCacheWithOpts() *AttrReloadCacheWithAPIOpts {
 		DispatcherProfileIDs:     []string{MetaAny},
 		DispatcherHostIDs:        []string{MetaAny},
 		TimingIDs:                []string{MetaAny},
+		HolidayCalendarIDs:       []string{MetaAny},
 		AttributeFilterIndexIDs:  []string{MetaAny},
 		ResourceFilterIndexIDs:   []string{MetaAny},
 		StatFilterIndexIDs:       []string{MetaAny},
@@ -1441,6 +1469,7 @@ func NewAttrReloadCacheWithOptsFromMap(arg map[string][]string, tnt string, opts
 		DispatcherHostIDs:        arg[CacheDispatcherHosts],
 		Dispatchers:              arg[CacheDispatchers],
 		TimingIDs:                arg[CacheTimings],
+		HolidayCalendarIDs:       arg[CacheHolidayCalendars],
 		AttributeFilterIndexIDs:  arg[CacheAttributeFilterIndexes],
 		ResourceFilterIndexIDs:   arg[CacheResourceFilterIndexes],
 		StatFilterIndexIDs:       arg[CacheStatFilterIndexes],
@@ -1482,6 +1511,7 @@ type AttrReloadCacheWithAPIOpts struct {
 	DispatcherHostIDs        []string       `json:",omitempty"`
 	Dispatchers              []string       `json:",omitempty"`
 	TimingIDs                []string       `json:",omitempty"`
+	HolidayCalendarIDs       []string       `json:",omitempty"`
 	AttributeFilterIndexIDs  []string       `json:",omitempty"`
 	ResourceFilterIndexIDs   []string       `json:",omitempty"`
 	StatFilterIndexIDs       []string       `json:",omitempty"`
@@ -1521,6 +1551,7 @@ func (a *AttrReloadCacheWithAPIOpts) Map() map[string][]string {
 		CacheDispatcherHosts:         a.DispatcherHostIDs,
 		CacheDispatchers:             a.Dispatchers,
 		CacheTimings:                 a.TimingIDs,
+		CacheHolidayCalendars:        a.HolidayCalendarIDs,
 		CacheAttributeFilterIndexes:  a.AttributeFilterIndexIDs,
 		CacheResourceFilterIndexes:   a.ResourceFilterIndexIDs,
 		CacheStatFilterIndexes:       a.StatFilterIndexIDs,
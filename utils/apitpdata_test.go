@@ -202,6 +202,12 @@ func TestNewTiming(t *testing.T) {
 	if !reflect.DeepEqual(eOut, rcv) {
 		t.Errorf("Expected %+v, received %+v", eOut, rcv)
 	}
+	//check the holiday calendars referenced within the month days
+	rcv = NewTiming("1", "2020", "04", "18;*holidays:DE;*holidays:cgrates.org:FR", "06", "00:00:00;11:11:11")
+	eOut.HolidayCalendarIDs = []string{"DE", "cgrates.org:FR"}
+	if !reflect.DeepEqual(eOut, rcv) {
+		t.Errorf("Expected %+v, received %+v", eOut, rcv)
+	}
 }
 
 func TestTPTimingSetTiming(t *testing.T) {
@@ -1001,6 +1007,7 @@ func TestNewAttrReloadCacheWithOpts(t *testing.T) {
 		FilterIndexIDs:           []string{MetaAny},
 		RankingIDs:               []string{MetaAny},
 		RankingProfileIDs:        []string{MetaAny},
+		HolidayCalendarIDs:       []string{MetaAny},
 	}
 	eMap := NewAttrReloadCacheWithOpts()
 	if !reflect.DeepEqual(eMap, newAttrReloadCache) {
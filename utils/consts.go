@@ -38,6 +38,7 @@ var (
 
 	DataDBPartitions = NewStringSet([]string{CacheDestinations, CacheReverseDestinations, CacheRatingPlans,
 		CacheRatingProfiles, CacheDispatcherProfiles, CacheDispatcherHosts, CacheChargerProfiles, CacheActions, CacheActionTriggers, CacheSharedGroups, CacheTimings,
+		CacheHolidayCalendars,
 		CacheResourceProfiles, CacheResources, CacheEventResources, CacheStatQueueProfiles, CacheRankingProfiles, CacheRankings, CacheStatQueues,
 		CacheThresholdProfiles, CacheThresholds, CacheFilters, CacheRouteProfiles, CacheAttributeProfiles, CacheTrendProfiles, CacheTrends,
 		CacheResourceFilterIndexes, CacheStatFilterIndexes, CacheThresholdFilterIndexes, CacheRouteFilterIndexes,
@@ -49,7 +50,7 @@ var (
 		CacheTBLTPActionPlans, CacheTBLTPActionTriggers, CacheTBLTPAccountActions, CacheTBLTPResources,
 		CacheTBLTPStats, CacheTBLTPThresholds, CacheTBLTPRankings, CacheTBLTPFilters, CacheSessionCostsTBL, CacheCDRsTBL,
 		CacheTBLTPRoutes, CacheTBLTPAttributes, CacheTBLTPChargers, CacheTBLTPDispatchers,
-		CacheTBLTPDispatcherHosts, CacheVersions})
+		CacheTBLTPDispatcherHosts, CacheTBLTPHolidayCalendars, CacheVersions})
 
 	// CachePartitions enables creation of cache partitions
 	CachePartitions = JoinStringSet(extraDBPartition, DataDBPartitions)
@@ -67,6 +68,7 @@ var (
 		CacheResourceProfiles:        ResourceProfilesPrefix,
 		CacheResources:               ResourcesPrefix,
 		CacheTimings:                 TimingsPrefix,
+		CacheHolidayCalendars:        HolidayCalendarPrefix,
 		CacheStatQueueProfiles:       StatQueueProfilePrefix,
 		CacheStatQueues:              StatQueuePrefix,
 		CacheRankingProfiles:         RankingsProfilePrefix,
@@ -150,6 +152,7 @@ var (
 		TBLTPChargers:         CacheTBLTPChargers,
 		TBLTPDispatchers:      CacheTBLTPDispatchers,
 		TBLTPDispatcherHosts:  CacheTBLTPDispatcherHosts,
+		TBLTPHolidayCalendars: CacheTBLTPHolidayCalendars,
 	}
 
 	// ProtectedSFlds are the fields that sessions should not alter
@@ -202,6 +205,7 @@ const (
 	JSON                     = "json"
 	JSONCaps                 = "JSON"
 	GOBCaps                  = "GOB"
+	GRPCCaps                 = "gRPC"
 	MsgPack                  = "msgpack"
 	CSVLoad                  = "CSVLOAD"
 	CGRID                    = "CGRID"
@@ -237,6 +241,8 @@ const (
 	Value                   = "Value"
 	Filter                  = "Filter"
 	LastUsed                = "LastUsed"
+	Services                = "Services"
+	ServiceID               = "ServiceID"
 	PDD                     = "PDD"
 	Route                   = "Route"
 	RunID                   = "RunID"
@@ -276,10 +282,15 @@ const (
 	MetaMaxCostDisconnect   = "*disconnect"
 	MetaOut                 = "*out"
 	MetaPause               = "*pause"
+	MetaSkip                = "*skip"
+	MetaRunOnce             = "*run_once"
+	MetaRunAll              = "*run_all"
 
 	MetaVoice                 = "*voice"
 	ACD                       = "ACD"
 	TasksKey                  = "tasks"
+	LeasePrefix               = "lse_"
+	ActionTimingLastRunPrefix = "alr_"
 	ActionPlanPrefix          = "apl_"
 	AccountActionPlansPrefix  = "aap_"
 	ActionTriggerPrefix       = "atr_"
@@ -298,6 +309,7 @@ const (
 	TrendPrefix               = "trd_"
 	RankingPrefix             = "rnk_"
 	TimingsPrefix             = "tmg_"
+	HolidayCalendarPrefix     = "hol_"
 	FilterPrefix              = "ftr_"
 	CDRsStatsPrefix           = "cst_"
 	VersionPrefix             = "ver_"
@@ -333,9 +345,12 @@ const (
 	MetaSQSjsonMap            = "*sqs_json_map"
 	MetaKafkajsonMap          = "*kafka_json_map"
 	MetaNatsjsonMap           = "*nats_json_map"
+	MetaMQTTjsonMap           = "*mqtt_json_map"
 	MetaSQL                   = "*sql"
 	MetaMySQL                 = "*mysql"
 	MetaS3jsonMap             = "*s3_json_map"
+	MetaLocal                 = "*local"
+	MetaS3                    = "*s3"
 	ConfigPath                = "/etc/cgrates/"
 	DisconnectCause           = "DisconnectCause"
 	MetaRating                = "*rating"
@@ -439,6 +454,8 @@ const (
 	XMLSuffix                = ".xml"
 	CSVSuffix                = ".csv"
 	FWVSuffix                = ".fwv"
+	ParquetSuffix            = ".parquet"
+	AvroSuffix               = ".avro"
 	ContentJSON              = "json"
 	ContentForm              = "form"
 	FileLockPrefix           = "file_"
@@ -448,6 +465,8 @@ const (
 	MetaVirt                 = "*virt"
 	MetaElastic              = "*els"
 	MetaFileFWV              = "*file_fwv"
+	MetaFileParquet          = "*file_parquet"
+	MetaFileAvro             = "*file_avro"
 	MetaFile                 = "*file"
 	Accounts                 = "Accounts"
 	AccountService           = "AccountS"
@@ -462,6 +481,7 @@ const (
 	UpdateTime               = "UpdateTime"
 	SharedGroups             = "SharedGroups"
 	Timings                  = "Timings"
+	HolidayCalendars         = "HolidayCalendars"
 	Rates                    = "Rates"
 	DestinationRates         = "DestinationRates"
 	RatingPlans              = "RatingPlans"
@@ -537,6 +557,14 @@ const (
 	TrendUpdate           = "TrendUpdate"
 	RankingUpdate         = "RankingUpdate"
 	ResourceUpdate        = "ResourceUpdate"
+	DataDBUpdate          = "DataDBUpdate"
+	LogRecordEv           = "LogRecord"
+	LogTimestampFld       = "Timestamp"
+	LogLevelFld           = "Level"
+	LogNodeIDFld          = "NodeID"
+	LogSubsystemFld       = "Subsystem"
+	LogMessageFld         = "Message"
+	ItemType              = "ItemType"
 	CDR                   = "CDR"
 	CDRs                  = "CDRs"
 	ExpiryTime            = "ExpiryTime"
@@ -569,6 +597,7 @@ const (
 	MetaQOS                  = "*qos"
 	MetaReas                 = "*reas"
 	MetaReds                 = "*reds"
+	MetaComposite            = "*composite"
 	Weight                   = "Weight"
 	Limit                    = "Limit"
 	UsageTTL                 = "UsageTTL"
@@ -732,6 +761,7 @@ const (
 	Local                   = "local"
 	TCP                     = "tcp"
 	UDP                     = "udp"
+	SCTP                    = "sctp"
 	VersionName             = "Version"
 	MetaTenant              = "*tenant"
 	ResourceUsage           = "ResourceUsage"
@@ -755,6 +785,8 @@ const (
 	MetaReload              = "*reload"
 	MetaLoad                = "*load"
 	MetaFloat64             = "*float64"
+	MetaInt64               = "*int64"
+	MetaTimestamp           = "*timestamp"
 	MetaRemove              = "*remove"
 	MetaRemoveAll           = "*removeall"
 	MetaStore               = "*store"
@@ -793,6 +825,8 @@ const (
 	EEs                     = "EEs"
 	Ratio                   = "Ratio"
 	Load                    = "Load"
+	Score                   = "Score"
+	Scores                  = "Scores"
 	Slash                   = "/"
 	UUID                    = "UUID"
 	Uuid                    = "Uuid"
@@ -826,7 +860,11 @@ const (
 	MaxCost                 = "MaxCost"
 	MetaLoaders             = "*loaders"
 	TmpSuffix               = ".tmp"
+	CorruptedSuffix         = ".corrupted"
 	MetaDiamreq             = "*diamreq"
+	MetaDiamrep             = "*diamrep"
+	MetaRelay               = "*relay"
+	MetaPeers               = "*peers"
 	MetaRadDAReq            = "*radDAReq"
 	MetaRadCoATemplate      = "*radCoATemplate"
 	MetaRadDMRTemplate      = "*radDMRTemplate"
@@ -860,6 +898,8 @@ const (
 	APIKey                  = "ApiKey"
 	RouteID                 = "RouteID"
 	MetaMonthlyEstimated    = "*monthly_estimated"
+	MetaHolidays            = "*holidays"
+	MetaEaster              = "*easter"
 	MetaProcessedProfileIDs = "*processedProfileIDs"
 	MetaAttrPrfTenantID     = "*apTenantID"
 	HashtagSep              = "#"
@@ -868,6 +908,8 @@ const (
 	InvalidUsage            = -1
 	InvalidDuration         = time.Duration(-1)
 	Schedule                = "Schedule"
+	HolidayDate             = "Date"
+	HolidayName             = "Name"
 	ActionFilterIDs         = "ActionFilterIDs"
 	ActionBlocker           = "ActionBlocker"
 	ActionTTL               = "ActionTTL"
@@ -982,6 +1024,7 @@ const (
 	OSThreadsInUse = "os_threads_in_use"
 	CAPSAllocated  = "caps_allocated"
 	CAPSPeak       = "caps_peak"
+	RateLimitStats = "rate_limit_stats"
 	RunningSince   = "running_since"
 	OpenFiles      = "open_files"
 	CPUTime        = "cpu_time"
@@ -1008,6 +1051,7 @@ const (
 	MetaStatQueueProfiles   = "*statqueue_profiles"
 	MetaStatQueues          = "*statqueues"
 	MetaRankingProfiles     = "*ranking_profiles"
+	MetaHolidayCalendars    = "*holiday_calendars"
 	MetaTrendProfiles       = "*trend_profiles"
 	MetaThresholdProfiles   = "*threshold_profiles"
 	MetaRouteProfiles       = "*route_profiles"
@@ -1029,19 +1073,25 @@ const (
 
 // MetaMetrics
 const (
-	MetaASR      = "*asr"
-	MetaACD      = "*acd"
-	MetaTCD      = "*tcd"
-	MetaACC      = "*acc"
-	MetaTCC      = "*tcc"
-	MetaPDD      = "*pdd"
-	MetaDDC      = "*ddc"
-	MetaSum      = "*sum"
-	MetaAverage  = "*average"
-	MetaDistinct = "*distinct"
-	MetaRAR      = "*rar"
-	MetaDMR      = "*dmr"
-	MetaCoA      = "*coa"
+	MetaASR        = "*asr"
+	MetaACD        = "*acd"
+	MetaTCD        = "*tcd"
+	MetaACC        = "*acc"
+	MetaTCC        = "*tcc"
+	MetaPDD        = "*pdd"
+	MetaDDC        = "*ddc"
+	MetaSum        = "*sum"
+	MetaAverage    = "*average"
+	MetaDistinct   = "*distinct"
+	MetaMedian     = "*median"
+	MetaStdDev     = "*stddev"
+	MetaHistogram  = "*histogram"
+	MetaPercentile = "*p" // prefix for the *pXX metrics, eg: *p95
+	MetaOneEvent   = "*one_event"
+	PositiveInf    = "+Inf"
+	MetaRAR        = "*rar"
+	MetaDMR        = "*dmr"
+	MetaCoA        = "*coa"
 )
 
 // Services
@@ -1172,6 +1222,7 @@ const (
 	CapRouteProfiles        = "RouteProfiles"
 	CapThresholds           = "Thresholds"
 	CapStatQueues           = "StatQueues"
+	CapFinalUnit            = "FinalUnit"
 )
 
 // cgr-tester
@@ -1198,6 +1249,7 @@ const (
 	TpResources          = "TpResources"
 	TpRates              = "TpRates"
 	TpTiming             = "TpTiming"
+	TpHolidayCalendars   = "TpHolidayCalendars"
 	TpResource           = "TpResource"
 	TpDestinations       = "TpDestinations"
 	TpRatingPlan         = "TpRatingPlan"
@@ -1215,6 +1267,10 @@ const (
 	MetaRoundRobin     = "*round_robin"
 	MetaRatio          = "*ratio"
 	MetaDefaultRatio   = "*default_ratio"
+	MetaConsistentHash = "*consistent_hash"
+	MetaLeastLatency   = "*least_latency"
+	MetaHashField      = "*hash_field"
+	MetaVirtualNodes   = "*virtual_nodes"
 	ThresholdSv1       = "ThresholdSv1"
 	StatSv1            = "StatSv1"
 	TrendSv1           = "TrendSv1"
@@ -1240,6 +1296,7 @@ const (
 	MetaEmpty              = "*empty"
 	MetaExists             = "*exists"
 	MetaTimings            = "*timings"
+	MetaHoliday            = "*holiday"
 	MetaRSR                = "*rsr"
 	MetaDestinations       = "*destinations"
 	MetaLessThan           = "*lt"
@@ -1265,6 +1322,7 @@ const (
 	MetaNotEmpty              = "*notempty"
 	MetaNotExists             = "*notexists"
 	MetaNotTimings            = "*nottimings"
+	MetaNotHoliday            = "*notholiday"
 	MetaNotRSR                = "*notrsr"
 	MetaNotStatS              = "*notstats"
 	MetaNotDestinations       = "*notdestinations"
@@ -1297,6 +1355,7 @@ const (
 	ReplicatorSv1GetTrend                = "ReplicatorSv1.GetTrend"
 	ReplicatorSv1GetTrendProfile         = "ReplicatorSv1.GetTrendProfile"
 	ReplicatorSv1GetTiming               = "ReplicatorSv1.GetTiming"
+	ReplicatorSv1GetHolidayCalendar      = "ReplicatorSv1.GetHolidayCalendar"
 	ReplicatorSv1GetResource             = "ReplicatorSv1.GetResource"
 	ReplicatorSv1GetResourceProfile      = "ReplicatorSv1.GetResourceProfile"
 	ReplicatorSv1GetActionTriggers       = "ReplicatorSv1.GetActionTriggers"
@@ -1326,6 +1385,7 @@ const (
 	ReplicatorSv1SetTrend                = "ReplicatorSv1.SetTrend"
 	ReplicatorSv1SetTrendProfile         = "ReplicatorSv1.SetTrendProfile"
 	ReplicatorSv1SetTiming               = "ReplicatorSv1.SetTiming"
+	ReplicatorSv1SetHolidayCalendar      = "ReplicatorSv1.SetHolidayCalendar"
 	ReplicatorSv1SetResource             = "ReplicatorSv1.SetResource"
 	ReplicatorSv1SetResourceProfile      = "ReplicatorSv1.SetResourceProfile"
 	ReplicatorSv1SetActionTriggers       = "ReplicatorSv1.SetActionTriggers"
@@ -1355,6 +1415,7 @@ const (
 	ReplicatorSv1RemoveTrend             = "ReplicatorSv1.RemoveTrend"
 	ReplicatorSv1RemoveTrendProfile      = "ReplicatorSv1.RemoveTrendProfile"
 	ReplicatorSv1RemoveTiming            = "ReplicatorSv1.RemoveTiming"
+	ReplicatorSv1RemoveHolidayCalendar   = "ReplicatorSv1.RemoveHolidayCalendar"
 	ReplicatorSv1RemoveResource          = "ReplicatorSv1.RemoveResource"
 	ReplicatorSv1RemoveResourceProfile   = "ReplicatorSv1.RemoveResourceProfile"
 	ReplicatorSv1RemoveActionTriggers    = "ReplicatorSv1.RemoveActionTriggers"
@@ -1421,6 +1482,8 @@ const (
 	APIerSv1SetStorDBVersions                 = "APIerSv1.SetStorDBVersions"
 	APIerSv1GetAccountActionPlan              = "APIerSv1.GetAccountActionPlan"
 	APIerSv1ComputeActionPlanIndexes          = "APIerSv1.ComputeActionPlanIndexes"
+	APIerSv1SnapshotDataDB                    = "APIerSv1.SnapshotDataDB"
+	APIerSv1SnapshotStorDB                    = "APIerSv1.SnapshotStorDB"
 	APIerSv1GetActions                        = "APIerSv1.GetActions"
 	APIerSv1GetActionPlan                     = "APIerSv1.GetActionPlan"
 	APIerSv1GetActionPlanIDs                  = "APIerSv1.GetActionPlanIDs"
@@ -1556,11 +1619,16 @@ const (
 	APIerSv1GetSharedGroup                    = "APIerSv1.GetSharedGroup"
 	APIerSv1RemoveActionTrigger               = "APIerSv1.RemoveActionTrigger"
 	APIerSv1GetAccount                        = "APIerSv1.GetAccount"
+	APIerSv1GetAccounts                       = "APIerSv1.GetAccounts"
 	APIerSv1GetAttributeProfileCount          = "APIerSv1.GetAttributeProfileCount"
 	APIerSv1GetMaxUsage                       = "APIerSv1.GetMaxUsage"
 	APIerSv1GetTiming                         = "APIerSv1.GetTiming"
 	APIerSv1SetTiming                         = "APIerSv1.SetTiming"
 	APIerSv1RemoveTiming                      = "APIerSv1.RemoveTiming"
+	APIerSv1GetHolidayCalendar                = "APIerSv1.GetHolidayCalendar"
+	APIerSv1GetHolidayCalendarIDs             = "APIerSv1.GetHolidayCalendarIDs"
+	APIerSv1SetHolidayCalendar                = "APIerSv1.SetHolidayCalendar"
+	APIerSv1RemoveHolidayCalendar             = "APIerSv1.RemoveHolidayCalendar"
 	APIerSV1GetAccountCost                    = "APIerSv1.GetAccountCost"
 	APIerSV1TimingIsActiveAt                  = "APIerSv1.TimingIsActiveAt"
 )
@@ -1821,6 +1889,7 @@ const (
 	DispatcherSv1RemoteStatus        = "DispatcherSv1.RemoteStatus"
 	DispatcherSv1RemoteSleep         = "DispatcherSv1.RemoteSleep"
 	DispatcherSv1RemotePing          = "DispatcherSv1.RemotePing"
+	DispatcherSv1GetHostsHealth      = "DispatcherSv1.GetHostsHealth"
 )
 
 // RegistrarS APIs
@@ -1907,9 +1976,10 @@ const (
 
 // EEs
 const (
-	EeSv1             = "EeSv1"
-	EeSv1Ping         = "EeSv1.Ping"
-	EeSv1ProcessEvent = "EeSv1.ProcessEvent"
+	EeSv1               = "EeSv1"
+	EeSv1Ping           = "EeSv1.Ping"
+	EeSv1ProcessEvent   = "EeSv1.ProcessEvent"
+	EeSv1GetRetryQueues = "EeSv1.GetRetryQueues"
 )
 
 // ERs
@@ -1953,6 +2023,7 @@ const (
 	StatsCsv              = "Stats.csv"
 	TrendsCsv             = "Trends.csv"
 	RankingsCsv           = "Rankings.csv"
+	HolidayCalendarsCsv   = "HolidayCalendars.csv"
 	ThresholdsCsv         = "Thresholds.csv"
 	FiltersCsv            = "Filters.csv"
 	RoutesCsv             = "Routes.csv"
@@ -1978,6 +2049,7 @@ const (
 	TBLTPResources        = "tp_resources"
 	TBLTPStats            = "tp_stats"
 	TBLTPRankings         = "tp_rankings"
+	TBLTPHolidayCalendars = "tp_holiday_calendars"
 	TBLTPTrends           = "tp_trends"
 	TBLTPThresholds       = "tp_thresholds"
 	TBLTPFilters          = "tp_filters"
@@ -2006,6 +2078,7 @@ const (
 	CacheResources               = "*resources"
 	CacheResourceProfiles        = "*resource_profiles"
 	CacheTimings                 = "*timings"
+	CacheHolidayCalendars        = "*holiday_calendars"
 	CacheEventResources          = "*event_resources"
 	CacheStatQueueProfiles       = "*statqueue_profiles"
 	CacheStatQueues              = "*statqueues"
@@ -2068,6 +2141,7 @@ const (
 	CacheTBLTPStats            = "*tp_stats"
 	CacheTBLTPTrends           = "*tp_trends"
 	CacheTBLTPRankings         = "*tp_rankings"
+	CacheTBLTPHolidayCalendars = "*tp_holiday_calendars"
 	CacheTBLTPThresholds       = "*tp_thresholds"
 	CacheTBLTPFilters          = "*tp_filters"
 	CacheSessionCostsTBL       = "*session_costs"
@@ -2102,6 +2176,7 @@ const (
 	HTTPAgent       = "HTTPAgent"
 	SIPAgent        = "SIPAgent"
 	JanusAgent      = "JanusAgent"
+	PrometheusAgent = "PrometheusAgent"
 )
 
 // Google_API
@@ -2127,6 +2202,9 @@ const (
 	NodeIDCfg               = "node_id"
 	LoggerCfg               = "logger"
 	LogLevelCfg             = "log_level"
+	LogLevelsCfg            = "log_levels"
+	LoggerEEsConnsCfg       = "logger_ees_conns"
+	LoggerExporterIDsCfg    = "logger_exporter_ids"
 	RoundingDecimalsCfg     = "rounding_decimals"
 	DBDataEncodingCfg       = "dbdata_encoding"
 	TpExportPathCfg         = "tpexport_dir"
@@ -2174,6 +2252,10 @@ const (
 	OptsCfg                = "opts"
 	Tenants                = "tenants"
 	MysqlLocation          = "mysqlLocation"
+
+	InternalDBDumpPathCfg         = "internalDBDumpPath"
+	InternalDBFsyncIntervalCfg    = "internalDBFsyncInterval"
+	InternalDBSnapshotIntervalCfg = "internalDBSnapshotInterval"
 )
 
 // DataDbCfg
@@ -2234,6 +2316,7 @@ const (
 	RPCJSONTLSListenCfg = "rpc_json_tls"
 	RPCGOBTLSListenCfg  = "rpc_gob_tls"
 	HTTPTLSListenCfg    = "http_tls"
+	GRPCListenCfg       = "grpc"
 )
 
 // HTTPCfg
@@ -2286,6 +2369,7 @@ const (
 	BalanceRatingSubjectCfg    = "balance_rating_subject"
 	MaxIncrementsCfg           = "max_increments"
 	FallbackDepthCfg           = "fallback_depth"
+	DecimalBalancesCfg         = "decimal_balances"
 )
 
 // SchedulerCfg
@@ -2392,10 +2476,21 @@ const (
 	ASRTemplateCfg       = "asr_template"
 	RARTemplateCfg       = "rar_template"
 	ForcedDisconnectCfg  = "forced_disconnect"
+	PeersCfg             = "peers"
+	RealmCfg             = "realm"
+	ApplicationIDsCfg    = "application_ids"
+	WatchdogIntervalCfg  = "watchdog_interval"
+	ReconnectIntervalCfg = "reconnect_interval"
+	RelayTimeoutCfg      = "relay_timeout"
 	TemplatesCfg         = "templates"
 	RequestProcessorsCfg = "request_processors"
 
 	JanusConnsCfg = "janus_conns"
+
+	// PrometheusAgentCfg
+	StatQueueIDsCfg = "stat_queue_ids"
+	ResourceIDsCfg  = "resource_ids"
+	TrendIDsCfg     = "trend_ids"
 	// RequestProcessor
 	RequestFieldsCfg = "request_fields"
 	ReplyFieldsCfg   = "reply_fields"
@@ -2440,12 +2535,16 @@ const (
 	AttributeContextCfg   = "attribute_context"
 	AttributeIDsCfg       = "attribute_ids"
 	ConcurrentRequestsCfg = "concurrent_requests"
+	RetryQueueDirCfg      = "retry_queue_dir"
+	RetryBackoffCfg       = "retry_backoff"
+	RetryMaxBackoffCfg    = "retry_max_backoff"
 
 	//LoaderSCfg
 	DryRunCfg       = "dry_run"
 	LockFilePathCfg = "lockfile_path"
 	TpInDirCfg      = "tp_in_dir"
 	TpOutDirCfg     = "tp_out_dir"
+	SourceTypeCfg   = "source_type"
 	DataCfg         = "data"
 
 	DefaultRatioCfg           = "default_ratio"
@@ -2459,6 +2558,9 @@ const (
 	TransportCfg              = "transport"
 	StrategyCfg               = "strategy"
 	DynaprepaidActionplansCfg = "dynaprepaid_actionplans"
+	LeaderElectionCfg         = "leader_election"
+	LeaseTTLCfg               = "lease_ttl"
+	CatchUpPolicyCfg          = "catchup_policy"
 
 	//RateSCfg
 	RateIndexedSelectsCfg      = "rate_indexed_selects"
@@ -2490,14 +2592,26 @@ const (
 	CapsStrategyCfg      = "caps_strategy"
 	CapsStatsIntervalCfg = "caps_stats_interval"
 	ShutdownTimeoutCfg   = "shutdown_timeout"
+	RateLimitsCfg        = "rate_limits"
+	TenantsCfg           = "tenants"
+	APIsCfg              = "apis"
+	PerTenantCfg         = "per_tenant"
+	PerAPICfg            = "per_api"
+	PerConnectionCfg     = "per_connection"
+	RateCfg              = "rate"
+	BurstCfg             = "burst"
 
 	// AccountSCfg
 	MaxIterations = "max_iterations"
 	MaxUsage      = "max_usage"
 
 	// DispatcherSCfg
-	AnySubsystemCfg = "any_subsystem"
-	PreventLoopCfg  = "prevent_loop"
+	AnySubsystemCfg        = "any_subsystem"
+	PreventLoopCfg         = "prevent_loop"
+	HealthCheckIntervalCfg = "health_check_interval"
+	HealthCheckMethodCfg   = "health_check_method"
+	UnhealthyThresholdCfg  = "unhealthy_threshold"
+	HealthyThresholdCfg    = "healthy_threshold"
 )
 
 // FC Template
@@ -2519,6 +2633,7 @@ const (
 	CostShiftDigitsCfg = "cost_shift_digits"
 	MaskDestIDCfg      = "mask_destinationd_id"
 	MaskLenCfg         = "mask_length"
+	ValueTypeCfg       = "value_type"
 )
 
 // SureTax
@@ -2705,6 +2820,8 @@ const (
 	OptsSessionsTTLUsage     = "*sessionsTTLUsage"
 	OptsDebitInterval        = "*sessionsDebitInterval"
 	OptsChargeable           = "*sessionsChargeable"
+	OptsAlterVariables       = "*alterVariables" // event fields pushed as variables into the running call
+	OptsAlterMaxUsage        = "*alterMaxUsage"  // new maximum usage of the running call, counted from now on
 	// STIR
 	OptsStirATest              = "*stirATest"
 	OptsStirPayloadMaxDuration = "*stirPayloadMaxDuration"
@@ -2809,6 +2926,7 @@ const (
 	SQLDBNameOpt              = "sqlDBName"
 	SQLTableNameOpt           = "sqlTableName"
 	SQLDeleteIndexedFieldsOpt = "sqlDeleteIndexedFields"
+	SQLColumnsOpt             = "sqlColumns"
 
 	SQLMaxOpenConns    = "sqlMaxOpenConns"
 	SQLConnMaxLifetime = "sqlConnMaxLifetime"
@@ -2820,6 +2938,9 @@ const (
 	CSVLazyQuotes       = "csvLazyQuotes"
 	HeaderDefineCharOpt = "csvHeaderDefineChar"
 
+	// fileParquet and fileAvro
+	RowGroupSizeOpt = "rowGroupSize"
+
 	// fileXML
 	XMLRootPathOpt = "xmlRootPath"
 
@@ -2847,6 +2968,22 @@ const (
 	KafkaSkipTLSVerify = "kafkaSkipTLSVerify"
 	KafkaGroupID       = "kafkaGroupID"
 	KafkaMaxWait       = "kafkaMaxWait"
+	KafkaSASLMechanism = "kafkaSASLMechanism"
+	KafkaSASLUsername  = "kafkaSASLUsername"
+	KafkaSASLPassword  = "kafkaSASLPassword"
+	KafkaKeyField      = "kafkaKeyField"
+	KafkaHeaders       = "kafkaHeaders"
+
+	KafkaSASLPlain       = "plain"
+	KafkaSASLScramSHA256 = "scram-sha-256"
+	KafkaSASLScramSHA512 = "scram-sha-512"
+
+	// fields exposed by the *kafka data provider
+	MetaKafka      = "*kafka"
+	KafkaKey       = "Key"
+	KafkaPartition = "Partition"
+	KafkaOffset    = "Offset"
+	KafkaHeadersDP = "Headers"
 
 	// partial
 	PartialOpt = "*partial"
@@ -2899,6 +3036,26 @@ const (
 	NatsJetStream            = "natsJetStream"
 	NatsJetStreamMaxWait     = "natsJetStreamMaxWait"
 
+	// mqtt
+	MQTTTopic                = "mqttTopic"
+	MQTTQoS                  = "mqttQoS"
+	MQTTClientID             = "mqttClientID"
+	MQTTUsername             = "mqttUsername"
+	MQTTPassword             = "mqttPassword"
+	MQTTSharedGroup          = "mqttSharedGroup"
+	MQTTRetained             = "mqttRetained"
+	MQTTCertificateAuthority = "mqttCertificateAuthority"
+	MQTTClientCertificate    = "mqttClientCertificate"
+	MQTTClientKey            = "mqttClientKey"
+	MQTTSkipTLSVerify        = "mqttSkipTLSVerify"
+	MQTTSharedPrefix         = "$share/"
+
+	// fields exposed by the *mqtt data provider
+	MetaMQTT        = "*mqtt"
+	MQTTMsgTopic    = "Topic"
+	MQTTMsgQoS      = "QoS"
+	MQTTMsgRetained = "Retained"
+
 	// rpc
 	RpcCodec        = "rpcCodec"
 	ServiceMethod   = "serviceMethod"
@@ -2989,8 +3146,10 @@ const (
 	CacheSAddress     = "caches_address"
 	SchedulerAddress  = "scheduler_address"
 	//Cgr migrator
-	CgrMigrator = "cgr-migrator"
-	ExecCgr     = "exec"
+	CgrMigrator   = "cgr-migrator"
+	ExecCgr       = "exec"
+	DataDBDumpCgr = "datadb_dump_path"
+	StorDBDumpCgr = "stordb_dump_path"
 )
 
 // SessionS disconnect causes
@@ -56,6 +56,30 @@ func SubstractDecimal(x, y *Decimal) *Decimal {
 	return &Decimal{new(decimal.Big).Sub(x.Big, y.Big)}
 }
 
+// SumDecimal adds two Decimals and returns the result
+func SumDecimal(x, y *Decimal) *Decimal {
+	return &Decimal{new(decimal.Big).Add(x.Big, y.Big)}
+}
+
+// RoundDecimal rounds the Decimal to prec decimals following the same methods as Round
+func RoundDecimal(x *Decimal, prec int, method string) *Decimal {
+	z := new(decimal.Big).Copy(x.Big)
+	switch method {
+	case MetaRoundingUp:
+		z.Context.RoundingMode = decimal.ToPositiveInf
+	case MetaRoundingDown:
+		z.Context.RoundingMode = decimal.ToNegativeInf
+	case MetaRoundingMiddle:
+		z.Context.RoundingMode = decimal.ToNearestAway
+	default:
+		return &Decimal{z}
+	}
+	z.Context.Precision = decimal.MaxPrecision // do not lose the integer digits
+	z.Quantize(prec)
+	z.Context = decimal.Context{}
+	return &Decimal{z}
+}
+
 // NewDecimalFromFloat64 is a constructor for Decimal out of float64
 // passing through string is necessary due to differences between decimal and binary representation of float64
 func NewDecimalFromFloat64(f float64) *Decimal {
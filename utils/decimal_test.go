@@ -206,3 +206,28 @@ func TestDecimalClone(t *testing.T) {
 		t.Errorf("Expected <+%v> but received <+%v>", d, rcv)
 	}
 }
+
+func TestRoundDecimal(t *testing.T) {
+	for _, tc := range []struct {
+		val    string
+		method string
+		exp    string
+	}{
+		{"0.1234565", MetaRoundingMiddle, "0.123457"},
+		{"0.1234561", MetaRoundingUp, "0.123457"},
+		{"0.1234569", MetaRoundingDown, "0.123456"},
+		{"123456789012.1234565", MetaRoundingMiddle, "123456789012.123457"},
+		{"9", MetaRoundingMiddle, "9.000000"},
+		{"0.1234565", EmptyString, "0.1234565"},
+	} {
+		d, err := NewDecimalFromString(tc.val)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rcv := RoundDecimal(d, 6, tc.method); rcv.String() != tc.exp {
+			t.Errorf("Expected %s rounded %s to be %s, received %s", tc.val, tc.method, tc.exp, rcv)
+		} else if d.String() != tc.val {
+			t.Errorf("Expected the original value %s unchanged, received %s", tc.val, d)
+		}
+	}
+}
@@ -78,11 +78,14 @@ var (
 	ErrServiceAlreadyRunning            = fmt.Errorf("service already running")
 	ErrMaxConcurrentRPCExceededNoCaps   = errors.New("max concurrent rpc exceeded") // on internal we return this error for concureq
 	ErrMaxConcurrentRPCExceeded         = errors.New("MAX_CONCURRENT_RPC_EXCEEDED") // but the codec will rewrite it with this one to be sure that we corectly dealocate the request
+	ErrRateLimitExceeded                = errors.New("RATE_LIMIT_EXCEEDED")
 	ErrMaxIterationsReached             = errors.New("maximum iterations reached")
 	ErrNegative                         = errors.New("NEGATIVE")
 	ErrCastFailed                       = errors.New("CAST_FAILED")
 	ErrNoBackupFound                    = errors.New("NO_BACKUP_FOUND")
 	ErrCorrelationUndefined             = errors.New("CORRELATION_UNDEFINED")
+	ErrDumpNotEnabled                   = errors.New("DUMP_NOT_ENABLED")
+	ErrMixedServices                    = errors.New("MIXED_SERVICES") // services received for a session not charged per service
 
 	ErrMap = map[string]error{
 		ErrNoMoreData.Error():                       ErrNoMoreData,
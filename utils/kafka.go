@@ -0,0 +1,47 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package utils
+
+import (
+	"fmt"
+
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+)
+
+// NewKafkaSASLMechanism returns the SASL mechanism used to authenticate towards the kafka brokers,
+// nil if the mechanism is empty
+func NewKafkaSASLMechanism(mechanism, username, password string) (sasl.Mechanism, error) {
+	switch mechanism {
+	case EmptyString:
+		return nil, nil
+	case KafkaSASLPlain:
+		return plain.Mechanism{
+			Username: username,
+			Password: password,
+		}, nil
+	case KafkaSASLScramSHA256:
+		return scram.Mechanism(scram.SHA256, username, password)
+	case KafkaSASLScramSHA512:
+		return scram.Mechanism(scram.SHA512, username, password)
+	default:
+		return nil, fmt.Errorf("unsupported %s: <%s>", KafkaSASLMechanism, mechanism)
+	}
+}
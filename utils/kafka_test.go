@@ -0,0 +1,48 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package utils
+
+import (
+	"testing"
+)
+
+func TestNewKafkaSASLMechanism(t *testing.T) {
+	if mech, err := NewKafkaSASLMechanism(EmptyString, "user", "pass"); err != nil {
+		t.Fatal(err)
+	} else if mech != nil {
+		t.Errorf("expected no mechanism, received %+v", mech)
+	}
+	for mechanism, name := range map[string]string{
+		KafkaSASLPlain:       "PLAIN",
+		KafkaSASLScramSHA256: "SCRAM-SHA-256",
+		KafkaSASLScramSHA512: "SCRAM-SHA-512",
+	} {
+		mech, err := NewKafkaSASLMechanism(mechanism, "user", "pass")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mech.Name() != name {
+			t.Errorf("expected %q, received %q", name, mech.Name())
+		}
+	}
+	expErr := "unsupported kafkaSASLMechanism: <gssapi>"
+	if _, err := NewKafkaSASLMechanism("gssapi", "user", "pass"); err == nil || err.Error() != expErr {
+		t.Errorf("expected error %q, received %v", expErr, err)
+	}
+}
@@ -24,6 +24,8 @@ import (
 	"log/syslog"
 	"reflect"
 	"runtime"
+	"sort"
+	"strings"
 )
 
 var Logger LoggerInterface
@@ -46,8 +48,11 @@ func init() {
 func Newlogger(loggertype, id string) (lgr LoggerInterface, err error) {
 	lgr = &StdLogger{nodeID: id}
 	switch loggertype {
-	case MetaStdLog:
+	case MetaStdLog,
+		MetaEEs: // logs to stdout until the EEs connections are available
 		return
+	case MetaJSON:
+		return NewJSONLogger(id, nil), nil
 	case MetaSysLog:
 		if noSysLog {
 			return
@@ -64,6 +69,7 @@ func Newlogger(loggertype, id string) (lgr LoggerInterface, err error) {
 type LoggerInterface interface {
 	SetSyslog(log *syslog.Writer)
 	SetLogLevel(level int)
+	SetLogLevels(levels map[string]int)
 	Close() error
 	Emerg(m string) error
 	Alert(m string) error
@@ -73,6 +79,7 @@ type LoggerInterface interface {
 	Notice(m string) error
 	Info(m string) error
 	Debug(m string) error
+	LogFields(level int, subsystem, m string, fields map[string]any) error
 	Write(p []byte) (n int, err error)
 }
 
@@ -88,11 +95,84 @@ const (
 	LOGLEVEL_DEBUG
 )
 
+// logLevelNames are the names of the log severities as displayed in the logs
+var logLevelNames = map[int]string{
+	LOGLEVEL_EMERGENCY: "EMERGENCY",
+	LOGLEVEL_ALERT:     "ALERT",
+	LOGLEVEL_CRITICAL:  "CRITICAL",
+	LOGLEVEL_ERROR:     "ERROR",
+	LOGLEVEL_WARNING:   "WARNING",
+	LOGLEVEL_NOTICE:    "NOTICE",
+	LOGLEVEL_INFO:      "INFO",
+	LOGLEVEL_DEBUG:     "DEBUG",
+}
+
+// LogSubsystem returns the subsystem of the message out of its "<Subsystem>" prefix
+// together with the remaining message
+func LogSubsystem(m string) (subsystem, msg string) {
+	if !strings.HasPrefix(m, "<") {
+		return EmptyString, m
+	}
+	end := strings.IndexByte(m, '>')
+	if end == -1 {
+		return EmptyString, m
+	}
+	return m[1:end], strings.TrimPrefix(m[end+1:], " ")
+}
+
+// LogLevelFor returns the log level of the subsystem
+// falling back on the system wide one
+func LogLevelFor(logLevel int, logLevels map[string]int, subsystem string) int {
+	if lvl, has := logLevels[subsystem]; has && subsystem != EmptyString {
+		return lvl
+	}
+	return logLevel
+}
+
+// FormatLogFields appends the fields to the message in key=value format
+func FormatLogFields(subsystem, m string, fields map[string]any) string {
+	if subsystem != EmptyString {
+		m = "<" + subsystem + "> " + m
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		m += " " + k + "=" + IfaceAsString(fields[k])
+	}
+	return m
+}
+
+// LogAtLevel dispatches the message to the method of the logger matching the level
+func LogAtLevel(lgr LoggerInterface, level int, m string) error {
+	switch level {
+	case LOGLEVEL_EMERGENCY:
+		return lgr.Emerg(m)
+	case LOGLEVEL_ALERT:
+		return lgr.Alert(m)
+	case LOGLEVEL_CRITICAL:
+		return lgr.Crit(m)
+	case LOGLEVEL_ERROR:
+		return lgr.Err(m)
+	case LOGLEVEL_WARNING:
+		return lgr.Warning(m)
+	case LOGLEVEL_NOTICE:
+		return lgr.Notice(m)
+	case LOGLEVEL_INFO:
+		return lgr.Info(m)
+	default:
+		return lgr.Debug(m)
+	}
+}
+
 // Logs to standard output
 type StdLogger struct {
-	logLevel int
-	nodeID   string
-	syslog   *syslog.Writer
+	logLevel  int
+	logLevels map[string]int // log level overwrites per subsystem
+	nodeID    string
+	syslog    *syslog.Writer
 }
 
 func (sl *StdLogger) Close() (err error) {
@@ -117,9 +197,28 @@ func (sl *StdLogger) SetLogLevel(level int) {
 	sl.logLevel = level
 }
 
+// SetLogLevels changes the log levels per subsystem
+func (sl *StdLogger) SetLogLevels(levels map[string]int) {
+	sl.logLevels = levels
+}
+
+// levelFor returns the log level for the subsystem of the message
+func (sl *StdLogger) levelFor(m string) int {
+	if len(sl.logLevels) == 0 {
+		return sl.logLevel
+	}
+	subsystem, _ := LogSubsystem(m)
+	return LogLevelFor(sl.logLevel, sl.logLevels, subsystem)
+}
+
+// LogFields logs the message with the fields appended in key=value format
+func (sl *StdLogger) LogFields(level int, subsystem, m string, fields map[string]any) error {
+	return LogAtLevel(sl, level, FormatLogFields(subsystem, m, fields))
+}
+
 // Alert logs to syslog with alert level
 func (sl *StdLogger) Alert(m string) (err error) {
-	if sl.logLevel < LOGLEVEL_ALERT {
+	if sl.levelFor(m) < LOGLEVEL_ALERT {
 		return
 	}
 	if sl.syslog != nil {
@@ -132,7 +231,7 @@ func (sl *StdLogger) Alert(m string) (err error) {
 
 // Crit logs to syslog with critical level
 func (sl *StdLogger) Crit(m string) (err error) {
-	if sl.logLevel < LOGLEVEL_CRITICAL {
+	if sl.levelFor(m) < LOGLEVEL_CRITICAL {
 		return
 	}
 	if sl.syslog != nil {
@@ -145,7 +244,7 @@ func (sl *StdLogger) Crit(m string) (err error) {
 
 // Debug logs to syslog with debug level
 func (sl *StdLogger) Debug(m string) (err error) {
-	if sl.logLevel < LOGLEVEL_DEBUG {
+	if sl.levelFor(m) < LOGLEVEL_DEBUG {
 		return
 	}
 	if sl.syslog != nil {
@@ -158,7 +257,7 @@ func (sl *StdLogger) Debug(m string) (err error) {
 
 // Emerg logs to syslog with emergency level
 func (sl *StdLogger) Emerg(m string) (err error) {
-	if sl.logLevel < LOGLEVEL_EMERGENCY {
+	if sl.levelFor(m) < LOGLEVEL_EMERGENCY {
 		return
 	}
 	if sl.syslog != nil {
@@ -171,7 +270,7 @@ func (sl *StdLogger) Emerg(m string) (err error) {
 
 // Err logs to syslog with error level
 func (sl *StdLogger) Err(m string) (err error) {
-	if sl.logLevel < LOGLEVEL_ERROR {
+	if sl.levelFor(m) < LOGLEVEL_ERROR {
 		return
 	}
 	if sl.syslog != nil {
@@ -184,7 +283,7 @@ func (sl *StdLogger) Err(m string) (err error) {
 
 // Info logs to syslog with info level
 func (sl *StdLogger) Info(m string) (err error) {
-	if sl.logLevel < LOGLEVEL_INFO {
+	if sl.levelFor(m) < LOGLEVEL_INFO {
 		return
 	}
 	if sl.syslog != nil {
@@ -197,7 +296,7 @@ func (sl *StdLogger) Info(m string) (err error) {
 
 // Notice logs to syslog with notice level
 func (sl *StdLogger) Notice(m string) (err error) {
-	if sl.logLevel < LOGLEVEL_NOTICE {
+	if sl.levelFor(m) < LOGLEVEL_NOTICE {
 		return
 	}
 	if sl.syslog != nil {
@@ -210,7 +309,7 @@ func (sl *StdLogger) Notice(m string) (err error) {
 
 // Warning logs to syslog with warning level
 func (sl *StdLogger) Warning(m string) (err error) {
-	if sl.logLevel < LOGLEVEL_WARNING {
+	if sl.levelFor(m) < LOGLEVEL_WARNING {
 		return
 	}
 
@@ -0,0 +1,163 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"log/syslog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogRecord is the structured form of one log message
+type LogRecord struct {
+	Timestamp time.Time      `json:"timestamp"`
+	Level     string         `json:"level"`
+	NodeID    string         `json:"node_id"`
+	Subsystem string         `json:"subsystem,omitempty"`
+	Message   string         `json:"message"`
+	Fields    map[string]any `json:"fields,omitempty"`
+}
+
+// NewLogRecord builds the LogRecord, the subsystem is taken out of
+// the "<Subsystem>" prefix of the message if not specified
+func NewLogRecord(nodeID string, level int, subsystem, m string, fields map[string]any) *LogRecord {
+	if subsystem == EmptyString {
+		subsystem, m = LogSubsystem(m)
+	}
+	return &LogRecord{
+		Timestamp: time.Now(),
+		Level:     logLevelNames[level],
+		NodeID:    nodeID,
+		Subsystem: subsystem,
+		Message:   m,
+		Fields:    fields,
+	}
+}
+
+// NewJSONLogger creates the logger writing JSON records to w
+// or to the output of the standard logger if w is nil
+func NewJSONLogger(nodeID string, w io.Writer) *JSONLogger {
+	return &JSONLogger{
+		nodeID: nodeID,
+		w:      w,
+	}
+}
+
+// JSONLogger logs structured records in JSON format, one per line
+type JSONLogger struct {
+	sync.Mutex
+	logLevel  int
+	logLevels map[string]int // log level overwrites per subsystem
+	nodeID    string
+	w         io.Writer
+}
+
+// SetSyslog is not used by the JSONLogger
+func (jl *JSONLogger) SetSyslog(*syslog.Writer) {}
+
+// SetLogLevel changes the log level
+func (jl *JSONLogger) SetLogLevel(level int) {
+	jl.logLevel = level
+}
+
+// SetLogLevels changes the log levels per subsystem
+func (jl *JSONLogger) SetLogLevels(levels map[string]int) {
+	jl.logLevels = levels
+}
+
+// Close is part of the LoggerInterface
+func (jl *JSONLogger) Close() error {
+	return nil
+}
+
+// Write logs the received bytes as a message with info level
+func (jl *JSONLogger) Write(p []byte) (n int, err error) {
+	if err = jl.LogFields(LOGLEVEL_INFO, EmptyString,
+		strings.TrimSpace(string(p)), nil); err != nil {
+		return
+	}
+	return len(p), nil
+}
+
+// LogFields logs the message together with the fields
+func (jl *JSONLogger) LogFields(level int, subsystem, m string, fields map[string]any) (err error) {
+	if subsystem == EmptyString {
+		subsystem, m = LogSubsystem(m)
+	}
+	if LogLevelFor(jl.logLevel, jl.logLevels, subsystem) < level {
+		return
+	}
+	rec := NewLogRecord(jl.nodeID, level, subsystem, m, fields)
+	var b []byte
+	if b, err = json.Marshal(rec); err != nil {
+		return
+	}
+	w := jl.w
+	if w == nil {
+		w = log.Writer()
+	}
+	jl.Lock()
+	_, err = w.Write(append(b, '\n'))
+	jl.Unlock()
+	return
+}
+
+// Emerg logs with emergency level
+func (jl *JSONLogger) Emerg(m string) error {
+	return jl.LogFields(LOGLEVEL_EMERGENCY, EmptyString, m, nil)
+}
+
+// Alert logs with alert level
+func (jl *JSONLogger) Alert(m string) error {
+	return jl.LogFields(LOGLEVEL_ALERT, EmptyString, m, nil)
+}
+
+// Crit logs with critical level
+func (jl *JSONLogger) Crit(m string) error {
+	return jl.LogFields(LOGLEVEL_CRITICAL, EmptyString, m, nil)
+}
+
+// Err logs with error level
+func (jl *JSONLogger) Err(m string) error {
+	return jl.LogFields(LOGLEVEL_ERROR, EmptyString, m, nil)
+}
+
+// Warning logs with warning level
+func (jl *JSONLogger) Warning(m string) error {
+	return jl.LogFields(LOGLEVEL_WARNING, EmptyString, m, nil)
+}
+
+// Notice logs with notice level
+func (jl *JSONLogger) Notice(m string) error {
+	return jl.LogFields(LOGLEVEL_NOTICE, EmptyString, m, nil)
+}
+
+// Info logs with info level
+func (jl *JSONLogger) Info(m string) error {
+	return jl.LogFields(LOGLEVEL_INFO, EmptyString, m, nil)
+}
+
+// Debug logs with debug level
+func (jl *JSONLogger) Debug(m string) error {
+	return jl.LogFields(LOGLEVEL_DEBUG, EmptyString, m, nil)
+}
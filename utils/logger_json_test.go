@@ -0,0 +1,89 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewLoggerJSON(t *testing.T) {
+	if lgr, err := Newlogger(MetaJSON, "id_json"); err != nil {
+		t.Error(err)
+	} else if _, canCast := lgr.(*JSONLogger); !canCast {
+		t.Errorf("Expected *JSONLogger, received %T", lgr)
+	}
+}
+
+func TestJSONLoggerLogFields(t *testing.T) {
+	output := new(bytes.Buffer)
+	lgr := NewJSONLogger("id_json", output)
+	lgr.SetLogLevel(LOGLEVEL_INFO)
+	lgr.SetLogLevels(map[string]int{SessionS: LOGLEVEL_DEBUG})
+
+	if err := lgr.Debug("<ChargerS> filtered"); err != nil {
+		t.Error(err)
+	}
+	if output.Len() != 0 {
+		t.Errorf("Expected no output, received %q", output.String())
+	}
+	if err := lgr.LogFields(LOGLEVEL_DEBUG, EmptyString, "<SessionS> session started",
+		map[string]any{"OriginID": "abc"}); err != nil {
+		t.Fatal(err)
+	}
+	var rec LogRecord
+	if err := json.Unmarshal(output.Bytes(), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Timestamp.IsZero() {
+		t.Error("Expected timestamp to be populated")
+	}
+	rec.Timestamp = rec.Timestamp.UTC()
+	exp := LogRecord{
+		Timestamp: rec.Timestamp,
+		Level:     "DEBUG",
+		NodeID:    "id_json",
+		Subsystem: SessionS,
+		Message:   "session started",
+		Fields:    map[string]any{"OriginID": "abc"},
+	}
+	if !reflect.DeepEqual(exp, rec) {
+		t.Errorf("Expected %s, received %s", ToJSON(exp), ToJSON(rec))
+	}
+}
+
+func TestJSONLoggerWrite(t *testing.T) {
+	output := new(bytes.Buffer)
+	lgr := NewJSONLogger("id_json", output)
+	lgr.SetLogLevel(LOGLEVEL_INFO)
+	if n, err := lgr.Write([]byte("message\n")); err != nil {
+		t.Error(err)
+	} else if n != 8 {
+		t.Errorf("Expected 8, received %d", n)
+	}
+	var rec LogRecord
+	if err := json.Unmarshal(output.Bytes(), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Level != "INFO" || rec.Message != "message" || rec.Subsystem != EmptyString {
+		t.Errorf("Unexpected record: %s", ToJSON(rec))
+	}
+}
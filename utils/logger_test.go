@@ -407,3 +407,52 @@ func TestLoggerWarning(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestLoggerLogLevelsPerSubsystem(t *testing.T) {
+	output := new(bytes.Buffer)
+	log.SetOutput(output)
+	defer log.SetOutput(os.Stderr)
+
+	newLogger := &StdLogger{nodeID: "id_levels"}
+	newLogger.SetLogLevel(LOGLEVEL_INFO)
+	newLogger.SetLogLevels(map[string]int{SessionS: LOGLEVEL_DEBUG})
+	newLogger.Debug("<ChargerS> not logged")
+	newLogger.Debug("<SessionS> logged")
+	expected := "CGRateS <id_levels> [DEBUG] <SessionS> logged\n"
+	if rcv := output.String(); !strings.HasSuffix(rcv, expected) ||
+		strings.Contains(rcv, "not logged") {
+		t.Errorf("Expected %q, received %q", expected, rcv)
+	}
+}
+
+func TestLoggerLogFields(t *testing.T) {
+	output := new(bytes.Buffer)
+	log.SetOutput(output)
+	defer log.SetOutput(os.Stderr)
+
+	newLogger := &StdLogger{nodeID: "id_fields"}
+	newLogger.SetLogLevel(LOGLEVEL_INFO)
+	if err := newLogger.LogFields(LOGLEVEL_WARNING, SessionS, "session expired",
+		map[string]any{"OriginID": "abc", "Account": "1001"}); err != nil {
+		t.Fatal(err)
+	}
+	expected := "CGRateS <id_fields> [WARNING] <SessionS> session expired Account=1001 OriginID=abc\n"
+	if rcv := output.String(); !strings.HasSuffix(rcv, expected) {
+		t.Errorf("Expected %q, received %q", expected, rcv)
+	}
+}
+
+func TestLogSubsystem(t *testing.T) {
+	for _, tc := range []struct {
+		m, subsystem, msg string
+	}{
+		{"<SessionS> message", SessionS, "message"},
+		{"message", EmptyString, "message"},
+		{"<SessionS message", EmptyString, "<SessionS message"},
+	} {
+		if subsystem, msg := LogSubsystem(tc.m); subsystem != tc.subsystem || msg != tc.msg {
+			t.Errorf("For %q expected <%s> %q, received <%s> %q",
+				tc.m, tc.subsystem, tc.msg, subsystem, msg)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// MQTTConnOpts holds the connection settings shared by the MQTT readers and exporters
+type MQTTConnOpts struct {
+	BrokerURL            string
+	ClientID             string
+	Username             string
+	Password             string
+	CertificateAuthority string
+	ClientCertificate    string
+	ClientKey            string
+	SkipTLSVerify        bool
+	ConnectTimeout       time.Duration
+}
+
+// ClientOptions builds the paho client options out of the connection settings
+func (o *MQTTConnOpts) ClientOptions() (*mqtt.ClientOptions, error) {
+	opts := mqtt.NewClientOptions().
+		AddBroker(o.BrokerURL).
+		SetClientID(o.ClientID).
+		SetConnectTimeout(o.ConnectTimeout).
+		SetAutoReconnect(true)
+	if o.Username != EmptyString {
+		opts.SetUsername(o.Username)
+		opts.SetPassword(o.Password)
+	}
+	switch {
+	case o.ClientCertificate != EmptyString && o.ClientKey == EmptyString:
+		return nil, fmt.Errorf("has certificate but no key")
+	case o.ClientKey != EmptyString && o.ClientCertificate == EmptyString:
+		return nil, fmt.Errorf("has key but no certificate")
+	}
+	if o.CertificateAuthority == EmptyString &&
+		o.ClientCertificate == EmptyString &&
+		!o.SkipTLSVerify {
+		return opts, nil
+	}
+	tlsCfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: o.SkipTLSVerify,
+	}
+	if o.CertificateAuthority != EmptyString {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, err
+		}
+		rootPEM, err := os.ReadFile(o.CertificateAuthority)
+		if err != nil {
+			return nil, fmt.Errorf("mqtt: error loading rootCA file: %v", err)
+		}
+		if !pool.AppendCertsFromPEM(rootPEM) {
+			return nil, fmt.Errorf("mqtt: failed to parse root certificate from %q",
+				o.CertificateAuthority)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if o.ClientCertificate != EmptyString {
+		cert, err := tls.LoadX509KeyPair(o.ClientCertificate, o.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return opts.SetTLSConfig(tlsCfg), nil
+}
+
+// WaitMQTTToken waits for the operation to complete, returning its error
+func WaitMQTTToken(tkn mqtt.Token, timeout time.Duration) error {
+	if timeout > 0 && !tkn.WaitTimeout(timeout) {
+		return fmt.Errorf("mqtt: operation timed out after %s", timeout)
+	}
+	tkn.Wait()
+	return tkn.Error()
+}